@@ -94,6 +94,8 @@ func cmdFetchPieces(cmd *cobra.Command, args []string) (err error) {
 		db.OverlayCache(),
 		db.Reputation(),
 		db.Containment(),
+		db.AuditFailures(),
+		db.RepairNodeStats(),
 		rollupsWriteCache,
 		version.Build,
 		&runCfg.Config,
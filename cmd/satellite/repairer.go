@@ -75,6 +75,8 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 		db.OverlayCache(),
 		db.Reputation(),
 		db.Containment(),
+		db.AuditFailures(),
+		db.RepairNodeStats(),
 		rollupsWriteCache,
 		version.Build,
 		&runCfg.Config,
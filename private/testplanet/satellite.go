@@ -108,7 +108,8 @@ type Satellite struct {
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint        *inspector.Endpoint
+		OverlayEndpoint *inspector.OverlayEndpoint
 	}
 
 	Orders struct {
@@ -585,6 +586,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Metabase.SegmentLoop = peer.Metainfo.SegmentLoop
 
 	system.Inspector.Endpoint = api.Inspector.Endpoint
+	system.Inspector.OverlayEndpoint = api.Inspector.OverlayEndpoint
 
 	system.Orders.DB = api.Orders.DB
 	system.Orders.Endpoint = api.Orders.Endpoint
@@ -673,7 +675,7 @@ func (planet *Planet) newRepairer(ctx context.Context, index int, identity *iden
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
+	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.Reputation(), db.Containment(), db.AuditFailures(), db.RepairNodeStats(), rollupsWriteCache, versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -5,8 +5,10 @@ package web
 
 import (
 	"context"
+	"math"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -84,6 +86,9 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 		}
 		limit := rl.getUserLimit(key)
 		if !limit.Allow() {
+			if delay := retryAfter(limit); delay > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			}
 			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
 			return
 		}
@@ -91,6 +96,17 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 	})
 }
 
+// retryAfter returns how long until the limiter allows another request, or zero when it never will.
+func retryAfter(limit *rate.Limiter) time.Duration {
+	reservation := limit.Reserve()
+	defer reservation.Cancel()
+
+	if !reservation.OK() {
+		return 0
+	}
+	return reservation.Delay()
+}
+
 // GetRequestIP gets the original IP address of the request by handling the request headers.
 func GetRequestIP(r *http.Request) (ip string, err error) {
 	realIP := r.Header.Get("X-REAL-IP")
@@ -67,4 +67,5 @@ func testWithAddress(ctx context.Context, t *testing.T, remoteAddress string, bu
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, req)
 	assert.Equal(t, rr.Code, http.StatusTooManyRequests, remoteAddress)
+	assert.NotEmpty(t, rr.Header().Get("Retry-After"), remoteAddress)
 }
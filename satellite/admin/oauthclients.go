@@ -4,8 +4,11 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -31,6 +34,14 @@ func (server *Server) createOAuthClient(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if oauthClient.ClaimTemplate != nil {
+		err = oauthClient.ClaimTemplate.Validate()
+		if err != nil {
+			sendJSONError(w, "invalid claim template", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	err = server.db.OIDC().OAuthClients().Create(r.Context(), oauthClient)
 	if err != nil {
 		sendJSONError(w, "failed to create client", err.Error(), http.StatusInternalServerError)
@@ -56,6 +67,14 @@ func (server *Server) updateOAuthClient(w http.ResponseWriter, r *http.Request)
 
 	oauthClient.ID = id
 
+	if oauthClient.ClaimTemplate != nil {
+		err = oauthClient.ClaimTemplate.Validate()
+		if err != nil {
+			sendJSONError(w, "invalid claim template", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	err = server.db.OIDC().OAuthClients().Update(r.Context(), oauthClient)
 	if err != nil {
 		sendJSONError(w, "failed to update client", err.Error(), http.StatusInternalServerError)
@@ -80,3 +99,108 @@ func (server *Server) deleteOAuthClient(w http.ResponseWriter, r *http.Request)
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func (server *Server) rotateOAuthClientSecret(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "missing required client id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	secret, err := oidc.NewService(server.db.OIDC()).RotateClientSecret(r.Context(), id, server.console.OauthClientSecretOverlap)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "client does not exist", "", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to rotate client secret", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Secret string `json:"secret"`
+	}{
+		Secret: string(secret),
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) finalizeOAuthClientSecret(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "missing required client id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = oidc.NewService(server.db.OIDC()).FinalizeClientSecretRotation(r.Context(), id)
+	if err != nil {
+		sendJSONError(w, "failed to finalize client secret rotation", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) denyOAuthClient(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "missing required client id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var revokeTokens bool
+	if revoke := r.URL.Query().Get("revoke"); revoke != "" {
+		revokeTokens, err = strconv.ParseBool(revoke)
+		if err != nil {
+			sendJSONError(w, "invalid revoke parameter", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	revoked, err := oidc.NewService(server.db.OIDC()).DenyClient(r.Context(), id, revokeTokens)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "client does not exist", "", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to deny client", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		RevokedTokens int64 `json:"revokedTokens"`
+	}{
+		RevokedTokens: revoked,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) allowOAuthClient(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "missing required client id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = oidc.NewService(server.db.OIDC()).AllowClient(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "client does not exist", "", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to allow client", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -45,6 +45,14 @@ func TestAdminOAuthAPI(t *testing.T) {
 		client := oidc.OAuthClient{ID: id, Secret: []byte("badadmin"), UserID: userID, RedirectURL: "http://localhost:1234"}
 		updated := client
 		updated.RedirectURL = "http://localhost:1235"
+		updated.ClaimTemplate = &oidc.ClaimTemplate{Claims: []string{"name"}, Static: map[string]interface{}{"tenant": "acme"}}
+
+		invalidID, err := uuid.New()
+		require.NoError(t, err)
+		invalid := client
+		invalid.ID = invalidID
+		invalid.ClaimTemplate = &oidc.ClaimTemplate{Static: map[string]interface{}{"email": "spoofed@mail.test"}}
+		invalidUpdate := oidc.OAuthClient{ClaimTemplate: invalid.ClaimTemplate}
 
 		testCases := []struct {
 			name    string
@@ -53,8 +61,10 @@ func TestAdminOAuthAPI(t *testing.T) {
 			status  int
 		}{
 			{"create - bad request", "", empty, 400},
+			{"create - invalid claim template", "", invalid, 400},
 			{"create - success", "", client, 200},
 			{"update - empty", id.String(), empty, 200},
+			{"update - invalid claim template", id.String(), invalidUpdate, 400},
 			{"update - success", id.String(), updated, 200},
 			{"delete", id.String(), nil, 200},
 		}
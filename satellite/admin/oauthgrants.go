@@ -0,0 +1,84 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+)
+
+func (server *Server) userOAuthGrants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	grants, err := server.db.OIDC().OAuthTokens().ListGrants(ctx, user.ID)
+	if err != nil {
+		sendJSONError(w, "failed to list oauth grants",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type Grant struct {
+		ClientID          uuid.UUID `json:"clientId"`
+		Scope             string    `json:"scope"`
+		Active            bool      `json:"active"`
+		GrantedAt         time.Time `json:"grantedAt"`
+		RefreshExpiresAt  time.Time `json:"refreshExpiresAt"`
+		AbsoluteExpiresAt time.Time `json:"absoluteExpiresAt"`
+	}
+
+	output := []Grant{}
+	for _, grant := range grants {
+		// without a max age, refreshing keeps the expiration of the grant
+		absoluteExpiresAt := grant.RefreshExpiresAt
+		if maxAge := server.console.OIDC.RefreshTokenMaxAge; maxAge > 0 {
+			absoluteExpiresAt = grant.GrantedAt.Add(maxAge)
+		}
+
+		output = append(output, Grant{
+			ClientID:          grant.ClientID,
+			Scope:             grant.Scope,
+			Active:            true,
+			GrantedAt:         grant.GrantedAt,
+			RefreshExpiresAt:  grant.RefreshExpiresAt,
+			AbsoluteExpiresAt: absoluteExpiresAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
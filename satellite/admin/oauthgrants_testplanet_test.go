@@ -0,0 +1,83 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/oidc"
+)
+
+func TestAdminOAuthGrants(t *testing.T) {
+	const maxAge = 24 * time.Hour
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				config.Console.OIDC.RefreshTokenMaxAge = maxAge
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		user := planet.Uplinks[0].Projects[0].Owner
+		tokens := sat.DB.OIDC().OAuthTokens()
+
+		grantedAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+		clientID, expiredClientID := testrand.UUID(), testrand.UUID()
+
+		for _, token := range []oidc.OAuthToken{
+			// the grant was refreshed once, and its latest refresh token expires last
+			{ClientID: clientID, Scope: "object:list object:read", Kind: oidc.KindRefreshToken, ExpiresAt: grantedAt.Add(2 * time.Hour)},
+			{ClientID: clientID, Scope: "object:list object:read", Kind: oidc.KindRefreshToken, ExpiresAt: grantedAt.Add(3 * time.Hour)},
+			{ClientID: clientID, Scope: "object:list object:read", Kind: oidc.KindAccessToken, ExpiresAt: grantedAt.Add(4 * time.Hour)},
+			{ClientID: expiredClientID, Scope: "object:list", Kind: oidc.KindRefreshToken, ExpiresAt: grantedAt.Add(time.Minute)},
+		} {
+			token.UserID = user.ID
+			token.Token = testrand.UUID().String()
+			token.CreatedAt = grantedAt
+			require.NoError(t, tokens.Create(ctx, token))
+		}
+
+		link := fmt.Sprintf("http://%s/api/users/%s/oauth/grants", sat.Admin.Admin.Listener.Addr(), user.Email)
+
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", sat.Config.Console.AuthToken)
+
+		var grants []struct {
+			ClientID          string    `json:"clientId"`
+			Scope             string    `json:"scope"`
+			Active            bool      `json:"active"`
+			GrantedAt         time.Time `json:"grantedAt"`
+			RefreshExpiresAt  time.Time `json:"refreshExpiresAt"`
+			AbsoluteExpiresAt time.Time `json:"absoluteExpiresAt"`
+		}
+		require.NoError(t, json.Unmarshal(body, &grants))
+		require.Len(t, grants, 1)
+
+		grant := grants[0]
+		require.Equal(t, clientID.String(), grant.ClientID)
+		require.Equal(t, "object:list object:read", grant.Scope)
+		require.True(t, grant.Active)
+		require.WithinDuration(t, grantedAt, grant.GrantedAt, 0)
+		require.WithinDuration(t, grantedAt.Add(3*time.Hour), grant.RefreshExpiresAt, 0)
+		require.WithinDuration(t, grantedAt.Add(maxAge), grant.AbsoluteExpiresAt, 0)
+
+		// the grants are only listed for admins
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusForbidden, "", "not-the-admin-token")
+	})
+}
@@ -95,9 +95,14 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/users/{useremail}", server.userInfo).Methods("GET")
 	api.HandleFunc("/users/{useremail}", server.deleteUser).Methods("DELETE")
 	api.HandleFunc("/users/{useremail}/mfa", server.disableUserMFA).Methods("DELETE")
+	api.HandleFunc("/users/{useremail}/oauth/grants", server.userOAuthGrants).Methods("GET")
 	api.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
 	api.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
 	api.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
+	api.HandleFunc("/oauth/clients/{id}/secret/rotate", server.rotateOAuthClientSecret).Methods("POST")
+	api.HandleFunc("/oauth/clients/{id}/secret/finalize", server.finalizeOAuthClientSecret).Methods("POST")
+	api.HandleFunc("/oauth/clients/{id}/deny", server.denyOAuthClient).Methods("POST")
+	api.HandleFunc("/oauth/clients/{id}/deny", server.allowOAuthClient).Methods("DELETE")
 	api.HandleFunc("/projects", server.addProject).Methods("POST")
 	api.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
 	api.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods("GET")
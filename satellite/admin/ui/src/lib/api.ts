@@ -146,6 +146,46 @@ export class Admin {
 				func: async (clientID: string): Promise<null> => {
 					return this.fetch('DELETE', `oauth/clients/${clientID}`, null) as Promise<null>;
 				}
+			},
+			{
+				name: 'rotate secret',
+				desc: 'Generate a new secret for an oauth client, still accepting the current secret for a while',
+				params: [['Client ID', new InputText('text', true)]],
+				func: async (clientID: string): Promise<Record<string, unknown>> => {
+					return this.fetch('POST', `oauth/clients/${clientID}/secret/rotate`);
+				}
+			},
+			{
+				name: 'finalize secret rotation',
+				desc: 'Stop accepting the previous secret of an oauth client',
+				params: [['Client ID', new InputText('text', true)]],
+				func: async (clientID: string): Promise<null> => {
+					return this.fetch(
+						'POST',
+						`oauth/clients/${clientID}/secret/finalize`,
+						null
+					) as Promise<null>;
+				}
+			},
+			{
+				name: 'deny',
+				desc: 'Reject the authorize and token requests of an oauth client until it is allowed again',
+				params: [
+					['Client ID', new InputText('text', true)],
+					['Revoke its tokens', new InputText('checkbox', false)]
+				],
+				func: async (clientID: string, revoke: boolean): Promise<Record<string, unknown>> => {
+					const query = this.urlQueryFromObject({ revoke: revoke ? 'true' : 'false' });
+					return this.fetch('POST', `oauth/clients/${clientID}/deny`, query);
+				}
+			},
+			{
+				name: 'allow',
+				desc: 'Allow a denied oauth client again',
+				params: [['Client ID', new InputText('text', true)]],
+				func: async (clientID: string): Promise<null> => {
+					return this.fetch('DELETE', `oauth/clients/${clientID}/deny`, null) as Promise<null>;
+				}
 			}
 		],
 		project: [
@@ -379,6 +419,14 @@ Blank fields will not be updated.`,
 				func: async (email: string): Promise<null> => {
 					return this.fetch('DELETE', `users/${email}/mfa`) as Promise<null>;
 				}
+			},
+			{
+				name: 'get OAuth grants',
+				desc: "Get the user's OAuth grants and when their refresh tokens expire",
+				params: [['email', new InputText('email', true)]],
+				func: async (email: string): Promise<Record<string, unknown>> => {
+					return this.fetch('GET', `users/${email}/oauth/grants`);
+				}
 			}
 		],
 		rest_api_keys: [
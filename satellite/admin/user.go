@@ -17,6 +17,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/oidc"
 )
 
 func (server *Server) addUser(w http.ResponseWriter, r *http.Request) {
@@ -425,6 +426,14 @@ func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// tokens granted to oauth clients must not outlive the account
+	err = oidc.NewService(server.db.OIDC()).RevokeUserTokens(ctx, user.ID)
+	if err != nil {
+		sendJSONError(w, "unable to revoke oauth tokens",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	err = server.payments.CreditCards().RemoveAll(ctx, user.ID)
 	if err != nil {
 		sendJSONError(w, "unable to delete credit card(s) from stripe account",
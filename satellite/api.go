@@ -108,7 +108,8 @@ type API struct {
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint        *inspector.Endpoint
+		OverlayEndpoint *inspector.OverlayEndpoint
 	}
 
 	Accounting struct {
@@ -467,10 +468,35 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("inspector"),
 			peer.Overlay.Service,
 			peer.Metainfo.Metabase,
+			peer.DB.RepairQueue(),
+			peer.DB.Console().Projects(),
+			peer.DB.Console().Users(),
+			peer.Accounting.ProjectUsage,
+			config.Checker,
+			config.Inspector,
 		)
 		if err := internalpb.DRPCRegisterHealthInspector(peer.Server.PrivateDRPC(), peer.Inspector.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Inspector.OverlayEndpoint = inspector.NewOverlayEndpoint(
+			peer.Log.Named("inspector:overlay"),
+			peer.Overlay.Service,
+			peer.DB.StoragenodeAccounting(),
+			peer.Reputation.Service,
+			peer.DB.GracefulExit(),
+			config.GracefulExit,
+			peer.DB.Containment(),
+			peer.DB.AuditFailures(),
+			config.Audit,
+			peer.DB.RepairNodeStats(),
+			peer.DB.RetainFilters(),
+			versionInfo,
+			config.Inspector,
+		)
+		if err := internalpb.DRPCRegisterOverlayInspector(peer.Server.PrivateDRPC(), peer.Inspector.OverlayEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup mailservice
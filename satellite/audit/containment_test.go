@@ -4,12 +4,14 @@
 package audit_test
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -44,6 +46,35 @@ func TestContainIncrementAndGet(t *testing.T) {
 	})
 }
 
+func TestContainList(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		containment := planet.Satellites[0].DB.Containment()
+
+		var nodeIDs storj.NodeIDList
+		for _, node := range planet.StorageNodes[:2] {
+			nodeIDs = append(nodeIDs, node.ID())
+			require.NoError(t, containment.IncrementPending(ctx, &audit.PendingAudit{
+				NodeID:            node.ID(),
+				ExpectedShareHash: pkcrypto.SHA256Hash(testrand.Bytes(10)),
+			}))
+		}
+		sort.Sort(nodeIDs)
+
+		pending, err := containment.List(ctx, storj.NodeID{}, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, nodeIDs[0], pending[0].NodeID)
+		require.Equal(t, nodeIDs[1], pending[1].NodeID)
+
+		pending, err = containment.List(ctx, nodeIDs[0], 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, nodeIDs[1], pending[0].NodeID)
+	})
+}
+
 func TestContainIncrementPendingEntryExists(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1,
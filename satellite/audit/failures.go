@@ -0,0 +1,53 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// FailuresError is the audit failures errs class.
+var FailuresError = errs.Class("audit failures")
+
+// FailedAudit is an audit of a segment a node failed.
+type FailedAudit struct {
+	NodeID   storj.NodeID
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+	FailedAt time.Time
+}
+
+// FailureStreak are the audits a node failed since it last passed one.
+type FailureStreak struct {
+	NodeID storj.NodeID
+	// Failures is the number of audits the node failed in a row.
+	Failures int
+	// Recent are the most recently failed audits, latest first.
+	Recent []FailedAudit
+}
+
+// FailureStreakCursor is the last failure streak listed, streaks are ordered by most failures first and then by node.
+type FailureStreakCursor struct {
+	Failures int
+	NodeID   storj.NodeID
+}
+
+// Failures records the audits nodes failed since their last successful audit, so that nodes failing their recent
+// audits can be found before they're disqualified. A segment failed more than once counts as one failure.
+//
+// architecture: Database
+type Failures interface {
+	// Record records the failed audits, and forgets the failures of the nodes that passed an audit.
+	Record(ctx context.Context, failed []FailedAudit, passed storj.NodeIDList) error
+	// ListStreaks returns up to limit of the nodes that failed at least minFailures audits in a row, after the
+	// cursor, along with up to recent of their latest failures. A zero cursor starts from the beginning.
+	ListStreaks(ctx context.Context, minFailures, recent int, cursor FailureStreakCursor, limit int) ([]FailureStreak, error)
+}
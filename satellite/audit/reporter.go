@@ -5,6 +5,7 @@ package audit
 
 import (
 	"context"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -21,6 +22,7 @@ type reporter struct {
 	log              *zap.Logger
 	reputations      *reputation.Service
 	containment      Containment
+	failures         Failures
 	maxRetries       int
 	maxReverifyCount int32
 }
@@ -42,14 +44,18 @@ type Report struct {
 	PendingAudits   []*PendingAudit
 	Unknown         storj.NodeIDList
 	NodesReputation map[storj.NodeID]overlay.ReputationStatus
+
+	// FailedSegments are the segments the nodes in Fails failed their audit of.
+	FailedSegments map[storj.NodeID]Segment
 }
 
 // NewReporter instantiates a reporter.
-func NewReporter(log *zap.Logger, reputations *reputation.Service, containment Containment, maxRetries int, maxReverifyCount int32) Reporter {
+func NewReporter(log *zap.Logger, reputations *reputation.Service, containment Containment, failures Failures, maxRetries int, maxReverifyCount int32) Reporter {
 	return &reporter{
 		log:              log,
 		reputations:      reputations,
 		containment:      containment,
+		failures:         failures,
 		maxRetries:       maxRetries,
 		maxReverifyCount: maxReverifyCount,
 	}
@@ -75,6 +81,8 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) (_ Repor
 		zap.Int("pending", len(pendingAudits)),
 	)
 
+	reporter.recordFailures(ctx, req)
+
 	var errlist errs.Group
 	nodesReputation := req.NodesReputation
 
@@ -113,6 +121,49 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) (_ Repor
 	return Report{}, nil
 }
 
+// recordFailures records the audits the nodes failed, and forgets the failures of the nodes that passed an audit.
+// Failing to record them doesn't fail the report, since they only help finding nodes in trouble.
+func (reporter *reporter) recordFailures(ctx context.Context, req Report) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	var failed []FailedAudit
+	for _, nodeID := range req.Fails {
+		segment, ok := req.FailedSegments[nodeID]
+		if !ok {
+			continue
+		}
+		failed = append(failed, FailedAudit{
+			NodeID:   nodeID,
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+			FailedAt: now,
+		})
+	}
+	for _, pendingAudit := range req.PendingAudits {
+		// pending audits reverified too often count as failed
+		if pendingAudit.ReverifyCount >= reporter.maxReverifyCount {
+			failed = append(failed, FailedAudit{
+				NodeID:   pendingAudit.NodeID,
+				StreamID: pendingAudit.StreamID,
+				Position: pendingAudit.Position,
+				FailedAt: now,
+			})
+		}
+	}
+
+	if len(failed) == 0 && len(req.Successes) == 0 {
+		return
+	}
+
+	err = reporter.failures.Record(ctx, failed, req.Successes)
+	if err != nil {
+		reporter.log.Warn("failed to record audit failures", zap.Error(err))
+	}
+}
+
 func (reporter *reporter) recordAuditStatus(ctx context.Context, nodeIDs storj.NodeIDList, nodesReputation map[storj.NodeID]overlay.ReputationStatus, auditOutcome reputation.AuditType) (failed storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -91,6 +91,9 @@ func (verifier *Verifier) Verify(ctx context.Context, segment Segment, skip map[
 	defer func() {
 		recordStats(report, len(segmentInfo.Pieces), err)
 	}()
+	defer func() {
+		report.FailedSegments = failedSegments(report.Fails, segment)
+	}()
 
 	if segment.Expired(verifier.nowFn()) {
 		verifier.log.Debug("segment expired before Verify")
@@ -295,6 +298,19 @@ func (verifier *Verifier) Verify(ctx context.Context, segment Segment, skip map[
 	}, nil
 }
 
+// failedSegments maps the nodes that failed their audit of the segment onto it.
+func failedSegments(failed storj.NodeIDList, segment Segment) map[storj.NodeID]Segment {
+	if len(failed) == 0 {
+		return nil
+	}
+
+	segments := make(map[storj.NodeID]Segment, len(failed))
+	for _, nodeID := range failed {
+		segments[nodeID] = segment
+	}
+	return segments
+}
+
 func segmentInfoString(segment Segment) string {
 	return fmt.Sprintf("%s/%d",
 		segment.StreamID.String(),
@@ -517,7 +533,7 @@ func (verifier *Verifier) Reverify(ctx context.Context, segment Segment) (report
 						return
 					}
 					// missing share
-					ch <- result{nodeID: pending.NodeID, status: failed, reputation: cachedNodeInfo.Reputation, release: true}
+					ch <- result{nodeID: pending.NodeID, status: failed, pendingAudit: pending, reputation: cachedNodeInfo.Reputation, release: true}
 					verifier.log.Info("Reverify: piece not found (audit failed)", zap.Stringer("Node ID", pending.NodeID), zap.Error(err))
 					return
 				}
@@ -545,7 +561,7 @@ func (verifier *Verifier) Reverify(ctx context.Context, segment Segment) (report
 				}
 				verifier.log.Info("Reverify: hashes mismatch (audit failed)", zap.Stringer("Node ID", pending.NodeID),
 					zap.Binary("expected hash", pending.ExpectedShareHash), zap.Binary("downloaded hash", downloadedHash))
-				ch <- result{nodeID: pending.NodeID, status: failed, reputation: cachedNodeInfo.Reputation, release: true}
+				ch <- result{nodeID: pending.NodeID, status: failed, pendingAudit: pending, reputation: cachedNodeInfo.Reputation, release: true}
 			}
 		}(pending)
 	}
@@ -564,6 +580,13 @@ func (verifier *Verifier) Reverify(ctx context.Context, segment Segment) (report
 			report.Offlines = append(report.Offlines, result.nodeID)
 		case failed:
 			report.Fails = append(report.Fails, result.nodeID)
+			if report.FailedSegments == nil {
+				report.FailedSegments = make(map[storj.NodeID]Segment)
+			}
+			report.FailedSegments[result.nodeID] = Segment{
+				StreamID: result.pendingAudit.StreamID,
+				Position: result.pendingAudit.Position,
+			}
 		case contained:
 			report.PendingAudits = append(report.PendingAudits, result.pendingAudit)
 		case unknown:
@@ -4,6 +4,7 @@
 package consoleapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -57,6 +58,9 @@ type Auth struct {
 	mailService               *mailservice.Service
 	cookieAuth                *consolewebauth.CookieAuth
 	partners                  *rewards.PartnersService
+
+	// SessionEnded is notified when a user logs out, e.g. to let oauth clients know.
+	SessionEnded func(ctx context.Context, userID, sessionID uuid.UUID)
 }
 
 // NewAuth is a constructor for api auth controller.
@@ -150,6 +154,12 @@ func (a *Auth) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 
 	a.cookieAuth.RemoveTokenCookie(w)
+
+	if a.SessionEnded != nil {
+		if user, err := console.GetUser(ctx); err == nil {
+			a.SessionEnded(ctx, user.ID, id)
+		}
+	}
 }
 
 // replaceURLCharacters replaces slash, colon, and dot characters in a string with a hyphen.
@@ -100,9 +100,9 @@ type Config struct {
 	HomepageURL                     string             `help:"url link to storj.io homepage" default:"https://www.storj.io"`
 	NativeTokenPaymentsEnabled      bool               `help:"indicates if storj native token payments system is enabled" default:"false"`
 
-	OauthCodeExpiry         time.Duration `help:"how long oauth authorization codes are issued for" default:"10m"`
-	OauthAccessTokenExpiry  time.Duration `help:"how long oauth access tokens are issued for" default:"24h"`
-	OauthRefreshTokenExpiry time.Duration `help:"how long oauth refresh tokens are issued for" default:"720h"`
+	OauthClientSecretOverlap time.Duration `help:"how long the previous secret of a rotated oauth client is still accepted, so that the client can switch to the new secret" default:"24h"`
+
+	OIDC oidc.Config
 
 	// RateLimit defines the configuration for the IP and userID rate limiters.
 	RateLimit web.RateLimiterConfig
@@ -129,6 +129,9 @@ type Server struct {
 	userIDRateLimiter *web.RateLimiter
 	nodeURL           storj.NodeURL
 
+	// oidc is the identity provider, when the console serves it.
+	oidc *oidc.Endpoint
+
 	stripePublicKey string
 
 	pricing paymentsconfig.PricingValues
@@ -330,14 +333,26 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		oidc := oidc.NewEndpoint(
 			server.nodeURL, server.config.ExternalAddress,
 			logger, oidcService, service,
-			server.config.OauthCodeExpiry, server.config.OauthAccessTokenExpiry, server.config.OauthRefreshTokenExpiry,
+			server.config.OIDC,
 		)
-
-		router.HandleFunc("/.well-known/openid-configuration", oidc.WellKnownConfiguration)
-		router.Handle("/oauth/v2/authorize", server.withAuth(http.HandlerFunc(oidc.AuthorizeUser))).Methods(http.MethodPost)
-		router.Handle("/oauth/v2/tokens", server.ipRateLimiter.Limit(http.HandlerFunc(oidc.Tokens))).Methods(http.MethodPost)
-		router.Handle("/oauth/v2/userinfo", server.ipRateLimiter.Limit(http.HandlerFunc(oidc.UserInfo))).Methods(http.MethodGet)
-		router.Handle("/oauth/v2/clients/{id}", server.withAuth(http.HandlerFunc(oidc.GetClient))).Methods(http.MethodGet)
+		authController.SessionEnded = oidc.EndSession
+		oidc.Sessions = oidcSessions{server: &server}
+		server.oidc = oidc
+
+		oidcPrefix := server.config.OIDC.PathPrefix()
+
+		router.Handle(oidcPrefix+".well-known/openid-configuration", oidc.AccessLog(http.HandlerFunc(oidc.WellKnownConfiguration)))
+		router.Handle(oidcPrefix+"oauth/v2/authorize", oidc.AccessLog(oidc.SecureFlow(http.HandlerFunc(server.appHandler)))).Methods(http.MethodGet)
+		authorize := server.withAuth(http.HandlerFunc(oidc.AuthorizeUser))
+		if server.config.OIDC.LoginURL != "" {
+			// users without a session are sent to log in by the oidc endpoint
+			authorize = server.withOptionalAuth(http.HandlerFunc(oidc.AuthorizeUser))
+		}
+		router.Handle(oidcPrefix+"oauth/v2/authorize", oidc.AccessLog(oidc.SecureFlow(authorize))).Methods(http.MethodPost)
+		router.Handle(oidcPrefix+"oauth/v2/tokens", oidc.AccessLog(server.ipRateLimiter.Limit(http.HandlerFunc(oidc.Tokens)))).Methods(http.MethodPost)
+		router.Handle(oidcPrefix+"oauth/v2/userinfo", oidc.AccessLog(server.ipRateLimiter.Limit(http.HandlerFunc(oidc.UserInfo)))).Methods(http.MethodGet)
+		router.Handle(oidcPrefix+"oauth/v2/logout", oidc.AccessLog(oidc.SecureFlow(http.HandlerFunc(oidc.Logout)))).Methods(http.MethodGet, http.MethodPost)
+		router.Handle(oidcPrefix+"oauth/v2/clients/{id}", oidc.AccessLog(server.withAuth(http.HandlerFunc(oidc.GetClient)))).Methods(http.MethodGet)
 
 		fs := http.FileServer(http.Dir(server.config.StaticDir))
 		router.PathPrefix("/static/").Handler(server.brotliMiddleware(http.StripPrefix("/static", fs)))
@@ -381,6 +396,11 @@ func (server *Server) Run(ctx context.Context) (err error) {
 		server.ipRateLimiter.Run(ctx)
 		return nil
 	})
+	if server.oidc != nil {
+		group.Go(func() error {
+			return server.oidc.Run(ctx)
+		})
+	}
 	group.Go(func() error {
 		defer cancel()
 		err := server.server.Serve(server.listener)
@@ -555,6 +575,63 @@ func (server *Server) withAuth(handler http.Handler) http.Handler {
 	})
 }
 
+// withOptionalAuth authenticates requests that carry a valid session cookie and passes the others on unauthenticated.
+func (server *Server) withOptionalAuth(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		tokenInfo, err := server.cookieAuth.GetToken(r)
+		if err == nil {
+			if authenticated, err := server.service.TokenAuth(ctx, tokenInfo.Token, time.Now()); err == nil {
+				r = r.Clone(authenticated)
+			}
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// oidcSessions lets the oidc end session endpoint look up and end console sessions.
+type oidcSessions struct {
+	server *Server
+}
+
+// Current implements oidc.ConsoleSessions.
+func (sessions oidcSessions) Current(r *http.Request) (userID, sessionID uuid.UUID, ok bool) {
+	tokenInfo, err := sessions.server.cookieAuth.GetToken(r)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, false
+	}
+
+	sessionID, err = uuid.FromBytes(tokenInfo.Token.Payload)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, false
+	}
+
+	ctx, err := sessions.server.service.TokenAuth(r.Context(), tokenInfo.Token, time.Now())
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, false
+	}
+
+	user, err := console.GetUser(ctx)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, false
+	}
+
+	return user.ID, sessionID, true
+}
+
+// End implements oidc.ConsoleSessions.
+func (sessions oidcSessions) End(ctx context.Context, w http.ResponseWriter, sessionID uuid.UUID) error {
+	err := sessions.server.service.DeleteSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	sessions.server.cookieAuth.RemoveTokenCookie(w)
+	return nil
+}
+
 // withRequest ensures the http request itself is reachable from the context.
 func (server *Server) withRequest(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1306,9 +1306,12 @@ func (s *Service) ChangeEmail(ctx context.Context, newEmail string) (err error)
 		return ErrEmailUsed.New(emailUsedErrMsg)
 	}
 
+	// the new email isn't verified
+	emailUnverified := true
 	user.Email = newEmail
 	err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
-		Email: &user.Email,
+		Email:           &user.Email,
+		EmailUnverified: &emailUnverified,
 	})
 	if err != nil {
 		return Error.Wrap(err)
@@ -2395,6 +2398,37 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// ListBucketNames retrieves up to limit names of the allowed buckets of a specific project, in lexicographic order. It
+// also reports whether the project has more allowed buckets than were returned.
+func (s *Service) ListBucketNames(ctx context.Context, projectID uuid.UUID, allowed macaroon.AllowedBuckets, limit int) (_ []string, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "list bucket names", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	bucketsList, err := s.buckets.ListBuckets(ctx, projectID, storj.BucketListOptions{
+		Direction: storj.Forward,
+		Limit:     limit,
+	}, allowed)
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	names := make([]string, 0, len(bucketsList.Items))
+	for _, bucket := range bucketsList.Items {
+		names = append(names, bucket.Name)
+	}
+
+	return names, bucketsList.More, nil
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
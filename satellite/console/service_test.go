@@ -258,6 +258,7 @@ func TestService(t *testing.T) {
 				user, _, err := service.GetUserByEmailWithUnverified(userCtx2, newEmail)
 				require.NoError(t, err)
 				require.Equal(t, newEmail, user.Email)
+				require.True(t, user.EmailUnverified)
 
 				err = service.ChangeEmail(userCtx2, newEmail)
 				require.Error(t, err)
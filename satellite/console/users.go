@@ -186,6 +186,10 @@ type User struct {
 	FailedLoginCount       int       `json:"failedLoginCount"`
 	LoginLockoutExpiration time.Time `json:"loginLockoutExpiration"`
 	SignupCaptcha          *float64  `json:"-"`
+
+	// EmailUnverified is set when the email was changed after the account was activated, since the new email isn't
+	// verified.
+	EmailUnverified bool `json:"-"`
 }
 
 // ResponseUser is an entity which describes db User and can be sent in response.
@@ -254,4 +258,6 @@ type UpdateUserRequest struct {
 	FailedLoginCount *int
 
 	LoginLockoutExpiration **time.Time
+
+	EmailUnverified *bool
 }
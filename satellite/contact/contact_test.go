@@ -97,12 +97,13 @@ func TestSatellitePingBack_Failure(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
-		pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, err := planet.Satellites[0].Contact.Service.PingBack(ctx, storj.NodeURL{})
+		pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, latency, err := planet.Satellites[0].Contact.Service.PingBack(ctx, storj.NodeURL{})
 
 		require.NoError(t, err)
 		require.NotEmpty(t, pingErrorMessage)
 		require.False(t, pingNodeSuccess)
 		require.False(t, pingNodeSuccessQUIC)
+		require.Zero(t, latency)
 	})
 }
 
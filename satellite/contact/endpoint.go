@@ -83,7 +83,7 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		ID:      nodeID,
 		Address: req.Address,
 	}
-	pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, err := endpoint.service.PingBack(ctx, nodeurl)
+	pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, latency, err := endpoint.service.PingBack(ctx, nodeurl)
 	if err != nil {
 		return nil, endpoint.checkPingRPCErr(err, nodeurl)
 	}
@@ -109,6 +109,7 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		LastNet:    resolvedNetwork,
 		LastIPPort: net.JoinHostPort(resolvedIP.String(), port),
 		IsUp:       pingNodeSuccess,
+		Latency:    latency,
 		Capacity:   req.Capacity,
 		Operator:   req.Operator,
 		Version:    req.Version,
@@ -75,8 +75,9 @@ func (service *Service) Local() overlay.NodeDossier {
 // Close closes resources.
 func (service *Service) Close() error { return nil }
 
-// PingBack pings the node to test connectivity.
-func (service *Service) PingBack(ctx context.Context, nodeurl storj.NodeURL) (_ bool, _ bool, _ string, err error) {
+// PingBack pings the node to test connectivity. The returned latency is the round trip time of the ping, and is zero
+// when the ping failed.
+func (service *Service) PingBack(ctx context.Context, nodeurl storj.NodeURL) (_ bool, _ bool, _ string, latency time.Duration, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if service.timeout > 0 {
@@ -102,10 +103,12 @@ func (service *Service) PingBack(ctx context.Context, nodeurl storj.NodeURL) (_
 		service.log.Debug("pingBack failed to dial storage node",
 			zap.String("pingErrorMessage", pingErrorMessage),
 		)
-		return pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, nil
+		return pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, 0, nil
 	}
 	defer func() { err = errs.Combine(err, client.Close()) }()
 
+	// the connection is established already, so this only measures the round trip
+	start := time.Now()
 	_, err = client.pingNode(ctx, &pb.ContactPingRequest{})
 	if err != nil {
 		mon.Event("failed_ping_node") //mon:locked
@@ -116,8 +119,9 @@ func (service *Service) PingBack(ctx context.Context, nodeurl storj.NodeURL) (_
 			zap.String("pingErrorMessage", pingErrorMessage),
 		)
 
-		return pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, nil
+		return pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, 0, nil
 	}
+	latency = time.Since(start)
 
 	pingNodeSuccessQUIC = true
 	err = service.pingNodeQUIC(ctx, nodeurl)
@@ -128,7 +132,7 @@ func (service *Service) PingBack(ctx context.Context, nodeurl storj.NodeURL) (_
 		pingErrorMessage = err.Error()
 	}
 
-	return pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, nil
+	return pingNodeSuccess, pingNodeSuccessQUIC, pingErrorMessage, latency, nil
 }
 
 func (service *Service) pingNodeQUIC(ctx context.Context, nodeurl storj.NodeURL) error {
@@ -379,6 +379,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Audit.Reporter = audit.NewReporter(log.Named("audit:reporter"),
 			peer.Reputation.Service,
 			peer.DB.Containment(),
+			peer.DB.AuditFailures(),
 			config.MaxRetriesStatDB,
 			int32(config.MaxReverifyCount),
 		)
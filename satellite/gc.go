@@ -151,6 +151,7 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 			config.GarbageCollection,
 			peer.Dialer,
 			peer.Overlay.DB,
+			peer.DB.RetainFilters(),
 		)
 
 		peer.Services.Add(lifecycle.Item{
@@ -0,0 +1,41 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sender
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	// SentFiltersError is the sent retain filters errs class.
+	SentFiltersError = errs.Class("sent retain filters")
+	// ErrFilterNotSent is returned for nodes that weren't sent a retain filter yet.
+	ErrFilterNotSent = errs.Class("retain filter not sent")
+)
+
+// SentFilter is the latest retain filter sent to a node.
+type SentFilter struct {
+	NodeID storj.NodeID
+	SentAt time.Time
+	// CreationDate is the time the filter was created at. The node keeps the pieces created after it, and the pieces
+	// it no longer has to keep after it are only collected by a later filter.
+	CreationDate time.Time
+	// PieceCount is the number of pieces the filter retains.
+	PieceCount int64
+}
+
+// SentFilters remembers the latest retain filter sent to every node.
+//
+// architecture: Database
+type SentFilters interface {
+	// Record replaces the filter remembered for the node.
+	Record(ctx context.Context, filter SentFilter) error
+	// Get returns the latest filter sent to the node, or ErrFilterNotSent when none was.
+	Get(ctx context.Context, nodeID storj.NodeID) (SentFilter, error)
+}
@@ -43,14 +43,15 @@ type Config struct {
 }
 
 // NewService creates a new instance of the gc sender service.
-func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB) *Service {
+func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB, sentFilters SentFilters) *Service {
 	return &Service{
 		log:    log,
 		Config: config,
 		Loop:   sync2.NewCycle(config.Interval),
 
-		dialer:  dialer,
-		overlay: overlay,
+		dialer:      dialer,
+		overlay:     overlay,
+		sentFilters: sentFilters,
 	}
 }
 
@@ -66,8 +67,9 @@ type Service struct {
 	Config Config
 	Loop   *sync2.Cycle
 
-	dialer  rpc.Dialer
-	overlay overlay.DB
+	dialer      rpc.Dialer
+	overlay     overlay.DB
+	sentFilters SentFilters
 }
 
 // Run continuously polls for new retain filters and sends them out.
@@ -165,7 +167,21 @@ func (service *Service) sendRetainRequest(ctx context.Context, retainInfo *inter
 		CreationDate: retainInfo.CreationDate,
 		Filter:       retainInfo.Filter,
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the filter was sent either way, so failing to remember it doesn't fail the send
+	err = service.sentFilters.Record(ctx, SentFilter{
+		NodeID:       retainInfo.StorageNodeId,
+		SentAt:       time.Now(),
+		CreationDate: retainInfo.CreationDate,
+		PieceCount:   retainInfo.PieceCount,
+	})
+	if err != nil {
+		service.log.Warn("failed to record sent retain filter", zap.Stringer("Node ID", retainInfo.StorageNodeId), zap.Error(err))
+	}
+	return nil
 }
 
 // moveToErrorPrefix moves an object to prefix "error" and attaches the error to the metadata.
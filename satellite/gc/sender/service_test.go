@@ -7,6 +7,7 @@ import (
 	"io"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
@@ -65,6 +66,12 @@ func TestSendRetainFilters(t *testing.T) {
 
 		require.Equal(t, 1, storageNode0.Peer.Storage2.RetainService.HowManyQueued())
 
+		// the sent filter is remembered for the node
+		sent, err := planet.Satellites[0].DB.RetainFilters().Get(ctx, storageNode0.ID())
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), sent.SentAt, time.Minute)
+		require.Positive(t, sent.PieceCount)
+
 		// check that zip was moved to sent
 		project, err := planet.Uplinks[0].OpenProject(ctx, planet.Satellites[1])
 		require.NoError(t, err)
@@ -42,3 +42,8 @@ type Config struct {
 	AsOfSystemTimeInterval time.Duration `help:"interval for AS OF SYSTEM TIME clause (crdb specific) to read from db at a specific time in the past" default:"-10s" testDefault:"-1µs"`
 	TransferQueueBatchSize int           `help:"batch size (crdb specific) for deleting and adding items to the transfer queue" default:"1000"`
 }
+
+// EligibleAt returns when a node that joined the network at joinedAt is old enough to initiate graceful exit.
+func (config Config) EligibleAt(joinedAt time.Time) time.Time {
+	return joinedAt.AddDate(0, config.NodeMinAgeInMonths, 0)
+}
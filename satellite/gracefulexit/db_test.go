@@ -150,6 +150,10 @@ func TestSegmentTransferQueueItem(t *testing.T) {
 			queueItems, err := geDB.GetIncomplete(ctx, nodeID1, 10, 0)
 			require.NoError(t, err)
 			require.Len(t, queueItems, 1)
+
+			count, err := geDB.CountIncomplete(ctx, nodeID1)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, count)
 			for _, queueItem := range queueItems {
 				require.Equal(t, nodeID1, queueItem.NodeID)
 				require.Equal(t, streamID2, queueItem.StreamID)
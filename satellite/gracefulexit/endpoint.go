@@ -786,7 +786,7 @@ func (endpoint *Endpoint) checkExitStatus(ctx context.Context, nodeID storj.Node
 			endpoint.log.Error("unable to retrieve node dossier for attempted exiting node", zap.Stringer("node ID", nodeID))
 			return nil, Error.Wrap(err)
 		}
-		geEligibilityDate := nodeDossier.CreatedAt.AddDate(0, endpoint.config.NodeMinAgeInMonths, 0)
+		geEligibilityDate := endpoint.config.EligibleAt(nodeDossier.CreatedAt)
 		if time.Now().Before(geEligibilityDate) {
 			return nil, ErrIneligibleNodeAge.New("will be eligible after %s", geEligibilityDate.String())
 		}
@@ -930,7 +930,7 @@ func (endpoint *Endpoint) GracefulExitFeasibility(ctx context.Context, req *pb.G
 		return nil, Error.Wrap(err)
 	}
 
-	eligibilityDate := nodeDossier.CreatedAt.AddDate(0, endpoint.config.NodeMinAgeInMonths, 0)
+	eligibilityDate := endpoint.config.EligibleAt(nodeDossier.CreatedAt)
 	if time.Now().Before(eligibilityDate) {
 		response.IsAllowed = false
 	} else {
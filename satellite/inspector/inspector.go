@@ -4,19 +4,33 @@
 package inspector
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
 )
 
 var (
@@ -25,22 +39,45 @@ var (
 	Error = errs.Class("inspector")
 )
 
+const (
+	// defaultLimit is the page size used when a request doesn't specify one.
+	defaultLimit = 100
+	// maxLimit is the largest page size a request may ask for, larger ones are clamped to it.
+	maxLimit = 1000
+	// defaultScanLimit is the number of segments scanned when a request doesn't specify one.
+	defaultScanLimit = 10000
+	// projectsPageSize is the number of projects listed at a time when checking every project.
+	projectsPageSize = 1000
+)
+
 // Endpoint for checking object and segment health.
 //
 // architecture: Endpoint
 type Endpoint struct {
 	internalpb.DRPCHealthInspectorUnimplementedServer
-	log      *zap.Logger
-	overlay  *overlay.Service
-	metabase *metabase.DB
+	log         *zap.Logger
+	overlay     *overlay.Service
+	metabase    *metabase.DB
+	repairQueue queue.RepairQueue
+	projects    console.Projects
+	users       console.Users
+	usage       *accounting.Service
+	checker     checker.Config
+	config      Config
 }
 
 // NewEndpoint will initialize an Endpoint struct.
-func NewEndpoint(log *zap.Logger, cache *overlay.Service, metabase *metabase.DB) *Endpoint {
+func NewEndpoint(log *zap.Logger, cache *overlay.Service, metabase *metabase.DB, repairQueue queue.RepairQueue, projects console.Projects, users console.Users, usage *accounting.Service, checkerConfig checker.Config, config Config) *Endpoint {
 	return &Endpoint{
-		log:      log,
-		overlay:  cache,
-		metabase: metabase,
+		log:         log,
+		overlay:     cache,
+		metabase:    metabase,
+		repairQueue: repairQueue,
+		projects:    projects,
+		users:       users,
+		usage:       usage,
+		checker:     checkerConfig,
+		config:      config,
 	}
 }
 
@@ -110,37 +147,221 @@ func (endpoint *Endpoint) ObjectHealth(ctx context.Context, in *internalpb.Objec
 func (endpoint *Endpoint) SegmentHealth(ctx context.Context, in *internalpb.SegmentHealthRequest) (_ *internalpb.SegmentHealthResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	projectID, err := uuid.FromBytes(in.GetProjectId())
+	segment, err := endpoint.lookupSegment(ctx, in.GetProjectId(), in.GetBucket(), in.GetEncryptedPath(), in.GetSegmentIndex())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if segment.Inline() {
+		return nil, Error.New("cannot check health of inline segment")
+	}
+
+	return endpoint.segmentHealth(ctx, segment)
+}
+
+// SegmentGeoSpread returns the country and subnet of the nodes holding a segment's pieces, along with the countries
+// and subnets that hold more pieces than allowed.
+func (endpoint *Endpoint) SegmentGeoSpread(ctx context.Context, in *internalpb.SegmentGeoSpreadRequest) (_ *internalpb.SegmentGeoSpreadResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetMaxPerCountry() < 0 || in.GetMaxPerSubnet() < 0 {
+		return nil, Error.New("limits must not be negative")
+	}
+
+	maxPerSubnet := int(in.GetMaxPerSubnet())
+	if maxPerSubnet == 0 {
+		maxPerSubnet = 1
+	}
+
+	segment, err := endpoint.lookupSegment(ctx, in.GetProjectId(), in.GetBucket(), in.GetEncryptedPath(), in.GetSegmentIndex())
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
+	if segment.Inline() {
+		return nil, Error.New("inline segments aren't stored on nodes")
+	}
+
+	pieces := append(metabase.Pieces(nil), segment.Pieces...)
+	sort.Slice(pieces, func(i, k int) bool {
+		return pieces[i].Number < pieces[k].Number
+	})
+
+	response := &internalpb.SegmentGeoSpreadResponse{}
+	countries := make(map[string][]int32)
+	subnets := make(map[string][]int32)
+	for _, piece := range pieces {
+		pieceLocation := &internalpb.PieceLocation{
+			PieceNum: int32(piece.Number),
+			NodeId:   piece.StorageNode,
+		}
+
+		node, err := endpoint.overlay.Get(ctx, piece.StorageNode)
+		switch {
+		case err == nil:
+			if node.CountryCode != location.None {
+				pieceLocation.CountryCode = node.CountryCode.String()
+				countries[pieceLocation.CountryCode] = append(countries[pieceLocation.CountryCode], pieceLocation.PieceNum)
+			}
+			if node.LastNet != "" {
+				pieceLocation.LastNet = node.LastNet
+				subnets[node.LastNet] = append(subnets[node.LastNet], pieceLocation.PieceNum)
+			}
+		case !overlay.ErrNodeNotFound.Has(err):
+			return nil, Error.Wrap(err)
+		}
+
+		response.Pieces = append(response.Pieces, pieceLocation)
+	}
+
+	if maxPerCountry := int(in.GetMaxPerCountry()); maxPerCountry > 0 {
+		response.CrowdedCountries = crowdedLocations(countries, maxPerCountry)
+	}
+	response.CrowdedSubnets = crowdedLocations(subnets, maxPerSubnet)
+
+	return response, nil
+}
+
+// lookupSegment returns the segment at the encoded position of the last committed object at the location.
+func (endpoint *Endpoint) lookupSegment(ctx context.Context, projectIDBytes, bucket, encryptedPath []byte, segmentIndex int64) (_ metabase.Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromBytes(projectIDBytes)
+	if err != nil {
+		return metabase.Segment{}, err
+	}
+
 	objectLocation := metabase.ObjectLocation{
 		ProjectID:  projectID,
-		BucketName: string(in.GetBucket()),
-		ObjectKey:  metabase.ObjectKey(in.GetEncryptedPath()),
+		BucketName: string(bucket),
+		ObjectKey:  metabase.ObjectKey(encryptedPath),
 	}
 
 	object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
 		ObjectLocation: objectLocation,
 	})
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return metabase.Segment{}, err
 	}
 
-	segment, err := endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+	return endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
 		StreamID: object.StreamID,
-		Position: metabase.SegmentPositionFromEncoded(uint64(in.GetSegmentIndex())),
+		Position: metabase.SegmentPositionFromEncoded(uint64(segmentIndex)),
+	})
+}
+
+// crowdedLocations returns the locations holding more than the allowed number of pieces, ordered by location.
+func crowdedLocations(pieces map[string][]int32, allowed int) []*internalpb.LocationGroup {
+	var crowded []*internalpb.LocationGroup
+	for location, pieceNums := range pieces {
+		if len(pieceNums) > allowed {
+			crowded = append(crowded, &internalpb.LocationGroup{
+				Location:  location,
+				PieceNums: pieceNums,
+			})
+		}
+	}
+	sort.Slice(crowded, func(i, k int) bool {
+		return crowded[i].Location < crowded[k].Location
+	})
+	return crowded
+}
+
+// SegmentsAtRisk scans a batch of remote segments and counts them by their healthy piece margin above the repair
+// threshold. Callers continue the scan by passing the returned cursor until done is set.
+func (endpoint *Endpoint) SegmentsAtRisk(ctx context.Context, in *internalpb.SegmentsAtRiskRequest) (_ *internalpb.SegmentsAtRiskResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := defaultScanLimit
+	if in.GetLimit() > 0 {
+		limit = int(in.GetLimit())
+	}
+
+	var cursorStreamID uuid.UUID
+	if len(in.GetCursorStreamId()) > 0 {
+		cursorStreamID, err = uuid.FromBytes(in.GetCursorStreamId())
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	result, err := endpoint.metabase.ListVerifySegments(ctx, metabase.ListVerifySegments{
+		CursorStreamID: cursorStreamID,
+		CursorPosition: metabase.SegmentPositionFromEncoded(uint64(in.GetCursorPosition())),
+		Limit:          limit,
 	})
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	if segment.Inline() {
-		return nil, Error.New("cannot check health of inline segment")
+	response := &internalpb.SegmentsAtRiskResponse{
+		SegmentsScanned: int64(len(result.Segments)),
+		CursorStreamId:  cursorStreamID[:],
+		CursorPosition:  in.GetCursorPosition(),
+		Done:            len(result.Segments) < limit,
+	}
+	if len(result.Segments) == 0 {
+		return response, nil
 	}
 
-	return endpoint.segmentHealth(ctx, segment)
+	last := result.Segments[len(result.Segments)-1]
+	response.CursorStreamId = last.StreamID[:]
+	response.CursorPosition = int64(last.Position.Encode())
+
+	aliasMap, err := endpoint.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	segmentNodes := make([][]storj.NodeID, len(result.Segments))
+	var allNodes storj.NodeIDList
+	seen := make(map[storj.NodeID]bool)
+	for i, segment := range result.Segments {
+		for _, piece := range segment.AliasPieces {
+			nodeID, ok := aliasMap.Node(piece.Alias)
+			if !ok {
+				return nil, Error.New("unknown node alias %d", piece.Alias)
+			}
+			segmentNodes[i] = append(segmentNodes[i], nodeID)
+			if !seen[nodeID] {
+				seen[nodeID] = true
+				allNodes = append(allNodes, nodeID)
+			}
+		}
+	}
+
+	badNodes, err := endpoint.overlay.KnownUnreliableOrOffline(ctx, allNodes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	unhealthy := make(map[storj.NodeID]bool, len(badNodes))
+	for _, id := range badNodes {
+		unhealthy[id] = true
+	}
+
+	counts := make(map[int32]int64)
+	for i, segment := range result.Segments {
+		healthy := 0
+		for _, nodeID := range segmentNodes[i] {
+			if !unhealthy[nodeID] {
+				healthy++
+			}
+		}
+		counts[int32(healthy-int(segment.Redundancy.RepairShares))]++
+	}
+
+	for margin, count := range counts {
+		response.Margins = append(response.Margins, &internalpb.SegmentMarginCount{
+			Margin: margin,
+			Count:  count,
+		})
+	}
+	sort.Slice(response.Margins, func(i, k int) bool {
+		return response.Margins[i].Margin < response.Margins[k].Margin
+	})
+
+	return response, nil
 }
 
 func (endpoint *Endpoint) segmentHealth(ctx context.Context, segment metabase.Segment) (_ *internalpb.SegmentHealthResponse, err error) {
@@ -202,3 +423,1331 @@ func (endpoint *Endpoint) segmentHealth(ctx context.Context, segment metabase.Se
 		Redundancy: redundancy,
 	}, nil
 }
+
+// defaultHealthBuckets is the number of buckets the health of queued segments is distributed over by default.
+const defaultHealthBuckets = 10
+
+// RepairQueueStats returns the length of the repair queue, how long its oldest segment has been queued for and how the
+// health of the queued segments is distributed.
+func (endpoint *Endpoint) RepairQueueStats(ctx context.Context, in *internalpb.RepairQueueStatsRequest) (_ *internalpb.RepairQueueStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets := int(in.GetHealthBuckets())
+	if buckets < 0 {
+		return nil, Error.New("health buckets must not be negative")
+	}
+	if buckets == 0 {
+		buckets = defaultHealthBuckets
+	}
+
+	stats, err := endpoint.repairQueue.Stats(ctx, buckets)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.RepairQueueStatsResponse{
+		Length:    stats.Count,
+		MinHealth: stats.MinHealth,
+		MaxHealth: stats.MaxHealth,
+	}
+	if stats.OldestInsertedAt != nil {
+		response.OldestAge = time.Since(*stats.OldestInsertedAt)
+	}
+	for _, bucket := range stats.HealthBuckets {
+		response.HealthBuckets = append(response.HealthBuckets, &internalpb.RepairHealthBucket{
+			Lower: bucket.Lower,
+			Upper: bucket.Upper,
+			Count: bucket.Count,
+		})
+	}
+
+	return response, nil
+}
+
+// defaultRepairQueueAgeBounds are the upper bounds of the age ranges of queued segments when a request doesn't
+// specify any.
+var defaultRepairQueueAgeBounds = []time.Duration{time.Hour, 6 * time.Hour, 24 * time.Hour}
+
+// RepairQueueAgeHistogram counts the queued segments by how long ago they were inserted into the repair queue, to tell
+// whether the repair workers keep pace with the checker or old segments are starving. Segments queued again keep the
+// time they were first inserted at.
+func (endpoint *Endpoint) RepairQueueAgeHistogram(ctx context.Context, in *internalpb.RepairQueueAgeHistogramRequest) (_ *internalpb.RepairQueueAgeHistogramResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bounds := in.GetUpperBounds()
+	if len(bounds) == 0 {
+		bounds = defaultRepairQueueAgeBounds
+	}
+	for i, bound := range bounds {
+		if bound <= 0 || (i > 0 && bound <= bounds[i-1]) {
+			return nil, Error.New("upper bounds must be ascending positive durations: %v", bounds)
+		}
+	}
+
+	now := time.Now()
+	histogram, err := endpoint.repairQueue.AgeHistogram(ctx, now, bounds)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.RepairQueueAgeHistogramResponse{
+		Length: histogram.Count,
+	}
+	if histogram.OldestInsertedAt != nil {
+		response.OldestAge = now.Sub(*histogram.OldestInsertedAt)
+	}
+	for _, ageRange := range histogram.Ranges {
+		response.Ranges = append(response.Ranges, &internalpb.RepairQueueAgeRange{
+			LowerBound: ageRange.Lower,
+			UpperBound: ageRange.Upper,
+			Count:      ageRange.Count,
+		})
+	}
+
+	return response, nil
+}
+
+// SegmentRepairReason returns the health a queued segment was queued with, and why the nodes holding its unhealthy
+// pieces are considered unhealthy. The queue only keeps the health of the segment, so the node states are the current
+// ones, which tells whether the segment is being repaired because of nodes leaving or because of nodes failing audits.
+func (endpoint *Endpoint) SegmentRepairReason(ctx context.Context, in *internalpb.SegmentRepairReasonRequest) (_ *internalpb.SegmentRepairReasonResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamID, err := uuid.FromBytes(in.GetStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	position := metabase.SegmentPositionFromEncoded(uint64(in.GetPosition()))
+
+	injured, err := endpoint.repairQueue.Get(ctx, streamID, position)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "segment is not queued for repair")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	segment, err := endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.SegmentRepairReasonResponse{
+		SegmentHealth: injured.SegmentHealth,
+		InsertedAt:    injured.InsertedAt,
+		UpdatedAt:     injured.UpdatedAt,
+		Redundancy: &pb.RedundancyScheme{
+			MinReq:           int32(segment.Redundancy.RequiredShares),
+			RepairThreshold:  int32(segment.Redundancy.RepairShares),
+			SuccessThreshold: int32(segment.Redundancy.OptimalShares),
+			Total:            int32(segment.Redundancy.TotalShares),
+		},
+	}
+
+	for _, piece := range segment.Pieces {
+		unhealthy, err := endpoint.pieceFailure(ctx, piece)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		switch {
+		case unhealthy == nil:
+			response.HealthyPieces++
+			continue
+		case unhealthy.Churned:
+			response.ChurnedPieces++
+		default:
+			response.AuditFailedPieces++
+		}
+		response.UnhealthyPieces = append(response.UnhealthyPieces, unhealthy)
+	}
+
+	sort.Slice(response.UnhealthyPieces, func(i, k int) bool {
+		return response.UnhealthyPieces[i].PieceNum < response.UnhealthyPieces[k].PieceNum
+	})
+
+	return response, nil
+}
+
+// pieceFailure returns why the piece isn't healthy, deciding it the same way the repair checker does, or nil when the
+// piece is healthy.
+func (endpoint *Endpoint) pieceFailure(ctx context.Context, piece metabase.Piece) (_ *internalpb.UnhealthyPiece, err error) {
+	unhealthy := &internalpb.UnhealthyPiece{
+		PieceNum: int32(piece.Number),
+		NodeId:   piece.StorageNode,
+	}
+
+	node, err := endpoint.overlay.Get(ctx, piece.StorageNode)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			unhealthy.Failure = internalpb.UnhealthyPiece_MISSING
+			unhealthy.Churned = true
+			return unhealthy, nil
+		}
+		return nil, err
+	}
+
+	switch {
+	case node.Disqualified != nil:
+		unhealthy.Failure = internalpb.UnhealthyPiece_DISQUALIFIED
+		// nodes disqualified for being offline left rather than failing audits
+		unhealthy.Churned = node.DisqualificationReason != nil && *node.DisqualificationReason == overlay.DisqualificationReasonNodeOffline
+	case node.ExitStatus.ExitFinishedAt != nil:
+		unhealthy.Failure = internalpb.UnhealthyPiece_EXITED
+		unhealthy.Churned = true
+	case node.UnknownAuditSuspended != nil:
+		unhealthy.Failure = internalpb.UnhealthyPiece_UNKNOWN_AUDIT_SUSPENDED
+	case node.OfflineSuspended != nil:
+		unhealthy.Failure = internalpb.UnhealthyPiece_OFFLINE_SUSPENDED
+		unhealthy.Churned = true
+	case !endpoint.overlay.IsOnline(node):
+		unhealthy.Failure = internalpb.UnhealthyPiece_OFFLINE
+		unhealthy.Churned = true
+	default:
+		return nil, nil
+	}
+	return unhealthy, nil
+}
+
+// DetectOrphanedPieces estimates how many of the pieces recorded for a node no longer belong to a live segment. It
+// samples a contiguous range of segments, extrapolates from the sample how many pieces the node holds for live segments
+// and compares that with the piece count garbage collection last recorded for the node. Nothing is modified.
+func (endpoint *Endpoint) DetectOrphanedPieces(ctx context.Context, in *internalpb.DetectOrphanedPiecesRequest) (_ *internalpb.DetectOrphanedPiecesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.OrphanedPiecesSampleSize, endpoint.config.OrphanedPiecesMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	node, err := endpoint.overlay.Get(ctx, in.NodeId)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	aliasMap, err := endpoint.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	alias, hasAlias := aliasMap.Alias(in.NodeId)
+
+	var sampledPieces int64
+	scanned, fraction, err := endpoint.sampleSegments(ctx, start, sampleSize, func(segment *metabase.VerifySegment) {
+		if !hasAlias {
+			return
+		}
+		for _, piece := range segment.AliasPieces {
+			if piece.Alias == alias {
+				sampledPieces++
+			}
+		}
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.DetectOrphanedPiecesResponse{
+		NodeId:          in.NodeId,
+		RecordedPieces:  node.PieceCount,
+		SegmentsScanned: int64(scanned),
+		SampledPieces:   sampledPieces,
+		SampleFraction:  fraction,
+		Exact:           fraction == 1,
+	}
+	if fraction > 0 {
+		response.EstimatedLivePieces = int64(math.Round(float64(sampledPieces) / fraction))
+	}
+	if orphaned := response.RecordedPieces - response.EstimatedLivePieces; orphaned > 0 {
+		response.EstimatedOrphanedPieces = orphaned
+	}
+
+	return response, nil
+}
+
+// resolveSampleSize returns the number of segments to sample for a request, falling back to the configured size when the
+// request doesn't specify one and capping it at the configured max.
+func resolveSampleSize(requested int32, configured, max int) (int, error) {
+	if requested < 0 {
+		return 0, Error.New("sample size must not be negative")
+	}
+
+	size := configured
+	if requested > 0 {
+		size = int(requested)
+	}
+	if max > 0 && size > max {
+		size = max
+	}
+	if size <= 0 {
+		size = defaultScanLimit
+	}
+	return size, nil
+}
+
+// resolveSampleRate returns the fraction of objects to sample for a request, falling back to the configured rate when
+// the request doesn't specify one.
+func resolveSampleRate(requested, configured float64) (float64, error) {
+	rate := requested
+	if rate == 0 {
+		rate = configured
+	}
+	if rate <= 0 || rate > 1 {
+		return 0, Error.New("sample rate must be in (0, 1]: %v", rate)
+	}
+	return rate, nil
+}
+
+// sampleStart returns the stream id a sample starts at, which is random when the request doesn't specify one.
+func sampleStart(streamID []byte) (uuid.UUID, error) {
+	if len(streamID) > 0 {
+		return uuid.FromBytes(streamID)
+	}
+	return uuid.New()
+}
+
+// sampleSegments calls fn for up to size remote segments, starting after the start stream id and wrapping around to
+// the first segment when the last one is reached. It returns the number of sampled segments and the fraction of the
+// stream id space they cover, which estimates the fraction of all segments sampled since stream ids are random.
+func (endpoint *Endpoint) sampleSegments(ctx context.Context, start uuid.UUID, size int, fn func(*metabase.VerifySegment)) (scanned int, fraction float64, err error) {
+	return sampleListedSegments(ctx, func(ctx context.Context, cursorStreamID uuid.UUID, cursorPosition metabase.SegmentPosition, limit int) (metabase.ListVerifySegmentsResult, error) {
+		return endpoint.metabase.ListVerifySegments(ctx, metabase.ListVerifySegments{
+			CursorStreamID: cursorStreamID,
+			CursorPosition: cursorPosition,
+			Limit:          limit,
+		})
+	}, start, size, fn)
+}
+
+// segmentLister lists the segments after the cursor, in the order of ListVerifySegments.
+type segmentLister func(ctx context.Context, cursorStreamID uuid.UUID, cursorPosition metabase.SegmentPosition, limit int) (metabase.ListVerifySegmentsResult, error)
+
+// sampleListedSegments samples the segments the lister lists the way sampleSegments samples all remote segments.
+func sampleListedSegments(ctx context.Context, list segmentLister, start uuid.UUID, size int, fn func(*metabase.VerifySegment)) (scanned int, fraction float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cursorStreamID := start
+	var cursorPosition metabase.SegmentPosition
+	wrapped := false
+
+	for scanned < size {
+		limit := size - scanned
+		if limit > defaultScanLimit {
+			limit = defaultScanLimit
+		}
+
+		result, err := list(ctx, cursorStreamID, cursorPosition, limit)
+		if err != nil {
+			return scanned, 0, err
+		}
+
+		for i := range result.Segments {
+			segment := &result.Segments[i]
+			if wrapped && !segment.StreamID.Less(start) {
+				// every segment was sampled
+				return scanned, 1, nil
+			}
+
+			fn(segment)
+			scanned++
+			cursorStreamID, cursorPosition = segment.StreamID, segment.Position
+		}
+
+		if len(result.Segments) < limit {
+			if wrapped {
+				return scanned, 1, nil
+			}
+			wrapped = true
+			cursorStreamID, cursorPosition = uuid.UUID{}, metabase.SegmentPosition{}
+		}
+	}
+
+	// the unsigned difference of the leading bytes wraps around together with the sample
+	covered := binary.BigEndian.Uint64(cursorStreamID[:8]) - binary.BigEndian.Uint64(start[:8])
+	return scanned, float64(covered) / math.Pow(2, 64), nil
+}
+
+// defaultSegmentSizeBounds are the upper bounds of the segment size ranges, in bytes, when a request doesn't specify
+// any. The lowest bound matches the default inline segment size, and the highest the maximum segment size.
+var defaultSegmentSizeBounds = []int64{4 * memory.KiB.Int64(), 64 * memory.KiB.Int64(), memory.MiB.Int64(), 16 * memory.MiB.Int64(), 64 * memory.MiB.Int64()}
+
+// SegmentSizeHistogram counts the segments of a sample of the committed objects of a project or bucket by their
+// encrypted size, separating inline segments from remote ones. The counts of the sample are extrapolated to estimate
+// the counts of all objects.
+func (endpoint *Endpoint) SegmentSizeHistogram(ctx context.Context, in *internalpb.SegmentSizeHistogramRequest) (_ *internalpb.SegmentSizeHistogramResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromBytes(in.GetProjectId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sampleRate, err := resolveSampleRate(in.GetSampleRate(), endpoint.config.SegmentSizeSampleRate)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := in.GetUpperBounds()
+	if len(bounds) == 0 {
+		bounds = defaultSegmentSizeBounds
+	}
+	for i, bound := range bounds {
+		if bound <= 0 || (i > 0 && bound <= bounds[i-1]) {
+			return nil, Error.New("upper bounds must be ascending positive sizes: %v", bounds)
+		}
+	}
+
+	// the last range covers everything above the highest bound
+	ranges := make([]*internalpb.SegmentSizeRange, 0, len(bounds)+1)
+	lower := int64(0)
+	for _, bound := range append(bounds[:len(bounds):len(bounds)], 0) {
+		ranges = append(ranges, &internalpb.SegmentSizeRange{LowerBound: lower, UpperBound: bound})
+		lower = bound
+	}
+
+	response := &internalpb.SegmentSizeHistogramResponse{
+		Ranges:     ranges,
+		SampleRate: sampleRate,
+	}
+
+	err = endpoint.metabase.SampleSegmentSizes(ctx, metabase.SampleSegmentSizes{
+		ProjectID:  projectID,
+		BucketName: string(in.GetBucket()),
+		SampleRate: sampleRate,
+	}, func(size metabase.SegmentSize) {
+		index := 0
+		for index < len(ranges)-1 && int64(size.EncryptedSize) >= ranges[index].UpperBound {
+			index++
+		}
+
+		ranges[index].SampledSegments++
+		response.SampledSegments++
+		if size.Inline {
+			ranges[index].InlineSegments++
+			response.SampledInlineSegments++
+		}
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, sizeRange := range ranges {
+		sizeRange.EstimatedSegments = int64(math.Round(float64(sizeRange.SampledSegments) / sampleRate))
+	}
+
+	return response, nil
+}
+
+// RedundancyDistribution counts a sample of the remote segments by the redundancy scheme they were uploaded with, to
+// show the storage overhead of the segments across the network. The counts of the sample are extrapolated to estimate
+// the counts of all segments. Inline segments have no redundancy scheme and aren't counted.
+func (endpoint *Endpoint) RedundancyDistribution(ctx context.Context, in *internalpb.RedundancyDistributionRequest) (_ *internalpb.RedundancyDistributionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.RedundancySampleSize, endpoint.config.RedundancyMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	type scheme struct {
+		required, repair, optimal, total int16
+	}
+
+	counts := make(map[scheme]*internalpb.RedundancySchemeCount)
+	scanned, fraction, err := endpoint.sampleSegments(ctx, start, sampleSize, func(segment *metabase.VerifySegment) {
+		redundancy := segment.Redundancy
+		key := scheme{redundancy.RequiredShares, redundancy.RepairShares, redundancy.OptimalShares, redundancy.TotalShares}
+
+		count, ok := counts[key]
+		if !ok {
+			count = &internalpb.RedundancySchemeCount{
+				RequiredShares: int32(key.required),
+				RepairShares:   int32(key.repair),
+				OptimalShares:  int32(key.optimal),
+				TotalShares:    int32(key.total),
+			}
+			if key.required > 0 {
+				count.ExpansionFactor = float64(key.optimal) / float64(key.required)
+			}
+			counts[key] = count
+		}
+		count.SampledSegments++
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.RedundancyDistributionResponse{
+		SegmentsScanned: int64(scanned),
+		SampleFraction:  fraction,
+		Exact:           fraction == 1,
+	}
+	for _, count := range counts {
+		if fraction > 0 {
+			count.EstimatedSegments = int64(math.Round(float64(count.SampledSegments) / fraction))
+		}
+		response.Schemes = append(response.Schemes, count)
+	}
+
+	sort.Slice(response.Schemes, func(i, j int) bool {
+		a, b := response.Schemes[i], response.Schemes[j]
+		if a.SampledSegments != b.SampledSegments {
+			return a.SampledSegments > b.SampledSegments
+		}
+		if a.RequiredShares != b.RequiredShares {
+			return a.RequiredShares < b.RequiredShares
+		}
+		if a.RepairShares != b.RepairShares {
+			return a.RepairShares < b.RepairShares
+		}
+		if a.OptimalShares != b.OptimalShares {
+			return a.OptimalShares < b.OptimalShares
+		}
+		return a.TotalShares < b.TotalShares
+	})
+
+	return response, nil
+}
+
+// SegmentPieceNodes returns the node of every piece of a segment along with the address the node was last known at,
+// to tell which nodes a download of the segment contacts. Pieces on nodes that are offline or disqualified are marked
+// as such.
+func (endpoint *Endpoint) SegmentPieceNodes(ctx context.Context, in *internalpb.SegmentPieceNodesRequest) (_ *internalpb.SegmentPieceNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamID, err := uuid.FromBytes(in.GetStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	segment, err := endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: metabase.SegmentPositionFromEncoded(uint64(in.GetPosition())),
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	pieces := append(metabase.Pieces(nil), segment.Pieces...)
+	sort.Slice(pieces, func(i, k int) bool {
+		return pieces[i].Number < pieces[k].Number
+	})
+
+	response := &internalpb.SegmentPieceNodesResponse{}
+	for _, piece := range pieces {
+		pieceNode := &internalpb.PieceNode{
+			PieceNum: int32(piece.Number),
+			NodeId:   piece.StorageNode,
+		}
+
+		node, err := endpoint.overlay.Get(ctx, piece.StorageNode)
+		switch {
+		case err == nil:
+			if node.Address != nil {
+				pieceNode.Address = node.Address.Address
+			}
+			pieceNode.LastIpPort = node.LastIPPort
+			pieceNode.LastContactSuccess = node.Reputation.LastContactSuccess
+			pieceNode.Online = endpoint.overlay.IsOnline(node)
+			pieceNode.Disqualified = node.Disqualified != nil
+		case overlay.ErrNodeNotFound.Has(err):
+			pieceNode.Missing = true
+		default:
+			return nil, Error.Wrap(err)
+		}
+
+		response.Pieces = append(response.Pieces, pieceNode)
+	}
+
+	return response, nil
+}
+
+// InlineRemoteRatio compares the segments of a sample of the committed objects of a project or bucket that are stored
+// inline in the metainfo with the ones stored on nodes, to show the overhead of small objects. The totals of the sample
+// are extrapolated to estimate the totals of all objects.
+func (endpoint *Endpoint) InlineRemoteRatio(ctx context.Context, in *internalpb.InlineRemoteRatioRequest) (_ *internalpb.InlineRemoteRatioResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromBytes(in.GetProjectId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sampleRate, err := resolveSampleRate(in.GetSampleRate(), endpoint.config.SegmentSizeSampleRate)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &internalpb.InlineRemoteRatioResponse{
+		Inline:     &internalpb.SegmentTotals{},
+		Remote:     &internalpb.SegmentTotals{},
+		SampleRate: sampleRate,
+	}
+
+	err = endpoint.metabase.SampleSegmentSizes(ctx, metabase.SampleSegmentSizes{
+		ProjectID:  projectID,
+		BucketName: string(in.GetBucket()),
+		SampleRate: sampleRate,
+	}, func(size metabase.SegmentSize) {
+		totals := response.Remote
+		if size.Inline {
+			totals = response.Inline
+		}
+		totals.SampledSegments++
+		totals.SampledBytes += int64(size.EncryptedSize)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, totals := range []*internalpb.SegmentTotals{response.Inline, response.Remote} {
+		totals.EstimatedSegments = int64(math.Round(float64(totals.SampledSegments) / sampleRate))
+		totals.EstimatedBytes = int64(math.Round(float64(totals.SampledBytes) / sampleRate))
+	}
+
+	if segments := response.Inline.SampledSegments + response.Remote.SampledSegments; segments > 0 {
+		response.InlineSegmentFraction = float64(response.Inline.SampledSegments) / float64(segments)
+	}
+	if bytes := response.Inline.SampledBytes + response.Remote.SampledBytes; bytes > 0 {
+		response.InlineBytesFraction = float64(response.Inline.SampledBytes) / float64(bytes)
+	}
+
+	return response, nil
+}
+
+// DryRunRepairChecker runs the repair checker over a sample of the segments of a bucket, using the current health of
+// the nodes, and reports how many of them it would queue for repair and how healthy they are. Unlike the checker it
+// doesn't queue anything, so it tells what a change of node health or repair thresholds would do to the repair queue.
+func (endpoint *Endpoint) DryRunRepairChecker(ctx context.Context, in *internalpb.DryRunRepairCheckerRequest) (_ *internalpb.DryRunRepairCheckerResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromBytes(in.GetProjectId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(in.GetBucket()) == 0 {
+		return nil, Error.New("bucket missing")
+	}
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.DryRunRepairSampleSize, endpoint.config.DryRunRepairMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := int(in.GetHealthBuckets())
+	if buckets < 0 {
+		return nil, Error.New("health buckets must not be negative")
+	}
+	if buckets == 0 {
+		buckets = defaultHealthBuckets
+	}
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the checker considers the pieces on nodes that aren't reliable missing
+	reliableNodes, err := endpoint.overlay.Reliable(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	reliable := make(map[storj.NodeID]bool, len(reliableNodes))
+	for _, id := range reliableNodes {
+		reliable[id] = true
+	}
+
+	aliasMap, err := endpoint.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	repairOverrides := endpoint.checker.RepairOverrides.GetMap()
+
+	response := &internalpb.DryRunRepairCheckerResponse{}
+	margins := make(map[int32]int64)
+	var queuedHealth []float64
+	var unknownAlias error
+
+	list := func(ctx context.Context, cursorStreamID uuid.UUID, cursorPosition metabase.SegmentPosition, limit int) (metabase.ListVerifySegmentsResult, error) {
+		return endpoint.metabase.ListBucketVerifySegments(ctx, metabase.ListBucketVerifySegments{
+			ProjectID:      projectID,
+			BucketName:     string(in.GetBucket()),
+			CursorStreamID: cursorStreamID,
+			CursorPosition: cursorPosition,
+			Limit:          limit,
+		})
+	}
+	_, fraction, err := sampleListedSegments(ctx, list, start, sampleSize, func(segment *metabase.VerifySegment) {
+		if len(segment.AliasPieces) == 0 {
+			return
+		}
+
+		healthy := 0
+		for _, piece := range segment.AliasPieces {
+			nodeID, ok := aliasMap.Node(piece.Alias)
+			if !ok {
+				unknownAlias = Error.New("unknown node alias %d", piece.Alias)
+				continue
+			}
+			if reliable[nodeID] {
+				healthy++
+			}
+		}
+
+		required := int(segment.Redundancy.RequiredShares)
+		repairThreshold := int(segment.Redundancy.RepairShares)
+		if override := repairOverrides.GetOverrideValue(segment.Redundancy); override != 0 {
+			repairThreshold = int(override)
+		}
+		successThreshold := int(segment.Redundancy.OptimalShares)
+
+		response.SegmentsChecked++
+		margins[int32(healthy-repairThreshold)]++
+
+		// the same condition the checker queues segments with
+		if healthy <= repairThreshold && healthy < successThreshold {
+			response.WouldQueue++
+			if healthy < required {
+				response.Irreparable++
+			}
+			queuedHealth = append(queuedHealth, repair.SegmentHealth(healthy, required, len(reliableNodes), endpoint.checker.NodeFailureRate))
+		}
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if unknownAlias != nil {
+		return nil, unknownAlias
+	}
+
+	response.SampleFraction = fraction
+	response.Exact = fraction == 1
+	if fraction > 0 {
+		response.EstimatedWouldQueue = int64(math.Round(float64(response.WouldQueue) / fraction))
+	}
+
+	for margin, count := range margins {
+		response.Margins = append(response.Margins, &internalpb.SegmentMarginCount{
+			Margin: margin,
+			Count:  count,
+		})
+	}
+	sort.Slice(response.Margins, func(i, k int) bool {
+		return response.Margins[i].Margin < response.Margins[k].Margin
+	})
+
+	response.MinHealth, response.MaxHealth, response.HealthBuckets = healthDistribution(queuedHealth, buckets)
+
+	return response, nil
+}
+
+// healthDistribution splits the range between the minimum and maximum segment health into buckets of equal width, the
+// same way the repair queue stats do.
+func healthDistribution(health []float64, buckets int) (minHealth, maxHealth float64, distribution []*internalpb.RepairHealthBucket) {
+	if len(health) == 0 {
+		return 0, 0, nil
+	}
+
+	minHealth, maxHealth = health[0], health[0]
+	for _, value := range health[1:] {
+		minHealth = math.Min(minHealth, value)
+		maxHealth = math.Max(maxHealth, value)
+	}
+
+	// all segments share a single bucket when their health doesn't differ
+	if buckets <= 1 || minHealth == maxHealth {
+		return minHealth, maxHealth, []*internalpb.RepairHealthBucket{{Lower: minHealth, Upper: maxHealth, Count: int64(len(health))}}
+	}
+
+	width := (maxHealth - minHealth) / float64(buckets)
+	distribution = make([]*internalpb.RepairHealthBucket, buckets)
+	for i := range distribution {
+		distribution[i] = &internalpb.RepairHealthBucket{
+			Lower: minHealth + float64(i)*width,
+			Upper: minHealth + float64(i+1)*width,
+		}
+	}
+	distribution[buckets-1].Upper = maxHealth
+
+	for _, value := range health {
+		bucket := int(math.Floor((value - minHealth) / width))
+		if bucket > buckets-1 {
+			bucket = buckets - 1
+		}
+		distribution[bucket].Count++
+	}
+
+	return minHealth, maxHealth, distribution
+}
+
+// DuplicatePieceNodes samples remote segments for pieces of the same segment stored on the same node, which node
+// selection must never allow: the node failing would take several pieces of the segment with it, and the segment
+// would be less durable than its health says. Any violation points at a placement bug.
+func (endpoint *Endpoint) DuplicatePieceNodes(ctx context.Context, in *internalpb.DuplicatePieceNodesRequest) (_ *internalpb.DuplicatePieceNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.DuplicatePieceSampleSize, endpoint.config.DuplicatePieceMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetLimit() < 0 {
+		return nil, Error.New("limit must not be negative")
+	}
+	limit := pageLimit(in.GetLimit())
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	aliasMap, err := endpoint.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.DuplicatePieceNodesResponse{}
+	var unknownAlias error
+
+	scanned, fraction, err := endpoint.sampleSegments(ctx, start, sampleSize, func(segment *metabase.VerifySegment) {
+		pieces := make(map[metabase.NodeAlias][]int32, len(segment.AliasPieces))
+		var duplicates []metabase.NodeAlias
+		for _, piece := range segment.AliasPieces {
+			if len(pieces[piece.Alias]) == 1 {
+				duplicates = append(duplicates, piece.Alias)
+			}
+			pieces[piece.Alias] = append(pieces[piece.Alias], int32(piece.Number))
+		}
+		if len(duplicates) == 0 {
+			return
+		}
+
+		response.ViolatingSegments++
+		for _, alias := range duplicates {
+			nodeID, ok := aliasMap.Node(alias)
+			if !ok {
+				unknownAlias = Error.New("unknown node alias %d", alias)
+				continue
+			}
+
+			if len(response.Violations) >= limit {
+				response.More = true
+				continue
+			}
+
+			numbers := pieces[alias]
+			sort.Slice(numbers, func(i, k int) bool { return numbers[i] < numbers[k] })
+			response.Violations = append(response.Violations, &internalpb.DuplicatePieceNode{
+				StreamId:     segment.StreamID.Bytes(),
+				Position:     int64(segment.Position.Encode()),
+				NodeId:       nodeID,
+				PieceNumbers: numbers,
+			})
+		}
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if unknownAlias != nil {
+		return nil, unknownAlias
+	}
+
+	response.SegmentsScanned = int64(scanned)
+	response.SampleFraction = fraction
+	response.Exact = fraction == 1
+	if fraction > 0 {
+		response.EstimatedViolatingSegments = int64(math.Round(float64(response.ViolatingSegments) / fraction))
+	}
+
+	return response, nil
+}
+
+// OrphanedProjectSegments samples remote segments for the ones still stored for projects that are no longer active:
+// projects that were deleted and projects whose owner deleted their account. The owning projects are looked up in the
+// console database. Segments without an object aren't counted, they're left to the zombie deletion chore.
+func (endpoint *Endpoint) OrphanedProjectSegments(ctx context.Context, in *internalpb.OrphanedProjectSegmentsRequest) (_ *internalpb.OrphanedProjectSegmentsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.OrphanedProjectSampleSize, endpoint.config.OrphanedProjectMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetLimit() < 0 {
+		return nil, Error.New("limit must not be negative")
+	}
+	limit := pageLimit(in.GetLimit())
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	type sampledSegment struct {
+		streamID uuid.UUID
+		position metabase.SegmentPosition
+	}
+	var segments []sampledSegment
+	var streamIDs []uuid.UUID
+	scanned, fraction, err := endpoint.sampleSegments(ctx, start, sampleSize, func(segment *metabase.VerifySegment) {
+		if len(segments) == 0 || segments[len(segments)-1].streamID != segment.StreamID {
+			streamIDs = append(streamIDs, segment.StreamID)
+		}
+		segments = append(segments, sampledSegment{streamID: segment.StreamID, position: segment.Position})
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	streamProjects, err := endpoint.streamProjects(ctx, streamIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.OrphanedProjectSegmentsResponse{}
+
+	// the orphaned projects, and nil for the active ones
+	projects := map[uuid.UUID]*internalpb.OrphanedProject{}
+	for _, segment := range segments {
+		projectID, ok := streamProjects[segment.streamID]
+		if !ok {
+			continue
+		}
+
+		project, checked := projects[projectID]
+		if !checked {
+			reason, orphaned, err := endpoint.orphanReason(ctx, projectID)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if orphaned {
+				project = &internalpb.OrphanedProject{ProjectId: projectID.Bytes(), Reason: reason}
+				response.Projects = append(response.Projects, project)
+			}
+			projects[projectID] = project
+		}
+		if project == nil {
+			continue
+		}
+
+		project.Segments++
+		response.OrphanedSegments++
+		if len(response.Segments) >= limit {
+			response.More = true
+			continue
+		}
+		response.Segments = append(response.Segments, &internalpb.OrphanedProjectSegment{
+			StreamId:  segment.streamID.Bytes(),
+			Position:  int64(segment.position.Encode()),
+			ProjectId: project.ProjectId,
+			Reason:    project.Reason,
+		})
+	}
+
+	sort.SliceStable(response.Projects, func(i, k int) bool {
+		return response.Projects[i].Segments > response.Projects[k].Segments
+	})
+
+	response.SegmentsScanned = int64(scanned)
+	response.SampleFraction = fraction
+	response.Exact = fraction == 1
+	if fraction > 0 {
+		response.EstimatedOrphanedSegments = int64(math.Round(float64(response.OrphanedSegments) / fraction))
+	}
+
+	return response, nil
+}
+
+// orphanReason returns why the project is orphaned, if it's orphaned at all. Projects whose owner no longer exists
+// are treated like the ones whose owner deleted their account.
+func (endpoint *Endpoint) orphanReason(ctx context.Context, projectID uuid.UUID) (_ internalpb.OrphanedProject_Reason, orphaned bool, err error) {
+	project, err := endpoint.projects.Get(ctx, projectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return internalpb.OrphanedProject_PROJECT_DELETED, true, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	owner, err := endpoint.users.Get(ctx, project.OwnerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return internalpb.OrphanedProject_OWNER_DELETED, true, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return internalpb.OrphanedProject_OWNER_DELETED, owner.Status == console.Deleted, nil
+}
+
+// streamProjects looks up the projects owning the objects of the streams in batches, by stream id. Streams without an
+// object are left out.
+func (endpoint *Endpoint) streamProjects(ctx context.Context, streamIDs []uuid.UUID) (_ map[uuid.UUID]uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamProjects := make(map[uuid.UUID]uuid.UUID, len(streamIDs))
+	for len(streamIDs) > 0 {
+		batch := streamIDs
+		if len(batch) > defaultScanLimit {
+			batch = batch[:defaultScanLimit]
+		}
+		streamIDs = streamIDs[len(batch):]
+
+		projectIDs, err := endpoint.metabase.GetStreamProjectIDs(ctx, metabase.GetStreamProjectIDs{StreamIDs: batch})
+		if err != nil {
+			return nil, err
+		}
+		for streamID, projectID := range projectIDs {
+			streamProjects[streamID] = projectID
+		}
+	}
+	return streamProjects, nil
+}
+
+// NodeStorageByProject estimates how the pieces of a node are distributed across the projects owning them, to tell
+// whether a node mostly holds the data of a few customers. It samples a contiguous range of segments, looks up the
+// projects owning the segments holding the node's pieces and extrapolates the piece counts of the sample. The sample
+// is capped by the configured max, since every sampled stream holding a piece of the node is looked up.
+func (endpoint *Endpoint) NodeStorageByProject(ctx context.Context, in *internalpb.NodeStorageByProjectRequest) (_ *internalpb.NodeStorageByProjectResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.NodeStorageSampleSize, endpoint.config.NodeStorageMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetLimit() < 0 {
+		return nil, Error.New("limit must not be negative")
+	}
+	limit := pageLimit(in.GetLimit())
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = endpoint.overlay.Get(ctx, in.NodeId)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	aliasMap, err := endpoint.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	alias, hasAlias := aliasMap.Alias(in.NodeId)
+
+	// the node's pieces in the sampled segments, by stream
+	streamPieces := map[uuid.UUID]int64{}
+	var streamIDs []uuid.UUID
+	scanned, fraction, err := endpoint.sampleSegments(ctx, start, sampleSize, func(segment *metabase.VerifySegment) {
+		if !hasAlias {
+			return
+		}
+		for _, piece := range segment.AliasPieces {
+			if piece.Alias != alias {
+				continue
+			}
+			if _, ok := streamPieces[segment.StreamID]; !ok {
+				streamIDs = append(streamIDs, segment.StreamID)
+			}
+			streamPieces[segment.StreamID]++
+		}
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	streamProjects, err := endpoint.streamProjects(ctx, streamIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.NodeStorageByProjectResponse{
+		NodeId:          in.NodeId,
+		SegmentsScanned: int64(scanned),
+		SampleFraction:  fraction,
+		Exact:           fraction == 1,
+	}
+
+	projects := map[uuid.UUID]*internalpb.NodeProjectStorage{}
+	for _, streamID := range streamIDs {
+		pieces := streamPieces[streamID]
+		response.SampledPieces += pieces
+
+		projectID, ok := streamProjects[streamID]
+		if !ok {
+			response.UnownedPieces += pieces
+			continue
+		}
+
+		project, ok := projects[projectID]
+		if !ok {
+			project = &internalpb.NodeProjectStorage{ProjectId: projectID.Bytes()}
+			projects[projectID] = project
+			response.Projects = append(response.Projects, project)
+		}
+		project.SampledPieces += pieces
+	}
+
+	sort.SliceStable(response.Projects, func(i, k int) bool {
+		return response.Projects[i].SampledPieces > response.Projects[k].SampledPieces
+	})
+	_, end, more := pageBounds(len(response.Projects), 0, limit)
+	response.Projects, response.More = response.Projects[:end], more
+
+	for _, project := range response.Projects {
+		project.Share = float64(project.SampledPieces) / float64(response.SampledPieces)
+		if fraction > 0 {
+			project.EstimatedPieces = int64(math.Round(float64(project.SampledPieces) / fraction))
+		}
+	}
+	if fraction > 0 {
+		response.EstimatedPieces = int64(math.Round(float64(response.SampledPieces) / fraction))
+	}
+
+	return response, nil
+}
+
+// ProjectsOverLimit checks every project's storage usage and bandwidth usage of the current month against its limits,
+// and returns a page of the projects exceeding either of them, ordered by how far they're over.
+func (endpoint *Endpoint) ProjectsOverLimit(ctx context.Context, in *internalpb.ProjectsOverLimitRequest) (_ *internalpb.ProjectsOverLimitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetLimit() < 0 {
+		return nil, Error.New("limit must not be negative")
+	}
+	limit := pageLimit(in.GetLimit())
+
+	var cursorID uuid.UUID
+	if len(in.GetCursorProjectId()) > 0 {
+		cursorID, err = uuid.FromBytes(in.GetCursorProjectId())
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	afterCursor := func(project *internalpb.ProjectOverLimit) bool {
+		if cursorID.IsZero() || project.Overage < in.GetCursorOverage() {
+			return true
+		}
+		if project.Overage > in.GetCursorOverage() {
+			return false
+		}
+		return bytes.Compare(project.ProjectId, cursorID.Bytes()) > 0
+	}
+
+	response := &internalpb.ProjectsOverLimitResponse{}
+
+	var over []*internalpb.ProjectOverLimit
+	before := time.Now()
+	for offset := int64(0); ; {
+		page, err := endpoint.projects.List(ctx, offset, projectsPageSize, before)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, project := range page.Projects {
+			usage, err := endpoint.projectUsage(ctx, project)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			response.ProjectsChecked++
+
+			if usage.StorageUsed > usage.StorageLimit {
+				response.OverStorageLimit++
+			}
+			if usage.BandwidthUsed > usage.BandwidthLimit {
+				response.OverBandwidthLimit++
+			}
+			if usage.Overage > 0 && afterCursor(usage) {
+				over = append(over, usage)
+			}
+		}
+
+		if !page.Next {
+			break
+		}
+		offset = page.NextOffset
+	}
+
+	sort.Slice(over, func(i, k int) bool {
+		if over[i].Overage != over[k].Overage {
+			return over[i].Overage > over[k].Overage
+		}
+		return bytes.Compare(over[i].ProjectId, over[k].ProjectId) < 0
+	})
+
+	_, end, more := pageBounds(len(over), 0, limit)
+	response.Projects, response.More = over[:end], more
+
+	return response, nil
+}
+
+// projectUsage returns the usage and the limits of the project, along with how far it's over the limit it exceeds the
+// most. The limits are those enforced on uploads and downloads, falling back to the defaults for projects without
+// limits of their own.
+func (endpoint *Endpoint) projectUsage(ctx context.Context, project console.Project) (_ *internalpb.ProjectOverLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageUsed, err := endpoint.usage.GetProjectStorageTotals(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	storageLimit, err := endpoint.usage.GetProjectStorageLimit(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	bandwidthUsed, err := endpoint.usage.GetProjectBandwidthTotals(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	bandwidthLimit, err := endpoint.usage.GetProjectBandwidthLimit(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	overage := storageUsed - storageLimit.Int64()
+	if bandwidthOverage := bandwidthUsed - bandwidthLimit.Int64(); bandwidthOverage > overage {
+		overage = bandwidthOverage
+	}
+	if overage < 0 {
+		overage = 0
+	}
+
+	return &internalpb.ProjectOverLimit{
+		ProjectId:      project.ID.Bytes(),
+		OwnerId:        project.OwnerID.Bytes(),
+		Name:           project.Name,
+		StorageUsed:    storageUsed,
+		StorageLimit:   storageLimit.Int64(),
+		BandwidthUsed:  bandwidthUsed,
+		BandwidthLimit: bandwidthLimit.Int64(),
+		Overage:        overage,
+	}, nil
+}
+
+// SimulateBulkDisqualification samples remote segments and reports how many of them the checker would queue for repair,
+// or couldn't repair anymore, if the nodes were disqualified, compared to the nodes as they are. It's read-only, the
+// nodes aren't disqualified, so it tells whether disqualifying them at once would flood the repair queue.
+func (endpoint *Endpoint) SimulateBulkDisqualification(ctx context.Context, in *internalpb.SimulateBulkDisqualificationRequest) (_ *internalpb.SimulateBulkDisqualificationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(in.NodeIds) == 0 {
+		return nil, Error.New("node ids missing")
+	}
+
+	sampleSize, err := resolveSampleSize(in.GetSampleSize(), endpoint.config.DisqualificationSampleSize, endpoint.config.DisqualificationMaxSampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := sampleStart(in.GetStartStreamId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the checker considers the pieces on nodes that aren't reliable missing
+	reliableNodes, err := endpoint.overlay.Reliable(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	aliasMap, err := endpoint.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	reliable := make(map[metabase.NodeAlias]bool, len(reliableNodes))
+	for _, id := range reliableNodes {
+		if alias, ok := aliasMap.Alias(id); ok {
+			reliable[alias] = true
+		}
+	}
+	disqualified := make(map[metabase.NodeAlias]bool, len(in.NodeIds))
+	for _, id := range in.NodeIds {
+		if alias, ok := aliasMap.Alias(id); ok {
+			disqualified[alias] = true
+		}
+	}
+
+	repairOverrides := endpoint.checker.RepairOverrides.GetMap()
+
+	response := &internalpb.SimulateBulkDisqualificationResponse{}
+	scanned, fraction, err := endpoint.sampleSegments(ctx, start, sampleSize, func(segment *metabase.VerifySegment) {
+		healthy, remaining, removed := 0, 0, 0
+		for _, piece := range segment.AliasPieces {
+			if disqualified[piece.Alias] {
+				removed++
+			}
+			if !reliable[piece.Alias] {
+				continue
+			}
+			healthy++
+			if !disqualified[piece.Alias] {
+				remaining++
+			}
+		}
+		if removed > 0 {
+			response.AffectedSegments++
+			response.RemovedPieces += int64(removed)
+		}
+
+		required := int(segment.Redundancy.RequiredShares)
+		repairThreshold := int(segment.Redundancy.RepairShares)
+		if override := repairOverrides.GetOverrideValue(segment.Redundancy); override != 0 {
+			repairThreshold = int(override)
+		}
+		successThreshold := int(segment.Redundancy.OptimalShares)
+
+		// the same condition the checker queues segments with
+		queued := func(healthy int) bool { return healthy <= repairThreshold && healthy < successThreshold }
+
+		if queued(remaining) {
+			response.WouldQueue++
+			if !queued(healthy) {
+				response.NewlyQueued++
+			}
+		}
+		if remaining < required {
+			response.Irreparable++
+			if healthy >= required {
+				response.NewlyIrreparable++
+			}
+		}
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response.SegmentsScanned = int64(scanned)
+	response.SampleFraction = fraction
+	response.Exact = fraction == 1
+	if fraction > 0 {
+		response.EstimatedNewlyQueued = int64(math.Round(float64(response.NewlyQueued) / fraction))
+		response.EstimatedNewlyIrreparable = int64(math.Round(float64(response.NewlyIrreparable) / fraction))
+	}
+
+	return response, nil
+}
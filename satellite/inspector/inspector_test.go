@@ -6,21 +6,30 @@ package inspector_test
 import (
 	"encoding/binary"
 	"errors"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/base58"
 	"storj.io/common/encryption"
 	"storj.io/common/memory"
 	"storj.io/common/paths"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
 	"storj.io/uplink/private/eestream"
 )
 
@@ -106,6 +115,89 @@ func TestInspectorStats(t *testing.T) {
 			require.Equal(t, segment.Position, position)
 		}
 
+		{ // Test Segment Geo Spread Request
+			for i, piece := range segment.Pieces {
+				countryCode := "DE"
+				if i == 0 {
+					countryCode = "US"
+				}
+				require.NoError(t, satellite.Overlay.DB.TestNodeCountryCode(ctx, piece.StorageNode, countryCode))
+			}
+
+			req := &internalpb.SegmentGeoSpreadRequest{
+				ProjectId:     projectID[:],
+				EncryptedPath: []byte(encryptedPath.Raw()),
+				Bucket:        []byte(bucket),
+				SegmentIndex:  int64(segment.Position.Encode()),
+			}
+
+			resp, err := healthEndpoint.SegmentGeoSpread(ctx, req)
+			require.NoError(t, err)
+			require.Len(t, resp.Pieces, len(segment.Pieces))
+			require.Empty(t, resp.CrowdedCountries)
+
+			// all testplanet nodes share the loopback subnet
+			require.Len(t, resp.CrowdedSubnets, 1)
+			require.Len(t, resp.CrowdedSubnets[0].PieceNums, len(segment.Pieces))
+
+			req.MaxPerCountry = 1
+			req.MaxPerSubnet = int32(len(segment.Pieces))
+
+			resp, err = healthEndpoint.SegmentGeoSpread(ctx, req)
+			require.NoError(t, err)
+			require.Empty(t, resp.CrowdedSubnets)
+			require.Len(t, resp.CrowdedCountries, 1)
+			require.Equal(t, "DE", resp.CrowdedCountries[0].Location)
+			require.Len(t, resp.CrowdedCountries[0].PieceNums, len(segment.Pieces)-1)
+		}
+	})
+}
+
+func TestSegmentsAtRisk(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+
+		endpoint := satellite.Inspector.Endpoint
+
+		// every segment is stored on all four nodes with a repair threshold of three
+		resp, err := endpoint.SegmentsAtRisk(ctx, &internalpb.SegmentsAtRiskRequest{})
+		require.NoError(t, err)
+		require.True(t, resp.Done)
+		require.EqualValues(t, 2, resp.SegmentsScanned)
+		require.Equal(t, []*internalpb.SegmentMarginCount{{Margin: 1, Count: 2}}, resp.Margins)
+
+		{ // resume the scan with a cursor
+			req := &internalpb.SegmentsAtRiskRequest{Limit: 1}
+
+			var scanned int64
+			for i := 0; ; i++ {
+				require.Less(t, i, 3, "scan should finish")
+
+				resp, err := endpoint.SegmentsAtRisk(ctx, req)
+				require.NoError(t, err)
+
+				scanned += resp.SegmentsScanned
+				if resp.Done {
+					break
+				}
+
+				req.CursorStreamId = resp.CursorStreamId
+				req.CursorPosition = resp.CursorPosition
+			}
+			require.EqualValues(t, 2, scanned)
+		}
+
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.StorageNodes[0]))
+
+		resp, err = endpoint.SegmentsAtRisk(ctx, &internalpb.SegmentsAtRiskRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []*internalpb.SegmentMarginCount{{Margin: 0, Count: 2}}, resp.Margins)
 	})
 }
 
@@ -131,3 +223,722 @@ func encryptionAccess(access string) (*encryption.Store, error) {
 
 	return store, nil
 }
+
+func TestRepairQueueStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		resp, err := endpoint.RepairQueueStats(ctx, &internalpb.RepairQueueStatsRequest{})
+		require.NoError(t, err)
+		require.Zero(t, resp.Length)
+		require.Zero(t, resp.OldestAge)
+		require.Empty(t, resp.HealthBuckets)
+
+		for _, health := range []float64{1, 2, 3} {
+			_, err := satellite.DB.RepairQueue().Insert(ctx, &queue.InjuredSegment{
+				StreamID:      testrand.UUID(),
+				SegmentHealth: health,
+			})
+			require.NoError(t, err)
+		}
+
+		resp, err = endpoint.RepairQueueStats(ctx, &internalpb.RepairQueueStatsRequest{HealthBuckets: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.Length)
+		require.Positive(t, resp.OldestAge)
+		require.Len(t, resp.HealthBuckets, 2)
+		require.EqualValues(t, 1, resp.HealthBuckets[0].Count)
+		require.EqualValues(t, 2, resp.HealthBuckets[1].Count)
+
+		_, err = endpoint.RepairQueueStats(ctx, &internalpb.RepairQueueStatsRequest{HealthBuckets: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestRepairQueueAgeHistogram(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		resp, err := endpoint.RepairQueueAgeHistogram(ctx, &internalpb.RepairQueueAgeHistogramRequest{})
+		require.NoError(t, err)
+		require.Zero(t, resp.Length)
+		require.Zero(t, resp.OldestAge)
+		require.Len(t, resp.Ranges, 4)
+		require.Equal(t, time.Hour, resp.Ranges[0].UpperBound)
+		require.Equal(t, 24*time.Hour, resp.Ranges[3].LowerBound)
+		require.Zero(t, resp.Ranges[3].UpperBound)
+
+		for i := 0; i < 3; i++ {
+			_, err := satellite.DB.RepairQueue().Insert(ctx, &queue.InjuredSegment{
+				StreamID:      testrand.UUID(),
+				SegmentHealth: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		resp, err = endpoint.RepairQueueAgeHistogram(ctx, &internalpb.RepairQueueAgeHistogramRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.Length)
+		require.Positive(t, resp.OldestAge)
+		require.EqualValues(t, 3, resp.Ranges[0].Count)
+
+		// every segment was queued for longer than a nanosecond
+		resp, err = endpoint.RepairQueueAgeHistogram(ctx, &internalpb.RepairQueueAgeHistogramRequest{
+			UpperBounds: []time.Duration{time.Nanosecond},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Ranges, 2)
+		require.Zero(t, resp.Ranges[0].Count)
+		require.EqualValues(t, 3, resp.Ranges[1].Count)
+
+		_, err = endpoint.RepairQueueAgeHistogram(ctx, &internalpb.RepairQueueAgeHistogramRequest{
+			UpperBounds: []time.Duration{time.Hour, time.Minute},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestSegmentRepairReason(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(10*memory.KiB)))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		segment := segments[0]
+
+		request := &internalpb.SegmentRepairReasonRequest{
+			StreamId: segment.StreamID[:],
+			Position: int64(segment.Position.Encode()),
+		}
+
+		_, err = endpoint.SegmentRepairReason(ctx, request)
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+
+		nodes := make(map[storj.NodeID]int32)
+		for _, piece := range segment.Pieces {
+			nodes[piece.StorageNode] = int32(piece.Number)
+		}
+
+		failed, offline := planet.StorageNodes[0], planet.StorageNodes[1]
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, failed.ID(), time.Now(), overlay.DisqualificationReasonAuditFailure))
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, offline))
+
+		_, err = satellite.DB.RepairQueue().Insert(ctx, &queue.InjuredSegment{
+			StreamID:      segment.StreamID,
+			Position:      segment.Position,
+			SegmentHealth: 0.5,
+		})
+		require.NoError(t, err)
+
+		resp, err := endpoint.SegmentRepairReason(ctx, request)
+		require.NoError(t, err)
+		require.Equal(t, 0.5, resp.SegmentHealth)
+		require.EqualValues(t, 2, resp.HealthyPieces)
+		require.EqualValues(t, segment.Redundancy.RepairShares, resp.Redundancy.RepairThreshold)
+		require.EqualValues(t, 1, resp.ChurnedPieces)
+		require.EqualValues(t, 1, resp.AuditFailedPieces)
+
+		expected := []*internalpb.UnhealthyPiece{
+			{PieceNum: nodes[failed.ID()], NodeId: failed.ID(), Failure: internalpb.UnhealthyPiece_DISQUALIFIED},
+			{PieceNum: nodes[offline.ID()], NodeId: offline.ID(), Failure: internalpb.UnhealthyPiece_OFFLINE, Churned: true},
+		}
+		sort.Slice(expected, func(i, k int) bool {
+			return expected[i].PieceNum < expected[k].PieceNum
+		})
+		require.Equal(t, expected, resp.UnhealthyPieces)
+	})
+}
+
+func TestDetectOrphanedPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		node := planet.StorageNodes[0].ID()
+
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+
+		// garbage collection recorded pieces of segments that were deleted since
+		require.NoError(t, satellite.DB.OverlayCache().UpdatePieceCounts(ctx, map[storj.NodeID]int64{node: 5}))
+
+		endpoint := satellite.Inspector.Endpoint
+
+		// the sample covers every segment, so the estimate is exact
+		resp, err := endpoint.DetectOrphanedPieces(ctx, &internalpb.DetectOrphanedPiecesRequest{NodeId: node})
+		require.NoError(t, err)
+		require.True(t, resp.Exact)
+		require.EqualValues(t, 2, resp.SegmentsScanned)
+		require.EqualValues(t, 5, resp.RecordedPieces)
+		require.EqualValues(t, 2, resp.SampledPieces)
+		require.EqualValues(t, 2, resp.EstimatedLivePieces)
+		require.EqualValues(t, 3, resp.EstimatedOrphanedPieces)
+
+		// a partial sample extrapolates from the covered stream ids
+		resp, err = endpoint.DetectOrphanedPieces(ctx, &internalpb.DetectOrphanedPiecesRequest{NodeId: node, SampleSize: 1})
+		require.NoError(t, err)
+		require.False(t, resp.Exact)
+		require.EqualValues(t, 1, resp.SegmentsScanned)
+
+		_, err = endpoint.DetectOrphanedPieces(ctx, &internalpb.DetectOrphanedPiecesRequest{NodeId: testrand.NodeID()})
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+}
+
+func TestSegmentSizeHistogram(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "inline", testrand.Bytes(memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "otherbucket", "remote", testrand.Bytes(10*memory.KiB)))
+
+		endpoint := satellite.Inspector.Endpoint
+
+		resp, err := endpoint.SegmentSizeHistogram(ctx, &internalpb.SegmentSizeHistogramRequest{
+			ProjectId:  projectID[:],
+			SampleRate: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Ranges, 6)
+		require.EqualValues(t, 3, resp.SampledSegments)
+		require.EqualValues(t, 1, resp.SampledInlineSegments)
+		require.EqualValues(t, 0, resp.Ranges[0].LowerBound)
+		require.EqualValues(t, 4*memory.KiB, resp.Ranges[0].UpperBound)
+		require.EqualValues(t, 1, resp.Ranges[0].SampledSegments)
+		require.EqualValues(t, 1, resp.Ranges[0].InlineSegments)
+		require.EqualValues(t, 2, resp.Ranges[1].SampledSegments)
+		require.EqualValues(t, 2, resp.Ranges[1].EstimatedSegments)
+		require.Zero(t, resp.Ranges[1].InlineSegments)
+		require.Zero(t, resp.Ranges[5].UpperBound)
+
+		resp, err = endpoint.SegmentSizeHistogram(ctx, &internalpb.SegmentSizeHistogramRequest{
+			ProjectId:   projectID[:],
+			Bucket:      []byte("testbucket"),
+			SampleRate:  1,
+			UpperBounds: []int64{2 * memory.KiB.Int64()},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Ranges, 2)
+		require.EqualValues(t, 1, resp.Ranges[0].SampledSegments)
+		require.EqualValues(t, 1, resp.Ranges[1].SampledSegments)
+
+		_, err = endpoint.SegmentSizeHistogram(ctx, &internalpb.SegmentSizeHistogramRequest{})
+		require.Error(t, err)
+		_, err = endpoint.SegmentSizeHistogram(ctx, &internalpb.SegmentSizeHistogramRequest{ProjectId: projectID[:], SampleRate: 2})
+		require.Error(t, err)
+		_, err = endpoint.SegmentSizeHistogram(ctx, &internalpb.SegmentSizeHistogramRequest{ProjectId: projectID[:], UpperBounds: []int64{64, 32}})
+		require.Error(t, err)
+	})
+}
+
+func TestRedundancyDistribution(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "inline", testrand.Bytes(memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+
+		endpoint := satellite.Inspector.Endpoint
+		rs := satellite.Config.Metainfo.RS
+
+		// the sample covers every remote segment, which were all uploaded with the configured scheme
+		resp, err := endpoint.RedundancyDistribution(ctx, &internalpb.RedundancyDistributionRequest{})
+		require.NoError(t, err)
+		require.True(t, resp.Exact)
+		require.EqualValues(t, 2, resp.SegmentsScanned)
+		require.Len(t, resp.Schemes, 1)
+
+		scheme := resp.Schemes[0]
+		require.EqualValues(t, rs.Min, scheme.RequiredShares)
+		require.EqualValues(t, rs.Repair, scheme.RepairShares)
+		require.EqualValues(t, rs.Success, scheme.OptimalShares)
+		require.EqualValues(t, rs.Total, scheme.TotalShares)
+		require.EqualValues(t, 2, scheme.SampledSegments)
+		require.EqualValues(t, 2, scheme.EstimatedSegments)
+		require.Equal(t, float64(rs.Success)/float64(rs.Min), scheme.ExpansionFactor)
+
+		// a partial sample extrapolates from the covered stream ids
+		resp, err = endpoint.RedundancyDistribution(ctx, &internalpb.RedundancyDistributionRequest{SampleSize: 1})
+		require.NoError(t, err)
+		require.False(t, resp.Exact)
+		require.EqualValues(t, 1, resp.SegmentsScanned)
+		require.Len(t, resp.Schemes, 1)
+		require.EqualValues(t, 1, resp.Schemes[0].SampledSegments)
+
+		_, err = endpoint.RedundancyDistribution(ctx, &internalpb.RedundancyDistributionRequest{SampleSize: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestSegmentPieceNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(10*memory.KiB)))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		segment := segments[0]
+
+		disqualified, offline := planet.StorageNodes[0], planet.StorageNodes[1]
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, disqualified.ID(), time.Now(), overlay.DisqualificationReasonAuditFailure))
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, offline))
+
+		resp, err := endpoint.SegmentPieceNodes(ctx, &internalpb.SegmentPieceNodesRequest{
+			StreamId: segment.StreamID[:],
+			Position: int64(segment.Position.Encode()),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Pieces, len(segment.Pieces))
+
+		for i, piece := range resp.Pieces {
+			if i > 0 {
+				require.Less(t, resp.Pieces[i-1].PieceNum, piece.PieceNum)
+			}
+
+			node := planet.FindNode(piece.NodeId)
+			require.NotNil(t, node)
+			require.False(t, piece.Missing)
+			require.Equal(t, node.Addr(), piece.Address)
+			require.NotEmpty(t, piece.LastIpPort)
+			require.Equal(t, piece.NodeId == disqualified.ID(), piece.Disqualified)
+			require.Equal(t, piece.NodeId != offline.ID(), piece.Online)
+		}
+
+		_, err = endpoint.SegmentPieceNodes(ctx, &internalpb.SegmentPieceNodesRequest{
+			StreamId: testrand.UUID().Bytes(),
+		})
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+}
+
+func TestInlineRemoteRatio(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "inline", testrand.Bytes(memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "otherbucket", "remote", testrand.Bytes(10*memory.KiB)))
+
+		endpoint := satellite.Inspector.Endpoint
+
+		resp, err := endpoint.InlineRemoteRatio(ctx, &internalpb.InlineRemoteRatioRequest{
+			ProjectId:  projectID[:],
+			SampleRate: 1,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.SampleRate)
+		require.EqualValues(t, 1, resp.Inline.SampledSegments)
+		require.EqualValues(t, 1, resp.Inline.EstimatedSegments)
+		require.GreaterOrEqual(t, resp.Inline.SampledBytes, memory.KiB.Int64())
+		require.EqualValues(t, 2, resp.Remote.SampledSegments)
+		require.EqualValues(t, 2, resp.Remote.EstimatedSegments)
+		require.GreaterOrEqual(t, resp.Remote.SampledBytes, 20*memory.KiB.Int64())
+		require.Equal(t, resp.Remote.SampledBytes, resp.Remote.EstimatedBytes)
+		require.InDelta(t, 1.0/3, resp.InlineSegmentFraction, 1e-9)
+		require.Greater(t, resp.InlineBytesFraction, 0.0)
+		require.Less(t, resp.InlineBytesFraction, 0.1)
+
+		resp, err = endpoint.InlineRemoteRatio(ctx, &internalpb.InlineRemoteRatioRequest{
+			ProjectId:  projectID[:],
+			Bucket:     []byte("otherbucket"),
+			SampleRate: 1,
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.Inline.SampledSegments)
+		require.EqualValues(t, 1, resp.Remote.SampledSegments)
+		require.Zero(t, resp.InlineSegmentFraction)
+
+		_, err = endpoint.InlineRemoteRatio(ctx, &internalpb.InlineRemoteRatioRequest{})
+		require.Error(t, err)
+		_, err = endpoint.InlineRemoteRatio(ctx, &internalpb.InlineRemoteRatioRequest{ProjectId: projectID[:], SampleRate: 2})
+		require.Error(t, err)
+	})
+}
+
+func TestDryRunRepairChecker(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		satellite.Repair.Checker.Loop.Pause()
+
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "otherbucket", "other", testrand.Bytes(10*memory.KiB)))
+
+		endpoint := satellite.Inspector.Endpoint
+		req := &internalpb.DryRunRepairCheckerRequest{
+			ProjectId: projectID[:],
+			Bucket:    []byte("testbucket"),
+		}
+
+		// every segment is stored on all four nodes with a repair threshold of three
+		resp, err := endpoint.DryRunRepairChecker(ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Exact)
+		require.EqualValues(t, 2, resp.SegmentsChecked)
+		require.Zero(t, resp.WouldQueue)
+		require.Empty(t, resp.HealthBuckets)
+		require.Equal(t, []*internalpb.SegmentMarginCount{{Margin: 1, Count: 2}}, resp.Margins)
+
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.StorageNodes[0]))
+
+		resp, err = endpoint.DryRunRepairChecker(ctx, req)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.SegmentsChecked)
+		require.EqualValues(t, 2, resp.WouldQueue)
+		require.EqualValues(t, 2, resp.EstimatedWouldQueue)
+		require.Zero(t, resp.Irreparable)
+		require.Equal(t, []*internalpb.SegmentMarginCount{{Margin: 0, Count: 2}}, resp.Margins)
+		require.Len(t, resp.HealthBuckets, 1)
+		require.EqualValues(t, 2, resp.HealthBuckets[0].Count)
+		require.Equal(t, resp.MinHealth, resp.MaxHealth)
+
+		// nothing was queued
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		// a sample of one segment is extrapolated to the bucket
+		req.SampleSize = 1
+		resp, err = endpoint.DryRunRepairChecker(ctx, req)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.SegmentsChecked)
+		require.EqualValues(t, 1, resp.WouldQueue)
+		if !resp.Exact {
+			require.Greater(t, resp.SampleFraction, 0.0)
+			require.Less(t, resp.SampleFraction, 1.0)
+		}
+
+		_, err = endpoint.DryRunRepairChecker(ctx, &internalpb.DryRunRepairCheckerRequest{ProjectId: projectID[:]})
+		require.Error(t, err)
+		_, err = endpoint.DryRunRepairChecker(ctx, &internalpb.DryRunRepairCheckerRequest{ProjectId: projectID[:], Bucket: []byte("testbucket"), SampleSize: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestDuplicatePieceNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+
+		// node selection never puts two pieces of a segment on the same node
+		resp, err := endpoint.DuplicatePieceNodes(ctx, &internalpb.DuplicatePieceNodesRequest{})
+		require.NoError(t, err)
+		require.True(t, resp.Exact)
+		require.EqualValues(t, 2, resp.SegmentsScanned)
+		require.Zero(t, resp.ViolatingSegments)
+		require.Empty(t, resp.Violations)
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+		segment := segments[0]
+		require.GreaterOrEqual(t, len(segment.Pieces), 3)
+
+		// move the second and third piece onto the node of the first one
+		pieces := append(metabase.Pieces(nil), segment.Pieces...)
+		pieces[1].StorageNode = pieces[0].StorageNode
+		pieces[2].StorageNode = pieces[0].StorageNode
+		require.NoError(t, satellite.Metabase.DB.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+			StreamID:      segment.StreamID,
+			Position:      segment.Position,
+			OldPieces:     segment.Pieces,
+			NewRedundancy: segment.Redundancy,
+			NewPieces:     pieces,
+		}))
+
+		resp, err = endpoint.DuplicatePieceNodes(ctx, &internalpb.DuplicatePieceNodesRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.SegmentsScanned)
+		require.EqualValues(t, 1, resp.ViolatingSegments)
+		require.EqualValues(t, 1, resp.EstimatedViolatingSegments)
+		require.False(t, resp.More)
+		require.Equal(t, []*internalpb.DuplicatePieceNode{{
+			StreamId:     segment.StreamID.Bytes(),
+			Position:     int64(segment.Position.Encode()),
+			NodeId:       pieces[0].StorageNode,
+			PieceNumbers: []int32{int32(pieces[0].Number), int32(pieces[1].Number), int32(pieces[2].Number)},
+		}}, resp.Violations)
+
+		_, err = endpoint.DuplicatePieceNodes(ctx, &internalpb.DuplicatePieceNodesRequest{Limit: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestOrphanedProjectSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 3,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		for _, uplink := range planet.Uplinks {
+			require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(10*memory.KiB)))
+		}
+
+		resp, err := endpoint.OrphanedProjectSegments(ctx, &internalpb.OrphanedProjectSegmentsRequest{})
+		require.NoError(t, err)
+		require.True(t, resp.Exact)
+		require.EqualValues(t, 3, resp.SegmentsScanned)
+		require.Zero(t, resp.OrphanedSegments)
+		require.Empty(t, resp.Projects)
+		require.Empty(t, resp.Segments)
+
+		// delete the project of the first uplink, leaving its segments behind
+		deletedProject := planet.Uplinks[0].Projects[0].ID
+		require.NoError(t, satellite.DB.Buckets().DeleteBucket(ctx, []byte("testbucket"), deletedProject))
+		require.NoError(t, satellite.DB.Console().Projects().Delete(ctx, deletedProject))
+
+		// and delete the account of the owner of the second uplink's project
+		abandonedProject := planet.Uplinks[1].Projects[0]
+		deleted := console.Deleted
+		require.NoError(t, satellite.DB.Console().Users().Update(ctx, abandonedProject.Owner.ID, console.UpdateUserRequest{
+			Status: &deleted,
+		}))
+
+		resp, err = endpoint.OrphanedProjectSegments(ctx, &internalpb.OrphanedProjectSegmentsRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.SegmentsScanned)
+		require.EqualValues(t, 2, resp.OrphanedSegments)
+		require.EqualValues(t, 2, resp.EstimatedOrphanedSegments)
+		require.False(t, resp.More)
+		require.Len(t, resp.Segments, 2)
+
+		reasons := map[uuid.UUID]internalpb.OrphanedProject_Reason{}
+		for _, project := range resp.Projects {
+			projectID, err := uuid.FromBytes(project.ProjectId)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, project.Segments)
+			reasons[projectID] = project.Reason
+		}
+		require.Equal(t, map[uuid.UUID]internalpb.OrphanedProject_Reason{
+			deletedProject:      internalpb.OrphanedProject_PROJECT_DELETED,
+			abandonedProject.ID: internalpb.OrphanedProject_OWNER_DELETED,
+		}, reasons)
+
+		resp, err = endpoint.OrphanedProjectSegments(ctx, &internalpb.OrphanedProjectSegmentsRequest{Limit: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.OrphanedSegments)
+		require.True(t, resp.More)
+		require.Len(t, resp.Segments, 1)
+
+		_, err = endpoint.OrphanedProjectSegments(ctx, &internalpb.OrphanedProjectSegmentsRequest{Limit: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestNodeStorageByProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+		node := planet.StorageNodes[0].ID()
+
+		first, second := planet.Uplinks[0], planet.Uplinks[1]
+		require.NoError(t, first.Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, first.Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, second.Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(10*memory.KiB)))
+
+		// every node holds a piece of every segment
+		resp, err := endpoint.NodeStorageByProject(ctx, &internalpb.NodeStorageByProjectRequest{NodeId: node})
+		require.NoError(t, err)
+		require.True(t, resp.Exact)
+		require.EqualValues(t, 3, resp.SegmentsScanned)
+		require.EqualValues(t, 3, resp.SampledPieces)
+		require.EqualValues(t, 3, resp.EstimatedPieces)
+		require.Zero(t, resp.UnownedPieces)
+		require.False(t, resp.More)
+		require.Len(t, resp.Projects, 2)
+
+		require.Equal(t, first.Projects[0].ID.Bytes(), resp.Projects[0].ProjectId)
+		require.EqualValues(t, 2, resp.Projects[0].SampledPieces)
+		require.EqualValues(t, 2, resp.Projects[0].EstimatedPieces)
+		require.InDelta(t, 2.0/3, resp.Projects[0].Share, 1e-9)
+		require.Equal(t, second.Projects[0].ID.Bytes(), resp.Projects[1].ProjectId)
+		require.EqualValues(t, 1, resp.Projects[1].SampledPieces)
+
+		resp, err = endpoint.NodeStorageByProject(ctx, &internalpb.NodeStorageByProjectRequest{NodeId: node, Limit: 1})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Projects, 1)
+		require.EqualValues(t, 3, resp.SampledPieces)
+
+		resp, err = endpoint.NodeStorageByProject(ctx, &internalpb.NodeStorageByProjectRequest{NodeId: node, SampleSize: 1})
+		require.NoError(t, err)
+		require.False(t, resp.Exact)
+		require.EqualValues(t, 1, resp.SegmentsScanned)
+
+		_, err = endpoint.NodeStorageByProject(ctx, &internalpb.NodeStorageByProjectRequest{NodeId: node, Limit: -1})
+		require.Error(t, err)
+
+		_, err = endpoint.NodeStorageByProject(ctx, &internalpb.NodeStorageByProjectRequest{NodeId: testrand.NodeID()})
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+}
+
+func TestProjectsOverLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 3,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.ProjectLimit.CacheExpiration = time.Nanosecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		for _, uplink := range planet.Uplinks {
+			require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(10*memory.KiB)))
+		}
+
+		resp, err := endpoint.ProjectsOverLimit(ctx, &internalpb.ProjectsOverLimitRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.ProjectsChecked)
+		require.Zero(t, resp.OverStorageLimit)
+		require.Empty(t, resp.Projects)
+
+		mostOver := planet.Uplinks[0].Projects[0].ID
+		leastOver := planet.Uplinks[1].Projects[0].ID
+		require.NoError(t, satellite.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, mostOver, 1))
+		require.NoError(t, satellite.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, leastOver, memory.KiB))
+
+		resp, err = endpoint.ProjectsOverLimit(ctx, &internalpb.ProjectsOverLimitRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.ProjectsChecked)
+		require.EqualValues(t, 2, resp.OverStorageLimit)
+		require.False(t, resp.More)
+		require.Len(t, resp.Projects, 2)
+		require.Equal(t, mostOver.Bytes(), resp.Projects[0].ProjectId)
+		require.EqualValues(t, 1, resp.Projects[0].StorageLimit)
+		require.Equal(t, resp.Projects[0].StorageUsed-1, resp.Projects[0].Overage)
+		require.Equal(t, leastOver.Bytes(), resp.Projects[1].ProjectId)
+		require.Greater(t, resp.Projects[0].Overage, resp.Projects[1].Overage)
+
+		first, err := endpoint.ProjectsOverLimit(ctx, &internalpb.ProjectsOverLimitRequest{Limit: 1})
+		require.NoError(t, err)
+		require.True(t, first.More)
+		require.Len(t, first.Projects, 1)
+		require.Equal(t, mostOver.Bytes(), first.Projects[0].ProjectId)
+
+		second, err := endpoint.ProjectsOverLimit(ctx, &internalpb.ProjectsOverLimitRequest{
+			Limit:           1,
+			CursorOverage:   first.Projects[0].Overage,
+			CursorProjectId: first.Projects[0].ProjectId,
+		})
+		require.NoError(t, err)
+		require.False(t, second.More)
+		require.Len(t, second.Projects, 1)
+		require.Equal(t, leastOver.Bytes(), second.Projects[0].ProjectId)
+
+		_, err = endpoint.ProjectsOverLimit(ctx, &internalpb.ProjectsOverLimitRequest{Limit: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestSimulateBulkDisqualification(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.Endpoint
+
+		satellite.Repair.Checker.Loop.Pause()
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(10*memory.KiB)))
+
+		simulate := func(nodeIDs ...storj.NodeID) *internalpb.SimulateBulkDisqualificationResponse {
+			resp, err := endpoint.SimulateBulkDisqualification(ctx, &internalpb.SimulateBulkDisqualificationRequest{NodeIds: nodeIDs})
+			require.NoError(t, err)
+			require.True(t, resp.Exact)
+			require.EqualValues(t, 2, resp.SegmentsScanned)
+			return resp
+		}
+
+		// every segment is stored on all four nodes with a repair threshold of three
+		resp := simulate(testrand.NodeID())
+		require.Zero(t, resp.AffectedSegments)
+		require.Zero(t, resp.WouldQueue)
+
+		resp = simulate(planet.StorageNodes[0].ID())
+		require.EqualValues(t, 2, resp.AffectedSegments)
+		require.EqualValues(t, 2, resp.RemovedPieces)
+		require.EqualValues(t, 2, resp.WouldQueue)
+		require.EqualValues(t, 2, resp.NewlyQueued)
+		require.EqualValues(t, 2, resp.EstimatedNewlyQueued)
+		require.Zero(t, resp.Irreparable)
+
+		resp = simulate(planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID(), planet.StorageNodes[2].ID())
+		require.EqualValues(t, 6, resp.RemovedPieces)
+		require.EqualValues(t, 2, resp.Irreparable)
+		require.EqualValues(t, 2, resp.NewlyIrreparable)
+		require.EqualValues(t, 2, resp.EstimatedNewlyIrreparable)
+
+		// segments queued already aren't newly queued by the disqualification
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.StorageNodes[3]))
+
+		resp = simulate(planet.StorageNodes[0].ID())
+		require.EqualValues(t, 2, resp.WouldQueue)
+		require.Zero(t, resp.NewlyQueued)
+
+		// nothing was disqualified or queued
+		for _, node := range planet.StorageNodes[:3] {
+			dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.Nil(t, dossier.Disqualified)
+		}
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		_, err = endpoint.SimulateBulkDisqualification(ctx, &internalpb.SimulateBulkDisqualificationRequest{})
+		require.Error(t, err)
+		_, err = endpoint.SimulateBulkDisqualification(ctx, &internalpb.SimulateBulkDisqualificationRequest{
+			NodeIds:    []storj.NodeID{planet.StorageNodes[0].ID()},
+			SampleSize: -1,
+		})
+		require.Error(t, err)
+	})
+}
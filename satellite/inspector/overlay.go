@@ -0,0 +1,1963 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/private/version"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/gc/sender"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/reputation"
+)
+
+// Config contains configurable values for the inspector endpoints.
+type Config struct {
+	RevealOperatorEmail bool `help:"whether the overlay inspector returns operator emails without redacting them" default:"false"`
+
+	OrphanedPiecesSampleSize    int `help:"number of segments sampled to detect orphaned pieces when a request doesn't specify one" default:"100000"`
+	OrphanedPiecesMaxSampleSize int `help:"max number of segments a request may sample to detect orphaned pieces" default:"1000000"`
+
+	SelectionFairnessSelections    int `help:"number of simulated selections the selection fairness report runs when a request doesn't specify one" default:"1000"`
+	SelectionFairnessMaxSelections int `help:"max number of simulated selections a selection fairness request may run" default:"100000"`
+
+	RedundancySampleSize    int `help:"number of segments sampled for the redundancy distribution when a request doesn't specify one" default:"100000"`
+	RedundancyMaxSampleSize int `help:"max number of segments a request may sample for the redundancy distribution" default:"1000000"`
+
+	DryRunRepairSampleSize    int `help:"number of segments of a bucket checked by a repair checker dry run when a request doesn't specify one" default:"10000"`
+	DryRunRepairMaxSampleSize int `help:"max number of segments of a bucket a repair checker dry run may check" default:"100000"`
+
+	DuplicatePieceSampleSize    int `help:"number of segments checked for pieces stored on the same node when a request doesn't specify one" default:"100000"`
+	DuplicatePieceMaxSampleSize int `help:"max number of segments a request may check for pieces stored on the same node" default:"1000000"`
+
+	OrphanedProjectSampleSize    int `help:"number of segments sampled for segments of deleted or abandoned projects when a request doesn't specify one" default:"100000"`
+	OrphanedProjectMaxSampleSize int `help:"max number of segments a request may sample for segments of deleted or abandoned projects" default:"1000000"`
+
+	NodeStorageSampleSize    int `help:"number of segments sampled for the distribution of a node's pieces across projects when a request doesn't specify one" default:"100000"`
+	NodeStorageMaxSampleSize int `help:"max number of segments a request may sample for the distribution of a node's pieces across projects" default:"1000000"`
+
+	DisqualificationSampleSize    int `help:"number of segments sampled to simulate the disqualification of nodes when a request doesn't specify one" default:"100000"`
+	DisqualificationMaxSampleSize int `help:"max number of segments a request may sample to simulate the disqualification of nodes" default:"1000000"`
+
+	SegmentSizeSampleRate float64 `help:"fraction of the objects whose segments are sampled for segment size histograms and inline to remote ratios when a request doesn't specify one" default:"0.01"`
+
+	FreeSpaceTrendWindow time.Duration `help:"how far back the accounting rollups a node's free space trend is fit to reach when a request doesn't specify a window" default:"168h"`
+
+	GeoStaleAfter time.Duration `help:"how long after resolving a node's country code it's listed as stale when a request doesn't specify a window" default:"720h"`
+
+	PlacementSelectionWindow time.Duration `help:"how far back the upload node selections per placement are counted when a request doesn't specify a window" default:"1h"`
+
+	ClockSkewThreshold time.Duration `help:"how far a node's clock must be off from the satellite's to be listed as skewed when a request doesn't specify a threshold" default:"5m"`
+
+	RepairStatsWindow time.Duration `help:"how far back the pieces repair moved off and onto a node are counted when a request doesn't specify a window" default:"720h"`
+
+	AuditTrafficWindow       time.Duration `help:"how far back the pieces repair downloaded from nodes are counted for the audit to traffic comparison when a request doesn't specify a window" default:"720h"`
+	AuditTrafficDivergence   float64       `help:"how far the audit score of a node must be from the success rate of its repair downloads to be listed when a request doesn't specify one" default:"0.2"`
+	AuditTrafficMinDownloads int64         `help:"number of repair downloads a node needs in the window to be compared when a request doesn't specify one" default:"20"`
+
+	PendingGCWindow time.Duration `help:"how far back the pieces awaiting garbage collection are counted for nodes that weren't sent a retain filter yet" default:"720h"`
+
+	TrustWindow time.Duration `help:"how recently a node must have checked in to count as trusting the satellite when a request doesn't specify a window" default:"24h"`
+}
+
+// OverlayEndpoint for inspecting the nodes known to the overlay.
+//
+// architecture: Endpoint
+type OverlayEndpoint struct {
+	internalpb.DRPCOverlayInspectorUnimplementedServer
+	log        *zap.Logger
+	overlay    *overlay.Service
+	accounting accounting.StoragenodeAccounting
+	reputation *reputation.Service
+	config     Config
+
+	gracefulExit       gracefulexit.DB
+	gracefulExitConfig gracefulexit.Config
+
+	containment   audit.Containment
+	auditFailures audit.Failures
+	auditConfig   audit.Config
+
+	repairStats   repair.NodeStats
+	retainFilters sender.SentFilters
+
+	versionInfo version.Info
+}
+
+// NewOverlayEndpoint will initialize an OverlayEndpoint struct.
+func NewOverlayEndpoint(log *zap.Logger, overlay *overlay.Service, accounting accounting.StoragenodeAccounting, reputation *reputation.Service, gracefulExit gracefulexit.DB, gracefulExitConfig gracefulexit.Config, containment audit.Containment, auditFailures audit.Failures, auditConfig audit.Config, repairStats repair.NodeStats, retainFilters sender.SentFilters, versionInfo version.Info, config Config) *OverlayEndpoint {
+	return &OverlayEndpoint{
+		log:        log,
+		overlay:    overlay,
+		accounting: accounting,
+		reputation: reputation,
+		config:     config,
+
+		gracefulExit:       gracefulExit,
+		gracefulExitConfig: gracefulExitConfig,
+
+		containment:   containment,
+		auditFailures: auditFailures,
+		auditConfig:   auditConfig,
+
+		repairStats:   repairStats,
+		retainFilters: retainFilters,
+
+		versionInfo: versionInfo,
+	}
+}
+
+// NodesByIP returns the nodes whose last known ip is the requested ip or falls within the requested subnet.
+func (endpoint *OverlayEndpoint) NodesByIP(ctx context.Context, in *internalpb.NodesByIPRequest) (_ *internalpb.NodesByIPResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	subnet, err := parseIPSubnet(in.GetIp())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	nodes, more, err := endpoint.overlay.GetNodesByIP(ctx, subnet, in.StartAfter, pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.NodesByIPResponse{
+		Nodes: endpoint.overlayNodes(nodes),
+		More:  more,
+	}, nil
+}
+
+// IPsWithManyNodes returns the ips that more than the requested number of nodes resolve to.
+func (endpoint *OverlayEndpoint) IPsWithManyNodes(ctx context.Context, in *internalpb.IPsWithManyNodesRequest) (_ *internalpb.IPsWithManyNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetMinNodes() < 0 {
+		return nil, Error.New("min nodes must not be negative")
+	}
+
+	var startAfter net.IP
+	if in.GetStartAfter() != "" {
+		startAfter = net.ParseIP(in.GetStartAfter())
+		if startAfter == nil {
+			return nil, Error.New("invalid start after ip: %q", in.GetStartAfter())
+		}
+	}
+
+	ips, more, err := endpoint.overlay.GetIPsWithManyNodes(ctx, int(in.GetMinNodes()), startAfter, pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.IPsWithManyNodesResponse{More: more}
+	for _, ip := range ips {
+		response.Ips = append(response.Ips, &internalpb.IPNodes{
+			Ip:    ip.IP,
+			Nodes: endpoint.overlayNodes(ip.Nodes),
+		})
+	}
+
+	return response, nil
+}
+
+// ExplainNodeSelection returns the reason a node would not be selected for uploads with the requested placement.
+func (endpoint *OverlayEndpoint) ExplainNodeSelection(ctx context.Context, in *internalpb.ExplainNodeSelectionRequest) (_ *internalpb.ExplainNodeSelectionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetPlacement() < 0 || in.GetPlacement() > math.MaxUint16 {
+		return nil, Error.New("invalid placement: %d", in.GetPlacement())
+	}
+
+	node, reason, err := endpoint.overlay.ExplainNodeSelection(ctx, in.NodeId, storj.PlacementConstraint(in.GetPlacement()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.ExplainNodeSelectionResponse{
+		Reason: selectionReasons[reason],
+		Node:   endpoint.overlayNode(node),
+	}, nil
+}
+
+// TopNodesByStorage returns the nodes ordered by the bytes they store according to the latest accounting rollups.
+func (endpoint *OverlayEndpoint) TopNodesByStorage(ctx context.Context, in *internalpb.TopNodesByStorageRequest) (_ *internalpb.TopNodesByStorageResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	stored, more, err := endpoint.overlay.GetNodesByStoredBytes(ctx, in.GetOnlineOnly(), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.TopNodesByStorageResponse{More: more}
+	for _, node := range stored {
+		response.Nodes = append(response.Nodes, &internalpb.NodeStorage{
+			NodeId:      node.NodeID,
+			StoredBytes: node.StoredBytes,
+			PieceCount:  node.PieceCount,
+			Online:      node.Online,
+		})
+	}
+
+	return response, nil
+}
+
+// rollupStoredBytes returns the average bytes a node stored at rest during a rollup.
+func rollupStoredBytes(usage accounting.StorageNodeUsage) int64 {
+	hours := usage.IntervalEndTime.Sub(usage.Timestamp).Hours()
+	if hours <= 0 {
+		// rollups cover a day, use that when the end of the interval isn't known
+		hours = 24
+	}
+	return int64(usage.StorageUsed / hours)
+}
+
+// RecalculateReputation re-derives a node's reputation scores from its stored history and returns them before and
+// after the recalculation.
+func (endpoint *OverlayEndpoint) RecalculateReputation(ctx context.Context, in *internalpb.RecalculateReputationRequest) (_ *internalpb.RecalculateReputationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	before, after, err := endpoint.reputation.RecalculateReputation(ctx, in.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.RecalculateReputationResponse{
+		Before: nodeReputation(before),
+		After:  nodeReputation(after),
+	}, nil
+}
+
+// NodesNearOfflineDQ returns the nodes whose online score is below the requested threshold together with when they
+// would be disqualified at their current trajectory, most at risk first.
+func (endpoint *OverlayEndpoint) NodesNearOfflineDQ(ctx context.Context, in *internalpb.NodesNearOfflineDQRequest) (_ *internalpb.NodesNearOfflineDQResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+	if in.GetThreshold() < 0 || in.GetThreshold() > 1 {
+		return nil, Error.New("invalid threshold: %v", in.GetThreshold())
+	}
+
+	risks, err := endpoint.reputation.NodesNearOfflineDQ(ctx, in.GetThreshold(), time.Now())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	start, end, more := pageBounds(len(risks), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	risks = risks[start:end]
+
+	response := &internalpb.NodesNearOfflineDQResponse{
+		More:             more,
+		OfflineDqEnabled: endpoint.reputation.OfflineDQEnabled(),
+	}
+	for _, risk := range risks {
+		response.Nodes = append(response.Nodes, &internalpb.NodeOfflineRisk{
+			NodeId:           risk.NodeID,
+			OnlineScore:      risk.OnlineScore,
+			ProjectedDq:      risk.ProjectedDQ,
+			UnderReview:      risk.UnderReview != nil,
+			OfflineSuspended: risk.OfflineSuspended != nil,
+		})
+	}
+
+	return response, nil
+}
+
+// GetOperatorContact returns the operator email and wallet a node registered. Emails are redacted unless the inspector
+// is configured to reveal them.
+func (endpoint *OverlayEndpoint) GetOperatorContact(ctx context.Context, in *internalpb.GetOperatorContactRequest) (_ *internalpb.GetOperatorContactResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := endpoint.overlay.Get(ctx, in.NodeId)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.GetOperatorContactResponse{
+		Email:          node.Operator.Email,
+		Wallet:         node.Operator.Wallet,
+		WalletFeatures: node.Operator.WalletFeatures,
+	}
+
+	if !endpoint.config.RevealOperatorEmail && response.Email != "" {
+		response.Email = redactEmail(response.Email)
+		response.EmailRedacted = true
+	}
+
+	return response, nil
+}
+
+// redactEmail keeps the first character of the local part and the domain, so that support can still tell operators
+// apart.
+func redactEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return strings.Repeat("*", len(email))
+	}
+
+	return email[:1] + strings.Repeat("*", at-1) + email[at:]
+}
+
+// defaultUptimeBounds are the lower bounds of the uptime bands, in percent, when a request doesn't specify any.
+var defaultUptimeBounds = []float64{99, 95}
+
+// NodesByUptimeBand counts the nodes by the percentage of audits they were online for within the requested window.
+// The nodes of one band can be listed as well, lowest uptime first.
+func (endpoint *OverlayEndpoint) NodesByUptimeBand(ctx context.Context, in *internalpb.NodesByUptimeBandRequest) (_ *internalpb.NodesByUptimeBandResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetWindowHours() < 0 || in.GetOffset() < 0 {
+		return nil, Error.New("window and offset must not be negative")
+	}
+
+	bounds := in.GetLowerBounds()
+	if len(bounds) == 0 {
+		bounds = defaultUptimeBounds
+	}
+	for i, bound := range bounds {
+		if bound <= 0 || bound > 100 || (i > 0 && bound >= bounds[i-1]) {
+			return nil, Error.New("lower bounds must be descending percentages: %v", bounds)
+		}
+	}
+
+	// the last band covers everything below the lowest bound
+	bands := make([]*internalpb.UptimeBand, 0, len(bounds)+1)
+	upper := 100.0
+	for _, bound := range append(bounds[:len(bounds):len(bounds)], 0) {
+		bands = append(bands, &internalpb.UptimeBand{LowerBound: bound, UpperBound: upper})
+		upper = bound
+	}
+
+	if in.GetListBand() < 0 || int(in.GetListBand()) > len(bands) {
+		return nil, Error.New("invalid band: %d", in.GetListBand())
+	}
+
+	uptimes, err := endpoint.reputation.NodeUptimes(ctx, time.Duration(in.GetWindowHours())*time.Hour, time.Now())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.NodesByUptimeBandResponse{Bands: bands}
+
+	var listed []*internalpb.NodeUptime
+	for _, uptime := range uptimes {
+		if uptime.Total == 0 {
+			response.WithoutAudits++
+			continue
+		}
+
+		percentage := uptime.Percentage()
+		band := 0
+		for band < len(bands)-1 && percentage < bands[band].LowerBound {
+			band++
+		}
+		bands[band].NodeCount++
+
+		if band+1 == int(in.GetListBand()) {
+			listed = append(listed, &internalpb.NodeUptime{
+				NodeId: uptime.NodeID,
+				Uptime: percentage,
+				Audits: uptime.Total,
+			})
+		}
+	}
+
+	sort.Slice(listed, func(i, k int) bool {
+		if listed[i].Uptime != listed[k].Uptime {
+			return listed[i].Uptime < listed[k].Uptime
+		}
+		return listed[i].NodeId.Less(listed[k].NodeId)
+	})
+
+	start, end, more := pageBounds(len(listed), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	response.Nodes, response.More = listed[start:end], more
+
+	return response, nil
+}
+
+// CompareNodes returns the reputation and status of two nodes side by side, together with which of them differ.
+func (endpoint *OverlayEndpoint) CompareNodes(ctx context.Context, in *internalpb.CompareNodesRequest) (_ *internalpb.CompareNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	first, err := endpoint.compareNode(ctx, in.First)
+	if err != nil {
+		return nil, err
+	}
+
+	second, err := endpoint.compareNode(ctx, in.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalpb.CompareNodesResponse{
+		First:       first,
+		Second:      second,
+		Differences: compareDifferences(first, second),
+	}, nil
+}
+
+func (endpoint *OverlayEndpoint) compareNode(ctx context.Context, nodeID storj.NodeID) (*internalpb.NodeComparison, error) {
+	node, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	info, err := endpoint.reputation.Get(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	comparison := &internalpb.NodeComparison{
+		NodeId:            nodeID,
+		Reputation:        nodeReputation(info),
+		Status:            endpoint.nodeStatus(node),
+		TotalAuditCount:   info.TotalAuditCount,
+		AuditSuccessCount: info.AuditSuccessCount,
+		VettedAt:          info.VettedAt,
+	}
+
+	windows := info.AuditHistory.GetWindows()
+	for i := len(windows) - 1; i >= 0; i-- {
+		if windows[i].TotalCount > 0 {
+			lastAudit := windows[i].WindowStart
+			comparison.LastAudit = &lastAudit
+			break
+		}
+	}
+
+	return comparison, nil
+}
+
+// compareDifferences returns the names of the compared fields that differ between the nodes.
+func compareDifferences(first, second *internalpb.NodeComparison) []string {
+	equalTimes := func(a, b *time.Time) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && a.Equal(*b))
+	}
+
+	fields := []struct {
+		name  string
+		equal bool
+	}{
+		{"audit_score", first.Reputation.AuditScore == second.Reputation.AuditScore},
+		{"unknown_audit_score", first.Reputation.UnknownAuditScore == second.Reputation.UnknownAuditScore},
+		{"online_score", first.Reputation.OnlineScore == second.Reputation.OnlineScore},
+		{"online", first.Status.Online == second.Status.Online},
+		{"vetted", first.Status.Vetted == second.Status.Vetted},
+		{"disqualified", first.Status.Disqualified == second.Status.Disqualified},
+		{"unknown_audit_suspended", first.Status.UnknownAuditSuspended == second.Status.UnknownAuditSuspended},
+		{"offline_suspended", first.Status.OfflineSuspended == second.Status.OfflineSuspended},
+		{"exiting", first.Status.Exiting == second.Status.Exiting},
+		{"exited", first.Status.Exited == second.Status.Exited},
+		{"total_audit_count", first.TotalAuditCount == second.TotalAuditCount},
+		{"audit_success_count", first.AuditSuccessCount == second.AuditSuccessCount},
+		{"last_audit", equalTimes(first.LastAudit, second.LastAudit)},
+	}
+
+	var differences []string
+	for _, field := range fields {
+		if !field.equal {
+			differences = append(differences, field.name)
+		}
+	}
+	return differences
+}
+
+// ListExitingNodes returns the nodes with a graceful exit in progress together with how far along their exit is.
+func (endpoint *OverlayEndpoint) ListExitingNodes(ctx context.Context, in *internalpb.ListExitingNodesRequest) (_ *internalpb.ListExitingNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	exiting, err := endpoint.overlay.GetExitingNodes(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	nodes := make([]*internalpb.ExitingNode, 0, len(exiting))
+	for _, status := range exiting {
+		node, err := endpoint.exitingNode(ctx, status)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, k int) bool {
+		a, b := nodes[i], nodes[k]
+		if in.GetDescending() {
+			a, b = b, a
+		}
+
+		if in.GetOrder() == internalpb.ListExitingNodesRequest_PROGRESS && a.CompletionPercentage != b.CompletionPercentage {
+			return a.CompletionPercentage < b.CompletionPercentage
+		}
+		if !a.ExitInitiatedAt.Equal(*b.ExitInitiatedAt) {
+			return a.ExitInitiatedAt.Before(*b.ExitInitiatedAt)
+		}
+		return a.NodeId.Less(b.NodeId)
+	})
+
+	start, end, more := pageBounds(len(nodes), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	nodes = nodes[start:end]
+
+	return &internalpb.ListExitingNodesResponse{
+		Nodes: nodes,
+		More:  more,
+	}, nil
+}
+
+// exitingNode describes the progress of a graceful exit. The exit is complete once every queued transfer has been
+// processed, so nothing is complete while the queue is still being built. The exit is failing when the failed
+// transfers would fail the exit if it finished now.
+func (endpoint *OverlayEndpoint) exitingNode(ctx context.Context, status *overlay.ExitStatus) (*internalpb.ExitingNode, error) {
+	node := &internalpb.ExitingNode{
+		NodeId:          status.NodeID,
+		ExitInitiatedAt: status.ExitInitiatedAt,
+		QueueBuilt:      status.ExitLoopCompletedAt != nil,
+	}
+
+	progress, err := endpoint.gracefulExit.GetProgress(ctx, status.NodeID)
+	switch {
+	case gracefulexit.ErrNodeNotFound.Has(err):
+		progress = &gracefulexit.Progress{NodeID: status.NodeID}
+	case err != nil:
+		return nil, err
+	}
+
+	node.BytesTransferred = progress.BytesTransferred
+	node.PiecesTransferred = progress.PiecesTransferred
+	node.PiecesFailed = progress.PiecesFailed
+
+	node.PiecesRemaining, err = endpoint.gracefulExit.CountIncomplete(ctx, status.NodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	processed := progress.PiecesTransferred + progress.PiecesFailed
+	if node.QueueBuilt {
+		node.CompletionPercentage = 100
+		if total := processed + node.PiecesRemaining; total > 0 {
+			node.CompletionPercentage = 100 * float64(processed) / float64(total)
+		}
+	}
+
+	node.Failing = processed > 0 &&
+		float64(progress.PiecesFailed)/float64(processed)*100 >= float64(endpoint.gracefulExitConfig.OverallMaxFailuresPercentage)
+
+	return node, nil
+}
+
+// GetSelectionConfig returns the configuration node selection runs with. The text rendering lists one setting per
+// line in a fixed order, so that the configuration of different satellites can be diffed.
+func (endpoint *OverlayEndpoint) GetSelectionConfig(ctx context.Context, in *internalpb.GetSelectionConfigRequest) (_ *internalpb.GetSelectionConfigResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config := endpoint.overlay.SelectionConfig()
+
+	response := &internalpb.GetSelectionConfigResponse{
+		UploadExcludedCountries: config.UploadExcludedCountries,
+		RepairExcludedCountries: config.RepairExcludedCountries,
+		DistinctIp:              config.DistinctIP,
+		MinimumVersion:          config.MinimumVersion,
+		NewNodeFraction:         config.NewNodeFraction,
+		MinimumDiskSpace:        config.MinimumDiskSpace.Int64(),
+		OnlineWindow:            config.OnlineWindow,
+	}
+	for _, placement := range config.Placements {
+		response.Placements = append(response.Placements, &internalpb.PlacementDefinition{
+			Placement:    int32(placement.Placement),
+			Name:         placement.Name,
+			AllCountries: placement.AllCountries,
+			Countries:    placement.Countries,
+		})
+	}
+	response.Text = selectionConfigText(config)
+
+	return response, nil
+}
+
+// ListContainedNodes returns the nodes in audit containment, the segment their pending audit is for and how many
+// reverifications they have left before the audit counts as failed.
+func (endpoint *OverlayEndpoint) ListContainedNodes(ctx context.Context, in *internalpb.ListContainedNodesRequest) (_ *internalpb.ListContainedNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := pageLimit(in.GetLimit())
+
+	pendingAudits, err := endpoint.containment.List(ctx, in.StartAfter, limit+1)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, end, more := pageBounds(len(pendingAudits), 0, limit)
+	pendingAudits = pendingAudits[:end]
+
+	response := &internalpb.ListContainedNodesResponse{More: more}
+	for _, pending := range pendingAudits {
+		remaining := int32(endpoint.auditConfig.MaxReverifyCount) - pending.ReverifyCount
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		response.Nodes = append(response.Nodes, &internalpb.ContainedNode{
+			NodeId:                   pending.NodeID,
+			StreamId:                 pending.StreamID[:],
+			Position:                 int64(pending.Position.Encode()),
+			PieceId:                  pending.PieceID,
+			StripeIndex:              int64(pending.StripeIndex),
+			ReverifyCount:            pending.ReverifyCount,
+			ReverificationsRemaining: remaining,
+		})
+	}
+
+	return response, nil
+}
+
+const (
+	// defaultFairnessNodes is the number of nodes simulated selections request by default.
+	defaultFairnessNodes = 80
+	// defaultFairnessZThreshold is the z-score above which a subnet counts as over-represented by default.
+	defaultFairnessZThreshold = 3
+)
+
+// SelectionFairness runs simulated upload selections and reports how often each subnet and node was selected.
+// Subnets are compared with the count expected if every subnet that was selected at all had been selected equally
+// often, and are flagged when they were selected significantly more often than that. Selections that can't select as
+// many nodes as requested still count the nodes they could select, and are reported as partial.
+func (endpoint *OverlayEndpoint) SelectionFairness(ctx context.Context, in *internalpb.SelectionFairnessRequest) (_ *internalpb.SelectionFairnessResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetSelections() < 0 || in.GetNodes() < 0 || in.GetZThreshold() < 0 {
+		return nil, Error.New("selections, nodes and z threshold must not be negative")
+	}
+	if in.GetPlacement() < 0 || in.GetPlacement() > math.MaxUint16 {
+		return nil, Error.New("invalid placement: %d", in.GetPlacement())
+	}
+
+	selections := endpoint.config.SelectionFairnessSelections
+	if in.GetSelections() > 0 {
+		selections = int(in.GetSelections())
+	}
+	if max := endpoint.config.SelectionFairnessMaxSelections; max > 0 && selections > max {
+		selections = max
+	}
+
+	requested := int(in.GetNodes())
+	if requested == 0 {
+		requested = defaultFairnessNodes
+	}
+
+	threshold := in.GetZThreshold()
+	if threshold == 0 {
+		threshold = defaultFairnessZThreshold
+	}
+
+	nodeCounts := make(map[storj.NodeID]int64)
+	nodeSubnets := make(map[storj.NodeID]string)
+	subnetCounts := make(map[string]int64)
+	var selected, partial int64
+
+	for i := 0; i < selections; i++ {
+		nodes, err := endpoint.overlay.SimulateStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: requested,
+			Placement:      storj.PlacementConstraint(in.GetPlacement()),
+		})
+		if overlay.ErrNotEnoughNodes.Has(err) {
+			// small networks can't fill the default request, the nodes that could be selected still count
+			partial++
+		} else if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, node := range nodes {
+			nodeCounts[node.ID]++
+			nodeSubnets[node.ID] = node.LastNet
+			subnetCounts[node.LastNet]++
+			selected++
+		}
+	}
+
+	response := &internalpb.SelectionFairnessResponse{
+		Selections:        int64(selections),
+		NodesSelected:     selected,
+		SubnetsSeen:       int64(len(subnetCounts)),
+		PartialSelections: partial,
+	}
+
+	if len(subnetCounts) > 0 {
+		// every selected node is treated as an independent draw from equally likely subnets
+		p := 1 / float64(len(subnetCounts))
+		expected := float64(selected) * p
+		deviation := math.Sqrt(expected * (1 - p))
+
+		for subnet, count := range subnetCounts {
+			var zScore float64
+			if deviation > 0 {
+				zScore = (float64(count) - expected) / deviation
+			}
+
+			response.Subnets = append(response.Subnets, &internalpb.SubnetSelectionCount{
+				Subnet:          subnet,
+				Selected:        count,
+				Expected:        expected,
+				ZScore:          zScore,
+				OverRepresented: zScore > threshold,
+			})
+		}
+	}
+	sort.Slice(response.Subnets, func(i, k int) bool {
+		a, b := response.Subnets[i], response.Subnets[k]
+		if a.ZScore != b.ZScore {
+			return a.ZScore > b.ZScore
+		}
+		return a.Subnet < b.Subnet
+	})
+
+	for nodeID, count := range nodeCounts {
+		response.Nodes = append(response.Nodes, &internalpb.NodeSelectionCount{
+			NodeId:   nodeID,
+			Subnet:   nodeSubnets[nodeID],
+			Selected: count,
+		})
+	}
+	sort.Slice(response.Nodes, func(i, k int) bool {
+		a, b := response.Nodes[i], response.Nodes[k]
+		if a.Selected != b.Selected {
+			return a.Selected > b.Selected
+		}
+		return a.NodeId.Less(b.NodeId)
+	})
+
+	limit := pageLimit(in.GetLimit())
+	if len(response.Subnets) > limit {
+		response.Subnets = response.Subnets[:limit]
+	}
+	if len(response.Nodes) > limit {
+		response.Nodes = response.Nodes[:limit]
+	}
+
+	return response, nil
+}
+
+// defaultChurnedAfter is how long a node has to be out of contact to count as churned by default.
+const defaultChurnedAfter = 30 * 24 * time.Hour
+
+// NodeCohorts counts the nodes by the day, week, month or quarter they were first seen in.
+func (endpoint *OverlayEndpoint) NodeCohorts(ctx context.Context, in *internalpb.NodeCohortsRequest) (_ *internalpb.NodeCohortsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetChurnedAfter() < 0 {
+		return nil, Error.New("churned after must not be negative")
+	}
+
+	churnedAfter := in.GetChurnedAfter()
+	if churnedAfter == 0 {
+		churnedAfter = defaultChurnedAfter
+	}
+
+	now := time.Now()
+	counts := make(map[time.Time]int64)
+	err = endpoint.overlay.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		churned := node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil ||
+			now.Sub(node.Reputation.LastContactSuccess) > churnedAfter
+
+		switch in.GetFilter() {
+		case internalpb.NodeCohortsRequest_ACTIVE:
+			if churned {
+				return nil
+			}
+		case internalpb.NodeCohortsRequest_CHURNED:
+			if !churned {
+				return nil
+			}
+		}
+
+		counts[cohortStart(node.CreatedAt, in.GetGranularity())]++
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.NodeCohortsResponse{}
+	for start, count := range counts {
+		response.Cohorts = append(response.Cohorts, &internalpb.NodeCohort{
+			PeriodStart: start,
+			Nodes:       count,
+		})
+	}
+	sort.Slice(response.Cohorts, func(i, k int) bool {
+		return response.Cohorts[i].PeriodStart.Before(response.Cohorts[k].PeriodStart)
+	})
+
+	return response, nil
+}
+
+// cohortStart returns the start of the period, in utc, that the time falls in.
+func cohortStart(t time.Time, granularity internalpb.NodeCohortsRequest_Granularity) time.Time {
+	t = t.UTC()
+	year, month, day := t.Date()
+
+	switch granularity {
+	case internalpb.NodeCohortsRequest_DAY:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	case internalpb.NodeCohortsRequest_WEEK:
+		// time.Weekday starts the week on sunday
+		sinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-sinceMonday, 0, 0, 0, 0, time.UTC)
+	case internalpb.NodeCohortsRequest_QUARTER:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// defaultMaxFreeRatio is the reported free bytes allowed per accounted byte stored by default.
+const defaultMaxFreeRatio = 10
+
+// SpaceDiscrepancyNodes returns the nodes whose last reported free space is out of proportion to the bytes the
+// latest accounting rollups say they store. Nodes only report their free space, so this can't tell how much space a
+// node has allocated. It flags the nodes that claim a lot more room than their usage suggests, which is typical of
+// nodes that were misconfigured or that overstate their capacity to keep receiving uploads.
+func (endpoint *OverlayEndpoint) SpaceDiscrepancyNodes(ctx context.Context, in *internalpb.SpaceDiscrepancyNodesRequest) (_ *internalpb.SpaceDiscrepancyNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	maxFreeRatio := in.GetMaxFreeRatio()
+	switch {
+	case maxFreeRatio < 0:
+		return nil, Error.New("max free ratio must not be negative")
+	case maxFreeRatio == 0:
+		maxFreeRatio = defaultMaxFreeRatio
+	}
+
+	discrepancies, more, err := endpoint.overlay.GetSpaceDiscrepancies(ctx, maxFreeRatio, in.GetMinFreeBytes(), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.SpaceDiscrepancyNodesResponse{More: more}
+	for _, node := range discrepancies {
+		discrepancy := &internalpb.SpaceDiscrepancy{
+			NodeId:      node.NodeID,
+			StoredBytes: node.StoredBytes,
+			FreeBytes:   node.FreeBytes,
+			ExcessBytes: node.ExcessBytes,
+		}
+		if node.StoredBytes > 0 {
+			discrepancy.FreeRatio = float64(node.FreeBytes) / float64(node.StoredBytes)
+		}
+		response.Nodes = append(response.Nodes, discrepancy)
+	}
+
+	return response, nil
+}
+
+// defaultLatencyBounds are the upper bounds of the latency tiers, in milliseconds, when a request doesn't specify any.
+var defaultLatencyBounds = []int64{50, 100, 200}
+
+// NodesByLatencyTier counts the online nodes by the round trip time the satellite measured when pinging them back on
+// check-in, and lists the nodes of the requested tier. The latency tracks the slower pings of a node, so a node is
+// placed by how it usually performs at worst rather than by its last ping.
+func (endpoint *OverlayEndpoint) NodesByLatencyTier(ctx context.Context, in *internalpb.NodesByLatencyTierRequest) (_ *internalpb.NodesByLatencyTierResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	bounds := in.GetUpperBoundsMs()
+	if len(bounds) == 0 {
+		bounds = defaultLatencyBounds
+	}
+	for i, bound := range bounds {
+		if bound <= 0 || (i > 0 && bound <= bounds[i-1]) {
+			return nil, Error.New("upper bounds must be ascending positive milliseconds: %v", bounds)
+		}
+	}
+
+	// tiers are listed by their number, from 1, the tier after the bounds included
+	if in.GetListTier() < 0 || int(in.GetListTier()) > len(bounds)+1 {
+		return nil, Error.New("invalid tier: %d", in.GetListTier())
+	}
+
+	counts, unmeasured, err := endpoint.overlay.CountNodesByLatency(ctx, bounds)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the last tier covers everything above the highest bound
+	tiers := make([]*internalpb.LatencyTier, 0, len(bounds)+1)
+	lower := int64(0)
+	for i, bound := range append(bounds[:len(bounds):len(bounds)], 0) {
+		tiers = append(tiers, &internalpb.LatencyTier{LowerBoundMs: lower, UpperBoundMs: bound, NodeCount: counts[i]})
+		lower = bound
+	}
+
+	response := &internalpb.NodesByLatencyTierResponse{Tiers: tiers, Unmeasured: unmeasured}
+	if in.GetListTier() == 0 {
+		return response, nil
+	}
+
+	tier := tiers[in.GetListTier()-1]
+	listed, more, err := endpoint.overlay.GetNodesByLatency(ctx, tier.LowerBoundMs, tier.UpperBoundMs, int(in.GetOffset()), pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response.More = more
+	for _, node := range listed {
+		response.Nodes = append(response.Nodes, &internalpb.NodeLatency{
+			NodeId:    node.NodeID,
+			LatencyMs: node.LatencyMs,
+		})
+	}
+
+	return response, nil
+}
+
+// defaultWalletChangeLookback is how far back wallet changes are listed by default.
+const defaultWalletChangeLookback = 7 * 24 * time.Hour
+
+// NodesWithRecentWalletChange lists the wallet changes nodes' operators made within the lookback window, most recent
+// first. Changed wallets can indicate the operator's account was taken over.
+func (endpoint *OverlayEndpoint) NodesWithRecentWalletChange(ctx context.Context, in *internalpb.NodesWithRecentWalletChangeRequest) (_ *internalpb.NodesWithRecentWalletChangeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetLookback() < 0 {
+		return nil, Error.New("lookback must not be negative")
+	}
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	lookback := in.GetLookback()
+	if lookback == 0 {
+		lookback = defaultWalletChangeLookback
+	}
+
+	changes, more, err := endpoint.overlay.GetWalletChanges(ctx, time.Now().Add(-lookback), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.NodesWithRecentWalletChangeResponse{More: more}
+	for _, change := range changes {
+		response.Changes = append(response.Changes, &internalpb.NodeWalletChange{
+			NodeId:    change.NodeID,
+			OldWallet: change.OldWallet,
+			NewWallet: change.NewWallet,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	return response, nil
+}
+
+const (
+	// defaultChurnInterval is the interval churn is counted per by default.
+	defaultChurnInterval = 24 * time.Hour
+	// defaultChurnIntervals is the number of intervals churn is counted for when the range has no start.
+	defaultChurnIntervals = 30
+	// maxChurnIntervals limits the number of intervals a churn rate request may count churn for.
+	maxChurnIntervals = 1000
+)
+
+// ChurnRate counts the nodes that joined and left the network per interval of a time range. Nodes count as joined
+// once they're vetted, and as left once they're disqualified or finished exiting.
+func (endpoint *OverlayEndpoint) ChurnRate(ctx context.Context, in *internalpb.ChurnRateRequest) (_ *internalpb.ChurnRateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	interval := in.GetInterval()
+	if interval < 0 {
+		return nil, Error.New("interval must not be negative")
+	}
+	if interval == 0 {
+		interval = defaultChurnInterval
+	}
+
+	end := in.GetEnd()
+	if end.IsZero() {
+		end = time.Now()
+	}
+	start := in.GetStart()
+	if start.IsZero() {
+		start = end.Add(-defaultChurnIntervals * interval)
+	}
+	if !start.Before(end) {
+		return nil, Error.New("start must be before end")
+	}
+
+	count := (end.Sub(start) + interval - 1) / interval
+	if count > maxChurnIntervals {
+		return nil, Error.New("range spans more than %d intervals", maxChurnIntervals)
+	}
+
+	response := &internalpb.ChurnRateResponse{}
+	for i := 0; i < int(count); i++ {
+		response.Intervals = append(response.Intervals, &internalpb.ChurnInterval{
+			Start: start.Add(time.Duration(i) * interval).UTC(),
+		})
+	}
+
+	// intervalOf returns the interval the time falls into, or nil when it's outside of the range.
+	intervalOf := func(t *time.Time) *internalpb.ChurnInterval {
+		if t == nil || t.Before(start) || !t.Before(end) {
+			return nil
+		}
+		return response.Intervals[t.Sub(start)/interval]
+	}
+
+	err = endpoint.overlay.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		if churn := intervalOf(node.Reputation.Status.VettedAt); churn != nil {
+			churn.Joined++
+		}
+		if churn := intervalOf(node.Disqualified); churn != nil {
+			churn.Disqualified++
+		}
+		if churn := intervalOf(node.ExitStatus.ExitFinishedAt); churn != nil {
+			churn.Exited++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return response, nil
+}
+
+// ValidatePlacement checks whether enough nodes are selectable for uploads to satisfy the placement, without the
+// placement having to be configured first.
+func (endpoint *OverlayEndpoint) ValidatePlacement(ctx context.Context, in *internalpb.ValidatePlacementRequest) (_ *internalpb.ValidatePlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetRequiredCount() <= 0 {
+		return nil, Error.New("required count must be positive")
+	}
+	if in.GetMinimumFreeDisk() < 0 {
+		return nil, Error.New("minimum free disk must not be negative")
+	}
+
+	requirements := overlay.PlacementRequirements{
+		MinimumFreeDisk: in.GetMinimumFreeDisk(),
+		VettedOnly:      in.GetVettedOnly(),
+		RequiredCount:   int(in.GetRequiredCount()),
+		DistinctSubnets: in.GetDistinctSubnets(),
+	}
+	for _, code := range in.GetCountries() {
+		country := location.ToCountryCode(code)
+		if country == location.None {
+			return nil, Error.New("invalid country code: %q", code)
+		}
+		requirements.Countries = append(requirements.Countries, country)
+	}
+
+	validation, err := endpoint.overlay.ValidatePlacement(ctx, requirements)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.ValidatePlacementResponse{
+		Satisfiable:     validation.Satisfiable(),
+		Binding:         placementLimits[validation.Binding],
+		EligibleNodes:   validation.Eligible,
+		InCountries:     validation.InCountries,
+		WithFreeDisk:    validation.WithFreeDisk,
+		Vetted:          validation.Vetted,
+		DistinctSubnets: validation.DistinctSubnets,
+	}, nil
+}
+
+// NodesBelowMinVersion returns the nodes excluded from uploads for being below the minimum version, with the free disk
+// they would otherwise offer, largest first.
+func (endpoint *OverlayEndpoint) NodesBelowMinVersion(ctx context.Context, in *internalpb.NodesBelowMinVersionRequest) (_ *internalpb.NodesBelowMinVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	nodes, err := endpoint.overlay.NodesBelowMinimumVersion(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.NodesBelowMinVersionResponse{
+		MinimumVersion: endpoint.overlay.SelectionConfig().MinimumVersion,
+	}
+
+	outdated := nodes[:0]
+	for _, node := range nodes {
+		if in.GetOnlineOnly() && !endpoint.overlay.IsOnline(node) {
+			continue
+		}
+		outdated = append(outdated, node)
+		response.TotalNodes++
+		response.TotalFreeDisk += node.Capacity.FreeDisk
+	}
+	sort.Slice(outdated, func(i, k int) bool {
+		if outdated[i].Capacity.FreeDisk != outdated[k].Capacity.FreeDisk {
+			return outdated[i].Capacity.FreeDisk > outdated[k].Capacity.FreeDisk
+		}
+		return outdated[i].Id.Less(outdated[k].Id)
+	})
+
+	start, end, more := pageBounds(len(outdated), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	outdated, response.More = outdated[start:end], more
+
+	for _, node := range outdated {
+		response.Nodes = append(response.Nodes, &internalpb.OutdatedNode{
+			NodeId:             node.Id,
+			Version:            node.Version.GetVersion(),
+			Release:            node.Version.GetRelease(),
+			FreeDisk:           node.Capacity.FreeDisk,
+			LastContactSuccess: node.Reputation.LastContactSuccess,
+		})
+	}
+
+	return response, nil
+}
+
+// GetReputationThresholds returns the online window and the reputation thresholds nodes are vetted, suspended and
+// disqualified by, along with the version of the satellite, so that they can be correlated with deployments.
+func (endpoint *OverlayEndpoint) GetReputationThresholds(ctx context.Context, in *internalpb.GetReputationThresholdsRequest) (_ *internalpb.GetReputationThresholdsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config := endpoint.reputation.Config()
+
+	return &internalpb.GetReputationThresholdsResponse{
+		OnlineWindow:             endpoint.overlay.SelectionConfig().OnlineWindow,
+		AuditDq:                  config.AuditDQ,
+		UnknownAuditDq:           config.UnknownAuditDQ,
+		SuspensionGracePeriod:    config.SuspensionGracePeriod,
+		SuspensionDqEnabled:      config.SuspensionDQEnabled,
+		OfflineThreshold:         config.AuditHistory.OfflineThreshold,
+		OfflineSuspensionEnabled: config.AuditHistory.OfflineSuspensionEnabled,
+		OfflineDqEnabled:         config.AuditHistory.OfflineDQEnabled,
+		OfflineGracePeriod:       config.AuditHistory.GracePeriod,
+		TrackingPeriod:           config.AuditHistory.TrackingPeriod,
+		AuditWindowSize:          config.AuditHistory.WindowSize,
+		VettingAuditCount:        config.AuditCount,
+		Version: &internalpb.SatelliteVersion{
+			Version:    endpoint.versionInfo.Version.String(),
+			CommitHash: endpoint.versionInfo.CommitHash,
+			Timestamp:  endpoint.versionInfo.Timestamp,
+			Release:    endpoint.versionInfo.Release,
+		},
+	}, nil
+}
+
+// StaleGeoNodes lists the nodes whose country code wasn't resolved from their IP within the window, least recently
+// resolved first, so that they can be scheduled for re-resolution before stale countries misplace pieces.
+func (endpoint *OverlayEndpoint) StaleGeoNodes(ctx context.Context, in *internalpb.StaleGeoNodesRequest) (_ *internalpb.StaleGeoNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetStaleAfter() < 0 {
+		return nil, Error.New("stale after must not be negative")
+	}
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	staleAfter := in.GetStaleAfter()
+	if staleAfter == 0 {
+		staleAfter = endpoint.config.GeoStaleAfter
+	}
+
+	nodes, err := endpoint.overlay.StaleGeoNodes(ctx, time.Now().Add(-staleAfter))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sort.Slice(nodes, func(i, k int) bool {
+		refreshedI, refreshedK := geoRefreshedAt(nodes[i]), geoRefreshedAt(nodes[k])
+		if !refreshedI.Equal(refreshedK) {
+			return refreshedI.Before(refreshedK)
+		}
+		return nodes[i].Id.Less(nodes[k].Id)
+	})
+
+	response := &internalpb.StaleGeoNodesResponse{TotalNodes: int64(len(nodes))}
+
+	start, end, more := pageBounds(len(nodes), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	nodes, response.More = nodes[start:end], more
+
+	for _, node := range nodes {
+		response.Nodes = append(response.Nodes, &internalpb.StaleGeoNode{
+			NodeId:             node.Id,
+			LastIpPort:         node.LastIPPort,
+			CountryCode:        node.CountryCode.String(),
+			GeoRefreshedAt:     geoRefreshedAt(node),
+			LastContactSuccess: node.Reputation.LastContactSuccess,
+		})
+	}
+
+	return response, nil
+}
+
+// NodeFreeSpaceTrend lists the nodes by how fast their free space changed over the window, fastest filling first, to
+// forecast when the network runs out of space. Nodes only report their current free space, so the trend is fit to the
+// bytes the accounting rollups of the window say they stored: a node gaining stored bytes loses as much free space,
+// unless its operator changed its allocation. Nodes need at least two rollups in the window to have a trend.
+func (endpoint *OverlayEndpoint) NodeFreeSpaceTrend(ctx context.Context, in *internalpb.NodeFreeSpaceTrendRequest) (_ *internalpb.NodeFreeSpaceTrendResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetWindow() < 0 {
+		return nil, Error.New("window must not be negative")
+	}
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+
+	window := in.GetWindow()
+	if window == 0 {
+		window = endpoint.config.FreeSpaceTrendWindow
+	}
+
+	usages, err := endpoint.accounting.QueryStorageNodeUsageSince(ctx, time.Now().Add(-window))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rollups := make(map[storj.NodeID][]accounting.StorageNodeUsage)
+	for _, usage := range usages {
+		rollups[usage.NodeID] = append(rollups[usage.NodeID], usage)
+	}
+
+	response := &internalpb.NodeFreeSpaceTrendResponse{}
+
+	var nodes []*internalpb.NodeFreeSpaceTrend
+	err = endpoint.overlay.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+			return nil
+		}
+
+		change, ok := storedBytesPerDay(rollups[node.Id])
+		if !ok {
+			return nil
+		}
+		latest := rollups[node.Id][len(rollups[node.Id])-1]
+
+		trend := &internalpb.NodeFreeSpaceTrend{
+			NodeId:           node.Id,
+			FreeBytes:        node.Capacity.FreeDisk,
+			StoredBytes:      rollupStoredBytes(latest),
+			FreeChangePerDay: -int64(math.Round(change)),
+			Rollups:          int32(len(rollups[node.Id])),
+		}
+		trend.DaysUntilFull = daysUntilFull(trend.FreeBytes, trend.FreeChangePerDay)
+		nodes = append(nodes, trend)
+
+		// nodes that haven't reported their free space yet report it as negative
+		if trend.FreeBytes > 0 {
+			response.NetworkFreeBytes += trend.FreeBytes
+		}
+		response.NetworkFreeChangePerDay += trend.FreeChangePerDay
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sort.Slice(nodes, func(i, k int) bool {
+		if nodes[i].FreeChangePerDay != nodes[k].FreeChangePerDay {
+			return nodes[i].FreeChangePerDay < nodes[k].FreeChangePerDay
+		}
+		return nodes[i].NodeId.Less(nodes[k].NodeId)
+	})
+
+	response.TotalNodes = int64(len(nodes))
+	response.NetworkDaysUntilFull = daysUntilFull(response.NetworkFreeBytes, response.NetworkFreeChangePerDay)
+
+	start, end, more := pageBounds(len(nodes), int(in.GetOffset()), pageLimit(in.GetLimit()))
+	response.Nodes, response.More = nodes[start:end], more
+
+	return response, nil
+}
+
+// storedBytesPerDay fits a line to the bytes stored during the rollups, ordered by their start, and returns its slope in
+// bytes per day. It fails without at least two rollups that started at different times.
+func storedBytesPerDay(rollups []accounting.StorageNodeUsage) (_ float64, ok bool) {
+	if len(rollups) < 2 {
+		return 0, false
+	}
+
+	// least squares over days since the first rollup
+	first := rollups[0].Timestamp
+	var sumX, sumY, sumXX, sumXY float64
+	for _, rollup := range rollups {
+		x := rollup.Timestamp.Sub(first).Hours() / 24
+		y := float64(rollupStoredBytes(rollup))
+		sumX += x
+		sumY += y
+		sumXX += x * x
+		sumXY += x * y
+	}
+
+	n := float64(len(rollups))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0, false
+	}
+	return (n*sumXY - sumX*sumY) / denominator, true
+}
+
+// daysUntilFull returns how many days the free space lasts when it changes by the given bytes per day, or 0 when it
+// isn't shrinking.
+func daysUntilFull(free, changePerDay int64) float64 {
+	if changePerDay >= 0 || free <= 0 {
+		return 0
+	}
+	return float64(free) / float64(-changePerDay)
+}
+
+// PlacementSelectionCounts returns how many upload node selections this satellite process made per placement over the
+// window, and how many nodes they returned, to see how uploads spread across placements. The counts are kept in-process
+// for as long as the overlay retains them, so they start later than the window when the process started more recently
+// or the window reaches past the retention.
+func (endpoint *OverlayEndpoint) PlacementSelectionCounts(ctx context.Context, in *internalpb.PlacementSelectionCountsRequest) (_ *internalpb.PlacementSelectionCountsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetWindow() < 0 {
+		return nil, Error.New("window must not be negative")
+	}
+
+	window := in.GetWindow()
+	if window == 0 {
+		window = endpoint.config.PlacementSelectionWindow
+	}
+
+	selections, countedSince := endpoint.overlay.PlacementSelectionCounts(time.Now().Add(-window))
+
+	response := &internalpb.PlacementSelectionCountsResponse{
+		CountedSince: countedSince,
+	}
+	for _, selection := range selections {
+		response.Placements = append(response.Placements, &internalpb.PlacementSelectionCount{
+			Placement:     uint32(selection.Placement),
+			Selections:    selection.Selections,
+			SelectedNodes: selection.SelectedNodes,
+			Shortfalls:    selection.Shortfalls,
+		})
+		response.TotalSelectedNodes += selection.SelectedNodes
+	}
+	return response, nil
+}
+
+// ReinstateNode lifts the unknown audit or offline suspension of a node, for when the suspension was caused by the
+// satellite rather than the node. The reputation the suspension was imposed for is reset, so that the node isn't
+// suspended again right away. Disqualified nodes are never reinstated, their disqualification is final.
+func (endpoint *OverlayEndpoint) ReinstateNode(ctx context.Context, in *internalpb.ReinstateNodeRequest) (_ *internalpb.ReinstateNodeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if strings.TrimSpace(in.GetOperator()) == "" {
+		return nil, Error.New("operator is required")
+	}
+	if strings.TrimSpace(in.GetReason()) == "" {
+		return nil, Error.New("reason is required")
+	}
+
+	reinstatement := reputation.Reinstatement{
+		UnknownAudit: in.GetUnknownAudit(),
+		Offline:      in.GetOffline(),
+	}
+	if !reinstatement.UnknownAudit && !reinstatement.Offline {
+		reinstatement.UnknownAudit, reinstatement.Offline = true, true
+	}
+
+	before, after, err := endpoint.reputation.ReinstateNode(ctx, in.NodeId, reinstatement, in.GetOperator(), in.GetReason())
+	switch {
+	case reputation.ErrNodeNotFound.Has(err):
+		return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+	case reputation.ErrNodeDisqualified.Has(err), reputation.ErrNodeNotSuspended.Has(err):
+		return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, err)
+	case err != nil:
+		return nil, Error.Wrap(err)
+	}
+
+	node, err := endpoint.overlay.Get(ctx, in.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.ReinstateNodeResponse{
+		Status: endpoint.nodeStatus(node),
+		Before: nodeReputation(before),
+		After:  nodeReputation(after),
+	}, nil
+}
+
+// CapacityByCountry returns the number of qualified online nodes and their summed free disk per country. The nodes
+// whose country is unknown are returned separately, so that the countries and the unknown nodes add up to the totals.
+func (endpoint *OverlayEndpoint) CapacityByCountry(ctx context.Context, in *internalpb.CapacityByCountryRequest) (_ *internalpb.CapacityByCountryResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	countries, err := endpoint.overlay.CapacityByCountry(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.CapacityByCountryResponse{
+		Unknown: &internalpb.CountryCapacity{},
+	}
+	for code, country := range countries {
+		capacity := &internalpb.CountryCapacity{
+			CountryCode:    code.String(),
+			Nodes:          country.Nodes,
+			VettedNodes:    country.VettedNodes,
+			FreeDisk:       country.FreeDisk,
+			UploadExcluded: country.UploadExcluded,
+		}
+		response.TotalNodes += capacity.Nodes
+		response.TotalFreeDisk += capacity.FreeDisk
+
+		if code == location.None {
+			response.Unknown = capacity
+			continue
+		}
+		response.Countries = append(response.Countries, capacity)
+	}
+
+	sort.Slice(response.Countries, func(i, k int) bool {
+		if response.Countries[i].FreeDisk != response.Countries[k].FreeDisk {
+			return response.Countries[i].FreeDisk > response.Countries[k].FreeDisk
+		}
+		return response.Countries[i].CountryCode < response.Countries[k].CountryCode
+	})
+
+	return response, nil
+}
+
+// defaultRecentAuditFailures is how many of their most recent audits nodes have to have failed by default.
+const defaultRecentAuditFailures = 3
+
+// RecentAuditFailures lists the nodes that failed at least the requested number of their most recent audits in a row,
+// with the segments and times of those failures, to surface nodes degrading before they're disqualified. A node's
+// failures are forgotten as soon as it passes an audit.
+func (endpoint *OverlayEndpoint) RecentAuditFailures(ctx context.Context, in *internalpb.RecentAuditFailuresRequest) (_ *internalpb.RecentAuditFailuresResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetAudits() < 0 || in.GetStartAfterFailures() < 0 {
+		return nil, Error.New("audits and start after failures must not be negative")
+	}
+
+	audits := defaultRecentAuditFailures
+	if in.GetAudits() > 0 {
+		audits = int(in.GetAudits())
+	}
+	limit := pageLimit(in.GetLimit())
+
+	cursor := audit.FailureStreakCursor{
+		Failures: int(in.GetStartAfterFailures()),
+		NodeID:   in.StartAfter,
+	}
+	streaks, err := endpoint.auditFailures.ListStreaks(ctx, audits, audits, cursor, limit+1)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, end, more := pageBounds(len(streaks), 0, limit)
+	streaks = streaks[:end]
+
+	response := &internalpb.RecentAuditFailuresResponse{More: more}
+	for _, streak := range streaks {
+		node := &internalpb.AuditFailureStreak{
+			NodeId:   streak.NodeID,
+			Failures: int32(streak.Failures),
+		}
+		for _, failure := range streak.Recent {
+			node.Recent = append(node.Recent, &internalpb.FailedAudit{
+				StreamId: failure.StreamID[:],
+				Position: int64(failure.Position.Encode()),
+				FailedAt: failure.FailedAt,
+			})
+		}
+		response.Nodes = append(response.Nodes, node)
+	}
+
+	return response, nil
+}
+
+// ClockSkewNodes returns the nodes whose clock was off from the satellite's by at least the threshold, in either
+// direction, according to the timestamps they last signed their piece hashes with. A node signs them between the
+// creation of the order limits and the commit of the segment, so the skew is the least the clock was off by. The skews
+// are measured by the process serving the inspector, out of the segments committed through it.
+func (endpoint *OverlayEndpoint) ClockSkewNodes(ctx context.Context, in *internalpb.ClockSkewNodesRequest) (_ *internalpb.ClockSkewNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetThreshold() < 0 {
+		return nil, Error.New("threshold must not be negative")
+	}
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+	limit := pageLimit(in.GetLimit())
+
+	threshold := in.GetThreshold()
+	if threshold == 0 {
+		threshold = endpoint.config.ClockSkewThreshold
+	}
+
+	skews := endpoint.overlay.ClockSkews(threshold)
+
+	start, end, more := pageBounds(len(skews), int(in.GetOffset()), limit)
+
+	response := &internalpb.ClockSkewNodesResponse{More: more}
+	for _, skew := range skews[start:end] {
+		response.Nodes = append(response.Nodes, &internalpb.NodeClockSkew{
+			NodeId:     skew.NodeID,
+			Skew:       skew.Skew,
+			MeasuredAt: skew.MeasuredAt,
+		})
+	}
+	return response, nil
+}
+
+// CheckExitEligibility returns whether a node may start graceful exit. The criteria are checked in the order the
+// satellite checks them when the node starts exiting, and the first one the node fails is returned. Suspended nodes
+// may still exit, so suspension is only reported.
+func (endpoint *OverlayEndpoint) CheckExitEligibility(ctx context.Context, in *internalpb.CheckExitEligibilityRequest) (_ *internalpb.CheckExitEligibilityResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := endpoint.overlay.Get(ctx, in.NodeId)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.CheckExitEligibilityResponse{
+		JoinedAt:       node.CreatedAt,
+		OldEnoughAt:    endpoint.gracefulExitConfig.EligibleAt(node.CreatedAt),
+		MonthsRequired: int32(endpoint.gracefulExitConfig.NodeMinAgeInMonths),
+		Suspended:      node.UnknownAuditSuspended != nil || node.OfflineSuspended != nil,
+	}
+
+	switch {
+	case !endpoint.gracefulExitConfig.Enabled:
+		response.Reason = internalpb.CheckExitEligibilityResponse_GRACEFUL_EXIT_DISABLED
+	case node.Disqualified != nil:
+		response.Reason = internalpb.CheckExitEligibilityResponse_DISQUALIFIED
+	case node.ExitStatus.ExitFinishedAt != nil:
+		response.Reason = internalpb.CheckExitEligibilityResponse_EXIT_FINISHED
+	case node.ExitStatus.ExitInitiatedAt != nil:
+		response.Reason = internalpb.CheckExitEligibilityResponse_EXIT_STARTED
+	case time.Now().Before(response.OldEnoughAt):
+		response.Reason = internalpb.CheckExitEligibilityResponse_TOO_NEW
+	default:
+		response.Eligible = true
+	}
+
+	return response, nil
+}
+
+// NodeRepairStats returns how many pieces repair removed from the node because it failed to keep them, and how many it
+// uploaded to the node, in the hours within the window.
+func (endpoint *OverlayEndpoint) NodeRepairStats(ctx context.Context, in *internalpb.NodeRepairStatsRequest) (_ *internalpb.NodeRepairStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetWindow() < 0 {
+		return nil, Error.New("window must not be negative")
+	}
+
+	window := in.GetWindow()
+	if window == 0 {
+		window = endpoint.config.RepairStatsWindow
+	}
+
+	now := time.Now()
+	since := now.Add(-window)
+
+	repairs, err := endpoint.repairStats.Get(ctx, in.NodeId, since, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.NodeRepairStatsResponse{
+		NodeId:        in.NodeId,
+		Since:         since.UTC().Truncate(time.Hour),
+		PiecesRemoved: repairs.Removed,
+		PiecesAdded:   repairs.Added,
+	}, nil
+}
+
+// TrustingNodes counts the nodes that have the satellite in their trusted list. Nodes don't report their trusted list,
+// but they only check in with the satellites they trust, so the nodes that checked in within the window count as
+// trusting the satellite. Nodes that exited are left out, as they stop trusting the satellite once they're done.
+func (endpoint *OverlayEndpoint) TrustingNodes(ctx context.Context, in *internalpb.TrustingNodesRequest) (_ *internalpb.TrustingNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetWindow() < 0 {
+		return nil, Error.New("window must not be negative")
+	}
+
+	window := in.GetWindow()
+	if window == 0 {
+		window = endpoint.config.TrustWindow
+	}
+	since := time.Now().Add(-window)
+
+	stats, err := endpoint.overlay.CountNodesContactedSince(ctx, since)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	cursor := overlay.NodeContactCursor{
+		LastContact: in.GetStartAfterCheckIn(),
+		NodeID:      in.StartAfter,
+	}
+	nodes, more, err := endpoint.overlay.GetNodesContactedSince(ctx, since, cursor, pageLimit(in.GetLimit()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.TrustingNodesResponse{
+		Since:         since.UTC(),
+		TotalNodes:    stats.Nodes,
+		TrustingNodes: stats.Contacted,
+		LatestCheckIn: stats.LatestContact.UTC(),
+		More:          more,
+	}
+	for _, node := range nodes {
+		response.Nodes = append(response.Nodes, &internalpb.TrustingNode{
+			NodeId:             node.Id,
+			LastIpPort:         node.LastIPPort,
+			LastContactSuccess: node.Reputation.LastContactSuccess,
+		})
+	}
+
+	return response, nil
+}
+
+// SubnetSaturationStats counts the nodes eligible for uploads of the placement that selection excludes only because
+// another node on their subnet is eligible too, and how many more of the required nodes a selection could find without
+// the subnet rule.
+func (endpoint *OverlayEndpoint) SubnetSaturationStats(ctx context.Context, in *internalpb.SubnetSaturationStatsRequest) (_ *internalpb.SubnetSaturationStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetRequiredCount() <= 0 {
+		return nil, Error.New("required count must be positive")
+	}
+	if in.GetPlacement() < 0 || in.GetPlacement() > math.MaxUint16 {
+		return nil, Error.New("invalid placement: %d", in.GetPlacement())
+	}
+
+	saturation, err := endpoint.overlay.SubnetSaturation(ctx, storj.PlacementConstraint(in.GetPlacement()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &internalpb.SubnetSaturationStatsResponse{
+		EligibleNodes:            saturation.Eligible,
+		DistinctSubnets:          saturation.DistinctSubnets,
+		SaturatedSubnets:         saturation.SaturatedSubnets,
+		LargestSubnet:            saturation.LargestSubnet,
+		ExcludedNodes:            saturation.Excluded,
+		ExcludedFreeDisk:         saturation.ExcludedFreeDisk,
+		Selectable:               saturation.Selectable(int(in.GetRequiredCount()), true),
+		SelectableWithoutSubnets: saturation.Selectable(int(in.GetRequiredCount()), false),
+		DistinctIp:               saturation.DistinctIP,
+	}, nil
+}
+
+// PendingGCStats estimates how many pieces the node holds that it no longer has to keep, but hasn't collected yet, and
+// when it was last sent a retain filter. The node only collects the pieces removed before the latest filter it was sent
+// was created, so the pieces repair removed from the node since are pending. Pieces of deleted objects the node missed
+// the deletion of aren't tracked, so the estimate is a lower bound.
+func (endpoint *OverlayEndpoint) PendingGCStats(ctx context.Context, in *internalpb.PendingGCStatsRequest) (_ *internalpb.PendingGCStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response := &internalpb.PendingGCStatsResponse{NodeId: in.NodeId}
+
+	now := time.Now()
+	response.PendingSince = now.Add(-endpoint.config.PendingGCWindow)
+
+	filter, err := endpoint.retainFilters.Get(ctx, in.NodeId)
+	switch {
+	case sender.ErrFilterNotSent.Has(err):
+	case err != nil:
+		return nil, Error.Wrap(err)
+	default:
+		response.FilterSent = true
+		response.LastSentAt = filter.SentAt
+		response.FilterCreatedAt = filter.CreationDate
+		response.PiecesRetained = filter.PieceCount
+		response.PendingSince = filter.CreationDate
+	}
+
+	repairs, err := endpoint.repairStats.Get(ctx, in.NodeId, response.PendingSince, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	response.PendingSince = response.PendingSince.UTC().Truncate(time.Hour)
+	response.PendingPieces = repairs.Removed
+
+	return response, nil
+}
+
+// AuditVsTrafficAnomaly returns the nodes whose audit score diverges from the success rate of the pieces repair
+// downloaded from them in the window by at least the divergence, largest divergence first. The satellite only learns
+// the outcome of the downloads repair makes itself, customer downloads are settled without it, so repair downloads are
+// the traffic compared against. The audit score decays, so it weighs recent audits more than the window does.
+func (endpoint *OverlayEndpoint) AuditVsTrafficAnomaly(ctx context.Context, in *internalpb.AuditVsTrafficAnomalyRequest) (_ *internalpb.AuditVsTrafficAnomalyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if in.GetWindow() < 0 {
+		return nil, Error.New("window must not be negative")
+	}
+	if in.GetMinDivergence() < 0 || in.GetMinDivergence() > 1 {
+		return nil, Error.New("min divergence must be between 0 and 1")
+	}
+	if in.GetMinDownloads() < 0 {
+		return nil, Error.New("min downloads must not be negative")
+	}
+	if in.GetOffset() < 0 {
+		return nil, Error.New("offset must not be negative")
+	}
+	limit := pageLimit(in.GetLimit())
+
+	window := in.GetWindow()
+	if window == 0 {
+		window = endpoint.config.AuditTrafficWindow
+	}
+	minDivergence := in.GetMinDivergence()
+	if minDivergence == 0 {
+		minDivergence = endpoint.config.AuditTrafficDivergence
+	}
+	minDownloads := in.GetMinDownloads()
+	if minDownloads == 0 {
+		minDownloads = endpoint.config.AuditTrafficMinDownloads
+	}
+
+	now := time.Now()
+	since := now.Add(-window)
+
+	downloads, err := endpoint.repairStats.ListDownloads(ctx, since, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.AuditVsTrafficAnomalyResponse{
+		Since: since.UTC().Truncate(time.Hour),
+	}
+
+	var checked []repair.NodeRepairs
+	var nodeIDs []storj.NodeID
+	for _, node := range downloads {
+		total := node.DownloadsSucceeded + node.DownloadsFailed
+		if total == 0 || total < minDownloads {
+			continue
+		}
+		checked = append(checked, node)
+		nodeIDs = append(nodeIDs, node.NodeID)
+	}
+	response.NodesChecked = int64(len(checked))
+
+	auditScores, err := endpoint.reputation.AuditScores(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var anomalies []*internalpb.AuditVsTrafficNode
+	for _, node := range checked {
+		total := node.DownloadsSucceeded + node.DownloadsFailed
+		auditScore := auditScores[node.NodeID]
+		successRate := float64(node.DownloadsSucceeded) / float64(total)
+
+		divergence := auditScore - successRate
+		if math.Abs(divergence) < minDivergence {
+			continue
+		}
+
+		anomalies = append(anomalies, &internalpb.AuditVsTrafficNode{
+			NodeId:              node.NodeID,
+			AuditScore:          auditScore,
+			DownloadSuccessRate: successRate,
+			DownloadsSucceeded:  node.DownloadsSucceeded,
+			DownloadsFailed:     node.DownloadsFailed,
+			Divergence:          divergence,
+		})
+	}
+
+	sort.Slice(anomalies, func(i, k int) bool {
+		if a, b := math.Abs(anomalies[i].Divergence), math.Abs(anomalies[k].Divergence); a != b {
+			return a > b
+		}
+		return anomalies[i].NodeId.Less(anomalies[k].NodeId)
+	})
+
+	start, end, more := pageBounds(len(anomalies), int(in.GetOffset()), limit)
+	response.Nodes, response.More = anomalies[start:end], more
+
+	return response, nil
+}
+
+func selectionConfigText(config overlay.SelectionConfig) string {
+	var text strings.Builder
+	setting := func(name string, value interface{}) {
+		_, _ = fmt.Fprintf(&text, "%s=%v\n", name, value)
+	}
+
+	setting("distinct_ip", config.DistinctIP)
+	setting("minimum_disk_space", config.MinimumDiskSpace.Int64())
+	setting("minimum_version", config.MinimumVersion)
+	setting("new_node_fraction", strconv.FormatFloat(config.NewNodeFraction, 'f', -1, 64))
+	setting("online_window", config.OnlineWindow)
+	for _, placement := range config.Placements {
+		countries := strings.Join(placement.Countries, ",")
+		if placement.AllCountries {
+			countries = "*"
+		}
+		setting(fmt.Sprintf("placement.%d.%s", placement.Placement, placement.Name), countries)
+	}
+	setting("repair_excluded_countries", strings.Join(config.RepairExcludedCountries, ","))
+	setting("upload_excluded_countries", strings.Join(config.UploadExcludedCountries, ","))
+
+	return text.String()
+}
+
+func nodeReputation(info *reputation.Info) *internalpb.NodeReputation {
+	return &internalpb.NodeReputation{
+		AuditScore:        info.AuditReputationAlpha / (info.AuditReputationAlpha + info.AuditReputationBeta),
+		UnknownAuditScore: info.UnknownAuditReputationAlpha / (info.UnknownAuditReputationAlpha + info.UnknownAuditReputationBeta),
+		OnlineScore:       info.OnlineScore,
+		OnlineWindows:     int32(len(info.AuditHistory.GetWindows())),
+	}
+}
+
+var selectionReasons = map[overlay.NodeSelectionReason]internalpb.ExplainNodeSelectionResponse_Reason{
+	overlay.NodeSelectable:                       internalpb.ExplainNodeSelectionResponse_WOULD_BE_SELECTED,
+	overlay.NodeNotSelectedOffline:               internalpb.ExplainNodeSelectionResponse_OFFLINE,
+	overlay.NodeNotSelectedDisqualified:          internalpb.ExplainNodeSelectionResponse_DISQUALIFIED,
+	overlay.NodeNotSelectedSuspended:             internalpb.ExplainNodeSelectionResponse_SUSPENDED,
+	overlay.NodeNotSelectedExiting:               internalpb.ExplainNodeSelectionResponse_EXITING,
+	overlay.NodeNotSelectedInsufficientFreeSpace: internalpb.ExplainNodeSelectionResponse_INSUFFICIENT_FREE_SPACE,
+	overlay.NodeNotSelectedOutdatedVersion:       internalpb.ExplainNodeSelectionResponse_OUTDATED_VERSION,
+	overlay.NodeNotSelectedPlacement:             internalpb.ExplainNodeSelectionResponse_PLACEMENT_FILTER,
+	overlay.NodeNotSelectedUnvetted:              internalpb.ExplainNodeSelectionResponse_UNVETTED,
+	overlay.NodeNotSelectedSubnetConflict:        internalpb.ExplainNodeSelectionResponse_SUBNET_CONFLICT,
+}
+
+var placementLimits = map[overlay.PlacementLimit]internalpb.ValidatePlacementResponse_Constraint{
+	overlay.PlacementLimitNone:        internalpb.ValidatePlacementResponse_NONE,
+	overlay.PlacementLimitEligibility: internalpb.ValidatePlacementResponse_ELIGIBILITY,
+	overlay.PlacementLimitCountries:   internalpb.ValidatePlacementResponse_COUNTRIES,
+	overlay.PlacementLimitFreeDisk:    internalpb.ValidatePlacementResponse_FREE_DISK,
+	overlay.PlacementLimitVetting:     internalpb.ValidatePlacementResponse_VETTED,
+	overlay.PlacementLimitSubnets:     internalpb.ValidatePlacementResponse_SUBNETS,
+}
+
+func (endpoint *OverlayEndpoint) overlayNodes(nodes []*overlay.NodeDossier) []*internalpb.OverlayNode {
+	overlayNodes := make([]*internalpb.OverlayNode, 0, len(nodes))
+	for _, node := range nodes {
+		overlayNodes = append(overlayNodes, endpoint.overlayNode(node))
+	}
+	return overlayNodes
+}
+
+func (endpoint *OverlayEndpoint) overlayNode(node *overlay.NodeDossier) *internalpb.OverlayNode {
+	return &internalpb.OverlayNode{
+		NodeId:     node.Id,
+		Address:    node.Address.GetAddress(),
+		LastIpPort: node.LastIPPort,
+		LastNet:    node.LastNet,
+		Status:     endpoint.nodeStatus(node),
+	}
+}
+
+func (endpoint *OverlayEndpoint) nodeStatus(node *overlay.NodeDossier) *internalpb.NodeStatus {
+	return &internalpb.NodeStatus{
+		Online:                endpoint.overlay.IsOnline(node),
+		Vetted:                node.Reputation.Status.VettedAt != nil,
+		Disqualified:          node.Disqualified != nil,
+		UnknownAuditSuspended: node.UnknownAuditSuspended != nil,
+		OfflineSuspended:      node.OfflineSuspended != nil,
+		Exiting:               node.ExitStatus.ExitInitiatedAt != nil && node.ExitStatus.ExitFinishedAt == nil,
+		Exited:                node.ExitStatus.ExitFinishedAt != nil,
+	}
+}
+
+// parseIPSubnet parses an ip address or CIDR subnet, an ip address being the subnet of only that address.
+func parseIPSubnet(value string) (*net.IPNet, error) {
+	if _, subnet, err := net.ParseCIDR(value); err == nil {
+		return subnet, nil
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, Error.New("invalid ip or subnet: %q", value)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(8*net.IPv4len, 8*net.IPv4len)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(8*net.IPv6len, 8*net.IPv6len)}, nil
+}
+
+// geoRefreshedAt returns when the country code of the node was last resolved, or the zero time when it never was.
+func geoRefreshedAt(node *overlay.NodeDossier) time.Time {
+	if node.GeoRefreshedAt == nil {
+		return time.Time{}
+	}
+	return *node.GeoRefreshedAt
+}
+
+// pageLimit returns the page size the request asked for, clamped to maxLimit, or defaultLimit when it didn't ask for
+// one.
+func pageLimit(limit int32) int {
+	switch {
+	case limit <= 0:
+		return defaultLimit
+	case limit > maxLimit:
+		return maxLimit
+	default:
+		return int(limit)
+	}
+}
+
+// pageBounds returns the bounds of the page of length items that skips offset items and holds at most limit of them,
+// and whether there are more items after it.
+func pageBounds(length, offset, limit int) (start, end int, more bool) {
+	start, end = length, length
+	if offset < length {
+		start = offset
+	}
+	if end-start > limit {
+		end, more = start+limit, true
+	}
+	return start, end, more
+}
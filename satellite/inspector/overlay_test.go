@@ -0,0 +1,1949 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/gc/sender"
+	"storj.io/storj/satellite/inspector"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestNodesByIP(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		endpoint := planet.Satellites[0].Inspector.OverlayEndpoint
+
+		// all testplanet nodes are running on the same host
+		resp, err := endpoint.NodesByIP(ctx, &internalpb.NodesByIPRequest{Ip: "127.0.0.1"})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 4)
+		require.False(t, resp.More)
+
+		for _, node := range resp.Nodes {
+			require.True(t, node.Status.Online)
+			require.False(t, node.Status.Disqualified)
+		}
+
+		subnet, err := endpoint.NodesByIP(ctx, &internalpb.NodesByIPRequest{Ip: "127.0.0.0/8"})
+		require.NoError(t, err)
+		require.Equal(t, resp.Nodes, subnet.Nodes)
+
+		{ // paginate
+			first, err := endpoint.NodesByIP(ctx, &internalpb.NodesByIPRequest{Ip: "127.0.0.1", Limit: 3})
+			require.NoError(t, err)
+			require.Len(t, first.Nodes, 3)
+			require.True(t, first.More)
+
+			second, err := endpoint.NodesByIP(ctx, &internalpb.NodesByIPRequest{
+				Ip:         "127.0.0.1",
+				StartAfter: first.Nodes[2].NodeId,
+				Limit:      3,
+			})
+			require.NoError(t, err)
+			require.Len(t, second.Nodes, 1)
+			require.False(t, second.More)
+
+			require.Equal(t, resp.Nodes, append(first.Nodes, second.Nodes...))
+		}
+
+		none, err := endpoint.NodesByIP(ctx, &internalpb.NodesByIPRequest{Ip: "10.0.0.1"})
+		require.NoError(t, err)
+		require.Empty(t, none.Nodes)
+
+		_, err = endpoint.NodesByIP(ctx, &internalpb.NodesByIPRequest{Ip: "not an ip"})
+		require.Error(t, err)
+	})
+}
+
+func TestIPsWithManyNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		endpoint := planet.Satellites[0].Inspector.OverlayEndpoint
+
+		resp, err := endpoint.IPsWithManyNodes(ctx, &internalpb.IPsWithManyNodesRequest{MinNodes: 3})
+		require.NoError(t, err)
+		require.Len(t, resp.Ips, 1)
+		require.Equal(t, "127.0.0.1", resp.Ips[0].Ip)
+		require.Len(t, resp.Ips[0].Nodes, 4)
+		require.False(t, resp.More)
+
+		resp, err = endpoint.IPsWithManyNodes(ctx, &internalpb.IPsWithManyNodesRequest{MinNodes: 4})
+		require.NoError(t, err)
+		require.Empty(t, resp.Ips)
+
+		resp, err = endpoint.IPsWithManyNodes(ctx, &internalpb.IPsWithManyNodesRequest{MinNodes: 3, StartAfter: "127.0.0.1"})
+		require.NoError(t, err)
+		require.Empty(t, resp.Ips)
+
+		_, err = endpoint.IPsWithManyNodes(ctx, &internalpb.IPsWithManyNodesRequest{MinNodes: -1})
+		require.Error(t, err)
+		_, err = endpoint.IPsWithManyNodes(ctx, &internalpb.IPsWithManyNodesRequest{StartAfter: "not an ip"})
+		require.Error(t, err)
+	})
+}
+
+func TestExplainNodeSelection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		vetted, unvetted := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		disqualified, suspended := planet.StorageNodes[2].ID(), planet.StorageNodes[3].ID()
+
+		_, err := satellite.Overlay.DB.TestVetNode(ctx, vetted)
+		require.NoError(t, err)
+		require.NoError(t, satellite.Overlay.DB.TestUnvetNode(ctx, unvetted))
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, disqualified, time.Now(), overlay.DisqualificationReasonUnknown))
+		require.NoError(t, satellite.Overlay.DB.TestSuspendNodeOffline(ctx, suspended, time.Now()))
+
+		for _, tt := range []struct {
+			node      storj.NodeID
+			placement storj.PlacementConstraint
+			reason    internalpb.ExplainNodeSelectionResponse_Reason
+		}{
+			{vetted, storj.EveryCountry, internalpb.ExplainNodeSelectionResponse_WOULD_BE_SELECTED},
+			{vetted, storj.EU, internalpb.ExplainNodeSelectionResponse_PLACEMENT_FILTER},
+			{unvetted, storj.EveryCountry, internalpb.ExplainNodeSelectionResponse_UNVETTED},
+			{disqualified, storj.EveryCountry, internalpb.ExplainNodeSelectionResponse_DISQUALIFIED},
+			{suspended, storj.EveryCountry, internalpb.ExplainNodeSelectionResponse_SUSPENDED},
+		} {
+			resp, err := endpoint.ExplainNodeSelection(ctx, &internalpb.ExplainNodeSelectionRequest{
+				NodeId:    tt.node,
+				Placement: int32(tt.placement),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.reason, resp.Reason, tt.node.String())
+			require.Equal(t, tt.node, resp.Node.NodeId)
+		}
+
+		_, err = endpoint.ExplainNodeSelection(ctx, &internalpb.ExplainNodeSelectionRequest{NodeId: testrand.NodeID()})
+		require.Error(t, err)
+	})
+}
+
+func TestTopNodesByStorage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		day := time.Now().UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour)
+		rollups := accounting.RollupStats{day: make(map[storj.NodeID]*accounting.Rollup)}
+
+		// the last node has no rollup and isn't ranked
+		for i, node := range planet.StorageNodes[:3] {
+			rollups[day][node.ID()] = &accounting.Rollup{
+				NodeID:          node.ID(),
+				StartTime:       day,
+				IntervalEndTime: day.Add(10 * time.Hour),
+				AtRestTotal:     float64(i+1) * 10 * 1000,
+			}
+		}
+		require.NoError(t, satellite.DB.StoragenodeAccounting().SaveRollup(ctx, day, rollups))
+
+		resp, err := endpoint.TopNodesByStorage(ctx, &internalpb.TopNodesByStorageRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 3)
+		require.False(t, resp.More)
+
+		for i, node := range resp.Nodes {
+			require.Equal(t, planet.StorageNodes[2-i].ID(), node.NodeId)
+			require.Equal(t, int64(3-i)*1000, node.StoredBytes)
+			require.True(t, node.Online)
+		}
+
+		page, err := endpoint.TopNodesByStorage(ctx, &internalpb.TopNodesByStorageRequest{Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.Equal(t, resp.Nodes[1:2], page.Nodes)
+		require.True(t, page.More)
+
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.StorageNodes[2]))
+
+		online, err := endpoint.TopNodesByStorage(ctx, &internalpb.TopNodesByStorageRequest{OnlineOnly: true})
+		require.NoError(t, err)
+		require.Equal(t, resp.Nodes[1:], online.Nodes)
+	})
+}
+
+func TestSpaceDiscrepancyNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		day := time.Now().UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour)
+		rollups := accounting.RollupStats{day: make(map[storj.NodeID]*accounting.Rollup)}
+
+		// stored and free bytes of every node, the last node has no rollup and isn't reported
+		usage := []struct{ stored, free int64 }{
+			{stored: 1000, free: 5000},
+			{stored: 1000, free: 50000},
+			{stored: 0, free: 20000},
+			{stored: 0, free: 1e9},
+		}
+		for i, node := range planet.StorageNodes {
+			_, err := satellite.Overlay.Service.UpdateNodeInfo(ctx, node.ID(), &overlay.InfoResponse{
+				Capacity: &pb.NodeCapacity{FreeDisk: usage[i].free},
+			})
+			require.NoError(t, err)
+
+			if i < 3 {
+				rollups[day][node.ID()] = &accounting.Rollup{
+					NodeID:          node.ID(),
+					StartTime:       day,
+					IntervalEndTime: day.Add(10 * time.Hour),
+					AtRestTotal:     float64(usage[i].stored) * 10,
+				}
+			}
+		}
+		require.NoError(t, satellite.DB.StoragenodeAccounting().SaveRollup(ctx, day, rollups))
+
+		resp, err := endpoint.SpaceDiscrepancyNodes(ctx, &internalpb.SpaceDiscrepancyNodesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 2)
+		require.False(t, resp.More)
+
+		require.Equal(t, planet.StorageNodes[1].ID(), resp.Nodes[0].NodeId)
+		require.EqualValues(t, 1000, resp.Nodes[0].StoredBytes)
+		require.EqualValues(t, 50000, resp.Nodes[0].FreeBytes)
+		require.EqualValues(t, 40000, resp.Nodes[0].ExcessBytes)
+		require.EqualValues(t, 50, resp.Nodes[0].FreeRatio)
+
+		require.Equal(t, planet.StorageNodes[2].ID(), resp.Nodes[1].NodeId)
+		require.EqualValues(t, 20000, resp.Nodes[1].ExcessBytes)
+		require.Zero(t, resp.Nodes[1].FreeRatio)
+
+		lenient, err := endpoint.SpaceDiscrepancyNodes(ctx, &internalpb.SpaceDiscrepancyNodesRequest{MaxFreeRatio: 2})
+		require.NoError(t, err)
+		require.Len(t, lenient.Nodes, 3)
+		require.Equal(t, planet.StorageNodes[0].ID(), lenient.Nodes[2].NodeId)
+		require.EqualValues(t, 3000, lenient.Nodes[2].ExcessBytes)
+
+		page, err := endpoint.SpaceDiscrepancyNodes(ctx, &internalpb.SpaceDiscrepancyNodesRequest{Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.Equal(t, resp.Nodes[1:], page.Nodes)
+		require.False(t, page.More)
+
+		large, err := endpoint.SpaceDiscrepancyNodes(ctx, &internalpb.SpaceDiscrepancyNodesRequest{MinFreeBytes: 30000})
+		require.NoError(t, err)
+		require.Equal(t, resp.Nodes[:1], large.Nodes)
+
+		_, err = endpoint.SpaceDiscrepancyNodes(ctx, &internalpb.SpaceDiscrepancyNodesRequest{MaxFreeRatio: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestRecalculateReputation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		nodeID := planet.StorageNodes[0].ID()
+
+		require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, nodeID, overlay.ReputationStatus{}, reputation.AuditSuccess))
+
+		resp, err := endpoint.RecalculateReputation(ctx, &internalpb.RecalculateReputationRequest{NodeId: nodeID})
+		require.NoError(t, err)
+		require.Equal(t, resp.Before, resp.After)
+		require.EqualValues(t, 1, resp.After.OnlineScore)
+		require.InDelta(t, 1, resp.After.AuditScore, 1e-8)
+
+		_, err = endpoint.RecalculateReputation(ctx, &internalpb.RecalculateReputationRequest{NodeId: testrand.NodeID()})
+		require.Error(t, err)
+	})
+}
+
+func TestNodesNearOfflineDQ(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		config := satellite.Config.Reputation.AuditHistory
+
+		offline, declining := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		history := func(onlineCounts ...int32) *pb.AuditHistory {
+			windowStart := time.Now().Truncate(config.WindowSize).Add(-time.Duration(len(onlineCounts)-1) * config.WindowSize)
+			history := &pb.AuditHistory{}
+			for i, onlineCount := range onlineCounts {
+				history.Windows = append(history.Windows, &pb.AuditWindow{
+					WindowStart: windowStart.Add(time.Duration(i) * config.WindowSize),
+					OnlineCount: onlineCount,
+					TotalCount:  10,
+				})
+			}
+			reputation.RecalculateScore(history)
+			return history
+		}
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, node.ID(), overlay.ReputationStatus{}, reputation.AuditSuccess))
+		}
+		require.NoError(t, satellite.Reputation.Service.TestFlushAllNodeInfo(ctx))
+		require.NoError(t, satellite.DB.Reputation().UpdateAuditHistory(ctx, offline, history(5, 5, 10)))
+		require.NoError(t, satellite.DB.Reputation().UpdateAuditHistory(ctx, declining, history(10, 4, 10)))
+
+		resp, err := endpoint.NodesNearOfflineDQ(ctx, &internalpb.NodesNearOfflineDQRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Equal(t, config.OfflineDQEnabled, resp.OfflineDqEnabled)
+		require.Len(t, resp.Nodes, 2)
+
+		require.Equal(t, offline, resp.Nodes[0].NodeId)
+		require.InDelta(t, 0.5, resp.Nodes[0].OnlineScore, 1e-8)
+		require.NotNil(t, resp.Nodes[0].ProjectedDq)
+
+		require.Equal(t, declining, resp.Nodes[1].NodeId)
+		require.InDelta(t, 0.7, resp.Nodes[1].OnlineScore, 1e-8)
+		require.NotNil(t, resp.Nodes[1].ProjectedDq)
+		require.True(t, resp.Nodes[1].ProjectedDq.After(*resp.Nodes[0].ProjectedDq))
+
+		resp, err = endpoint.NodesNearOfflineDQ(ctx, &internalpb.NodesNearOfflineDQRequest{Threshold: 0.6})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, offline, resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.NodesNearOfflineDQ(ctx, &internalpb.NodesNearOfflineDQRequest{Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, declining, resp.Nodes[0].NodeId)
+
+		_, err = endpoint.NodesNearOfflineDQ(ctx, &internalpb.NodesNearOfflineDQRequest{Threshold: 2})
+		require.Error(t, err)
+	})
+}
+
+func TestNodesByUptimeBand(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		windowSize := satellite.Config.Reputation.AuditHistory.WindowSize
+
+		history := func(online, total int32) *pb.AuditHistory {
+			history := &pb.AuditHistory{Windows: []*pb.AuditWindow{{
+				WindowStart: time.Now().Truncate(windowSize),
+				OnlineCount: online,
+				TotalCount:  total,
+			}}}
+			reputation.RecalculateScore(history)
+			return history
+		}
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, node.ID(), overlay.ReputationStatus{}, reputation.AuditSuccess))
+		}
+		require.NoError(t, satellite.Reputation.Service.TestFlushAllNodeInfo(ctx))
+
+		perfect, good, poor, worse := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID(), planet.StorageNodes[2].ID(), planet.StorageNodes[3].ID()
+		require.NoError(t, satellite.DB.Reputation().UpdateAuditHistory(ctx, perfect, history(100, 100)))
+		require.NoError(t, satellite.DB.Reputation().UpdateAuditHistory(ctx, good, history(97, 100)))
+		require.NoError(t, satellite.DB.Reputation().UpdateAuditHistory(ctx, poor, history(80, 100)))
+		require.NoError(t, satellite.DB.Reputation().UpdateAuditHistory(ctx, worse, history(50, 100)))
+
+		resp, err := endpoint.NodesByUptimeBand(ctx, &internalpb.NodesByUptimeBandRequest{WindowHours: 48})
+		require.NoError(t, err)
+		require.Len(t, resp.Bands, 3)
+		require.Equal(t, []int64{1, 1, 2}, []int64{resp.Bands[0].NodeCount, resp.Bands[1].NodeCount, resp.Bands[2].NodeCount})
+		require.Equal(t, 100.0, resp.Bands[0].UpperBound)
+		require.Equal(t, 0.0, resp.Bands[2].LowerBound)
+		require.Empty(t, resp.Nodes)
+
+		resp, err = endpoint.NodesByUptimeBand(ctx, &internalpb.NodesByUptimeBandRequest{ListBand: 3, Limit: 1})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, worse, resp.Nodes[0].NodeId)
+		require.InDelta(t, 50, resp.Nodes[0].Uptime, 1e-8)
+		require.EqualValues(t, 100, resp.Nodes[0].Audits)
+
+		resp, err = endpoint.NodesByUptimeBand(ctx, &internalpb.NodesByUptimeBandRequest{LowerBounds: []float64{75}, ListBand: 1, Offset: 2})
+		require.NoError(t, err)
+		require.Equal(t, int64(3), resp.Bands[0].NodeCount)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, perfect, resp.Nodes[0].NodeId)
+
+		_, err = endpoint.NodesByUptimeBand(ctx, &internalpb.NodesByUptimeBandRequest{LowerBounds: []float64{95, 99}})
+		require.Error(t, err)
+		_, err = endpoint.NodesByUptimeBand(ctx, &internalpb.NodesByUptimeBandRequest{ListBand: 4})
+		require.Error(t, err)
+	})
+}
+
+func TestNodesByLatencyTier(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		checkIn := func(nodeID storj.NodeID, latency time.Duration) {
+			dossier, err := satellite.Overlay.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+
+			require.NoError(t, satellite.Overlay.DB.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:     nodeID,
+				Address:    dossier.Address,
+				LastNet:    dossier.LastNet,
+				LastIPPort: dossier.LastIPPort,
+				IsUp:       true,
+				Latency:    latency,
+				Operator:   &dossier.Operator,
+				Capacity:   &dossier.Capacity,
+				Version:    &dossier.Version,
+			}, time.Now(), satellite.Config.Overlay.Node))
+		}
+
+		fast, medium, slow, slowest := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID(), planet.StorageNodes[2].ID(), planet.StorageNodes[3].ID()
+		checkIn(fast, 20*time.Millisecond)
+		checkIn(medium, 70*time.Millisecond)
+		checkIn(slow, 150*time.Millisecond)
+		checkIn(slowest, 300*time.Millisecond)
+		// a faster ping only slowly lowers the latency
+		checkIn(slowest, 100*time.Millisecond)
+
+		resp, err := endpoint.NodesByLatencyTier(ctx, &internalpb.NodesByLatencyTierRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Tiers, 4)
+		require.Equal(t, []int64{1, 1, 1, 1}, []int64{resp.Tiers[0].NodeCount, resp.Tiers[1].NodeCount, resp.Tiers[2].NodeCount, resp.Tiers[3].NodeCount})
+		require.EqualValues(t, 0, resp.Tiers[0].LowerBoundMs)
+		require.EqualValues(t, 200, resp.Tiers[3].LowerBoundMs)
+		require.EqualValues(t, 0, resp.Tiers[3].UpperBoundMs)
+		require.Zero(t, resp.Unmeasured)
+		require.Empty(t, resp.Nodes)
+
+		resp, err = endpoint.NodesByLatencyTier(ctx, &internalpb.NodesByLatencyTierRequest{ListTier: 4})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, slowest, resp.Nodes[0].NodeId)
+		require.EqualValues(t, 280, resp.Nodes[0].LatencyMs)
+
+		resp, err = endpoint.NodesByLatencyTier(ctx, &internalpb.NodesByLatencyTierRequest{UpperBoundsMs: []int64{100}, ListTier: 1, Limit: 1})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), resp.Tiers[0].NodeCount)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, fast, resp.Nodes[0].NodeId)
+		require.EqualValues(t, 20, resp.Nodes[0].LatencyMs)
+
+		resp, err = endpoint.NodesByLatencyTier(ctx, &internalpb.NodesByLatencyTierRequest{UpperBoundsMs: []int64{100}, ListTier: 1, Offset: 1})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, medium, resp.Nodes[0].NodeId)
+
+		_, err = endpoint.NodesByLatencyTier(ctx, &internalpb.NodesByLatencyTierRequest{UpperBoundsMs: []int64{200, 100}})
+		require.Error(t, err)
+		_, err = endpoint.NodesByLatencyTier(ctx, &internalpb.NodesByLatencyTierRequest{ListTier: 5})
+		require.Error(t, err)
+	})
+}
+
+func TestCompareNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		good, bad := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, good, overlay.ReputationStatus{}, reputation.AuditSuccess))
+		require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, bad, overlay.ReputationStatus{}, reputation.AuditFailure))
+		require.NoError(t, satellite.Reputation.Service.TestFlushAllNodeInfo(ctx))
+
+		resp, err := endpoint.CompareNodes(ctx, &internalpb.CompareNodesRequest{First: good, Second: bad})
+		require.NoError(t, err)
+		require.Equal(t, good, resp.First.NodeId)
+		require.Equal(t, bad, resp.Second.NodeId)
+		require.Greater(t, resp.First.Reputation.AuditScore, resp.Second.Reputation.AuditScore)
+		require.EqualValues(t, 1, resp.First.AuditSuccessCount)
+		require.EqualValues(t, 0, resp.Second.AuditSuccessCount)
+		require.NotNil(t, resp.First.LastAudit)
+		require.Contains(t, resp.Differences, "audit_score")
+		require.Contains(t, resp.Differences, "audit_success_count")
+		require.NotContains(t, resp.Differences, "total_audit_count")
+
+		resp, err = endpoint.CompareNodes(ctx, &internalpb.CompareNodesRequest{First: good, Second: good})
+		require.NoError(t, err)
+		require.Empty(t, resp.Differences)
+
+		_, err = endpoint.CompareNodes(ctx, &internalpb.CompareNodesRequest{First: good, Second: testrand.NodeID()})
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+}
+
+func TestListExitingNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		queueing, transferring := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		now := time.Now()
+
+		_, err := satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          queueing,
+			ExitInitiatedAt: now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:              transferring,
+			ExitInitiatedAt:     now.Add(-2 * time.Hour),
+			ExitLoopCompletedAt: now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		require.NoError(t, satellite.DB.GracefulExit().IncrementProgress(ctx, transferring, 300, 3, 1))
+
+		resp, err := endpoint.ListExitingNodes(ctx, &internalpb.ListExitingNodesRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 2)
+
+		require.Equal(t, transferring, resp.Nodes[0].NodeId)
+		require.True(t, resp.Nodes[0].QueueBuilt)
+		require.Equal(t, 100.0, resp.Nodes[0].CompletionPercentage)
+		require.EqualValues(t, 300, resp.Nodes[0].BytesTransferred)
+		require.EqualValues(t, 1, resp.Nodes[0].PiecesFailed)
+		require.True(t, resp.Nodes[0].Failing)
+
+		require.Equal(t, queueing, resp.Nodes[1].NodeId)
+		require.False(t, resp.Nodes[1].QueueBuilt)
+		require.Zero(t, resp.Nodes[1].CompletionPercentage)
+		require.False(t, resp.Nodes[1].Failing)
+
+		resp, err = endpoint.ListExitingNodes(ctx, &internalpb.ListExitingNodesRequest{
+			Order: internalpb.ListExitingNodesRequest_PROGRESS,
+			Limit: 1,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, queueing, resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.ListExitingNodes(ctx, &internalpb.ListExitingNodesRequest{
+			Order:      internalpb.ListExitingNodesRequest_PROGRESS,
+			Descending: true,
+			Offset:     1,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, queueing, resp.Nodes[0].NodeId)
+	})
+}
+
+func TestGetSelectionConfig(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				testplanet.UploadExcludedCountryCodes([]string{"fr", "BE", "", "FR"}),
+				testplanet.RepairExcludedCountryCodes([]string{"DE"}),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		endpoint := planet.Satellites[0].Inspector.OverlayEndpoint
+
+		resp, err := endpoint.GetSelectionConfig(ctx, &internalpb.GetSelectionConfigRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"BE", "FR"}, resp.UploadExcludedCountries)
+		require.Equal(t, []string{"DE"}, resp.RepairExcludedCountries)
+
+		placements := make(map[storj.PlacementConstraint]*internalpb.PlacementDefinition)
+		for _, placement := range resp.Placements {
+			placements[storj.PlacementConstraint(placement.Placement)] = placement
+		}
+		require.True(t, placements[storj.EveryCountry].AllCountries)
+		require.Empty(t, placements[storj.EveryCountry].Countries)
+		require.Contains(t, placements[storj.EU].Countries, "DE")
+		require.NotContains(t, placements[storj.EU].Countries, "NO")
+		require.Contains(t, placements[storj.EEA].Countries, "NO")
+		require.Equal(t, []string{"US"}, placements[storj.US].Countries)
+		require.Equal(t, []string{"DE"}, placements[storj.DE].Countries)
+
+		require.Contains(t, resp.Text, "upload_excluded_countries=BE,FR\n")
+		require.Contains(t, resp.Text, "placement.0.every country=*\n")
+
+		again, err := endpoint.GetSelectionConfig(ctx, &internalpb.GetSelectionConfigRequest{})
+		require.NoError(t, err)
+		require.Equal(t, resp.Text, again.Text)
+	})
+}
+
+func TestNodeCohorts(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		disqualified := planet.StorageNodes[0].ID()
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, disqualified, time.Now(), overlay.DisqualificationReasonUnknown))
+
+		cohorts := func(req *internalpb.NodeCohortsRequest) []*internalpb.NodeCohort {
+			resp, err := endpoint.NodeCohorts(ctx, req)
+			require.NoError(t, err)
+			return resp.Cohorts
+		}
+
+		// every node joined during the test
+		now := time.Now().UTC()
+		all := cohorts(&internalpb.NodeCohortsRequest{})
+		require.Len(t, all, 1)
+		require.EqualValues(t, 3, all[0].Nodes)
+		require.Equal(t, time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), all[0].PeriodStart.UTC())
+
+		active := cohorts(&internalpb.NodeCohortsRequest{Filter: internalpb.NodeCohortsRequest_ACTIVE})
+		require.Len(t, active, 1)
+		require.EqualValues(t, 2, active[0].Nodes)
+
+		churned := cohorts(&internalpb.NodeCohortsRequest{Filter: internalpb.NodeCohortsRequest_CHURNED})
+		require.Len(t, churned, 1)
+		require.EqualValues(t, 1, churned[0].Nodes)
+
+		weekly := cohorts(&internalpb.NodeCohortsRequest{Granularity: internalpb.NodeCohortsRequest_WEEK})
+		require.Len(t, weekly, 1)
+		require.Equal(t, time.Monday, weekly[0].PeriodStart.UTC().Weekday())
+
+		quarterly := cohorts(&internalpb.NodeCohortsRequest{Granularity: internalpb.NodeCohortsRequest_QUARTER})
+		require.Len(t, quarterly, 1)
+		require.EqualValues(t, 1, quarterly[0].PeriodStart.UTC().Month()%3)
+
+		_, err := endpoint.NodeCohorts(ctx, &internalpb.NodeCohortsRequest{ChurnedAfter: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestListContainedNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		maxReverifyCount := int32(satellite.Config.Audit.MaxReverifyCount)
+
+		fresh, retried := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		streamID := testrand.UUID()
+		for nodeID, reverifyCount := range map[storj.NodeID]int32{fresh: 0, retried: maxReverifyCount - 1} {
+			require.NoError(t, satellite.DB.Containment().IncrementPending(ctx, &audit.PendingAudit{
+				NodeID:            nodeID,
+				PieceID:           testrand.PieceID(),
+				ExpectedShareHash: testrand.Bytes(32),
+				ReverifyCount:     reverifyCount,
+				StreamID:          streamID,
+				Position:          metabase.SegmentPosition{Index: 1},
+			}))
+		}
+
+		resp, err := endpoint.ListContainedNodes(ctx, &internalpb.ListContainedNodesRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 2)
+
+		remaining := make(map[storj.NodeID]int32)
+		for _, node := range resp.Nodes {
+			require.Equal(t, streamID[:], node.StreamId)
+			require.EqualValues(t, metabase.SegmentPosition{Index: 1}.Encode(), node.Position)
+			remaining[node.NodeId] = node.ReverificationsRemaining
+		}
+		require.Equal(t, maxReverifyCount, remaining[fresh])
+		require.EqualValues(t, 1, remaining[retried])
+
+		first, err := endpoint.ListContainedNodes(ctx, &internalpb.ListContainedNodesRequest{Limit: 1})
+		require.NoError(t, err)
+		require.True(t, first.More)
+		require.Len(t, first.Nodes, 1)
+
+		second, err := endpoint.ListContainedNodes(ctx, &internalpb.ListContainedNodesRequest{StartAfter: first.Nodes[0].NodeId, Limit: 1})
+		require.NoError(t, err)
+		require.False(t, second.More)
+		require.Len(t, second.Nodes, 1)
+		require.NotEqual(t, first.Nodes[0].NodeId, second.Nodes[0].NodeId)
+	})
+}
+
+func TestSelectionFairness(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Inspector.SelectionFairnessMaxSelections = 20
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		endpoint := planet.Satellites[0].Inspector.OverlayEndpoint
+
+		// the requested selections are capped
+		resp, err := endpoint.SelectionFairness(ctx, &internalpb.SelectionFairnessRequest{Selections: 50, Nodes: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 20, resp.Selections)
+		require.EqualValues(t, 40, resp.NodesSelected)
+		require.EqualValues(t, len(resp.Subnets), resp.SubnetsSeen)
+
+		var subnetTotal, nodeTotal int64
+		for _, subnet := range resp.Subnets {
+			subnetTotal += subnet.Selected
+			require.InDelta(t, float64(resp.NodesSelected)/float64(resp.SubnetsSeen), subnet.Expected, 1e-9)
+		}
+		for _, node := range resp.Nodes {
+			nodeTotal += node.Selected
+		}
+		require.EqualValues(t, 40, subnetTotal)
+		require.EqualValues(t, 40, nodeTotal)
+		require.LessOrEqual(t, len(resp.Nodes), 5)
+		require.Zero(t, resp.PartialSelections)
+
+		// the simulated selections aren't counted as upload selections
+		counts, _ := planet.Satellites[0].Overlay.Service.PlacementSelectionCounts(time.Time{})
+		require.Empty(t, counts)
+
+		resp, err = endpoint.SelectionFairness(ctx, &internalpb.SelectionFairnessRequest{Selections: 5, Nodes: 2, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Len(t, resp.Subnets, 1)
+
+		// the network is too small for the default number of nodes, so every selection is partial
+		resp, err = endpoint.SelectionFairness(ctx, &internalpb.SelectionFairnessRequest{Selections: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 5, resp.PartialSelections)
+		require.Positive(t, resp.NodesSelected)
+		require.LessOrEqual(t, resp.NodesSelected, int64(5*5))
+
+		_, err = endpoint.SelectionFairness(ctx, &internalpb.SelectionFairnessRequest{Selections: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestGetOperatorContact(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		email := node.Config.Operator.Email
+		at := strings.LastIndexByte(email, '@')
+
+		resp, err := satellite.Inspector.OverlayEndpoint.GetOperatorContact(ctx, &internalpb.GetOperatorContactRequest{NodeId: node.ID()})
+		require.NoError(t, err)
+		require.True(t, resp.EmailRedacted)
+		require.Equal(t, email[:1]+strings.Repeat("*", at-1)+email[at:], resp.Email)
+		require.Equal(t, node.Config.Operator.Wallet, resp.Wallet)
+
+		revealing := inspector.NewOverlayEndpoint(zaptest.NewLogger(t),
+			satellite.Overlay.Service, satellite.DB.StoragenodeAccounting(), satellite.Reputation.Service,
+			satellite.DB.GracefulExit(), satellite.Config.GracefulExit,
+			satellite.DB.Containment(), satellite.DB.AuditFailures(), satellite.Config.Audit,
+			satellite.DB.RepairNodeStats(), satellite.DB.RetainFilters(),
+			version.Info{}, inspector.Config{RevealOperatorEmail: true})
+
+		resp, err = revealing.GetOperatorContact(ctx, &internalpb.GetOperatorContactRequest{NodeId: node.ID()})
+		require.NoError(t, err)
+		require.False(t, resp.EmailRedacted)
+		require.Equal(t, email, resp.Email)
+
+		_, err = revealing.GetOperatorContact(ctx, &internalpb.GetOperatorContactRequest{NodeId: testrand.NodeID()})
+		require.Error(t, err)
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+}
+
+func TestNodesWithRecentWalletChange(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		changeWallet := func(nodeID storj.NodeID, wallet string, at time.Time) {
+			dossier, err := satellite.Overlay.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+
+			operator := dossier.Operator
+			operator.Wallet = wallet
+
+			require.NoError(t, satellite.Overlay.Service.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:     nodeID,
+				Address:    dossier.Address,
+				LastNet:    dossier.LastNet,
+				LastIPPort: dossier.LastIPPort,
+				IsUp:       true,
+				Operator:   &operator,
+				Capacity:   &dossier.Capacity,
+				Version:    &dossier.Version,
+			}, at))
+		}
+
+		first, second := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, first)
+		require.NoError(t, err)
+		originalWallet := dossier.Operator.Wallet
+
+		now := time.Now()
+		changeWallet(first, "0x0000000000000000000000000000000000000001", now.Add(-10*24*time.Hour))
+		changeWallet(first, "0x0000000000000000000000000000000000000002", now.Add(-time.Hour))
+		changeWallet(second, "0x0000000000000000000000000000000000000003", now.Add(-2*time.Hour))
+
+		resp, err := endpoint.NodesWithRecentWalletChange(ctx, &internalpb.NodesWithRecentWalletChangeRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Changes, 2)
+		require.Equal(t, first, resp.Changes[0].NodeId)
+		require.Equal(t, "0x0000000000000000000000000000000000000001", resp.Changes[0].OldWallet)
+		require.Equal(t, "0x0000000000000000000000000000000000000002", resp.Changes[0].NewWallet)
+		require.WithinDuration(t, now.Add(-time.Hour), resp.Changes[0].ChangedAt, time.Second)
+		require.Equal(t, second, resp.Changes[1].NodeId)
+		require.Equal(t, "0x0000000000000000000000000000000000000003", resp.Changes[1].NewWallet)
+
+		resp, err = endpoint.NodesWithRecentWalletChange(ctx, &internalpb.NodesWithRecentWalletChangeRequest{
+			Lookback: 30 * 24 * time.Hour,
+			Offset:   2,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Changes, 1)
+		require.Equal(t, first, resp.Changes[0].NodeId)
+		require.Equal(t, originalWallet, resp.Changes[0].OldWallet)
+
+		resp, err = endpoint.NodesWithRecentWalletChange(ctx, &internalpb.NodesWithRecentWalletChangeRequest{Limit: 1})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Changes, 1)
+		require.Equal(t, first, resp.Changes[0].NodeId)
+
+		_, err = endpoint.NodesWithRecentWalletChange(ctx, &internalpb.NodesWithRecentWalletChangeRequest{Lookback: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestChurnRate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		at := func(hours int) *time.Time {
+			t := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(hours) * time.Hour)
+			return &t
+		}
+
+		joined, left, exited := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID(), planet.StorageNodes[2].ID()
+		require.NoError(t, satellite.Overlay.DB.UpdateReputation(ctx, joined, overlay.ReputationUpdate{VettedAt: at(1)}))
+		require.NoError(t, satellite.Overlay.DB.UpdateReputation(ctx, left, overlay.ReputationUpdate{VettedAt: at(25)}))
+		require.NoError(t, satellite.Overlay.DB.UpdateReputation(ctx, exited, overlay.ReputationUpdate{}))
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, left, *at(49), overlay.DisqualificationReasonAuditFailure))
+		_, err := satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:              exited,
+			ExitInitiatedAt:     *at(30),
+			ExitLoopCompletedAt: *at(40),
+			ExitFinishedAt:      *at(50),
+			ExitSuccess:         true,
+		})
+		require.NoError(t, err)
+
+		counts := func(resp *internalpb.ChurnRateResponse) [][3]int64 {
+			var counts [][3]int64
+			for _, interval := range resp.Intervals {
+				counts = append(counts, [3]int64{interval.Joined, interval.Disqualified, interval.Exited})
+			}
+			return counts
+		}
+
+		resp, err := endpoint.ChurnRate(ctx, &internalpb.ChurnRateRequest{Start: *at(0), End: *at(72)})
+		require.NoError(t, err)
+		require.Equal(t, [][3]int64{{1, 0, 0}, {1, 0, 0}, {0, 1, 1}}, counts(resp))
+		require.Equal(t, *at(24), resp.Intervals[1].Start)
+
+		resp, err = endpoint.ChurnRate(ctx, &internalpb.ChurnRateRequest{
+			Interval: 7 * 24 * time.Hour,
+			Start:    *at(0),
+			End:      *at(7 * 24),
+		})
+		require.NoError(t, err)
+		require.Equal(t, [][3]int64{{2, 1, 1}}, counts(resp))
+
+		// the range ends before the node left
+		resp, err = endpoint.ChurnRate(ctx, &internalpb.ChurnRateRequest{Interval: time.Hour, Start: *at(24), End: *at(48)})
+		require.NoError(t, err)
+		require.Len(t, resp.Intervals, 24)
+		require.Equal(t, [3]int64{1, 0, 0}, counts(resp)[1])
+
+		_, err = endpoint.ChurnRate(ctx, &internalpb.ChurnRateRequest{Start: *at(72), End: *at(0)})
+		require.Error(t, err)
+		_, err = endpoint.ChurnRate(ctx, &internalpb.ChurnRateRequest{Interval: time.Second, Start: *at(0), End: *at(72)})
+		require.Error(t, err)
+		_, err = endpoint.ChurnRate(ctx, &internalpb.ChurnRateRequest{Interval: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestValidatePlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for i, country := range []string{"DE", "DE", "US", "DE", "DE"} {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, planet.StorageNodes[i].ID(), country))
+		}
+		for _, node := range planet.StorageNodes[:3] {
+			_, err := satellite.Overlay.DB.TestVetNode(ctx, node.ID())
+			require.NoError(t, err)
+		}
+		require.NoError(t, satellite.Overlay.DB.TestUnvetNode(ctx, planet.StorageNodes[3].ID()))
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, planet.StorageNodes[4].ID(), time.Now(), overlay.DisqualificationReasonUnknown))
+
+		resp, err := endpoint.ValidatePlacement(ctx, &internalpb.ValidatePlacementRequest{
+			Countries:     []string{"de"},
+			RequiredCount: 3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &internalpb.ValidatePlacementResponse{
+			Satisfiable:     true,
+			Binding:         internalpb.ValidatePlacementResponse_NONE,
+			EligibleNodes:   4,
+			InCountries:     3,
+			WithFreeDisk:    3,
+			Vetted:          3,
+			DistinctSubnets: 1, // every testplanet node is on the same subnet
+		}, resp)
+
+		for _, tt := range []struct {
+			request *internalpb.ValidatePlacementRequest
+			binding internalpb.ValidatePlacementResponse_Constraint
+		}{
+			{&internalpb.ValidatePlacementRequest{RequiredCount: 5}, internalpb.ValidatePlacementResponse_ELIGIBILITY},
+			{&internalpb.ValidatePlacementRequest{Countries: []string{"DE"}, RequiredCount: 4}, internalpb.ValidatePlacementResponse_COUNTRIES},
+			{&internalpb.ValidatePlacementRequest{MinimumFreeDisk: 1 << 60, RequiredCount: 1}, internalpb.ValidatePlacementResponse_FREE_DISK},
+			{&internalpb.ValidatePlacementRequest{Countries: []string{"DE"}, VettedOnly: true, RequiredCount: 3}, internalpb.ValidatePlacementResponse_VETTED},
+			{&internalpb.ValidatePlacementRequest{DistinctSubnets: true, RequiredCount: 2}, internalpb.ValidatePlacementResponse_SUBNETS},
+		} {
+			resp, err := endpoint.ValidatePlacement(ctx, tt.request)
+			require.NoError(t, err)
+			require.False(t, resp.Satisfiable, tt.binding.String())
+			require.Equal(t, tt.binding, resp.Binding)
+		}
+
+		_, err = endpoint.ValidatePlacement(ctx, &internalpb.ValidatePlacementRequest{})
+		require.Error(t, err)
+		_, err = endpoint.ValidatePlacement(ctx, &internalpb.ValidatePlacementRequest{Countries: []string{"DEU"}, RequiredCount: 1})
+		require.Error(t, err)
+	})
+}
+
+func TestNodesBelowMinVersion(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.MinimumVersion = "v1.2.0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		checkIn := func(nodeID storj.NodeID, version string, release bool, freeDisk int64, at time.Time) {
+			dossier, err := satellite.Overlay.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+
+			require.NoError(t, satellite.Overlay.DB.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:     nodeID,
+				Address:    dossier.Address,
+				LastNet:    dossier.LastNet,
+				LastIPPort: dossier.LastIPPort,
+				IsUp:       true,
+				Operator:   &dossier.Operator,
+				Capacity:   &pb.NodeCapacity{FreeDisk: freeDisk},
+				Version:    &pb.NodeVersion{Version: version, Release: release, Timestamp: time.Now()},
+			}, at, satellite.Config.Overlay.Node))
+		}
+
+		older, current := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		development, disqualified := planet.StorageNodes[2].ID(), planet.StorageNodes[3].ID()
+		checkIn(older, "v1.1.0", true, 1000, time.Now())
+		checkIn(current, "v1.3.0", true, 2000, time.Now())
+		checkIn(development, "v1.3.0", false, 3000, time.Now())
+		checkIn(disqualified, "v1.0.0", true, 4000, time.Now())
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, disqualified, time.Now(), overlay.DisqualificationReasonUnknown))
+
+		resp, err := endpoint.NodesBelowMinVersion(ctx, &internalpb.NodesBelowMinVersionRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "v1.2.0", resp.MinimumVersion)
+		require.EqualValues(t, 2, resp.TotalNodes)
+		require.EqualValues(t, 4000, resp.TotalFreeDisk)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 2)
+
+		require.Equal(t, development, resp.Nodes[0].NodeId)
+		require.Equal(t, "v1.3.0", resp.Nodes[0].Version)
+		require.False(t, resp.Nodes[0].Release)
+		require.EqualValues(t, 3000, resp.Nodes[0].FreeDisk)
+		require.Equal(t, older, resp.Nodes[1].NodeId)
+		require.Equal(t, "v1.1.0", resp.Nodes[1].Version)
+		require.True(t, resp.Nodes[1].Release)
+
+		resp, err = endpoint.NodesBelowMinVersion(ctx, &internalpb.NodesBelowMinVersionRequest{Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.TotalNodes)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, older, resp.Nodes[0].NodeId)
+
+		// nodes that haven't been in contact within the online window are left out when asked to
+		checkIn(older, "v1.1.0", true, 1000, time.Now().Add(-2*satellite.Config.Overlay.Node.OnlineWindow))
+
+		resp, err = endpoint.NodesBelowMinVersion(ctx, &internalpb.NodesBelowMinVersionRequest{OnlineOnly: true})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.TotalNodes)
+		require.Equal(t, development, resp.Nodes[0].NodeId)
+
+		_, err = endpoint.NodesBelowMinVersion(ctx, &internalpb.NodesBelowMinVersionRequest{Offset: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestGetReputationThresholds(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.OnlineWindow = 3 * time.Hour
+				config.Reputation.AuditDQ = 0.9
+				config.Reputation.AuditCount = 42
+				config.Reputation.AuditHistory.OfflineThreshold = 0.7
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		resp, err := satellite.Inspector.OverlayEndpoint.GetReputationThresholds(ctx, &internalpb.GetReputationThresholdsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 3*time.Hour, resp.OnlineWindow)
+		require.Equal(t, 0.9, resp.AuditDq)
+		require.Equal(t, satellite.Config.Reputation.UnknownAuditDQ, resp.UnknownAuditDq)
+		require.Equal(t, satellite.Config.Reputation.SuspensionGracePeriod, resp.SuspensionGracePeriod)
+		require.Equal(t, satellite.Config.Reputation.SuspensionDQEnabled, resp.SuspensionDqEnabled)
+		require.Equal(t, 0.7, resp.OfflineThreshold)
+		require.Equal(t, satellite.Config.Reputation.AuditHistory.TrackingPeriod, resp.TrackingPeriod)
+		require.Equal(t, int64(42), resp.VettingAuditCount)
+
+		require.NotNil(t, resp.Version)
+		require.Equal(t, "v0.0.1", resp.Version.Version)
+		require.Equal(t, "testplanet", resp.Version.CommitHash)
+		require.False(t, resp.Version.Release)
+	})
+}
+
+func TestStaleGeoNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		checkIn := func(nodeID storj.NodeID, countryCode location.CountryCode, geoRefresh bool, at time.Time) {
+			require.NoError(t, satellite.Overlay.DB.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:      nodeID,
+				Address:     &pb.NodeAddress{Address: "127.0.0.1:55555"},
+				LastNet:     "127.0.0",
+				LastIPPort:  "127.0.0.1:55555",
+				IsUp:        true,
+				Operator:    &pb.NodeOperator{},
+				Capacity:    &pb.NodeCapacity{},
+				Version:     &pb.NodeVersion{Version: "v1.0.0", Timestamp: time.Now()},
+				CountryCode: countryCode,
+				GeoRefresh:  geoRefresh,
+			}, at, satellite.Config.Overlay.Node))
+		}
+
+		// the country codes of the testplanet nodes were resolved when they checked in
+		older, old := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		disqualified := planet.StorageNodes[2].ID()
+		checkIn(older, location.Germany, true, time.Now().Add(-48*time.Hour))
+		checkIn(old, location.None, true, time.Now().Add(-24*time.Hour))
+		checkIn(disqualified, location.Germany, true, time.Now().Add(-48*time.Hour))
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, disqualified, time.Now(), overlay.DisqualificationReasonUnknown))
+
+		// check-ins that don't resolve the country code don't refresh it
+		checkIn(old, location.None, false, time.Now())
+
+		unresolved := testrand.NodeID()
+		checkIn(unresolved, location.None, false, time.Now())
+
+		resp, err := endpoint.StaleGeoNodes(ctx, &internalpb.StaleGeoNodesRequest{StaleAfter: 12 * time.Hour})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.TotalNodes)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 3)
+
+		require.Equal(t, unresolved, resp.Nodes[0].NodeId)
+		require.True(t, resp.Nodes[0].GeoRefreshedAt.IsZero())
+		require.Empty(t, resp.Nodes[0].CountryCode)
+		require.Equal(t, older, resp.Nodes[1].NodeId)
+		require.Equal(t, "DE", resp.Nodes[1].CountryCode)
+		require.WithinDuration(t, time.Now().Add(-48*time.Hour), resp.Nodes[1].GeoRefreshedAt, time.Minute)
+		require.Equal(t, old, resp.Nodes[2].NodeId)
+		require.WithinDuration(t, time.Now().Add(-24*time.Hour), resp.Nodes[2].GeoRefreshedAt, time.Minute)
+		require.WithinDuration(t, time.Now(), resp.Nodes[2].LastContactSuccess, time.Minute)
+
+		resp, err = endpoint.StaleGeoNodes(ctx, &internalpb.StaleGeoNodesRequest{StaleAfter: 12 * time.Hour, Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.TotalNodes)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, older, resp.Nodes[0].NodeId)
+
+		// the configured window applies when the request doesn't specify one
+		resp, err = endpoint.StaleGeoNodes(ctx, &internalpb.StaleGeoNodesRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.TotalNodes)
+		require.Equal(t, unresolved, resp.Nodes[0].NodeId)
+
+		_, err = endpoint.StaleGeoNodes(ctx, &internalpb.StaleGeoNodesRequest{StaleAfter: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestNodeFreeSpaceTrend(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		rollups := accounting.RollupStats{}
+
+		// bytes stored during the last three days and free bytes of every node, the third node only has a single
+		// rollup and the last none, so neither has a trend
+		usage := []struct {
+			stored []int64
+			free   int64
+		}{
+			{stored: []int64{1000, 2000, 3000}, free: 5000},
+			{stored: []int64{3000, 2000, 1000}, free: 50000},
+			{stored: []int64{1000}, free: 20000},
+			{free: 1e9},
+		}
+		for i, node := range planet.StorageNodes {
+			_, err := satellite.Overlay.Service.UpdateNodeInfo(ctx, node.ID(), &overlay.InfoResponse{
+				Capacity: &pb.NodeCapacity{FreeDisk: usage[i].free},
+			})
+			require.NoError(t, err)
+
+			for k, stored := range usage[i].stored {
+				day := today.Add(time.Duration(k-len(usage[i].stored)) * 24 * time.Hour)
+				if rollups[day] == nil {
+					rollups[day] = make(map[storj.NodeID]*accounting.Rollup)
+				}
+				rollups[day][node.ID()] = &accounting.Rollup{
+					NodeID:          node.ID(),
+					StartTime:       day,
+					IntervalEndTime: day.Add(24 * time.Hour),
+					AtRestTotal:     float64(stored) * 24,
+				}
+			}
+		}
+		require.NoError(t, satellite.DB.StoragenodeAccounting().SaveRollup(ctx, today, rollups))
+
+		resp, err := endpoint.NodeFreeSpaceTrend(ctx, &internalpb.NodeFreeSpaceTrendRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 2)
+		require.False(t, resp.More)
+		require.EqualValues(t, 2, resp.TotalNodes)
+
+		filling := resp.Nodes[0]
+		require.Equal(t, planet.StorageNodes[0].ID(), filling.NodeId)
+		require.EqualValues(t, -1000, filling.FreeChangePerDay)
+		require.EqualValues(t, 3000, filling.StoredBytes)
+		require.EqualValues(t, 5000, filling.FreeBytes)
+		require.EqualValues(t, 5, filling.DaysUntilFull)
+		require.EqualValues(t, 3, filling.Rollups)
+
+		draining := resp.Nodes[1]
+		require.Equal(t, planet.StorageNodes[1].ID(), draining.NodeId)
+		require.EqualValues(t, 1000, draining.FreeChangePerDay)
+		require.Zero(t, draining.DaysUntilFull)
+
+		// the nodes filling and draining at the same rate cancel each other out
+		require.EqualValues(t, 55000, resp.NetworkFreeBytes)
+		require.Zero(t, resp.NetworkFreeChangePerDay)
+		require.Zero(t, resp.NetworkDaysUntilFull)
+
+		page, err := endpoint.NodeFreeSpaceTrend(ctx, &internalpb.NodeFreeSpaceTrendRequest{Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.Equal(t, resp.Nodes[1:], page.Nodes)
+		require.False(t, page.More)
+		require.EqualValues(t, 2, page.TotalNodes)
+
+		// no rollup started within the last day
+		recent, err := endpoint.NodeFreeSpaceTrend(ctx, &internalpb.NodeFreeSpaceTrendRequest{Window: 24 * time.Hour})
+		require.NoError(t, err)
+		require.Empty(t, recent.Nodes)
+
+		_, err = endpoint.NodeFreeSpaceTrend(ctx, &internalpb.NodeFreeSpaceTrendRequest{Window: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestPlacementSelectionCounts(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		response, err := endpoint.PlacementSelectionCounts(ctx, &internalpb.PlacementSelectionCountsRequest{})
+		require.NoError(t, err)
+		require.Empty(t, response.Placements)
+		require.True(t, response.CountedSince.IsZero())
+
+		for i := 0; i < 2; i++ {
+			nodes, err := satellite.Overlay.Service.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: 3,
+				Placement:      storj.EveryCountry,
+			})
+			require.NoError(t, err)
+			require.Len(t, nodes, 3)
+		}
+
+		// none of the nodes is in the EU
+		_, _ = satellite.Overlay.Service.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 3,
+			Placement:      storj.EU,
+		})
+
+		response, err = endpoint.PlacementSelectionCounts(ctx, &internalpb.PlacementSelectionCountsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []*internalpb.PlacementSelectionCount{
+			{Placement: uint32(storj.EveryCountry), Selections: 2, SelectedNodes: 6},
+			{Placement: uint32(storj.EU), Selections: 1, Shortfalls: 1},
+		}, response.Placements)
+		require.EqualValues(t, 6, response.TotalSelectedNodes)
+		require.False(t, response.CountedSince.After(time.Now()))
+
+		_, err = endpoint.PlacementSelectionCounts(ctx, &internalpb.PlacementSelectionCountsRequest{Window: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestReinstateNode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		suspended, disqualified := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, node.ID(), overlay.ReputationStatus{}, reputation.AuditSuccess))
+		}
+		require.NoError(t, satellite.Reputation.Service.TestFlushAllNodeInfo(ctx))
+
+		request := func(nodeID storj.NodeID) *internalpb.ReinstateNodeRequest {
+			return &internalpb.ReinstateNodeRequest{NodeId: nodeID, Operator: "support", Reason: "suspended by a satellite bug"}
+		}
+
+		// nodes without suspensions have nothing to lift
+		_, err := endpoint.ReinstateNode(ctx, request(suspended))
+		require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+
+		require.NoError(t, satellite.Reputation.Service.TestSuspendNodeUnknownAudit(ctx, suspended, time.Now()))
+
+		_, err = endpoint.ReinstateNode(ctx, &internalpb.ReinstateNodeRequest{NodeId: suspended, Reason: "suspended by a satellite bug"})
+		require.Error(t, err)
+		_, err = endpoint.ReinstateNode(ctx, &internalpb.ReinstateNodeRequest{NodeId: suspended, Operator: "support"})
+		require.Error(t, err)
+
+		// only the offline suspension is requested to be lifted, which the node doesn't have
+		offline := request(suspended)
+		offline.Offline = true
+		_, err = endpoint.ReinstateNode(ctx, offline)
+		require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+
+		resp, err := endpoint.ReinstateNode(ctx, request(suspended))
+		require.NoError(t, err)
+		require.False(t, resp.Status.UnknownAuditSuspended)
+		require.False(t, resp.Status.Disqualified)
+		require.EqualValues(t, 1, resp.After.UnknownAuditScore)
+
+		info, err := satellite.Reputation.Service.Get(ctx, suspended)
+		require.NoError(t, err)
+		require.Nil(t, info.UnknownAuditSuspended)
+
+		node, err := satellite.Overlay.Service.Get(ctx, suspended)
+		require.NoError(t, err)
+		require.Nil(t, node.UnknownAuditSuspended)
+
+		// disqualifications are final, even when the node is suspended as well
+		require.NoError(t, satellite.Reputation.Service.TestSuspendNodeUnknownAudit(ctx, disqualified, time.Now()))
+		require.NoError(t, satellite.Reputation.Service.TestDisqualifyNode(ctx, disqualified, overlay.DisqualificationReasonAuditFailure))
+
+		_, err = endpoint.ReinstateNode(ctx, request(disqualified))
+		require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+
+		node, err = satellite.Overlay.Service.Get(ctx, disqualified)
+		require.NoError(t, err)
+		require.NotNil(t, node.Disqualified)
+
+		_, err = endpoint.ReinstateNode(ctx, request(testrand.NodeID()))
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+}
+
+func TestCapacityByCountry(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.UploadExcludedCountryCodes = []string{"US"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for i, country := range []string{"DE", "DE", "US", "", "DE"} {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, planet.StorageNodes[i].ID(), country))
+		}
+		_, err := satellite.Overlay.DB.TestVetNode(ctx, planet.StorageNodes[0].ID())
+		require.NoError(t, err)
+		require.NoError(t, satellite.Overlay.DB.TestUnvetNode(ctx, planet.StorageNodes[1].ID()))
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, planet.StorageNodes[4].ID(), time.Now(), overlay.DisqualificationReasonUnknown))
+
+		freeDisk := func(indexes ...int) (total int64) {
+			for _, i := range indexes {
+				node, err := satellite.Overlay.Service.Get(ctx, planet.StorageNodes[i].ID())
+				require.NoError(t, err)
+				total += node.Capacity.FreeDisk
+			}
+			return total
+		}
+
+		resp, err := endpoint.CapacityByCountry(ctx, &internalpb.CapacityByCountryRequest{})
+		require.NoError(t, err)
+
+		countries := map[string]*internalpb.CountryCapacity{}
+		for _, country := range resp.Countries {
+			countries[country.CountryCode] = country
+		}
+		require.Len(t, countries, 2)
+
+		// the disqualified node isn't counted
+		require.EqualValues(t, 2, countries["DE"].Nodes)
+		require.EqualValues(t, 1, countries["DE"].VettedNodes)
+		require.Equal(t, freeDisk(0, 1), countries["DE"].FreeDisk)
+		require.False(t, countries["DE"].UploadExcluded)
+
+		require.EqualValues(t, 1, countries["US"].Nodes)
+		require.Equal(t, freeDisk(2), countries["US"].FreeDisk)
+		require.True(t, countries["US"].UploadExcluded)
+
+		require.Empty(t, resp.Unknown.CountryCode)
+		require.EqualValues(t, 1, resp.Unknown.Nodes)
+		require.Equal(t, freeDisk(3), resp.Unknown.FreeDisk)
+
+		require.EqualValues(t, 4, resp.TotalNodes)
+		require.Equal(t, freeDisk(0, 1, 2, 3), resp.TotalFreeDisk)
+	})
+}
+
+func TestRecentAuditFailures(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		segments := make([]audit.Segment, 3)
+		for i := range segments {
+			segments[i] = audit.Segment{
+				StreamID: testrand.UUID(),
+				Position: metabase.SegmentPosition{Part: 1, Index: uint32(i)},
+			}
+		}
+
+		fail := func(segment audit.Segment, nodes ...int) {
+			report := audit.Report{FailedSegments: map[storj.NodeID]audit.Segment{}}
+			for _, i := range nodes {
+				report.Fails = append(report.Fails, planet.StorageNodes[i].ID())
+				report.FailedSegments[planet.StorageNodes[i].ID()] = segment
+			}
+			_, err := satellite.Audit.Reporter.RecordAudits(ctx, report)
+			require.NoError(t, err)
+		}
+
+		fail(segments[0], 0, 1, 2)
+		fail(segments[1], 0, 1, 2)
+		fail(segments[2], 0, 2)
+		// failing the same segment again doesn't count as another failure
+		fail(segments[1], 1)
+
+		// passing an audit forgets the failures
+		_, err := satellite.Audit.Reporter.RecordAudits(ctx, audit.Report{
+			Successes: storj.NodeIDList{planet.StorageNodes[2].ID()},
+		})
+		require.NoError(t, err)
+
+		resp, err := endpoint.RecentAuditFailures(ctx, &internalpb.RecentAuditFailuresRequest{Audits: 2})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 2)
+
+		require.Equal(t, planet.StorageNodes[0].ID(), resp.Nodes[0].NodeId)
+		require.EqualValues(t, 3, resp.Nodes[0].Failures)
+		require.Len(t, resp.Nodes[0].Recent, 2)
+		require.Equal(t, segments[2].StreamID[:], resp.Nodes[0].Recent[0].StreamId)
+		require.EqualValues(t, segments[2].Position.Encode(), resp.Nodes[0].Recent[0].Position)
+		require.False(t, resp.Nodes[0].Recent[0].FailedAt.Before(resp.Nodes[0].Recent[1].FailedAt))
+
+		require.Equal(t, planet.StorageNodes[1].ID(), resp.Nodes[1].NodeId)
+		require.EqualValues(t, 2, resp.Nodes[1].Failures)
+		require.Len(t, resp.Nodes[1].Recent, 2)
+
+		// nodes with fewer failures than requested aren't listed
+		resp, err = endpoint.RecentAuditFailures(ctx, &internalpb.RecentAuditFailuresRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, planet.StorageNodes[0].ID(), resp.Nodes[0].NodeId)
+		require.Len(t, resp.Nodes[0].Recent, 3)
+
+		// pages continue after the last node listed
+		resp, err = endpoint.RecentAuditFailures(ctx, &internalpb.RecentAuditFailuresRequest{Audits: 2, Limit: 1})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, planet.StorageNodes[0].ID(), resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.RecentAuditFailures(ctx, &internalpb.RecentAuditFailuresRequest{
+			Audits:             2,
+			StartAfterFailures: resp.Nodes[0].Failures,
+			StartAfter:         resp.Nodes[0].NodeId,
+			Limit:              1,
+		})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, planet.StorageNodes[1].ID(), resp.Nodes[0].NodeId)
+
+		_, err = endpoint.RecentAuditFailures(ctx, &internalpb.RecentAuditFailuresRequest{Audits: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestClockSkewNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		// the clocks of the nodes uploaded to are accurate
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(10*memory.KiB)))
+
+		response, err := endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, response.Nodes)
+
+		response, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{Threshold: time.Nanosecond})
+		require.NoError(t, err)
+		require.Empty(t, response.Nodes)
+
+		// one node signed an hour ahead of the commit, the other ten minutes before the order limits were created
+		now := time.Now()
+		ahead, behind := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		satellite.Overlay.Service.RecordClockSkews([]overlay.SignedTimestamp{
+			{NodeID: ahead, SignedAt: now.Add(time.Hour), Earliest: now.Add(-time.Minute)},
+			{NodeID: behind, SignedAt: now.Add(-11 * time.Minute), Earliest: now.Add(-time.Minute)},
+		}, now)
+
+		response, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []*internalpb.NodeClockSkew{
+			{NodeId: ahead, Skew: time.Hour, MeasuredAt: now},
+			{NodeId: behind, Skew: -10 * time.Minute, MeasuredAt: now},
+		}, response.Nodes)
+		require.False(t, response.More)
+
+		response, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{Threshold: 30 * time.Minute})
+		require.NoError(t, err)
+		require.Len(t, response.Nodes, 1)
+		require.Equal(t, ahead, response.Nodes[0].NodeId)
+
+		response, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, response.Nodes, 1)
+		require.True(t, response.More)
+
+		response, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, response.Nodes, 1)
+		require.Equal(t, behind, response.Nodes[0].NodeId)
+		require.False(t, response.More)
+
+		// a later accurate measurement replaces the skew
+		satellite.Overlay.Service.RecordClockSkews([]overlay.SignedTimestamp{{NodeID: ahead, SignedAt: now, Earliest: now.Add(-time.Minute)}}, now)
+		response, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Nodes, 1)
+		require.Equal(t, behind, response.Nodes[0].NodeId)
+
+		_, err = endpoint.ClockSkewNodes(ctx, &internalpb.ClockSkewNodesRequest{Threshold: -time.Minute})
+		require.Error(t, err)
+	})
+}
+
+func TestCheckExitEligibility(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		eligible, disqualified := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		exiting, exited := planet.StorageNodes[2].ID(), planet.StorageNodes[3].ID()
+		now := time.Now()
+
+		check := func(nodeID storj.NodeID) *internalpb.CheckExitEligibilityResponse {
+			resp, err := endpoint.CheckExitEligibility(ctx, &internalpb.CheckExitEligibilityRequest{NodeId: nodeID})
+			require.NoError(t, err)
+			return resp
+		}
+
+		resp := check(eligible)
+		require.True(t, resp.Eligible)
+		require.Equal(t, internalpb.CheckExitEligibilityResponse_ELIGIBLE, resp.Reason)
+		require.False(t, resp.Suspended)
+		require.Equal(t, resp.JoinedAt, resp.OldEnoughAt)
+
+		// suspended nodes may still exit
+		require.NoError(t, satellite.Reputation.Service.TestSuspendNodeUnknownAudit(ctx, eligible, now))
+		resp = check(eligible)
+		require.True(t, resp.Eligible)
+		require.True(t, resp.Suspended)
+
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, disqualified, now, overlay.DisqualificationReasonAuditFailure))
+		resp = check(disqualified)
+		require.False(t, resp.Eligible)
+		require.Equal(t, internalpb.CheckExitEligibilityResponse_DISQUALIFIED, resp.Reason)
+
+		_, err := satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          exiting,
+			ExitInitiatedAt: now,
+		})
+		require.NoError(t, err)
+		require.Equal(t, internalpb.CheckExitEligibilityResponse_EXIT_STARTED, check(exiting).Reason)
+
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          exited,
+			ExitInitiatedAt: now.Add(-time.Hour),
+			ExitFinishedAt:  now,
+			ExitSuccess:     true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, internalpb.CheckExitEligibilityResponse_EXIT_FINISHED, check(exited).Reason)
+
+		_, err = endpoint.CheckExitEligibility(ctx, &internalpb.CheckExitEligibilityRequest{NodeId: testrand.NodeID()})
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+	})
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.GracefulExit.NodeMinAgeInMonths = 6
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		resp, err := planet.Satellites[0].Inspector.OverlayEndpoint.CheckExitEligibility(ctx, &internalpb.CheckExitEligibilityRequest{
+			NodeId: planet.StorageNodes[0].ID(),
+		})
+		require.NoError(t, err)
+		require.False(t, resp.Eligible)
+		require.Equal(t, internalpb.CheckExitEligibilityResponse_TOO_NEW, resp.Reason)
+		require.EqualValues(t, 6, resp.MonthsRequired)
+		require.Equal(t, resp.JoinedAt.AddDate(0, 6, 0), resp.OldEnoughAt)
+	})
+}
+
+func TestNodeRepairStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		stats := satellite.DB.RepairNodeStats()
+
+		failing, destination := testrand.NodeID(), testrand.NodeID()
+		now := time.Now()
+
+		// a node listed more than once has every piece counted
+		require.NoError(t, stats.Record(ctx, now, storj.NodeIDList{failing, failing}, storj.NodeIDList{destination}))
+		require.NoError(t, stats.Record(ctx, now, storj.NodeIDList{failing}, storj.NodeIDList{destination, failing}))
+		require.NoError(t, stats.Record(ctx, now.Add(-48*time.Hour), storj.NodeIDList{failing}, nil))
+
+		resp, err := endpoint.NodeRepairStats(ctx, &internalpb.NodeRepairStatsRequest{NodeId: failing})
+		require.NoError(t, err)
+		require.Equal(t, failing, resp.NodeId)
+		require.EqualValues(t, 4, resp.PiecesRemoved)
+		require.EqualValues(t, 1, resp.PiecesAdded)
+
+		resp, err = endpoint.NodeRepairStats(ctx, &internalpb.NodeRepairStatsRequest{NodeId: failing, Window: 24 * time.Hour})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.PiecesRemoved)
+		require.Equal(t, now.Add(-24*time.Hour).UTC().Truncate(time.Hour), resp.Since)
+
+		resp, err = endpoint.NodeRepairStats(ctx, &internalpb.NodeRepairStatsRequest{NodeId: destination})
+		require.NoError(t, err)
+		require.Zero(t, resp.PiecesRemoved)
+		require.EqualValues(t, 2, resp.PiecesAdded)
+
+		// nodes repair never touched have nothing to count
+		resp, err = endpoint.NodeRepairStats(ctx, &internalpb.NodeRepairStatsRequest{NodeId: testrand.NodeID()})
+		require.NoError(t, err)
+		require.Zero(t, resp.PiecesRemoved)
+		require.Zero(t, resp.PiecesAdded)
+
+		_, err = endpoint.NodeRepairStats(ctx, &internalpb.NodeRepairStatsRequest{NodeId: failing, Window: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestTrustingNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		checkIn := func(nodeID storj.NodeID, at time.Time) {
+			require.NoError(t, satellite.Overlay.DB.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:     nodeID,
+				Address:    &pb.NodeAddress{Address: "127.0.0.1:55555"},
+				LastNet:    "127.0.0",
+				LastIPPort: "127.0.0.1:55555",
+				IsUp:       true,
+				Operator:   &pb.NodeOperator{},
+				Capacity:   &pb.NodeCapacity{},
+				Version:    &pb.NodeVersion{Version: "v1.0.0", Timestamp: time.Now()},
+			}, at, satellite.Config.Overlay.Node))
+		}
+
+		now := time.Now()
+		stale, exited := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+		checkIn(stale, now.Add(-48*time.Hour))
+
+		_, err := satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:              exited,
+			ExitInitiatedAt:     now.Add(-3 * time.Hour),
+			ExitLoopCompletedAt: now.Add(-2 * time.Hour),
+			ExitFinishedAt:      now.Add(-time.Hour),
+			ExitSuccess:         true,
+		})
+		require.NoError(t, err)
+
+		latest := testrand.NodeID()
+		checkIn(latest, now.Add(time.Minute))
+
+		resp, err := endpoint.TrustingNodes(ctx, &internalpb.TrustingNodesRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, resp.TotalNodes)
+		require.EqualValues(t, 3, resp.TrustingNodes)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 3)
+		require.Equal(t, latest, resp.Nodes[0].NodeId)
+		require.WithinDuration(t, now.Add(time.Minute), resp.LatestCheckIn, time.Second)
+		require.WithinDuration(t, now.Add(-satellite.Config.Inspector.TrustWindow), resp.Since, time.Minute)
+		for _, node := range resp.Nodes {
+			require.NotEqual(t, stale, node.NodeId)
+			require.NotEqual(t, exited, node.NodeId)
+		}
+
+		resp, err = endpoint.TrustingNodes(ctx, &internalpb.TrustingNodesRequest{Window: 72 * time.Hour, Limit: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, resp.TrustingNodes)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, latest, resp.Nodes[0].NodeId)
+
+		{ // paginate
+			var listed storj.NodeIDList
+			for page := resp; ; {
+				for _, node := range page.Nodes {
+					require.False(t, listed.Contains(node.NodeId))
+					listed = append(listed, node.NodeId)
+				}
+				if !page.More {
+					break
+				}
+
+				last := page.Nodes[len(page.Nodes)-1]
+				page, err = endpoint.TrustingNodes(ctx, &internalpb.TrustingNodesRequest{
+					Window:            72 * time.Hour,
+					StartAfterCheckIn: last.LastContactSuccess,
+					StartAfter:        last.NodeId,
+					Limit:             1,
+				})
+				require.NoError(t, err)
+			}
+			require.Len(t, listed, 4)
+			require.Contains(t, listed, stale)
+		}
+
+		_, err = endpoint.TrustingNodes(ctx, &internalpb.TrustingNodesRequest{Window: -time.Hour})
+		require.Error(t, err)
+	})
+}
+
+func TestSubnetSaturationStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+
+		require.NoError(t, satellite.Overlay.DB.DisqualifyNode(ctx, planet.StorageNodes[3].ID(), time.Now(), overlay.DisqualificationReasonUnknown))
+
+		var freeDisk, largestFree int64
+		for _, node := range planet.StorageNodes[:3] {
+			dossier, err := satellite.Overlay.DB.Get(ctx, node.ID())
+			require.NoError(t, err)
+			freeDisk += dossier.Capacity.FreeDisk
+			if dossier.Capacity.FreeDisk > largestFree {
+				largestFree = dossier.Capacity.FreeDisk
+			}
+		}
+
+		resp, err := endpoint.SubnetSaturationStats(ctx, &internalpb.SubnetSaturationStatsRequest{RequiredCount: 2})
+		require.NoError(t, err)
+		require.Equal(t, &internalpb.SubnetSaturationStatsResponse{
+			EligibleNodes:            3,
+			DistinctSubnets:          1, // every testplanet node is on the same subnet
+			SaturatedSubnets:         1,
+			LargestSubnet:            3,
+			ExcludedNodes:            2,
+			ExcludedFreeDisk:         freeDisk - largestFree,
+			Selectable:               1,
+			SelectableWithoutSubnets: 2,
+			DistinctIp:               satellite.Config.Overlay.Node.DistinctIP,
+		}, resp)
+
+		resp, err = endpoint.SubnetSaturationStats(ctx, &internalpb.SubnetSaturationStatsRequest{RequiredCount: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.Selectable)
+		require.EqualValues(t, 3, resp.SelectableWithoutSubnets)
+
+		_, err = endpoint.SubnetSaturationStats(ctx, &internalpb.SubnetSaturationStatsRequest{})
+		require.Error(t, err)
+		_, err = endpoint.SubnetSaturationStats(ctx, &internalpb.SubnetSaturationStatsRequest{RequiredCount: 1, Placement: -1})
+		require.Error(t, err)
+	})
+}
+
+func TestPendingGCStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		stats := satellite.DB.RepairNodeStats()
+
+		nodeID := testrand.NodeID()
+		now := time.Now()
+
+		require.NoError(t, stats.Record(ctx, now.Add(-72*time.Hour), storj.NodeIDList{nodeID}, nil))
+		require.NoError(t, stats.Record(ctx, now, storj.NodeIDList{nodeID, nodeID}, nil))
+
+		// nodes that weren't sent a filter have every piece removed within the window pending
+		resp, err := endpoint.PendingGCStats(ctx, &internalpb.PendingGCStatsRequest{NodeId: nodeID})
+		require.NoError(t, err)
+		require.Equal(t, nodeID, resp.NodeId)
+		require.False(t, resp.FilterSent)
+		require.True(t, resp.LastSentAt.IsZero())
+		require.EqualValues(t, 3, resp.PendingPieces)
+		require.Equal(t, now.Add(-satellite.Config.Inspector.PendingGCWindow).UTC().Truncate(time.Hour), resp.PendingSince)
+
+		// the pieces removed before the filter was created are collected by it
+		createdAt := now.Add(-24 * time.Hour)
+		require.NoError(t, satellite.DB.RetainFilters().Record(ctx, sender.SentFilter{
+			NodeID:       nodeID,
+			SentAt:       now.Add(-time.Hour),
+			CreationDate: createdAt,
+			PieceCount:   100,
+		}))
+
+		resp, err = endpoint.PendingGCStats(ctx, &internalpb.PendingGCStatsRequest{NodeId: nodeID})
+		require.NoError(t, err)
+		require.True(t, resp.FilterSent)
+		require.WithinDuration(t, now.Add(-time.Hour), resp.LastSentAt, time.Second)
+		require.WithinDuration(t, createdAt, resp.FilterCreatedAt, time.Second)
+		require.EqualValues(t, 100, resp.PiecesRetained)
+		require.EqualValues(t, 2, resp.PendingPieces)
+		require.Equal(t, createdAt.UTC().Truncate(time.Hour), resp.PendingSince)
+
+		_, err = satellite.DB.RetainFilters().Get(ctx, testrand.NodeID())
+		require.True(t, sender.ErrFilterNotSent.Has(err))
+	})
+}
+
+func TestAuditVsTrafficAnomaly(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Inspector.OverlayEndpoint
+		stats := satellite.DB.RepairNodeStats()
+
+		// none of the nodes were audited, so they all have the initial audit score of 1
+		failing, flaky, healthy, quiet := testrand.NodeID(), testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+		now := time.Now()
+
+		record := func(at time.Time, nodeID storj.NodeID, succeeded, failed int) {
+			var succeededNodes, failedNodes storj.NodeIDList
+			for i := 0; i < succeeded; i++ {
+				succeededNodes = append(succeededNodes, nodeID)
+			}
+			for i := 0; i < failed; i++ {
+				failedNodes = append(failedNodes, nodeID)
+			}
+			require.NoError(t, stats.RecordDownloads(ctx, at, succeededNodes, failedNodes))
+		}
+		record(now, failing, 10, 30)
+		record(now, flaky, 15, 5)
+		record(now, healthy, 40, 0)
+		record(now.Add(-48*time.Hour), healthy, 0, 40)
+		record(now, quiet, 1, 5)
+
+		resp, err := endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		// the quiet node has too few downloads to be compared
+		require.EqualValues(t, 3, resp.NodesChecked)
+		require.Len(t, resp.Nodes, 3)
+
+		require.Equal(t, healthy, resp.Nodes[0].NodeId)
+		require.InDelta(t, 0.5, resp.Nodes[0].Divergence, 1e-9)
+		require.EqualValues(t, 40, resp.Nodes[0].DownloadsSucceeded)
+		require.EqualValues(t, 40, resp.Nodes[0].DownloadsFailed)
+
+		require.Equal(t, failing, resp.Nodes[1].NodeId)
+		require.InDelta(t, 1, resp.Nodes[1].AuditScore, 1e-9)
+		require.InDelta(t, 0.25, resp.Nodes[1].DownloadSuccessRate, 1e-9)
+		require.InDelta(t, 0.75, resp.Nodes[1].Divergence, 1e-9)
+
+		require.Equal(t, flaky, resp.Nodes[2].NodeId)
+		require.InDelta(t, 0.25, resp.Nodes[2].Divergence, 1e-9)
+
+		// the failed downloads of the healthy node fall out of a shorter window
+		resp, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Window: 24 * time.Hour})
+		require.NoError(t, err)
+		require.Equal(t, now.Add(-24*time.Hour).UTC().Truncate(time.Hour), resp.Since)
+		require.EqualValues(t, 3, resp.NodesChecked)
+		require.Len(t, resp.Nodes, 2)
+		require.Equal(t, failing, resp.Nodes[0].NodeId)
+		require.Equal(t, flaky, resp.Nodes[1].NodeId)
+
+		resp, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Window: 24 * time.Hour, MinDivergence: 0.5})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, failing, resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Window: 24 * time.Hour, MinDownloads: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, resp.NodesChecked)
+		require.Len(t, resp.Nodes, 3)
+		require.Equal(t, quiet, resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Limit: 1})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, healthy, resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Limit: 1, Offset: 1})
+		require.NoError(t, err)
+		require.True(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, failing, resp.Nodes[0].NodeId)
+
+		resp, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Offset: 2})
+		require.NoError(t, err)
+		require.False(t, resp.More)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, flaky, resp.Nodes[0].NodeId)
+
+		_, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Window: -time.Hour})
+		require.Error(t, err)
+		_, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{MinDivergence: 2})
+		require.Error(t, err)
+		_, err = endpoint.AuditVsTrafficAnomaly(ctx, &internalpb.AuditVsTrafficAnomalyRequest{Offset: -1})
+		require.Error(t, err)
+	})
+}
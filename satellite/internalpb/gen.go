@@ -68,7 +68,7 @@ func main() {
 			commonPb = "../../../common/pb"
 		}
 
-		overrideImports := ",Mgoogle/protobuf/timestamp.proto=storj.io/storj/satellite/internalpb"
+		overrideImports := ",Mgoogle/protobuf/timestamp.proto=storj.io/storj/satellite/internalpb,Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types"
 		args := []string{
 			"--lint_out=.",
 			"--gogo_out=paths=source_relative" + overrideImports + ":.",
@@ -6,8 +6,10 @@ package internalpb
 import (
 	fmt "fmt"
 	math "math"
+	time "time"
 
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 
 	pb "storj.io/common/pb"
 )
@@ -16,6 +18,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +26,275 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type UnhealthyPiece_Failure int32
+
+const (
+	UnhealthyPiece_MISSING                 UnhealthyPiece_Failure = 0
+	UnhealthyPiece_DISQUALIFIED            UnhealthyPiece_Failure = 1
+	UnhealthyPiece_EXITED                  UnhealthyPiece_Failure = 2
+	UnhealthyPiece_UNKNOWN_AUDIT_SUSPENDED UnhealthyPiece_Failure = 3
+	UnhealthyPiece_OFFLINE_SUSPENDED       UnhealthyPiece_Failure = 4
+	UnhealthyPiece_OFFLINE                 UnhealthyPiece_Failure = 5
+)
+
+var UnhealthyPiece_Failure_name = map[int32]string{
+	0: "MISSING",
+	1: "DISQUALIFIED",
+	2: "EXITED",
+	3: "UNKNOWN_AUDIT_SUSPENDED",
+	4: "OFFLINE_SUSPENDED",
+	5: "OFFLINE",
+}
+
+var UnhealthyPiece_Failure_value = map[string]int32{
+	"MISSING":                 0,
+	"DISQUALIFIED":            1,
+	"EXITED":                  2,
+	"UNKNOWN_AUDIT_SUSPENDED": 3,
+	"OFFLINE_SUSPENDED":       4,
+	"OFFLINE":                 5,
+}
+
+func (x UnhealthyPiece_Failure) String() string {
+	return proto.EnumName(UnhealthyPiece_Failure_name, int32(x))
+}
+
+func (UnhealthyPiece_Failure) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{19, 0}
+}
+
+type OrphanedProject_Reason int32
+
+const (
+	OrphanedProject_PROJECT_DELETED OrphanedProject_Reason = 0
+	OrphanedProject_OWNER_DELETED   OrphanedProject_Reason = 1
+)
+
+var OrphanedProject_Reason_name = map[int32]string{
+	0: "PROJECT_DELETED",
+	1: "OWNER_DELETED",
+}
+
+var OrphanedProject_Reason_value = map[string]int32{
+	"PROJECT_DELETED": 0,
+	"OWNER_DELETED":   1,
+}
+
+func (x OrphanedProject_Reason) String() string {
+	return proto.EnumName(OrphanedProject_Reason_name, int32(x))
+}
+
+func (OrphanedProject_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{39, 0}
+}
+
+type ExplainNodeSelectionResponse_Reason int32
+
+const (
+	ExplainNodeSelectionResponse_WOULD_BE_SELECTED       ExplainNodeSelectionResponse_Reason = 0
+	ExplainNodeSelectionResponse_OFFLINE                 ExplainNodeSelectionResponse_Reason = 1
+	ExplainNodeSelectionResponse_DISQUALIFIED            ExplainNodeSelectionResponse_Reason = 2
+	ExplainNodeSelectionResponse_SUSPENDED               ExplainNodeSelectionResponse_Reason = 3
+	ExplainNodeSelectionResponse_EXITING                 ExplainNodeSelectionResponse_Reason = 4
+	ExplainNodeSelectionResponse_INSUFFICIENT_FREE_SPACE ExplainNodeSelectionResponse_Reason = 5
+	ExplainNodeSelectionResponse_OUTDATED_VERSION        ExplainNodeSelectionResponse_Reason = 6
+	ExplainNodeSelectionResponse_PLACEMENT_FILTER        ExplainNodeSelectionResponse_Reason = 7
+	ExplainNodeSelectionResponse_UNVETTED                ExplainNodeSelectionResponse_Reason = 8
+	ExplainNodeSelectionResponse_SUBNET_CONFLICT         ExplainNodeSelectionResponse_Reason = 9
+)
+
+var ExplainNodeSelectionResponse_Reason_name = map[int32]string{
+	0: "WOULD_BE_SELECTED",
+	1: "OFFLINE",
+	2: "DISQUALIFIED",
+	3: "SUSPENDED",
+	4: "EXITING",
+	5: "INSUFFICIENT_FREE_SPACE",
+	6: "OUTDATED_VERSION",
+	7: "PLACEMENT_FILTER",
+	8: "UNVETTED",
+	9: "SUBNET_CONFLICT",
+}
+
+var ExplainNodeSelectionResponse_Reason_value = map[string]int32{
+	"WOULD_BE_SELECTED":       0,
+	"OFFLINE":                 1,
+	"DISQUALIFIED":            2,
+	"SUSPENDED":               3,
+	"EXITING":                 4,
+	"INSUFFICIENT_FREE_SPACE": 5,
+	"OUTDATED_VERSION":        6,
+	"PLACEMENT_FILTER":        7,
+	"UNVETTED":                8,
+	"SUBNET_CONFLICT":         9,
+}
+
+func (x ExplainNodeSelectionResponse_Reason) String() string {
+	return proto.EnumName(ExplainNodeSelectionResponse_Reason_name, int32(x))
+}
+
+func (ExplainNodeSelectionResponse_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{55, 0}
+}
+
+type ListExitingNodesRequest_Order int32
+
+const (
+	ListExitingNodesRequest_INITIATED_AT ListExitingNodesRequest_Order = 0
+	ListExitingNodesRequest_PROGRESS     ListExitingNodesRequest_Order = 1
+)
+
+var ListExitingNodesRequest_Order_name = map[int32]string{
+	0: "INITIATED_AT",
+	1: "PROGRESS",
+}
+
+var ListExitingNodesRequest_Order_value = map[string]int32{
+	"INITIATED_AT": 0,
+	"PROGRESS":     1,
+}
+
+func (x ListExitingNodesRequest_Order) String() string {
+	return proto.EnumName(ListExitingNodesRequest_Order_name, int32(x))
+}
+
+func (ListExitingNodesRequest_Order) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{74, 0}
+}
+
+type NodeCohortsRequest_Granularity int32
+
+const (
+	NodeCohortsRequest_MONTH   NodeCohortsRequest_Granularity = 0
+	NodeCohortsRequest_DAY     NodeCohortsRequest_Granularity = 1
+	NodeCohortsRequest_WEEK    NodeCohortsRequest_Granularity = 2
+	NodeCohortsRequest_QUARTER NodeCohortsRequest_Granularity = 3
+)
+
+var NodeCohortsRequest_Granularity_name = map[int32]string{
+	0: "MONTH",
+	1: "DAY",
+	2: "WEEK",
+	3: "QUARTER",
+}
+
+var NodeCohortsRequest_Granularity_value = map[string]int32{
+	"MONTH":   0,
+	"DAY":     1,
+	"WEEK":    2,
+	"QUARTER": 3,
+}
+
+func (x NodeCohortsRequest_Granularity) String() string {
+	return proto.EnumName(NodeCohortsRequest_Granularity_name, int32(x))
+}
+
+func (NodeCohortsRequest_Granularity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{80, 0}
+}
+
+type NodeCohortsRequest_Filter int32
+
+const (
+	NodeCohortsRequest_ALL     NodeCohortsRequest_Filter = 0
+	NodeCohortsRequest_ACTIVE  NodeCohortsRequest_Filter = 1
+	NodeCohortsRequest_CHURNED NodeCohortsRequest_Filter = 2
+)
+
+var NodeCohortsRequest_Filter_name = map[int32]string{
+	0: "ALL",
+	1: "ACTIVE",
+	2: "CHURNED",
+}
+
+var NodeCohortsRequest_Filter_value = map[string]int32{
+	"ALL":     0,
+	"ACTIVE":  1,
+	"CHURNED": 2,
+}
+
+func (x NodeCohortsRequest_Filter) String() string {
+	return proto.EnumName(NodeCohortsRequest_Filter_name, int32(x))
+}
+
+func (NodeCohortsRequest_Filter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{80, 1}
+}
+
+type ValidatePlacementResponse_Constraint int32
+
+const (
+	ValidatePlacementResponse_NONE        ValidatePlacementResponse_Constraint = 0
+	ValidatePlacementResponse_ELIGIBILITY ValidatePlacementResponse_Constraint = 1
+	ValidatePlacementResponse_COUNTRIES   ValidatePlacementResponse_Constraint = 2
+	ValidatePlacementResponse_FREE_DISK   ValidatePlacementResponse_Constraint = 3
+	ValidatePlacementResponse_VETTED      ValidatePlacementResponse_Constraint = 4
+	ValidatePlacementResponse_SUBNETS     ValidatePlacementResponse_Constraint = 5
+)
+
+var ValidatePlacementResponse_Constraint_name = map[int32]string{
+	0: "NONE",
+	1: "ELIGIBILITY",
+	2: "COUNTRIES",
+	3: "FREE_DISK",
+	4: "VETTED",
+	5: "SUBNETS",
+}
+
+var ValidatePlacementResponse_Constraint_value = map[string]int32{
+	"NONE":        0,
+	"ELIGIBILITY": 1,
+	"COUNTRIES":   2,
+	"FREE_DISK":   3,
+	"VETTED":      4,
+	"SUBNETS":     5,
+}
+
+func (x ValidatePlacementResponse_Constraint) String() string {
+	return proto.EnumName(ValidatePlacementResponse_Constraint_name, int32(x))
+}
+
+func (ValidatePlacementResponse_Constraint) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{104, 0}
+}
+
+type CheckExitEligibilityResponse_Reason int32
+
+const (
+	CheckExitEligibilityResponse_ELIGIBLE               CheckExitEligibilityResponse_Reason = 0
+	CheckExitEligibilityResponse_GRACEFUL_EXIT_DISABLED CheckExitEligibilityResponse_Reason = 1
+	CheckExitEligibilityResponse_DISQUALIFIED           CheckExitEligibilityResponse_Reason = 2
+	CheckExitEligibilityResponse_EXIT_FINISHED          CheckExitEligibilityResponse_Reason = 3
+	CheckExitEligibilityResponse_EXIT_STARTED           CheckExitEligibilityResponse_Reason = 4
+	CheckExitEligibilityResponse_TOO_NEW                CheckExitEligibilityResponse_Reason = 5
+)
+
+var CheckExitEligibilityResponse_Reason_name = map[int32]string{
+	0: "ELIGIBLE",
+	1: "GRACEFUL_EXIT_DISABLED",
+	2: "DISQUALIFIED",
+	3: "EXIT_FINISHED",
+	4: "EXIT_STARTED",
+	5: "TOO_NEW",
+}
+
+var CheckExitEligibilityResponse_Reason_value = map[string]int32{
+	"ELIGIBLE":               0,
+	"GRACEFUL_EXIT_DISABLED": 1,
+	"DISQUALIFIED":           2,
+	"EXIT_FINISHED":          3,
+	"EXIT_STARTED":           4,
+	"TOO_NEW":                5,
+}
+
+func (x CheckExitEligibilityResponse_Reason) String() string {
+	return proto.EnumName(CheckExitEligibilityResponse_Reason_name, int32(x))
+}
+
+func (CheckExitEligibilityResponse_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{133, 0}
+}
+
 type ObjectHealthRequest struct {
 	EncryptedPath        []byte   `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
 	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
@@ -296,49 +568,9417 @@ func (m *SegmentHealth) GetSegment() []byte {
 	return nil
 }
 
+type SegmentsAtRiskRequest struct {
+	CursorStreamId       []byte   `protobuf:"bytes,1,opt,name=cursor_stream_id,json=cursorStreamId,proto3" json:"cursor_stream_id,omitempty"`
+	CursorPosition       int64    `protobuf:"varint,2,opt,name=cursor_position,json=cursorPosition,proto3" json:"cursor_position,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentsAtRiskRequest) Reset()         { *m = SegmentsAtRiskRequest{} }
+func (m *SegmentsAtRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentsAtRiskRequest) ProtoMessage()    {}
+func (*SegmentsAtRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{5}
+}
+func (m *SegmentsAtRiskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentsAtRiskRequest.Unmarshal(m, b)
+}
+func (m *SegmentsAtRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentsAtRiskRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentsAtRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentsAtRiskRequest.Merge(m, src)
+}
+func (m *SegmentsAtRiskRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentsAtRiskRequest.Size(m)
+}
+func (m *SegmentsAtRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentsAtRiskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentsAtRiskRequest proto.InternalMessageInfo
+
+func (m *SegmentsAtRiskRequest) GetCursorStreamId() []byte {
+	if m != nil {
+		return m.CursorStreamId
+	}
+	return nil
+}
+
+func (m *SegmentsAtRiskRequest) GetCursorPosition() int64 {
+	if m != nil {
+		return m.CursorPosition
+	}
+	return 0
+}
+
+func (m *SegmentsAtRiskRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SegmentsAtRiskResponse struct {
+	Margins              []*SegmentMarginCount `protobuf:"bytes,1,rep,name=margins,proto3" json:"margins,omitempty"`
+	SegmentsScanned      int64                 `protobuf:"varint,2,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	CursorStreamId       []byte                `protobuf:"bytes,3,opt,name=cursor_stream_id,json=cursorStreamId,proto3" json:"cursor_stream_id,omitempty"`
+	CursorPosition       int64                 `protobuf:"varint,4,opt,name=cursor_position,json=cursorPosition,proto3" json:"cursor_position,omitempty"`
+	Done                 bool                  `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SegmentsAtRiskResponse) Reset()         { *m = SegmentsAtRiskResponse{} }
+func (m *SegmentsAtRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentsAtRiskResponse) ProtoMessage()    {}
+func (*SegmentsAtRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{6}
+}
+func (m *SegmentsAtRiskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentsAtRiskResponse.Unmarshal(m, b)
+}
+func (m *SegmentsAtRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentsAtRiskResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentsAtRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentsAtRiskResponse.Merge(m, src)
+}
+func (m *SegmentsAtRiskResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentsAtRiskResponse.Size(m)
+}
+func (m *SegmentsAtRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentsAtRiskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentsAtRiskResponse proto.InternalMessageInfo
+
+func (m *SegmentsAtRiskResponse) GetMargins() []*SegmentMarginCount {
+	if m != nil {
+		return m.Margins
+	}
+	return nil
+}
+
+func (m *SegmentsAtRiskResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *SegmentsAtRiskResponse) GetCursorStreamId() []byte {
+	if m != nil {
+		return m.CursorStreamId
+	}
+	return nil
+}
+
+func (m *SegmentsAtRiskResponse) GetCursorPosition() int64 {
+	if m != nil {
+		return m.CursorPosition
+	}
+	return 0
+}
+
+func (m *SegmentsAtRiskResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type SegmentMarginCount struct {
+	Margin               int32    `protobuf:"varint,1,opt,name=margin,proto3" json:"margin,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMarginCount) Reset()         { *m = SegmentMarginCount{} }
+func (m *SegmentMarginCount) String() string { return proto.CompactTextString(m) }
+func (*SegmentMarginCount) ProtoMessage()    {}
+func (*SegmentMarginCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+}
+func (m *SegmentMarginCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMarginCount.Unmarshal(m, b)
+}
+func (m *SegmentMarginCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMarginCount.Marshal(b, m, deterministic)
+}
+func (m *SegmentMarginCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMarginCount.Merge(m, src)
+}
+func (m *SegmentMarginCount) XXX_Size() int {
+	return xxx_messageInfo_SegmentMarginCount.Size(m)
+}
+func (m *SegmentMarginCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMarginCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMarginCount proto.InternalMessageInfo
+
+func (m *SegmentMarginCount) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *SegmentMarginCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type SegmentGeoSpreadRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,3,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	SegmentIndex         int64    `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	MaxPerCountry        int32    `protobuf:"varint,5,opt,name=max_per_country,json=maxPerCountry,proto3" json:"max_per_country,omitempty"`
+	MaxPerSubnet         int32    `protobuf:"varint,6,opt,name=max_per_subnet,json=maxPerSubnet,proto3" json:"max_per_subnet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentGeoSpreadRequest) Reset()         { *m = SegmentGeoSpreadRequest{} }
+func (m *SegmentGeoSpreadRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentGeoSpreadRequest) ProtoMessage()    {}
+func (*SegmentGeoSpreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+}
+func (m *SegmentGeoSpreadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentGeoSpreadRequest.Unmarshal(m, b)
+}
+func (m *SegmentGeoSpreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentGeoSpreadRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentGeoSpreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentGeoSpreadRequest.Merge(m, src)
+}
+func (m *SegmentGeoSpreadRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentGeoSpreadRequest.Size(m)
+}
+func (m *SegmentGeoSpreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentGeoSpreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentGeoSpreadRequest proto.InternalMessageInfo
+
+func (m *SegmentGeoSpreadRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *SegmentGeoSpreadRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentGeoSpreadRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *SegmentGeoSpreadRequest) GetSegmentIndex() int64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *SegmentGeoSpreadRequest) GetMaxPerCountry() int32 {
+	if m != nil {
+		return m.MaxPerCountry
+	}
+	return 0
+}
+
+func (m *SegmentGeoSpreadRequest) GetMaxPerSubnet() int32 {
+	if m != nil {
+		return m.MaxPerSubnet
+	}
+	return 0
+}
+
+type SegmentGeoSpreadResponse struct {
+	Pieces               []*PieceLocation `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	CrowdedCountries     []*LocationGroup `protobuf:"bytes,2,rep,name=crowded_countries,json=crowdedCountries,proto3" json:"crowded_countries,omitempty"`
+	CrowdedSubnets       []*LocationGroup `protobuf:"bytes,3,rep,name=crowded_subnets,json=crowdedSubnets,proto3" json:"crowded_subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SegmentGeoSpreadResponse) Reset()         { *m = SegmentGeoSpreadResponse{} }
+func (m *SegmentGeoSpreadResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentGeoSpreadResponse) ProtoMessage()    {}
+func (*SegmentGeoSpreadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+}
+func (m *SegmentGeoSpreadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentGeoSpreadResponse.Unmarshal(m, b)
+}
+func (m *SegmentGeoSpreadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentGeoSpreadResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentGeoSpreadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentGeoSpreadResponse.Merge(m, src)
+}
+func (m *SegmentGeoSpreadResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentGeoSpreadResponse.Size(m)
+}
+func (m *SegmentGeoSpreadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentGeoSpreadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentGeoSpreadResponse proto.InternalMessageInfo
+
+func (m *SegmentGeoSpreadResponse) GetPieces() []*PieceLocation {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
+func (m *SegmentGeoSpreadResponse) GetCrowdedCountries() []*LocationGroup {
+	if m != nil {
+		return m.CrowdedCountries
+	}
+	return nil
+}
+
+func (m *SegmentGeoSpreadResponse) GetCrowdedSubnets() []*LocationGroup {
+	if m != nil {
+		return m.CrowdedSubnets
+	}
+	return nil
+}
+
+type PieceLocation struct {
+	PieceNum             int32    `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	CountryCode          string   `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	LastNet              string   `protobuf:"bytes,4,opt,name=last_net,json=lastNet,proto3" json:"last_net,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceLocation) Reset()         { *m = PieceLocation{} }
+func (m *PieceLocation) String() string { return proto.CompactTextString(m) }
+func (*PieceLocation) ProtoMessage()    {}
+func (*PieceLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+}
+func (m *PieceLocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceLocation.Unmarshal(m, b)
+}
+func (m *PieceLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceLocation.Marshal(b, m, deterministic)
+}
+func (m *PieceLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceLocation.Merge(m, src)
+}
+func (m *PieceLocation) XXX_Size() int {
+	return xxx_messageInfo_PieceLocation.Size(m)
+}
+func (m *PieceLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceLocation proto.InternalMessageInfo
+
+func (m *PieceLocation) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *PieceLocation) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *PieceLocation) GetLastNet() string {
+	if m != nil {
+		return m.LastNet
+	}
+	return ""
+}
+
+type LocationGroup struct {
+	Location             string   `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	PieceNums            []int32  `protobuf:"varint,2,rep,packed,name=piece_nums,json=pieceNums,proto3" json:"piece_nums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocationGroup) Reset()         { *m = LocationGroup{} }
+func (m *LocationGroup) String() string { return proto.CompactTextString(m) }
+func (*LocationGroup) ProtoMessage()    {}
+func (*LocationGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+}
+func (m *LocationGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocationGroup.Unmarshal(m, b)
+}
+func (m *LocationGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocationGroup.Marshal(b, m, deterministic)
+}
+func (m *LocationGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationGroup.Merge(m, src)
+}
+func (m *LocationGroup) XXX_Size() int {
+	return xxx_messageInfo_LocationGroup.Size(m)
+}
+func (m *LocationGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocationGroup proto.InternalMessageInfo
+
+func (m *LocationGroup) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *LocationGroup) GetPieceNums() []int32 {
+	if m != nil {
+		return m.PieceNums
+	}
+	return nil
+}
+
+type RepairQueueStatsRequest struct {
+	HealthBuckets        int32    `protobuf:"varint,1,opt,name=health_buckets,json=healthBuckets,proto3" json:"health_buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepairQueueStatsRequest) Reset()         { *m = RepairQueueStatsRequest{} }
+func (m *RepairQueueStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*RepairQueueStatsRequest) ProtoMessage()    {}
+func (*RepairQueueStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+}
+func (m *RepairQueueStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairQueueStatsRequest.Unmarshal(m, b)
+}
+func (m *RepairQueueStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairQueueStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *RepairQueueStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairQueueStatsRequest.Merge(m, src)
+}
+func (m *RepairQueueStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_RepairQueueStatsRequest.Size(m)
+}
+func (m *RepairQueueStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairQueueStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairQueueStatsRequest proto.InternalMessageInfo
+
+func (m *RepairQueueStatsRequest) GetHealthBuckets() int32 {
+	if m != nil {
+		return m.HealthBuckets
+	}
+	return 0
+}
+
+type RepairQueueStatsResponse struct {
+	Length               int64                 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	OldestAge            time.Duration         `protobuf:"bytes,2,opt,name=oldest_age,json=oldestAge,proto3,stdduration" json:"oldest_age"`
+	MinHealth            float64               `protobuf:"fixed64,3,opt,name=min_health,json=minHealth,proto3" json:"min_health,omitempty"`
+	MaxHealth            float64               `protobuf:"fixed64,4,opt,name=max_health,json=maxHealth,proto3" json:"max_health,omitempty"`
+	HealthBuckets        []*RepairHealthBucket `protobuf:"bytes,5,rep,name=health_buckets,json=healthBuckets,proto3" json:"health_buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RepairQueueStatsResponse) Reset()         { *m = RepairQueueStatsResponse{} }
+func (m *RepairQueueStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*RepairQueueStatsResponse) ProtoMessage()    {}
+func (*RepairQueueStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+}
+func (m *RepairQueueStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairQueueStatsResponse.Unmarshal(m, b)
+}
+func (m *RepairQueueStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairQueueStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *RepairQueueStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairQueueStatsResponse.Merge(m, src)
+}
+func (m *RepairQueueStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_RepairQueueStatsResponse.Size(m)
+}
+func (m *RepairQueueStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairQueueStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairQueueStatsResponse proto.InternalMessageInfo
+
+func (m *RepairQueueStatsResponse) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *RepairQueueStatsResponse) GetOldestAge() time.Duration {
+	if m != nil {
+		return m.OldestAge
+	}
+	return 0
+}
+
+func (m *RepairQueueStatsResponse) GetMinHealth() float64 {
+	if m != nil {
+		return m.MinHealth
+	}
+	return 0
+}
+
+func (m *RepairQueueStatsResponse) GetMaxHealth() float64 {
+	if m != nil {
+		return m.MaxHealth
+	}
+	return 0
+}
+
+func (m *RepairQueueStatsResponse) GetHealthBuckets() []*RepairHealthBucket {
+	if m != nil {
+		return m.HealthBuckets
+	}
+	return nil
+}
+
+type RepairHealthBucket struct {
+	Lower                float64  `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper                float64  `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepairHealthBucket) Reset()         { *m = RepairHealthBucket{} }
+func (m *RepairHealthBucket) String() string { return proto.CompactTextString(m) }
+func (*RepairHealthBucket) ProtoMessage()    {}
+func (*RepairHealthBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+}
+func (m *RepairHealthBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairHealthBucket.Unmarshal(m, b)
+}
+func (m *RepairHealthBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairHealthBucket.Marshal(b, m, deterministic)
+}
+func (m *RepairHealthBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairHealthBucket.Merge(m, src)
+}
+func (m *RepairHealthBucket) XXX_Size() int {
+	return xxx_messageInfo_RepairHealthBucket.Size(m)
+}
+func (m *RepairHealthBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairHealthBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairHealthBucket proto.InternalMessageInfo
+
+func (m *RepairHealthBucket) GetLower() float64 {
+	if m != nil {
+		return m.Lower
+	}
+	return 0
+}
+
+func (m *RepairHealthBucket) GetUpper() float64 {
+	if m != nil {
+		return m.Upper
+	}
+	return 0
+}
+
+func (m *RepairHealthBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DetectOrphanedPiecesRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	SampleSize           int32    `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,3,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DetectOrphanedPiecesRequest) Reset()         { *m = DetectOrphanedPiecesRequest{} }
+func (m *DetectOrphanedPiecesRequest) String() string { return proto.CompactTextString(m) }
+func (*DetectOrphanedPiecesRequest) ProtoMessage()    {}
+func (*DetectOrphanedPiecesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{15}
+}
+func (m *DetectOrphanedPiecesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectOrphanedPiecesRequest.Unmarshal(m, b)
+}
+func (m *DetectOrphanedPiecesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectOrphanedPiecesRequest.Marshal(b, m, deterministic)
+}
+func (m *DetectOrphanedPiecesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectOrphanedPiecesRequest.Merge(m, src)
+}
+func (m *DetectOrphanedPiecesRequest) XXX_Size() int {
+	return xxx_messageInfo_DetectOrphanedPiecesRequest.Size(m)
+}
+func (m *DetectOrphanedPiecesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectOrphanedPiecesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectOrphanedPiecesRequest proto.InternalMessageInfo
+
+func (m *DetectOrphanedPiecesRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *DetectOrphanedPiecesRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+type DetectOrphanedPiecesResponse struct {
+	NodeId                  NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	RecordedPieces          int64    `protobuf:"varint,2,opt,name=recorded_pieces,json=recordedPieces,proto3" json:"recorded_pieces,omitempty"`
+	SegmentsScanned         int64    `protobuf:"varint,3,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	SampledPieces           int64    `protobuf:"varint,4,opt,name=sampled_pieces,json=sampledPieces,proto3" json:"sampled_pieces,omitempty"`
+	SampleFraction          float64  `protobuf:"fixed64,5,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                   bool     `protobuf:"varint,6,opt,name=exact,proto3" json:"exact,omitempty"`
+	EstimatedLivePieces     int64    `protobuf:"varint,7,opt,name=estimated_live_pieces,json=estimatedLivePieces,proto3" json:"estimated_live_pieces,omitempty"`
+	EstimatedOrphanedPieces int64    `protobuf:"varint,8,opt,name=estimated_orphaned_pieces,json=estimatedOrphanedPieces,proto3" json:"estimated_orphaned_pieces,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *DetectOrphanedPiecesResponse) Reset()         { *m = DetectOrphanedPiecesResponse{} }
+func (m *DetectOrphanedPiecesResponse) String() string { return proto.CompactTextString(m) }
+func (*DetectOrphanedPiecesResponse) ProtoMessage()    {}
+func (*DetectOrphanedPiecesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{16}
+}
+func (m *DetectOrphanedPiecesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectOrphanedPiecesResponse.Unmarshal(m, b)
+}
+func (m *DetectOrphanedPiecesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectOrphanedPiecesResponse.Marshal(b, m, deterministic)
+}
+func (m *DetectOrphanedPiecesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectOrphanedPiecesResponse.Merge(m, src)
+}
+func (m *DetectOrphanedPiecesResponse) XXX_Size() int {
+	return xxx_messageInfo_DetectOrphanedPiecesResponse.Size(m)
+}
+func (m *DetectOrphanedPiecesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectOrphanedPiecesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectOrphanedPiecesResponse proto.InternalMessageInfo
+
+func (m *DetectOrphanedPiecesResponse) GetRecordedPieces() int64 {
+	if m != nil {
+		return m.RecordedPieces
+	}
+	return 0
+}
+
+func (m *DetectOrphanedPiecesResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *DetectOrphanedPiecesResponse) GetSampledPieces() int64 {
+	if m != nil {
+		return m.SampledPieces
+	}
+	return 0
+}
+
+func (m *DetectOrphanedPiecesResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *DetectOrphanedPiecesResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+func (m *DetectOrphanedPiecesResponse) GetEstimatedLivePieces() int64 {
+	if m != nil {
+		return m.EstimatedLivePieces
+	}
+	return 0
+}
+
+func (m *DetectOrphanedPiecesResponse) GetEstimatedOrphanedPieces() int64 {
+	if m != nil {
+		return m.EstimatedOrphanedPieces
+	}
+	return 0
+}
+
+type SegmentRepairReasonRequest struct {
+	StreamId             []byte   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position             int64    `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentRepairReasonRequest) Reset()         { *m = SegmentRepairReasonRequest{} }
+func (m *SegmentRepairReasonRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentRepairReasonRequest) ProtoMessage()    {}
+func (*SegmentRepairReasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{17}
+}
+func (m *SegmentRepairReasonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentRepairReasonRequest.Unmarshal(m, b)
+}
+func (m *SegmentRepairReasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentRepairReasonRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentRepairReasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRepairReasonRequest.Merge(m, src)
+}
+func (m *SegmentRepairReasonRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentRepairReasonRequest.Size(m)
+}
+func (m *SegmentRepairReasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRepairReasonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRepairReasonRequest proto.InternalMessageInfo
+
+func (m *SegmentRepairReasonRequest) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *SegmentRepairReasonRequest) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+type SegmentRepairReasonResponse struct {
+	SegmentHealth        float64              `protobuf:"fixed64,1,opt,name=segment_health,json=segmentHealth,proto3" json:"segment_health,omitempty"`
+	InsertedAt           time.Time            `protobuf:"bytes,2,opt,name=inserted_at,json=insertedAt,proto3,stdtime" json:"inserted_at"`
+	UpdatedAt            time.Time            `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	HealthyPieces        int32                `protobuf:"varint,4,opt,name=healthy_pieces,json=healthyPieces,proto3" json:"healthy_pieces,omitempty"`
+	Redundancy           *pb.RedundancyScheme `protobuf:"bytes,5,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	UnhealthyPieces      []*UnhealthyPiece    `protobuf:"bytes,6,rep,name=unhealthy_pieces,json=unhealthyPieces,proto3" json:"unhealthy_pieces,omitempty"`
+	ChurnedPieces        int32                `protobuf:"varint,7,opt,name=churned_pieces,json=churnedPieces,proto3" json:"churned_pieces,omitempty"`
+	AuditFailedPieces    int32                `protobuf:"varint,8,opt,name=audit_failed_pieces,json=auditFailedPieces,proto3" json:"audit_failed_pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SegmentRepairReasonResponse) Reset()         { *m = SegmentRepairReasonResponse{} }
+func (m *SegmentRepairReasonResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentRepairReasonResponse) ProtoMessage()    {}
+func (*SegmentRepairReasonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{18}
+}
+func (m *SegmentRepairReasonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentRepairReasonResponse.Unmarshal(m, b)
+}
+func (m *SegmentRepairReasonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentRepairReasonResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentRepairReasonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRepairReasonResponse.Merge(m, src)
+}
+func (m *SegmentRepairReasonResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentRepairReasonResponse.Size(m)
+}
+func (m *SegmentRepairReasonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRepairReasonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRepairReasonResponse proto.InternalMessageInfo
+
+func (m *SegmentRepairReasonResponse) GetSegmentHealth() float64 {
+	if m != nil {
+		return m.SegmentHealth
+	}
+	return 0
+}
+
+func (m *SegmentRepairReasonResponse) GetInsertedAt() time.Time {
+	if m != nil {
+		return m.InsertedAt
+	}
+	return time.Time{}
+}
+
+func (m *SegmentRepairReasonResponse) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *SegmentRepairReasonResponse) GetHealthyPieces() int32 {
+	if m != nil {
+		return m.HealthyPieces
+	}
+	return 0
+}
+
+func (m *SegmentRepairReasonResponse) GetRedundancy() *pb.RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
+func (m *SegmentRepairReasonResponse) GetUnhealthyPieces() []*UnhealthyPiece {
+	if m != nil {
+		return m.UnhealthyPieces
+	}
+	return nil
+}
+
+func (m *SegmentRepairReasonResponse) GetChurnedPieces() int32 {
+	if m != nil {
+		return m.ChurnedPieces
+	}
+	return 0
+}
+
+func (m *SegmentRepairReasonResponse) GetAuditFailedPieces() int32 {
+	if m != nil {
+		return m.AuditFailedPieces
+	}
+	return 0
+}
+
+type UnhealthyPiece struct {
+	PieceNum             int32                  `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Failure              UnhealthyPiece_Failure `protobuf:"varint,3,opt,name=failure,proto3,enum=satellite.inspector.UnhealthyPiece_Failure" json:"failure,omitempty"`
+	Churned              bool                   `protobuf:"varint,4,opt,name=churned,proto3" json:"churned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UnhealthyPiece) Reset()         { *m = UnhealthyPiece{} }
+func (m *UnhealthyPiece) String() string { return proto.CompactTextString(m) }
+func (*UnhealthyPiece) ProtoMessage()    {}
+func (*UnhealthyPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{19}
+}
+func (m *UnhealthyPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnhealthyPiece.Unmarshal(m, b)
+}
+func (m *UnhealthyPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnhealthyPiece.Marshal(b, m, deterministic)
+}
+func (m *UnhealthyPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnhealthyPiece.Merge(m, src)
+}
+func (m *UnhealthyPiece) XXX_Size() int {
+	return xxx_messageInfo_UnhealthyPiece.Size(m)
+}
+func (m *UnhealthyPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnhealthyPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnhealthyPiece proto.InternalMessageInfo
+
+func (m *UnhealthyPiece) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *UnhealthyPiece) GetFailure() UnhealthyPiece_Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return UnhealthyPiece_MISSING
+}
+
+func (m *UnhealthyPiece) GetChurned() bool {
+	if m != nil {
+		return m.Churned
+	}
+	return false
+}
+
+type SegmentSizeHistogramRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	SampleRate           float64  `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	UpperBounds          []int64  `protobuf:"varint,4,rep,packed,name=upper_bounds,json=upperBounds,proto3" json:"upper_bounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentSizeHistogramRequest) Reset()         { *m = SegmentSizeHistogramRequest{} }
+func (m *SegmentSizeHistogramRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentSizeHistogramRequest) ProtoMessage()    {}
+func (*SegmentSizeHistogramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{20}
+}
+func (m *SegmentSizeHistogramRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentSizeHistogramRequest.Unmarshal(m, b)
+}
+func (m *SegmentSizeHistogramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentSizeHistogramRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentSizeHistogramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentSizeHistogramRequest.Merge(m, src)
+}
+func (m *SegmentSizeHistogramRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentSizeHistogramRequest.Size(m)
+}
+func (m *SegmentSizeHistogramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentSizeHistogramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentSizeHistogramRequest proto.InternalMessageInfo
+
+func (m *SegmentSizeHistogramRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *SegmentSizeHistogramRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentSizeHistogramRequest) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *SegmentSizeHistogramRequest) GetUpperBounds() []int64 {
+	if m != nil {
+		return m.UpperBounds
+	}
+	return nil
+}
+
+type SegmentSizeHistogramResponse struct {
+	Ranges                []*SegmentSizeRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	SampleRate            float64             `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	SampledSegments       int64               `protobuf:"varint,3,opt,name=sampled_segments,json=sampledSegments,proto3" json:"sampled_segments,omitempty"`
+	SampledInlineSegments int64               `protobuf:"varint,4,opt,name=sampled_inline_segments,json=sampledInlineSegments,proto3" json:"sampled_inline_segments,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}            `json:"-"`
+	XXX_unrecognized      []byte              `json:"-"`
+	XXX_sizecache         int32               `json:"-"`
+}
+
+func (m *SegmentSizeHistogramResponse) Reset()         { *m = SegmentSizeHistogramResponse{} }
+func (m *SegmentSizeHistogramResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentSizeHistogramResponse) ProtoMessage()    {}
+func (*SegmentSizeHistogramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{21}
+}
+func (m *SegmentSizeHistogramResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentSizeHistogramResponse.Unmarshal(m, b)
+}
+func (m *SegmentSizeHistogramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentSizeHistogramResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentSizeHistogramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentSizeHistogramResponse.Merge(m, src)
+}
+func (m *SegmentSizeHistogramResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentSizeHistogramResponse.Size(m)
+}
+func (m *SegmentSizeHistogramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentSizeHistogramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentSizeHistogramResponse proto.InternalMessageInfo
+
+func (m *SegmentSizeHistogramResponse) GetRanges() []*SegmentSizeRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *SegmentSizeHistogramResponse) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *SegmentSizeHistogramResponse) GetSampledSegments() int64 {
+	if m != nil {
+		return m.SampledSegments
+	}
+	return 0
+}
+
+func (m *SegmentSizeHistogramResponse) GetSampledInlineSegments() int64 {
+	if m != nil {
+		return m.SampledInlineSegments
+	}
+	return 0
+}
+
+type SegmentSizeRange struct {
+	LowerBound           int64    `protobuf:"varint,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound           int64    `protobuf:"varint,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	SampledSegments      int64    `protobuf:"varint,3,opt,name=sampled_segments,json=sampledSegments,proto3" json:"sampled_segments,omitempty"`
+	InlineSegments       int64    `protobuf:"varint,4,opt,name=inline_segments,json=inlineSegments,proto3" json:"inline_segments,omitempty"`
+	EstimatedSegments    int64    `protobuf:"varint,5,opt,name=estimated_segments,json=estimatedSegments,proto3" json:"estimated_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentSizeRange) Reset()         { *m = SegmentSizeRange{} }
+func (m *SegmentSizeRange) String() string { return proto.CompactTextString(m) }
+func (*SegmentSizeRange) ProtoMessage()    {}
+func (*SegmentSizeRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{22}
+}
+func (m *SegmentSizeRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentSizeRange.Unmarshal(m, b)
+}
+func (m *SegmentSizeRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentSizeRange.Marshal(b, m, deterministic)
+}
+func (m *SegmentSizeRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentSizeRange.Merge(m, src)
+}
+func (m *SegmentSizeRange) XXX_Size() int {
+	return xxx_messageInfo_SegmentSizeRange.Size(m)
+}
+func (m *SegmentSizeRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentSizeRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentSizeRange proto.InternalMessageInfo
+
+func (m *SegmentSizeRange) GetLowerBound() int64 {
+	if m != nil {
+		return m.LowerBound
+	}
+	return 0
+}
+
+func (m *SegmentSizeRange) GetUpperBound() int64 {
+	if m != nil {
+		return m.UpperBound
+	}
+	return 0
+}
+
+func (m *SegmentSizeRange) GetSampledSegments() int64 {
+	if m != nil {
+		return m.SampledSegments
+	}
+	return 0
+}
+
+func (m *SegmentSizeRange) GetInlineSegments() int64 {
+	if m != nil {
+		return m.InlineSegments
+	}
+	return 0
+}
+
+func (m *SegmentSizeRange) GetEstimatedSegments() int64 {
+	if m != nil {
+		return m.EstimatedSegments
+	}
+	return 0
+}
+
+type RedundancyDistributionRequest struct {
+	SampleSize           int32    `protobuf:"varint,1,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,2,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedundancyDistributionRequest) Reset()         { *m = RedundancyDistributionRequest{} }
+func (m *RedundancyDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*RedundancyDistributionRequest) ProtoMessage()    {}
+func (*RedundancyDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{23}
+}
+func (m *RedundancyDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedundancyDistributionRequest.Unmarshal(m, b)
+}
+func (m *RedundancyDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedundancyDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *RedundancyDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedundancyDistributionRequest.Merge(m, src)
+}
+func (m *RedundancyDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_RedundancyDistributionRequest.Size(m)
+}
+func (m *RedundancyDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedundancyDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedundancyDistributionRequest proto.InternalMessageInfo
+
+func (m *RedundancyDistributionRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *RedundancyDistributionRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+type RedundancyDistributionResponse struct {
+	Schemes              []*RedundancySchemeCount `protobuf:"bytes,1,rep,name=schemes,proto3" json:"schemes,omitempty"`
+	SegmentsScanned      int64                    `protobuf:"varint,2,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	SampleFraction       float64                  `protobuf:"fixed64,3,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                bool                     `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *RedundancyDistributionResponse) Reset()         { *m = RedundancyDistributionResponse{} }
+func (m *RedundancyDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*RedundancyDistributionResponse) ProtoMessage()    {}
+func (*RedundancyDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{24}
+}
+func (m *RedundancyDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedundancyDistributionResponse.Unmarshal(m, b)
+}
+func (m *RedundancyDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedundancyDistributionResponse.Marshal(b, m, deterministic)
+}
+func (m *RedundancyDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedundancyDistributionResponse.Merge(m, src)
+}
+func (m *RedundancyDistributionResponse) XXX_Size() int {
+	return xxx_messageInfo_RedundancyDistributionResponse.Size(m)
+}
+func (m *RedundancyDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedundancyDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedundancyDistributionResponse proto.InternalMessageInfo
+
+func (m *RedundancyDistributionResponse) GetSchemes() []*RedundancySchemeCount {
+	if m != nil {
+		return m.Schemes
+	}
+	return nil
+}
+
+func (m *RedundancyDistributionResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *RedundancyDistributionResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *RedundancyDistributionResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+type RedundancySchemeCount struct {
+	RequiredShares       int32    `protobuf:"varint,1,opt,name=required_shares,json=requiredShares,proto3" json:"required_shares,omitempty"`
+	RepairShares         int32    `protobuf:"varint,2,opt,name=repair_shares,json=repairShares,proto3" json:"repair_shares,omitempty"`
+	OptimalShares        int32    `protobuf:"varint,3,opt,name=optimal_shares,json=optimalShares,proto3" json:"optimal_shares,omitempty"`
+	TotalShares          int32    `protobuf:"varint,4,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	SampledSegments      int64    `protobuf:"varint,5,opt,name=sampled_segments,json=sampledSegments,proto3" json:"sampled_segments,omitempty"`
+	EstimatedSegments    int64    `protobuf:"varint,6,opt,name=estimated_segments,json=estimatedSegments,proto3" json:"estimated_segments,omitempty"`
+	ExpansionFactor      float64  `protobuf:"fixed64,7,opt,name=expansion_factor,json=expansionFactor,proto3" json:"expansion_factor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedundancySchemeCount) Reset()         { *m = RedundancySchemeCount{} }
+func (m *RedundancySchemeCount) String() string { return proto.CompactTextString(m) }
+func (*RedundancySchemeCount) ProtoMessage()    {}
+func (*RedundancySchemeCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{25}
+}
+func (m *RedundancySchemeCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedundancySchemeCount.Unmarshal(m, b)
+}
+func (m *RedundancySchemeCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedundancySchemeCount.Marshal(b, m, deterministic)
+}
+func (m *RedundancySchemeCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedundancySchemeCount.Merge(m, src)
+}
+func (m *RedundancySchemeCount) XXX_Size() int {
+	return xxx_messageInfo_RedundancySchemeCount.Size(m)
+}
+func (m *RedundancySchemeCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedundancySchemeCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedundancySchemeCount proto.InternalMessageInfo
+
+func (m *RedundancySchemeCount) GetRequiredShares() int32 {
+	if m != nil {
+		return m.RequiredShares
+	}
+	return 0
+}
+
+func (m *RedundancySchemeCount) GetRepairShares() int32 {
+	if m != nil {
+		return m.RepairShares
+	}
+	return 0
+}
+
+func (m *RedundancySchemeCount) GetOptimalShares() int32 {
+	if m != nil {
+		return m.OptimalShares
+	}
+	return 0
+}
+
+func (m *RedundancySchemeCount) GetTotalShares() int32 {
+	if m != nil {
+		return m.TotalShares
+	}
+	return 0
+}
+
+func (m *RedundancySchemeCount) GetSampledSegments() int64 {
+	if m != nil {
+		return m.SampledSegments
+	}
+	return 0
+}
+
+func (m *RedundancySchemeCount) GetEstimatedSegments() int64 {
+	if m != nil {
+		return m.EstimatedSegments
+	}
+	return 0
+}
+
+func (m *RedundancySchemeCount) GetExpansionFactor() float64 {
+	if m != nil {
+		return m.ExpansionFactor
+	}
+	return 0
+}
+
+type SegmentPieceNodesRequest struct {
+	StreamId             []byte   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position             int64    `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentPieceNodesRequest) Reset()         { *m = SegmentPieceNodesRequest{} }
+func (m *SegmentPieceNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentPieceNodesRequest) ProtoMessage()    {}
+func (*SegmentPieceNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+}
+func (m *SegmentPieceNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentPieceNodesRequest.Unmarshal(m, b)
+}
+func (m *SegmentPieceNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentPieceNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentPieceNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentPieceNodesRequest.Merge(m, src)
+}
+func (m *SegmentPieceNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentPieceNodesRequest.Size(m)
+}
+func (m *SegmentPieceNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentPieceNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentPieceNodesRequest proto.InternalMessageInfo
+
+func (m *SegmentPieceNodesRequest) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *SegmentPieceNodesRequest) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+type SegmentPieceNodesResponse struct {
+	Pieces               []*PieceNode `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SegmentPieceNodesResponse) Reset()         { *m = SegmentPieceNodesResponse{} }
+func (m *SegmentPieceNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentPieceNodesResponse) ProtoMessage()    {}
+func (*SegmentPieceNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+}
+func (m *SegmentPieceNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentPieceNodesResponse.Unmarshal(m, b)
+}
+func (m *SegmentPieceNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentPieceNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentPieceNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentPieceNodesResponse.Merge(m, src)
+}
+func (m *SegmentPieceNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentPieceNodesResponse.Size(m)
+}
+func (m *SegmentPieceNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentPieceNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentPieceNodesResponse proto.InternalMessageInfo
+
+func (m *SegmentPieceNodesResponse) GetPieces() []*PieceNode {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
+type PieceNode struct {
+	PieceNum             int32     `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID    `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Missing              bool      `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	Address              string    `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	LastIpPort           string    `protobuf:"bytes,5,opt,name=last_ip_port,json=lastIpPort,proto3" json:"last_ip_port,omitempty"`
+	LastContactSuccess   time.Time `protobuf:"bytes,6,opt,name=last_contact_success,json=lastContactSuccess,proto3,stdtime" json:"last_contact_success"`
+	Online               bool      `protobuf:"varint,7,opt,name=online,proto3" json:"online,omitempty"`
+	Disqualified         bool      `protobuf:"varint,8,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PieceNode) Reset()         { *m = PieceNode{} }
+func (m *PieceNode) String() string { return proto.CompactTextString(m) }
+func (*PieceNode) ProtoMessage()    {}
+func (*PieceNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+}
+func (m *PieceNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceNode.Unmarshal(m, b)
+}
+func (m *PieceNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceNode.Marshal(b, m, deterministic)
+}
+func (m *PieceNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceNode.Merge(m, src)
+}
+func (m *PieceNode) XXX_Size() int {
+	return xxx_messageInfo_PieceNode.Size(m)
+}
+func (m *PieceNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceNode proto.InternalMessageInfo
+
+func (m *PieceNode) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *PieceNode) GetMissing() bool {
+	if m != nil {
+		return m.Missing
+	}
+	return false
+}
+
+func (m *PieceNode) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PieceNode) GetLastIpPort() string {
+	if m != nil {
+		return m.LastIpPort
+	}
+	return ""
+}
+
+func (m *PieceNode) GetLastContactSuccess() time.Time {
+	if m != nil {
+		return m.LastContactSuccess
+	}
+	return time.Time{}
+}
+
+func (m *PieceNode) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+func (m *PieceNode) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
+type InlineRemoteRatioRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	SampleRate           float64  `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InlineRemoteRatioRequest) Reset()         { *m = InlineRemoteRatioRequest{} }
+func (m *InlineRemoteRatioRequest) String() string { return proto.CompactTextString(m) }
+func (*InlineRemoteRatioRequest) ProtoMessage()    {}
+func (*InlineRemoteRatioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+}
+func (m *InlineRemoteRatioRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InlineRemoteRatioRequest.Unmarshal(m, b)
+}
+func (m *InlineRemoteRatioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InlineRemoteRatioRequest.Marshal(b, m, deterministic)
+}
+func (m *InlineRemoteRatioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InlineRemoteRatioRequest.Merge(m, src)
+}
+func (m *InlineRemoteRatioRequest) XXX_Size() int {
+	return xxx_messageInfo_InlineRemoteRatioRequest.Size(m)
+}
+func (m *InlineRemoteRatioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InlineRemoteRatioRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InlineRemoteRatioRequest proto.InternalMessageInfo
+
+func (m *InlineRemoteRatioRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *InlineRemoteRatioRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *InlineRemoteRatioRequest) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+type InlineRemoteRatioResponse struct {
+	Inline                *SegmentTotals `protobuf:"bytes,1,opt,name=inline,proto3" json:"inline,omitempty"`
+	Remote                *SegmentTotals `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	SampleRate            float64        `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	InlineSegmentFraction float64        `protobuf:"fixed64,4,opt,name=inline_segment_fraction,json=inlineSegmentFraction,proto3" json:"inline_segment_fraction,omitempty"`
+	InlineBytesFraction   float64        `protobuf:"fixed64,5,opt,name=inline_bytes_fraction,json=inlineBytesFraction,proto3" json:"inline_bytes_fraction,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}       `json:"-"`
+	XXX_unrecognized      []byte         `json:"-"`
+	XXX_sizecache         int32          `json:"-"`
+}
+
+func (m *InlineRemoteRatioResponse) Reset()         { *m = InlineRemoteRatioResponse{} }
+func (m *InlineRemoteRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*InlineRemoteRatioResponse) ProtoMessage()    {}
+func (*InlineRemoteRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+}
+func (m *InlineRemoteRatioResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InlineRemoteRatioResponse.Unmarshal(m, b)
+}
+func (m *InlineRemoteRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InlineRemoteRatioResponse.Marshal(b, m, deterministic)
+}
+func (m *InlineRemoteRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InlineRemoteRatioResponse.Merge(m, src)
+}
+func (m *InlineRemoteRatioResponse) XXX_Size() int {
+	return xxx_messageInfo_InlineRemoteRatioResponse.Size(m)
+}
+func (m *InlineRemoteRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InlineRemoteRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InlineRemoteRatioResponse proto.InternalMessageInfo
+
+func (m *InlineRemoteRatioResponse) GetInline() *SegmentTotals {
+	if m != nil {
+		return m.Inline
+	}
+	return nil
+}
+
+func (m *InlineRemoteRatioResponse) GetRemote() *SegmentTotals {
+	if m != nil {
+		return m.Remote
+	}
+	return nil
+}
+
+func (m *InlineRemoteRatioResponse) GetSampleRate() float64 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *InlineRemoteRatioResponse) GetInlineSegmentFraction() float64 {
+	if m != nil {
+		return m.InlineSegmentFraction
+	}
+	return 0
+}
+
+func (m *InlineRemoteRatioResponse) GetInlineBytesFraction() float64 {
+	if m != nil {
+		return m.InlineBytesFraction
+	}
+	return 0
+}
+
+type SegmentTotals struct {
+	SampledSegments      int64    `protobuf:"varint,1,opt,name=sampled_segments,json=sampledSegments,proto3" json:"sampled_segments,omitempty"`
+	SampledBytes         int64    `protobuf:"varint,2,opt,name=sampled_bytes,json=sampledBytes,proto3" json:"sampled_bytes,omitempty"`
+	EstimatedSegments    int64    `protobuf:"varint,3,opt,name=estimated_segments,json=estimatedSegments,proto3" json:"estimated_segments,omitempty"`
+	EstimatedBytes       int64    `protobuf:"varint,4,opt,name=estimated_bytes,json=estimatedBytes,proto3" json:"estimated_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentTotals) Reset()         { *m = SegmentTotals{} }
+func (m *SegmentTotals) String() string { return proto.CompactTextString(m) }
+func (*SegmentTotals) ProtoMessage()    {}
+func (*SegmentTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
+}
+func (m *SegmentTotals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentTotals.Unmarshal(m, b)
+}
+func (m *SegmentTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentTotals.Marshal(b, m, deterministic)
+}
+func (m *SegmentTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentTotals.Merge(m, src)
+}
+func (m *SegmentTotals) XXX_Size() int {
+	return xxx_messageInfo_SegmentTotals.Size(m)
+}
+func (m *SegmentTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentTotals proto.InternalMessageInfo
+
+func (m *SegmentTotals) GetSampledSegments() int64 {
+	if m != nil {
+		return m.SampledSegments
+	}
+	return 0
+}
+
+func (m *SegmentTotals) GetSampledBytes() int64 {
+	if m != nil {
+		return m.SampledBytes
+	}
+	return 0
+}
+
+func (m *SegmentTotals) GetEstimatedSegments() int64 {
+	if m != nil {
+		return m.EstimatedSegments
+	}
+	return 0
+}
+
+func (m *SegmentTotals) GetEstimatedBytes() int64 {
+	if m != nil {
+		return m.EstimatedBytes
+	}
+	return 0
+}
+
+type DryRunRepairCheckerRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	SampleSize           int32    `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,4,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	HealthBuckets        int32    `protobuf:"varint,5,opt,name=health_buckets,json=healthBuckets,proto3" json:"health_buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DryRunRepairCheckerRequest) Reset()         { *m = DryRunRepairCheckerRequest{} }
+func (m *DryRunRepairCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunRepairCheckerRequest) ProtoMessage()    {}
+func (*DryRunRepairCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
+}
+func (m *DryRunRepairCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunRepairCheckerRequest.Unmarshal(m, b)
+}
+func (m *DryRunRepairCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunRepairCheckerRequest.Marshal(b, m, deterministic)
+}
+func (m *DryRunRepairCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunRepairCheckerRequest.Merge(m, src)
+}
+func (m *DryRunRepairCheckerRequest) XXX_Size() int {
+	return xxx_messageInfo_DryRunRepairCheckerRequest.Size(m)
+}
+func (m *DryRunRepairCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunRepairCheckerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunRepairCheckerRequest proto.InternalMessageInfo
+
+func (m *DryRunRepairCheckerRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *DryRunRepairCheckerRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *DryRunRepairCheckerRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+func (m *DryRunRepairCheckerRequest) GetHealthBuckets() int32 {
+	if m != nil {
+		return m.HealthBuckets
+	}
+	return 0
+}
+
+type DryRunRepairCheckerResponse struct {
+	SegmentsChecked      int64                 `protobuf:"varint,1,opt,name=segments_checked,json=segmentsChecked,proto3" json:"segments_checked,omitempty"`
+	WouldQueue           int64                 `protobuf:"varint,2,opt,name=would_queue,json=wouldQueue,proto3" json:"would_queue,omitempty"`
+	Irreparable          int64                 `protobuf:"varint,3,opt,name=irreparable,proto3" json:"irreparable,omitempty"`
+	EstimatedWouldQueue  int64                 `protobuf:"varint,4,opt,name=estimated_would_queue,json=estimatedWouldQueue,proto3" json:"estimated_would_queue,omitempty"`
+	Margins              []*SegmentMarginCount `protobuf:"bytes,5,rep,name=margins,proto3" json:"margins,omitempty"`
+	MinHealth            float64               `protobuf:"fixed64,6,opt,name=min_health,json=minHealth,proto3" json:"min_health,omitempty"`
+	MaxHealth            float64               `protobuf:"fixed64,7,opt,name=max_health,json=maxHealth,proto3" json:"max_health,omitempty"`
+	HealthBuckets        []*RepairHealthBucket `protobuf:"bytes,8,rep,name=health_buckets,json=healthBuckets,proto3" json:"health_buckets,omitempty"`
+	SampleFraction       float64               `protobuf:"fixed64,9,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                bool                  `protobuf:"varint,10,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DryRunRepairCheckerResponse) Reset()         { *m = DryRunRepairCheckerResponse{} }
+func (m *DryRunRepairCheckerResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunRepairCheckerResponse) ProtoMessage()    {}
+func (*DryRunRepairCheckerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+}
+func (m *DryRunRepairCheckerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunRepairCheckerResponse.Unmarshal(m, b)
+}
+func (m *DryRunRepairCheckerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunRepairCheckerResponse.Marshal(b, m, deterministic)
+}
+func (m *DryRunRepairCheckerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunRepairCheckerResponse.Merge(m, src)
+}
+func (m *DryRunRepairCheckerResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunRepairCheckerResponse.Size(m)
+}
+func (m *DryRunRepairCheckerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunRepairCheckerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunRepairCheckerResponse proto.InternalMessageInfo
+
+func (m *DryRunRepairCheckerResponse) GetSegmentsChecked() int64 {
+	if m != nil {
+		return m.SegmentsChecked
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetWouldQueue() int64 {
+	if m != nil {
+		return m.WouldQueue
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetIrreparable() int64 {
+	if m != nil {
+		return m.Irreparable
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetEstimatedWouldQueue() int64 {
+	if m != nil {
+		return m.EstimatedWouldQueue
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetMargins() []*SegmentMarginCount {
+	if m != nil {
+		return m.Margins
+	}
+	return nil
+}
+
+func (m *DryRunRepairCheckerResponse) GetMinHealth() float64 {
+	if m != nil {
+		return m.MinHealth
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetMaxHealth() float64 {
+	if m != nil {
+		return m.MaxHealth
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetHealthBuckets() []*RepairHealthBucket {
+	if m != nil {
+		return m.HealthBuckets
+	}
+	return nil
+}
+
+func (m *DryRunRepairCheckerResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *DryRunRepairCheckerResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+type DuplicatePieceNodesRequest struct {
+	SampleSize           int32    `protobuf:"varint,1,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,2,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DuplicatePieceNodesRequest) Reset()         { *m = DuplicatePieceNodesRequest{} }
+func (m *DuplicatePieceNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*DuplicatePieceNodesRequest) ProtoMessage()    {}
+func (*DuplicatePieceNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{34}
+}
+func (m *DuplicatePieceNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicatePieceNodesRequest.Unmarshal(m, b)
+}
+func (m *DuplicatePieceNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicatePieceNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *DuplicatePieceNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatePieceNodesRequest.Merge(m, src)
+}
+func (m *DuplicatePieceNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_DuplicatePieceNodesRequest.Size(m)
+}
+func (m *DuplicatePieceNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatePieceNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicatePieceNodesRequest proto.InternalMessageInfo
+
+func (m *DuplicatePieceNodesRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *DuplicatePieceNodesRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+func (m *DuplicatePieceNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DuplicatePieceNodesResponse struct {
+	Violations                 []*DuplicatePieceNode `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	More                       bool                  `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	SegmentsScanned            int64                 `protobuf:"varint,3,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	ViolatingSegments          int64                 `protobuf:"varint,4,opt,name=violating_segments,json=violatingSegments,proto3" json:"violating_segments,omitempty"`
+	EstimatedViolatingSegments int64                 `protobuf:"varint,5,opt,name=estimated_violating_segments,json=estimatedViolatingSegments,proto3" json:"estimated_violating_segments,omitempty"`
+	SampleFraction             float64               `protobuf:"fixed64,6,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                      bool                  `protobuf:"varint,7,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}              `json:"-"`
+	XXX_unrecognized           []byte                `json:"-"`
+	XXX_sizecache              int32                 `json:"-"`
+}
+
+func (m *DuplicatePieceNodesResponse) Reset()         { *m = DuplicatePieceNodesResponse{} }
+func (m *DuplicatePieceNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*DuplicatePieceNodesResponse) ProtoMessage()    {}
+func (*DuplicatePieceNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{35}
+}
+func (m *DuplicatePieceNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicatePieceNodesResponse.Unmarshal(m, b)
+}
+func (m *DuplicatePieceNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicatePieceNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *DuplicatePieceNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatePieceNodesResponse.Merge(m, src)
+}
+func (m *DuplicatePieceNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_DuplicatePieceNodesResponse.Size(m)
+}
+func (m *DuplicatePieceNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatePieceNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicatePieceNodesResponse proto.InternalMessageInfo
+
+func (m *DuplicatePieceNodesResponse) GetViolations() []*DuplicatePieceNode {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+func (m *DuplicatePieceNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *DuplicatePieceNodesResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *DuplicatePieceNodesResponse) GetViolatingSegments() int64 {
+	if m != nil {
+		return m.ViolatingSegments
+	}
+	return 0
+}
+
+func (m *DuplicatePieceNodesResponse) GetEstimatedViolatingSegments() int64 {
+	if m != nil {
+		return m.EstimatedViolatingSegments
+	}
+	return 0
+}
+
+func (m *DuplicatePieceNodesResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *DuplicatePieceNodesResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+type DuplicatePieceNode struct {
+	StreamId             []byte   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position             int64    `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	PieceNumbers         []int32  `protobuf:"varint,4,rep,packed,name=piece_numbers,json=pieceNumbers,proto3" json:"piece_numbers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DuplicatePieceNode) Reset()         { *m = DuplicatePieceNode{} }
+func (m *DuplicatePieceNode) String() string { return proto.CompactTextString(m) }
+func (*DuplicatePieceNode) ProtoMessage()    {}
+func (*DuplicatePieceNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{36}
+}
+func (m *DuplicatePieceNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicatePieceNode.Unmarshal(m, b)
+}
+func (m *DuplicatePieceNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicatePieceNode.Marshal(b, m, deterministic)
+}
+func (m *DuplicatePieceNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatePieceNode.Merge(m, src)
+}
+func (m *DuplicatePieceNode) XXX_Size() int {
+	return xxx_messageInfo_DuplicatePieceNode.Size(m)
+}
+func (m *DuplicatePieceNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatePieceNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicatePieceNode proto.InternalMessageInfo
+
+func (m *DuplicatePieceNode) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *DuplicatePieceNode) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *DuplicatePieceNode) GetPieceNumbers() []int32 {
+	if m != nil {
+		return m.PieceNumbers
+	}
+	return nil
+}
+
+type OrphanedProjectSegmentsRequest struct {
+	SampleSize           int32    `protobuf:"varint,1,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,2,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrphanedProjectSegmentsRequest) Reset()         { *m = OrphanedProjectSegmentsRequest{} }
+func (m *OrphanedProjectSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*OrphanedProjectSegmentsRequest) ProtoMessage()    {}
+func (*OrphanedProjectSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{37}
+}
+func (m *OrphanedProjectSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrphanedProjectSegmentsRequest.Unmarshal(m, b)
+}
+func (m *OrphanedProjectSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrphanedProjectSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *OrphanedProjectSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedProjectSegmentsRequest.Merge(m, src)
+}
+func (m *OrphanedProjectSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_OrphanedProjectSegmentsRequest.Size(m)
+}
+func (m *OrphanedProjectSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedProjectSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedProjectSegmentsRequest proto.InternalMessageInfo
+
+func (m *OrphanedProjectSegmentsRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *OrphanedProjectSegmentsRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+func (m *OrphanedProjectSegmentsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type OrphanedProjectSegmentsResponse struct {
+	Segments                  []*OrphanedProjectSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	More                      bool                      `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	Projects                  []*OrphanedProject        `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	SegmentsScanned           int64                     `protobuf:"varint,4,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	OrphanedSegments          int64                     `protobuf:"varint,5,opt,name=orphaned_segments,json=orphanedSegments,proto3" json:"orphaned_segments,omitempty"`
+	EstimatedOrphanedSegments int64                     `protobuf:"varint,6,opt,name=estimated_orphaned_segments,json=estimatedOrphanedSegments,proto3" json:"estimated_orphaned_segments,omitempty"`
+	SampleFraction            float64                   `protobuf:"fixed64,7,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                     bool                      `protobuf:"varint,8,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                  `json:"-"`
+	XXX_unrecognized          []byte                    `json:"-"`
+	XXX_sizecache             int32                     `json:"-"`
+}
+
+func (m *OrphanedProjectSegmentsResponse) Reset()         { *m = OrphanedProjectSegmentsResponse{} }
+func (m *OrphanedProjectSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*OrphanedProjectSegmentsResponse) ProtoMessage()    {}
+func (*OrphanedProjectSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{38}
+}
+func (m *OrphanedProjectSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrphanedProjectSegmentsResponse.Unmarshal(m, b)
+}
+func (m *OrphanedProjectSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrphanedProjectSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *OrphanedProjectSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedProjectSegmentsResponse.Merge(m, src)
+}
+func (m *OrphanedProjectSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_OrphanedProjectSegmentsResponse.Size(m)
+}
+func (m *OrphanedProjectSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedProjectSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedProjectSegmentsResponse proto.InternalMessageInfo
+
+func (m *OrphanedProjectSegmentsResponse) GetSegments() []*OrphanedProjectSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetProjects() []*OrphanedProject {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetOrphanedSegments() int64 {
+	if m != nil {
+		return m.OrphanedSegments
+	}
+	return 0
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetEstimatedOrphanedSegments() int64 {
+	if m != nil {
+		return m.EstimatedOrphanedSegments
+	}
+	return 0
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *OrphanedProjectSegmentsResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+type OrphanedProject struct {
+	ProjectId            []byte                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Reason               OrphanedProject_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=satellite.inspector.OrphanedProject_Reason" json:"reason,omitempty"`
+	Segments             int64                  `protobuf:"varint,3,opt,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *OrphanedProject) Reset()         { *m = OrphanedProject{} }
+func (m *OrphanedProject) String() string { return proto.CompactTextString(m) }
+func (*OrphanedProject) ProtoMessage()    {}
+func (*OrphanedProject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{39}
+}
+func (m *OrphanedProject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrphanedProject.Unmarshal(m, b)
+}
+func (m *OrphanedProject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrphanedProject.Marshal(b, m, deterministic)
+}
+func (m *OrphanedProject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedProject.Merge(m, src)
+}
+func (m *OrphanedProject) XXX_Size() int {
+	return xxx_messageInfo_OrphanedProject.Size(m)
+}
+func (m *OrphanedProject) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedProject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedProject proto.InternalMessageInfo
+
+func (m *OrphanedProject) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *OrphanedProject) GetReason() OrphanedProject_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return OrphanedProject_PROJECT_DELETED
+}
+
+func (m *OrphanedProject) GetSegments() int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return 0
+}
+
+type OrphanedProjectSegment struct {
+	StreamId             []byte                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position             int64                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	ProjectId            []byte                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Reason               OrphanedProject_Reason `protobuf:"varint,4,opt,name=reason,proto3,enum=satellite.inspector.OrphanedProject_Reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *OrphanedProjectSegment) Reset()         { *m = OrphanedProjectSegment{} }
+func (m *OrphanedProjectSegment) String() string { return proto.CompactTextString(m) }
+func (*OrphanedProjectSegment) ProtoMessage()    {}
+func (*OrphanedProjectSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{40}
+}
+func (m *OrphanedProjectSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrphanedProjectSegment.Unmarshal(m, b)
+}
+func (m *OrphanedProjectSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrphanedProjectSegment.Marshal(b, m, deterministic)
+}
+func (m *OrphanedProjectSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedProjectSegment.Merge(m, src)
+}
+func (m *OrphanedProjectSegment) XXX_Size() int {
+	return xxx_messageInfo_OrphanedProjectSegment.Size(m)
+}
+func (m *OrphanedProjectSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedProjectSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedProjectSegment proto.InternalMessageInfo
+
+func (m *OrphanedProjectSegment) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *OrphanedProjectSegment) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *OrphanedProjectSegment) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *OrphanedProjectSegment) GetReason() OrphanedProject_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return OrphanedProject_PROJECT_DELETED
+}
+
+type NodeStorageByProjectRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	SampleSize           int32    `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,3,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeStorageByProjectRequest) Reset()         { *m = NodeStorageByProjectRequest{} }
+func (m *NodeStorageByProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeStorageByProjectRequest) ProtoMessage()    {}
+func (*NodeStorageByProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{41}
+}
+func (m *NodeStorageByProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStorageByProjectRequest.Unmarshal(m, b)
+}
+func (m *NodeStorageByProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStorageByProjectRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeStorageByProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStorageByProjectRequest.Merge(m, src)
+}
+func (m *NodeStorageByProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeStorageByProjectRequest.Size(m)
+}
+func (m *NodeStorageByProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStorageByProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStorageByProjectRequest proto.InternalMessageInfo
+
+func (m *NodeStorageByProjectRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *NodeStorageByProjectRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+func (m *NodeStorageByProjectRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodeStorageByProjectResponse struct {
+	NodeId               NodeID                `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Projects             []*NodeProjectStorage `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	More                 bool                  `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	SegmentsScanned      int64                 `protobuf:"varint,4,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	SampledPieces        int64                 `protobuf:"varint,5,opt,name=sampled_pieces,json=sampledPieces,proto3" json:"sampled_pieces,omitempty"`
+	UnownedPieces        int64                 `protobuf:"varint,6,opt,name=unowned_pieces,json=unownedPieces,proto3" json:"unowned_pieces,omitempty"`
+	EstimatedPieces      int64                 `protobuf:"varint,7,opt,name=estimated_pieces,json=estimatedPieces,proto3" json:"estimated_pieces,omitempty"`
+	SampleFraction       float64               `protobuf:"fixed64,8,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                bool                  `protobuf:"varint,9,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *NodeStorageByProjectResponse) Reset()         { *m = NodeStorageByProjectResponse{} }
+func (m *NodeStorageByProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeStorageByProjectResponse) ProtoMessage()    {}
+func (*NodeStorageByProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{42}
+}
+func (m *NodeStorageByProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStorageByProjectResponse.Unmarshal(m, b)
+}
+func (m *NodeStorageByProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStorageByProjectResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeStorageByProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStorageByProjectResponse.Merge(m, src)
+}
+func (m *NodeStorageByProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeStorageByProjectResponse.Size(m)
+}
+func (m *NodeStorageByProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStorageByProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStorageByProjectResponse proto.InternalMessageInfo
+
+func (m *NodeStorageByProjectResponse) GetProjects() []*NodeProjectStorage {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *NodeStorageByProjectResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *NodeStorageByProjectResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *NodeStorageByProjectResponse) GetSampledPieces() int64 {
+	if m != nil {
+		return m.SampledPieces
+	}
+	return 0
+}
+
+func (m *NodeStorageByProjectResponse) GetUnownedPieces() int64 {
+	if m != nil {
+		return m.UnownedPieces
+	}
+	return 0
+}
+
+func (m *NodeStorageByProjectResponse) GetEstimatedPieces() int64 {
+	if m != nil {
+		return m.EstimatedPieces
+	}
+	return 0
+}
+
+func (m *NodeStorageByProjectResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *NodeStorageByProjectResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+type NodeProjectStorage struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SampledPieces        int64    `protobuf:"varint,2,opt,name=sampled_pieces,json=sampledPieces,proto3" json:"sampled_pieces,omitempty"`
+	EstimatedPieces      int64    `protobuf:"varint,3,opt,name=estimated_pieces,json=estimatedPieces,proto3" json:"estimated_pieces,omitempty"`
+	Share                float64  `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeProjectStorage) Reset()         { *m = NodeProjectStorage{} }
+func (m *NodeProjectStorage) String() string { return proto.CompactTextString(m) }
+func (*NodeProjectStorage) ProtoMessage()    {}
+func (*NodeProjectStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{43}
+}
+func (m *NodeProjectStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeProjectStorage.Unmarshal(m, b)
+}
+func (m *NodeProjectStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeProjectStorage.Marshal(b, m, deterministic)
+}
+func (m *NodeProjectStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeProjectStorage.Merge(m, src)
+}
+func (m *NodeProjectStorage) XXX_Size() int {
+	return xxx_messageInfo_NodeProjectStorage.Size(m)
+}
+func (m *NodeProjectStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeProjectStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeProjectStorage proto.InternalMessageInfo
+
+func (m *NodeProjectStorage) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *NodeProjectStorage) GetSampledPieces() int64 {
+	if m != nil {
+		return m.SampledPieces
+	}
+	return 0
+}
+
+func (m *NodeProjectStorage) GetEstimatedPieces() int64 {
+	if m != nil {
+		return m.EstimatedPieces
+	}
+	return 0
+}
+
+func (m *NodeProjectStorage) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+type RepairQueueAgeHistogramRequest struct {
+	// ascending upper bounds of the age ranges, defaults to 1h, 6h and 24h
+	UpperBounds          []time.Duration `protobuf:"bytes,1,rep,name=upper_bounds,json=upperBounds,proto3,stdduration" json:"upper_bounds"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RepairQueueAgeHistogramRequest) Reset()         { *m = RepairQueueAgeHistogramRequest{} }
+func (m *RepairQueueAgeHistogramRequest) String() string { return proto.CompactTextString(m) }
+func (*RepairQueueAgeHistogramRequest) ProtoMessage()    {}
+func (*RepairQueueAgeHistogramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{44}
+}
+func (m *RepairQueueAgeHistogramRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairQueueAgeHistogramRequest.Unmarshal(m, b)
+}
+func (m *RepairQueueAgeHistogramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairQueueAgeHistogramRequest.Marshal(b, m, deterministic)
+}
+func (m *RepairQueueAgeHistogramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairQueueAgeHistogramRequest.Merge(m, src)
+}
+func (m *RepairQueueAgeHistogramRequest) XXX_Size() int {
+	return xxx_messageInfo_RepairQueueAgeHistogramRequest.Size(m)
+}
+func (m *RepairQueueAgeHistogramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairQueueAgeHistogramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairQueueAgeHistogramRequest proto.InternalMessageInfo
+
+func (m *RepairQueueAgeHistogramRequest) GetUpperBounds() []time.Duration {
+	if m != nil {
+		return m.UpperBounds
+	}
+	return nil
+}
+
+type RepairQueueAgeHistogramResponse struct {
+	Length               int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	OldestAge            time.Duration          `protobuf:"bytes,2,opt,name=oldest_age,json=oldestAge,proto3,stdduration" json:"oldest_age"`
+	Ranges               []*RepairQueueAgeRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RepairQueueAgeHistogramResponse) Reset()         { *m = RepairQueueAgeHistogramResponse{} }
+func (m *RepairQueueAgeHistogramResponse) String() string { return proto.CompactTextString(m) }
+func (*RepairQueueAgeHistogramResponse) ProtoMessage()    {}
+func (*RepairQueueAgeHistogramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{45}
+}
+func (m *RepairQueueAgeHistogramResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairQueueAgeHistogramResponse.Unmarshal(m, b)
+}
+func (m *RepairQueueAgeHistogramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairQueueAgeHistogramResponse.Marshal(b, m, deterministic)
+}
+func (m *RepairQueueAgeHistogramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairQueueAgeHistogramResponse.Merge(m, src)
+}
+func (m *RepairQueueAgeHistogramResponse) XXX_Size() int {
+	return xxx_messageInfo_RepairQueueAgeHistogramResponse.Size(m)
+}
+func (m *RepairQueueAgeHistogramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairQueueAgeHistogramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairQueueAgeHistogramResponse proto.InternalMessageInfo
+
+func (m *RepairQueueAgeHistogramResponse) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *RepairQueueAgeHistogramResponse) GetOldestAge() time.Duration {
+	if m != nil {
+		return m.OldestAge
+	}
+	return 0
+}
+
+func (m *RepairQueueAgeHistogramResponse) GetRanges() []*RepairQueueAgeRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+type RepairQueueAgeRange struct {
+	LowerBound           time.Duration `protobuf:"bytes,1,opt,name=lower_bound,json=lowerBound,proto3,stdduration" json:"lower_bound"`
+	UpperBound           time.Duration `protobuf:"bytes,2,opt,name=upper_bound,json=upperBound,proto3,stdduration" json:"upper_bound"`
+	Count                int64         `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RepairQueueAgeRange) Reset()         { *m = RepairQueueAgeRange{} }
+func (m *RepairQueueAgeRange) String() string { return proto.CompactTextString(m) }
+func (*RepairQueueAgeRange) ProtoMessage()    {}
+func (*RepairQueueAgeRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{46}
+}
+func (m *RepairQueueAgeRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairQueueAgeRange.Unmarshal(m, b)
+}
+func (m *RepairQueueAgeRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairQueueAgeRange.Marshal(b, m, deterministic)
+}
+func (m *RepairQueueAgeRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairQueueAgeRange.Merge(m, src)
+}
+func (m *RepairQueueAgeRange) XXX_Size() int {
+	return xxx_messageInfo_RepairQueueAgeRange.Size(m)
+}
+func (m *RepairQueueAgeRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairQueueAgeRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairQueueAgeRange proto.InternalMessageInfo
+
+func (m *RepairQueueAgeRange) GetLowerBound() time.Duration {
+	if m != nil {
+		return m.LowerBound
+	}
+	return 0
+}
+
+func (m *RepairQueueAgeRange) GetUpperBound() time.Duration {
+	if m != nil {
+		return m.UpperBound
+	}
+	return 0
+}
+
+func (m *RepairQueueAgeRange) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type NodeStatus struct {
+	Online                bool     `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	Vetted                bool     `protobuf:"varint,2,opt,name=vetted,proto3" json:"vetted,omitempty"`
+	Disqualified          bool     `protobuf:"varint,3,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	UnknownAuditSuspended bool     `protobuf:"varint,4,opt,name=unknown_audit_suspended,json=unknownAuditSuspended,proto3" json:"unknown_audit_suspended,omitempty"`
+	OfflineSuspended      bool     `protobuf:"varint,5,opt,name=offline_suspended,json=offlineSuspended,proto3" json:"offline_suspended,omitempty"`
+	Exiting               bool     `protobuf:"varint,6,opt,name=exiting,proto3" json:"exiting,omitempty"`
+	Exited                bool     `protobuf:"varint,7,opt,name=exited,proto3" json:"exited,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *NodeStatus) Reset()         { *m = NodeStatus{} }
+func (m *NodeStatus) String() string { return proto.CompactTextString(m) }
+func (*NodeStatus) ProtoMessage()    {}
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{47}
+}
+func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStatus.Unmarshal(m, b)
+}
+func (m *NodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStatus.Marshal(b, m, deterministic)
+}
+func (m *NodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStatus.Merge(m, src)
+}
+func (m *NodeStatus) XXX_Size() int {
+	return xxx_messageInfo_NodeStatus.Size(m)
+}
+func (m *NodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
+
+func (m *NodeStatus) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+func (m *NodeStatus) GetVetted() bool {
+	if m != nil {
+		return m.Vetted
+	}
+	return false
+}
+
+func (m *NodeStatus) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
+func (m *NodeStatus) GetUnknownAuditSuspended() bool {
+	if m != nil {
+		return m.UnknownAuditSuspended
+	}
+	return false
+}
+
+func (m *NodeStatus) GetOfflineSuspended() bool {
+	if m != nil {
+		return m.OfflineSuspended
+	}
+	return false
+}
+
+func (m *NodeStatus) GetExiting() bool {
+	if m != nil {
+		return m.Exiting
+	}
+	return false
+}
+
+func (m *NodeStatus) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+type OverlayNode struct {
+	NodeId               NodeID      `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Address              string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	LastIpPort           string      `protobuf:"bytes,3,opt,name=last_ip_port,json=lastIpPort,proto3" json:"last_ip_port,omitempty"`
+	LastNet              string      `protobuf:"bytes,4,opt,name=last_net,json=lastNet,proto3" json:"last_net,omitempty"`
+	Status               *NodeStatus `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *OverlayNode) Reset()         { *m = OverlayNode{} }
+func (m *OverlayNode) String() string { return proto.CompactTextString(m) }
+func (*OverlayNode) ProtoMessage()    {}
+func (*OverlayNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{48}
+}
+func (m *OverlayNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OverlayNode.Unmarshal(m, b)
+}
+func (m *OverlayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OverlayNode.Marshal(b, m, deterministic)
+}
+func (m *OverlayNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverlayNode.Merge(m, src)
+}
+func (m *OverlayNode) XXX_Size() int {
+	return xxx_messageInfo_OverlayNode.Size(m)
+}
+func (m *OverlayNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverlayNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverlayNode proto.InternalMessageInfo
+
+func (m *OverlayNode) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OverlayNode) GetLastIpPort() string {
+	if m != nil {
+		return m.LastIpPort
+	}
+	return ""
+}
+
+func (m *OverlayNode) GetLastNet() string {
+	if m != nil {
+		return m.LastNet
+	}
+	return ""
+}
+
+func (m *OverlayNode) GetStatus() *NodeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type NodesByIPRequest struct {
+	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	StartAfter           NodeID   `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3,customtype=NodeID" json:"start_after"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodesByIPRequest) Reset()         { *m = NodesByIPRequest{} }
+func (m *NodesByIPRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesByIPRequest) ProtoMessage()    {}
+func (*NodesByIPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{49}
+}
+func (m *NodesByIPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesByIPRequest.Unmarshal(m, b)
+}
+func (m *NodesByIPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesByIPRequest.Marshal(b, m, deterministic)
+}
+func (m *NodesByIPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesByIPRequest.Merge(m, src)
+}
+func (m *NodesByIPRequest) XXX_Size() int {
+	return xxx_messageInfo_NodesByIPRequest.Size(m)
+}
+func (m *NodesByIPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesByIPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesByIPRequest proto.InternalMessageInfo
+
+func (m *NodesByIPRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *NodesByIPRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodesByIPResponse struct {
+	Nodes                []*OverlayNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool           `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *NodesByIPResponse) Reset()         { *m = NodesByIPResponse{} }
+func (m *NodesByIPResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesByIPResponse) ProtoMessage()    {}
+func (*NodesByIPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{50}
+}
+func (m *NodesByIPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesByIPResponse.Unmarshal(m, b)
+}
+func (m *NodesByIPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesByIPResponse.Marshal(b, m, deterministic)
+}
+func (m *NodesByIPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesByIPResponse.Merge(m, src)
+}
+func (m *NodesByIPResponse) XXX_Size() int {
+	return xxx_messageInfo_NodesByIPResponse.Size(m)
+}
+func (m *NodesByIPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesByIPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesByIPResponse proto.InternalMessageInfo
+
+func (m *NodesByIPResponse) GetNodes() []*OverlayNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NodesByIPResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type IPsWithManyNodesRequest struct {
+	MinNodes             int32    `protobuf:"varint,1,opt,name=min_nodes,json=minNodes,proto3" json:"min_nodes,omitempty"`
+	StartAfter           string   `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPsWithManyNodesRequest) Reset()         { *m = IPsWithManyNodesRequest{} }
+func (m *IPsWithManyNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*IPsWithManyNodesRequest) ProtoMessage()    {}
+func (*IPsWithManyNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{51}
+}
+func (m *IPsWithManyNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPsWithManyNodesRequest.Unmarshal(m, b)
+}
+func (m *IPsWithManyNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPsWithManyNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *IPsWithManyNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPsWithManyNodesRequest.Merge(m, src)
+}
+func (m *IPsWithManyNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_IPsWithManyNodesRequest.Size(m)
+}
+func (m *IPsWithManyNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPsWithManyNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPsWithManyNodesRequest proto.InternalMessageInfo
+
+func (m *IPsWithManyNodesRequest) GetMinNodes() int32 {
+	if m != nil {
+		return m.MinNodes
+	}
+	return 0
+}
+
+func (m *IPsWithManyNodesRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
+func (m *IPsWithManyNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type IPsWithManyNodesResponse struct {
+	Ips                  []*IPNodes `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips,omitempty"`
+	More                 bool       `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *IPsWithManyNodesResponse) Reset()         { *m = IPsWithManyNodesResponse{} }
+func (m *IPsWithManyNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*IPsWithManyNodesResponse) ProtoMessage()    {}
+func (*IPsWithManyNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{52}
+}
+func (m *IPsWithManyNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPsWithManyNodesResponse.Unmarshal(m, b)
+}
+func (m *IPsWithManyNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPsWithManyNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *IPsWithManyNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPsWithManyNodesResponse.Merge(m, src)
+}
+func (m *IPsWithManyNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_IPsWithManyNodesResponse.Size(m)
+}
+func (m *IPsWithManyNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPsWithManyNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPsWithManyNodesResponse proto.InternalMessageInfo
+
+func (m *IPsWithManyNodesResponse) GetIps() []*IPNodes {
+	if m != nil {
+		return m.Ips
+	}
+	return nil
+}
+
+func (m *IPsWithManyNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type IPNodes struct {
+	Ip                   string         `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Nodes                []*OverlayNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *IPNodes) Reset()         { *m = IPNodes{} }
+func (m *IPNodes) String() string { return proto.CompactTextString(m) }
+func (*IPNodes) ProtoMessage()    {}
+func (*IPNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{53}
+}
+func (m *IPNodes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPNodes.Unmarshal(m, b)
+}
+func (m *IPNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPNodes.Marshal(b, m, deterministic)
+}
+func (m *IPNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPNodes.Merge(m, src)
+}
+func (m *IPNodes) XXX_Size() int {
+	return xxx_messageInfo_IPNodes.Size(m)
+}
+func (m *IPNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPNodes proto.InternalMessageInfo
+
+func (m *IPNodes) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *IPNodes) GetNodes() []*OverlayNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type ExplainNodeSelectionRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Placement            int32    `protobuf:"varint,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainNodeSelectionRequest) Reset()         { *m = ExplainNodeSelectionRequest{} }
+func (m *ExplainNodeSelectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainNodeSelectionRequest) ProtoMessage()    {}
+func (*ExplainNodeSelectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{54}
+}
+func (m *ExplainNodeSelectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainNodeSelectionRequest.Unmarshal(m, b)
+}
+func (m *ExplainNodeSelectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainNodeSelectionRequest.Marshal(b, m, deterministic)
+}
+func (m *ExplainNodeSelectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainNodeSelectionRequest.Merge(m, src)
+}
+func (m *ExplainNodeSelectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ExplainNodeSelectionRequest.Size(m)
+}
+func (m *ExplainNodeSelectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainNodeSelectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainNodeSelectionRequest proto.InternalMessageInfo
+
+func (m *ExplainNodeSelectionRequest) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+type ExplainNodeSelectionResponse struct {
+	Reason               ExplainNodeSelectionResponse_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=satellite.inspector.ExplainNodeSelectionResponse_Reason" json:"reason,omitempty"`
+	Node                 *OverlayNode                        `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ExplainNodeSelectionResponse) Reset()         { *m = ExplainNodeSelectionResponse{} }
+func (m *ExplainNodeSelectionResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainNodeSelectionResponse) ProtoMessage()    {}
+func (*ExplainNodeSelectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{55}
+}
+func (m *ExplainNodeSelectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainNodeSelectionResponse.Unmarshal(m, b)
+}
+func (m *ExplainNodeSelectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainNodeSelectionResponse.Marshal(b, m, deterministic)
+}
+func (m *ExplainNodeSelectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainNodeSelectionResponse.Merge(m, src)
+}
+func (m *ExplainNodeSelectionResponse) XXX_Size() int {
+	return xxx_messageInfo_ExplainNodeSelectionResponse.Size(m)
+}
+func (m *ExplainNodeSelectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainNodeSelectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainNodeSelectionResponse proto.InternalMessageInfo
+
+func (m *ExplainNodeSelectionResponse) GetReason() ExplainNodeSelectionResponse_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExplainNodeSelectionResponse_WOULD_BE_SELECTED
+}
+
+func (m *ExplainNodeSelectionResponse) GetNode() *OverlayNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type TopNodesByStorageRequest struct {
+	OnlineOnly           bool     `protobuf:"varint,1,opt,name=online_only,json=onlineOnly,proto3" json:"online_only,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopNodesByStorageRequest) Reset()         { *m = TopNodesByStorageRequest{} }
+func (m *TopNodesByStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*TopNodesByStorageRequest) ProtoMessage()    {}
+func (*TopNodesByStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{56}
+}
+func (m *TopNodesByStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopNodesByStorageRequest.Unmarshal(m, b)
+}
+func (m *TopNodesByStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopNodesByStorageRequest.Marshal(b, m, deterministic)
+}
+func (m *TopNodesByStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopNodesByStorageRequest.Merge(m, src)
+}
+func (m *TopNodesByStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_TopNodesByStorageRequest.Size(m)
+}
+func (m *TopNodesByStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopNodesByStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopNodesByStorageRequest proto.InternalMessageInfo
+
+func (m *TopNodesByStorageRequest) GetOnlineOnly() bool {
+	if m != nil {
+		return m.OnlineOnly
+	}
+	return false
+}
+
+func (m *TopNodesByStorageRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TopNodesByStorageRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TopNodesByStorageResponse struct {
+	Nodes                []*NodeStorage `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool           `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TopNodesByStorageResponse) Reset()         { *m = TopNodesByStorageResponse{} }
+func (m *TopNodesByStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*TopNodesByStorageResponse) ProtoMessage()    {}
+func (*TopNodesByStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{57}
+}
+func (m *TopNodesByStorageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopNodesByStorageResponse.Unmarshal(m, b)
+}
+func (m *TopNodesByStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopNodesByStorageResponse.Marshal(b, m, deterministic)
+}
+func (m *TopNodesByStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopNodesByStorageResponse.Merge(m, src)
+}
+func (m *TopNodesByStorageResponse) XXX_Size() int {
+	return xxx_messageInfo_TopNodesByStorageResponse.Size(m)
+}
+func (m *TopNodesByStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopNodesByStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopNodesByStorageResponse proto.InternalMessageInfo
+
+func (m *TopNodesByStorageResponse) GetNodes() []*NodeStorage {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *TopNodesByStorageResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type NodeStorage struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	StoredBytes          int64    `protobuf:"varint,2,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty"`
+	PieceCount           int64    `protobuf:"varint,3,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
+	Online               bool     `protobuf:"varint,4,opt,name=online,proto3" json:"online,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeStorage) Reset()         { *m = NodeStorage{} }
+func (m *NodeStorage) String() string { return proto.CompactTextString(m) }
+func (*NodeStorage) ProtoMessage()    {}
+func (*NodeStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{58}
+}
+func (m *NodeStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStorage.Unmarshal(m, b)
+}
+func (m *NodeStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStorage.Marshal(b, m, deterministic)
+}
+func (m *NodeStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStorage.Merge(m, src)
+}
+func (m *NodeStorage) XXX_Size() int {
+	return xxx_messageInfo_NodeStorage.Size(m)
+}
+func (m *NodeStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStorage proto.InternalMessageInfo
+
+func (m *NodeStorage) GetStoredBytes() int64 {
+	if m != nil {
+		return m.StoredBytes
+	}
+	return 0
+}
+
+func (m *NodeStorage) GetPieceCount() int64 {
+	if m != nil {
+		return m.PieceCount
+	}
+	return 0
+}
+
+func (m *NodeStorage) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+type RecalculateReputationRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecalculateReputationRequest) Reset()         { *m = RecalculateReputationRequest{} }
+func (m *RecalculateReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*RecalculateReputationRequest) ProtoMessage()    {}
+func (*RecalculateReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{59}
+}
+func (m *RecalculateReputationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecalculateReputationRequest.Unmarshal(m, b)
+}
+func (m *RecalculateReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecalculateReputationRequest.Marshal(b, m, deterministic)
+}
+func (m *RecalculateReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecalculateReputationRequest.Merge(m, src)
+}
+func (m *RecalculateReputationRequest) XXX_Size() int {
+	return xxx_messageInfo_RecalculateReputationRequest.Size(m)
+}
+func (m *RecalculateReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecalculateReputationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecalculateReputationRequest proto.InternalMessageInfo
+
+type RecalculateReputationResponse struct {
+	Before               *NodeReputation `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After                *NodeReputation `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RecalculateReputationResponse) Reset()         { *m = RecalculateReputationResponse{} }
+func (m *RecalculateReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*RecalculateReputationResponse) ProtoMessage()    {}
+func (*RecalculateReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{60}
+}
+func (m *RecalculateReputationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecalculateReputationResponse.Unmarshal(m, b)
+}
+func (m *RecalculateReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecalculateReputationResponse.Marshal(b, m, deterministic)
+}
+func (m *RecalculateReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecalculateReputationResponse.Merge(m, src)
+}
+func (m *RecalculateReputationResponse) XXX_Size() int {
+	return xxx_messageInfo_RecalculateReputationResponse.Size(m)
+}
+func (m *RecalculateReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecalculateReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecalculateReputationResponse proto.InternalMessageInfo
+
+func (m *RecalculateReputationResponse) GetBefore() *NodeReputation {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *RecalculateReputationResponse) GetAfter() *NodeReputation {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type NodeReputation struct {
+	AuditScore           float64  `protobuf:"fixed64,1,opt,name=audit_score,json=auditScore,proto3" json:"audit_score,omitempty"`
+	UnknownAuditScore    float64  `protobuf:"fixed64,2,opt,name=unknown_audit_score,json=unknownAuditScore,proto3" json:"unknown_audit_score,omitempty"`
+	OnlineScore          float64  `protobuf:"fixed64,3,opt,name=online_score,json=onlineScore,proto3" json:"online_score,omitempty"`
+	OnlineWindows        int32    `protobuf:"varint,4,opt,name=online_windows,json=onlineWindows,proto3" json:"online_windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeReputation) Reset()         { *m = NodeReputation{} }
+func (m *NodeReputation) String() string { return proto.CompactTextString(m) }
+func (*NodeReputation) ProtoMessage()    {}
+func (*NodeReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{61}
+}
+func (m *NodeReputation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeReputation.Unmarshal(m, b)
+}
+func (m *NodeReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeReputation.Marshal(b, m, deterministic)
+}
+func (m *NodeReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeReputation.Merge(m, src)
+}
+func (m *NodeReputation) XXX_Size() int {
+	return xxx_messageInfo_NodeReputation.Size(m)
+}
+func (m *NodeReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeReputation proto.InternalMessageInfo
+
+func (m *NodeReputation) GetAuditScore() float64 {
+	if m != nil {
+		return m.AuditScore
+	}
+	return 0
+}
+
+func (m *NodeReputation) GetUnknownAuditScore() float64 {
+	if m != nil {
+		return m.UnknownAuditScore
+	}
+	return 0
+}
+
+func (m *NodeReputation) GetOnlineScore() float64 {
+	if m != nil {
+		return m.OnlineScore
+	}
+	return 0
+}
+
+func (m *NodeReputation) GetOnlineWindows() int32 {
+	if m != nil {
+		return m.OnlineWindows
+	}
+	return 0
+}
+
+type NodesNearOfflineDQRequest struct {
+	Threshold            float64  `protobuf:"fixed64,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodesNearOfflineDQRequest) Reset()         { *m = NodesNearOfflineDQRequest{} }
+func (m *NodesNearOfflineDQRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesNearOfflineDQRequest) ProtoMessage()    {}
+func (*NodesNearOfflineDQRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{62}
+}
+func (m *NodesNearOfflineDQRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesNearOfflineDQRequest.Unmarshal(m, b)
+}
+func (m *NodesNearOfflineDQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesNearOfflineDQRequest.Marshal(b, m, deterministic)
+}
+func (m *NodesNearOfflineDQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesNearOfflineDQRequest.Merge(m, src)
+}
+func (m *NodesNearOfflineDQRequest) XXX_Size() int {
+	return xxx_messageInfo_NodesNearOfflineDQRequest.Size(m)
+}
+func (m *NodesNearOfflineDQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesNearOfflineDQRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesNearOfflineDQRequest proto.InternalMessageInfo
+
+func (m *NodesNearOfflineDQRequest) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *NodesNearOfflineDQRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NodesNearOfflineDQRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodesNearOfflineDQResponse struct {
+	Nodes                []*NodeOfflineRisk `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool               `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	OfflineDqEnabled     bool               `protobuf:"varint,3,opt,name=offline_dq_enabled,json=offlineDqEnabled,proto3" json:"offline_dq_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *NodesNearOfflineDQResponse) Reset()         { *m = NodesNearOfflineDQResponse{} }
+func (m *NodesNearOfflineDQResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesNearOfflineDQResponse) ProtoMessage()    {}
+func (*NodesNearOfflineDQResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{63}
+}
+func (m *NodesNearOfflineDQResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesNearOfflineDQResponse.Unmarshal(m, b)
+}
+func (m *NodesNearOfflineDQResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesNearOfflineDQResponse.Marshal(b, m, deterministic)
+}
+func (m *NodesNearOfflineDQResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesNearOfflineDQResponse.Merge(m, src)
+}
+func (m *NodesNearOfflineDQResponse) XXX_Size() int {
+	return xxx_messageInfo_NodesNearOfflineDQResponse.Size(m)
+}
+func (m *NodesNearOfflineDQResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesNearOfflineDQResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesNearOfflineDQResponse proto.InternalMessageInfo
+
+func (m *NodesNearOfflineDQResponse) GetNodes() []*NodeOfflineRisk {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NodesNearOfflineDQResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *NodesNearOfflineDQResponse) GetOfflineDqEnabled() bool {
+	if m != nil {
+		return m.OfflineDqEnabled
+	}
+	return false
+}
+
+type NodeOfflineRisk struct {
+	NodeId               NodeID     `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	OnlineScore          float64    `protobuf:"fixed64,2,opt,name=online_score,json=onlineScore,proto3" json:"online_score,omitempty"`
+	ProjectedDq          *time.Time `protobuf:"bytes,3,opt,name=projected_dq,json=projectedDq,proto3,stdtime" json:"projected_dq,omitempty"`
+	UnderReview          bool       `protobuf:"varint,4,opt,name=under_review,json=underReview,proto3" json:"under_review,omitempty"`
+	OfflineSuspended     bool       `protobuf:"varint,5,opt,name=offline_suspended,json=offlineSuspended,proto3" json:"offline_suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *NodeOfflineRisk) Reset()         { *m = NodeOfflineRisk{} }
+func (m *NodeOfflineRisk) String() string { return proto.CompactTextString(m) }
+func (*NodeOfflineRisk) ProtoMessage()    {}
+func (*NodeOfflineRisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{64}
+}
+func (m *NodeOfflineRisk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeOfflineRisk.Unmarshal(m, b)
+}
+func (m *NodeOfflineRisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeOfflineRisk.Marshal(b, m, deterministic)
+}
+func (m *NodeOfflineRisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeOfflineRisk.Merge(m, src)
+}
+func (m *NodeOfflineRisk) XXX_Size() int {
+	return xxx_messageInfo_NodeOfflineRisk.Size(m)
+}
+func (m *NodeOfflineRisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeOfflineRisk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeOfflineRisk proto.InternalMessageInfo
+
+func (m *NodeOfflineRisk) GetOnlineScore() float64 {
+	if m != nil {
+		return m.OnlineScore
+	}
+	return 0
+}
+
+func (m *NodeOfflineRisk) GetProjectedDq() *time.Time {
+	if m != nil {
+		return m.ProjectedDq
+	}
+	return nil
+}
+
+func (m *NodeOfflineRisk) GetUnderReview() bool {
+	if m != nil {
+		return m.UnderReview
+	}
+	return false
+}
+
+func (m *NodeOfflineRisk) GetOfflineSuspended() bool {
+	if m != nil {
+		return m.OfflineSuspended
+	}
+	return false
+}
+
+type GetOperatorContactRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOperatorContactRequest) Reset()         { *m = GetOperatorContactRequest{} }
+func (m *GetOperatorContactRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorContactRequest) ProtoMessage()    {}
+func (*GetOperatorContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{65}
+}
+func (m *GetOperatorContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOperatorContactRequest.Unmarshal(m, b)
+}
+func (m *GetOperatorContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOperatorContactRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOperatorContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorContactRequest.Merge(m, src)
+}
+func (m *GetOperatorContactRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOperatorContactRequest.Size(m)
+}
+func (m *GetOperatorContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOperatorContactRequest proto.InternalMessageInfo
+
+type GetOperatorContactResponse struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	EmailRedacted        bool     `protobuf:"varint,2,opt,name=email_redacted,json=emailRedacted,proto3" json:"email_redacted,omitempty"`
+	Wallet               string   `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	WalletFeatures       []string `protobuf:"bytes,4,rep,name=wallet_features,json=walletFeatures,proto3" json:"wallet_features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOperatorContactResponse) Reset()         { *m = GetOperatorContactResponse{} }
+func (m *GetOperatorContactResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorContactResponse) ProtoMessage()    {}
+func (*GetOperatorContactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{66}
+}
+func (m *GetOperatorContactResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOperatorContactResponse.Unmarshal(m, b)
+}
+func (m *GetOperatorContactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOperatorContactResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOperatorContactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorContactResponse.Merge(m, src)
+}
+func (m *GetOperatorContactResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOperatorContactResponse.Size(m)
+}
+func (m *GetOperatorContactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorContactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOperatorContactResponse proto.InternalMessageInfo
+
+func (m *GetOperatorContactResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *GetOperatorContactResponse) GetEmailRedacted() bool {
+	if m != nil {
+		return m.EmailRedacted
+	}
+	return false
+}
+
+func (m *GetOperatorContactResponse) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+func (m *GetOperatorContactResponse) GetWalletFeatures() []string {
+	if m != nil {
+		return m.WalletFeatures
+	}
+	return nil
+}
+
+type NodesByUptimeBandRequest struct {
+	WindowHours          int32     `protobuf:"varint,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	LowerBounds          []float64 `protobuf:"fixed64,2,rep,packed,name=lower_bounds,json=lowerBounds,proto3" json:"lower_bounds,omitempty"`
+	ListBand             int32     `protobuf:"varint,3,opt,name=list_band,json=listBand,proto3" json:"list_band,omitempty"`
+	Offset               int32     `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32     `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *NodesByUptimeBandRequest) Reset()         { *m = NodesByUptimeBandRequest{} }
+func (m *NodesByUptimeBandRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesByUptimeBandRequest) ProtoMessage()    {}
+func (*NodesByUptimeBandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{67}
+}
+func (m *NodesByUptimeBandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesByUptimeBandRequest.Unmarshal(m, b)
+}
+func (m *NodesByUptimeBandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesByUptimeBandRequest.Marshal(b, m, deterministic)
+}
+func (m *NodesByUptimeBandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesByUptimeBandRequest.Merge(m, src)
+}
+func (m *NodesByUptimeBandRequest) XXX_Size() int {
+	return xxx_messageInfo_NodesByUptimeBandRequest.Size(m)
+}
+func (m *NodesByUptimeBandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesByUptimeBandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesByUptimeBandRequest proto.InternalMessageInfo
+
+func (m *NodesByUptimeBandRequest) GetWindowHours() int32 {
+	if m != nil {
+		return m.WindowHours
+	}
+	return 0
+}
+
+func (m *NodesByUptimeBandRequest) GetLowerBounds() []float64 {
+	if m != nil {
+		return m.LowerBounds
+	}
+	return nil
+}
+
+func (m *NodesByUptimeBandRequest) GetListBand() int32 {
+	if m != nil {
+		return m.ListBand
+	}
+	return 0
+}
+
+func (m *NodesByUptimeBandRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NodesByUptimeBandRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodesByUptimeBandResponse struct {
+	Bands                []*UptimeBand `protobuf:"bytes,1,rep,name=bands,proto3" json:"bands,omitempty"`
+	WithoutAudits        int64         `protobuf:"varint,2,opt,name=without_audits,json=withoutAudits,proto3" json:"without_audits,omitempty"`
+	Nodes                []*NodeUptime `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool          `protobuf:"varint,4,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodesByUptimeBandResponse) Reset()         { *m = NodesByUptimeBandResponse{} }
+func (m *NodesByUptimeBandResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesByUptimeBandResponse) ProtoMessage()    {}
+func (*NodesByUptimeBandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{68}
+}
+func (m *NodesByUptimeBandResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesByUptimeBandResponse.Unmarshal(m, b)
+}
+func (m *NodesByUptimeBandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesByUptimeBandResponse.Marshal(b, m, deterministic)
+}
+func (m *NodesByUptimeBandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesByUptimeBandResponse.Merge(m, src)
+}
+func (m *NodesByUptimeBandResponse) XXX_Size() int {
+	return xxx_messageInfo_NodesByUptimeBandResponse.Size(m)
+}
+func (m *NodesByUptimeBandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesByUptimeBandResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesByUptimeBandResponse proto.InternalMessageInfo
+
+func (m *NodesByUptimeBandResponse) GetBands() []*UptimeBand {
+	if m != nil {
+		return m.Bands
+	}
+	return nil
+}
+
+func (m *NodesByUptimeBandResponse) GetWithoutAudits() int64 {
+	if m != nil {
+		return m.WithoutAudits
+	}
+	return 0
+}
+
+func (m *NodesByUptimeBandResponse) GetNodes() []*NodeUptime {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NodesByUptimeBandResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type UptimeBand struct {
+	LowerBound           float64  `protobuf:"fixed64,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound           float64  `protobuf:"fixed64,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	NodeCount            int64    `protobuf:"varint,3,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UptimeBand) Reset()         { *m = UptimeBand{} }
+func (m *UptimeBand) String() string { return proto.CompactTextString(m) }
+func (*UptimeBand) ProtoMessage()    {}
+func (*UptimeBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{69}
+}
+func (m *UptimeBand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UptimeBand.Unmarshal(m, b)
+}
+func (m *UptimeBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UptimeBand.Marshal(b, m, deterministic)
+}
+func (m *UptimeBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UptimeBand.Merge(m, src)
+}
+func (m *UptimeBand) XXX_Size() int {
+	return xxx_messageInfo_UptimeBand.Size(m)
+}
+func (m *UptimeBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UptimeBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UptimeBand proto.InternalMessageInfo
+
+func (m *UptimeBand) GetLowerBound() float64 {
+	if m != nil {
+		return m.LowerBound
+	}
+	return 0
+}
+
+func (m *UptimeBand) GetUpperBound() float64 {
+	if m != nil {
+		return m.UpperBound
+	}
+	return 0
+}
+
+func (m *UptimeBand) GetNodeCount() int64 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+type NodeUptime struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Uptime               float64  `protobuf:"fixed64,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Audits               int64    `protobuf:"varint,3,opt,name=audits,proto3" json:"audits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeUptime) Reset()         { *m = NodeUptime{} }
+func (m *NodeUptime) String() string { return proto.CompactTextString(m) }
+func (*NodeUptime) ProtoMessage()    {}
+func (*NodeUptime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{70}
+}
+func (m *NodeUptime) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUptime.Unmarshal(m, b)
+}
+func (m *NodeUptime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUptime.Marshal(b, m, deterministic)
+}
+func (m *NodeUptime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUptime.Merge(m, src)
+}
+func (m *NodeUptime) XXX_Size() int {
+	return xxx_messageInfo_NodeUptime.Size(m)
+}
+func (m *NodeUptime) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUptime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUptime proto.InternalMessageInfo
+
+func (m *NodeUptime) GetUptime() float64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *NodeUptime) GetAudits() int64 {
+	if m != nil {
+		return m.Audits
+	}
+	return 0
+}
+
+type CompareNodesRequest struct {
+	First                NodeID   `protobuf:"bytes,1,opt,name=first,proto3,customtype=NodeID" json:"first"`
+	Second               NodeID   `protobuf:"bytes,2,opt,name=second,proto3,customtype=NodeID" json:"second"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareNodesRequest) Reset()         { *m = CompareNodesRequest{} }
+func (m *CompareNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*CompareNodesRequest) ProtoMessage()    {}
+func (*CompareNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{71}
+}
+func (m *CompareNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareNodesRequest.Unmarshal(m, b)
+}
+func (m *CompareNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *CompareNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareNodesRequest.Merge(m, src)
+}
+func (m *CompareNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_CompareNodesRequest.Size(m)
+}
+func (m *CompareNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareNodesRequest proto.InternalMessageInfo
+
+type CompareNodesResponse struct {
+	First                *NodeComparison `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second               *NodeComparison `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	Differences          []string        `protobuf:"bytes,3,rep,name=differences,proto3" json:"differences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CompareNodesResponse) Reset()         { *m = CompareNodesResponse{} }
+func (m *CompareNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*CompareNodesResponse) ProtoMessage()    {}
+func (*CompareNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{72}
+}
+func (m *CompareNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareNodesResponse.Unmarshal(m, b)
+}
+func (m *CompareNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *CompareNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareNodesResponse.Merge(m, src)
+}
+func (m *CompareNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_CompareNodesResponse.Size(m)
+}
+func (m *CompareNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareNodesResponse proto.InternalMessageInfo
+
+func (m *CompareNodesResponse) GetFirst() *NodeComparison {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *CompareNodesResponse) GetSecond() *NodeComparison {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+func (m *CompareNodesResponse) GetDifferences() []string {
+	if m != nil {
+		return m.Differences
+	}
+	return nil
+}
+
+type NodeComparison struct {
+	NodeId               NodeID          `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Reputation           *NodeReputation `protobuf:"bytes,2,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	Status               *NodeStatus     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	TotalAuditCount      int64           `protobuf:"varint,4,opt,name=total_audit_count,json=totalAuditCount,proto3" json:"total_audit_count,omitempty"`
+	AuditSuccessCount    int64           `protobuf:"varint,5,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
+	VettedAt             *time.Time      `protobuf:"bytes,6,opt,name=vetted_at,json=vettedAt,proto3,stdtime" json:"vetted_at,omitempty"`
+	LastAudit            *time.Time      `protobuf:"bytes,7,opt,name=last_audit,json=lastAudit,proto3,stdtime" json:"last_audit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NodeComparison) Reset()         { *m = NodeComparison{} }
+func (m *NodeComparison) String() string { return proto.CompactTextString(m) }
+func (*NodeComparison) ProtoMessage()    {}
+func (*NodeComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{73}
+}
+func (m *NodeComparison) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeComparison.Unmarshal(m, b)
+}
+func (m *NodeComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeComparison.Marshal(b, m, deterministic)
+}
+func (m *NodeComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeComparison.Merge(m, src)
+}
+func (m *NodeComparison) XXX_Size() int {
+	return xxx_messageInfo_NodeComparison.Size(m)
+}
+func (m *NodeComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeComparison proto.InternalMessageInfo
+
+func (m *NodeComparison) GetReputation() *NodeReputation {
+	if m != nil {
+		return m.Reputation
+	}
+	return nil
+}
+
+func (m *NodeComparison) GetStatus() *NodeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *NodeComparison) GetTotalAuditCount() int64 {
+	if m != nil {
+		return m.TotalAuditCount
+	}
+	return 0
+}
+
+func (m *NodeComparison) GetAuditSuccessCount() int64 {
+	if m != nil {
+		return m.AuditSuccessCount
+	}
+	return 0
+}
+
+func (m *NodeComparison) GetVettedAt() *time.Time {
+	if m != nil {
+		return m.VettedAt
+	}
+	return nil
+}
+
+func (m *NodeComparison) GetLastAudit() *time.Time {
+	if m != nil {
+		return m.LastAudit
+	}
+	return nil
+}
+
+type ListExitingNodesRequest struct {
+	Order                ListExitingNodesRequest_Order `protobuf:"varint,1,opt,name=order,proto3,enum=satellite.inspector.ListExitingNodesRequest_Order" json:"order,omitempty"`
+	Descending           bool                          `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	Offset               int32                         `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32                         `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListExitingNodesRequest) Reset()         { *m = ListExitingNodesRequest{} }
+func (m *ListExitingNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExitingNodesRequest) ProtoMessage()    {}
+func (*ListExitingNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{74}
+}
+func (m *ListExitingNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExitingNodesRequest.Unmarshal(m, b)
+}
+func (m *ListExitingNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExitingNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListExitingNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExitingNodesRequest.Merge(m, src)
+}
+func (m *ListExitingNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListExitingNodesRequest.Size(m)
+}
+func (m *ListExitingNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExitingNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExitingNodesRequest proto.InternalMessageInfo
+
+func (m *ListExitingNodesRequest) GetOrder() ListExitingNodesRequest_Order {
+	if m != nil {
+		return m.Order
+	}
+	return ListExitingNodesRequest_INITIATED_AT
+}
+
+func (m *ListExitingNodesRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *ListExitingNodesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListExitingNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListExitingNodesResponse struct {
+	Nodes                []*ExitingNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool           `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListExitingNodesResponse) Reset()         { *m = ListExitingNodesResponse{} }
+func (m *ListExitingNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExitingNodesResponse) ProtoMessage()    {}
+func (*ListExitingNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{75}
+}
+func (m *ListExitingNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExitingNodesResponse.Unmarshal(m, b)
+}
+func (m *ListExitingNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExitingNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListExitingNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExitingNodesResponse.Merge(m, src)
+}
+func (m *ListExitingNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExitingNodesResponse.Size(m)
+}
+func (m *ListExitingNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExitingNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExitingNodesResponse proto.InternalMessageInfo
+
+func (m *ListExitingNodesResponse) GetNodes() []*ExitingNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ListExitingNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ExitingNode struct {
+	NodeId               NodeID     `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	ExitInitiatedAt      *time.Time `protobuf:"bytes,2,opt,name=exit_initiated_at,json=exitInitiatedAt,proto3,stdtime" json:"exit_initiated_at,omitempty"`
+	QueueBuilt           bool       `protobuf:"varint,3,opt,name=queue_built,json=queueBuilt,proto3" json:"queue_built,omitempty"`
+	CompletionPercentage float64    `protobuf:"fixed64,4,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage,omitempty"`
+	BytesTransferred     int64      `protobuf:"varint,5,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	PiecesTransferred    int64      `protobuf:"varint,6,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64      `protobuf:"varint,7,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	PiecesRemaining      int64      `protobuf:"varint,8,opt,name=pieces_remaining,json=piecesRemaining,proto3" json:"pieces_remaining,omitempty"`
+	Failing              bool       `protobuf:"varint,9,opt,name=failing,proto3" json:"failing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ExitingNode) Reset()         { *m = ExitingNode{} }
+func (m *ExitingNode) String() string { return proto.CompactTextString(m) }
+func (*ExitingNode) ProtoMessage()    {}
+func (*ExitingNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{76}
+}
+func (m *ExitingNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitingNode.Unmarshal(m, b)
+}
+func (m *ExitingNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitingNode.Marshal(b, m, deterministic)
+}
+func (m *ExitingNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitingNode.Merge(m, src)
+}
+func (m *ExitingNode) XXX_Size() int {
+	return xxx_messageInfo_ExitingNode.Size(m)
+}
+func (m *ExitingNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitingNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitingNode proto.InternalMessageInfo
+
+func (m *ExitingNode) GetExitInitiatedAt() *time.Time {
+	if m != nil {
+		return m.ExitInitiatedAt
+	}
+	return nil
+}
+
+func (m *ExitingNode) GetQueueBuilt() bool {
+	if m != nil {
+		return m.QueueBuilt
+	}
+	return false
+}
+
+func (m *ExitingNode) GetCompletionPercentage() float64 {
+	if m != nil {
+		return m.CompletionPercentage
+	}
+	return 0
+}
+
+func (m *ExitingNode) GetBytesTransferred() int64 {
+	if m != nil {
+		return m.BytesTransferred
+	}
+	return 0
+}
+
+func (m *ExitingNode) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ExitingNode) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+func (m *ExitingNode) GetPiecesRemaining() int64 {
+	if m != nil {
+		return m.PiecesRemaining
+	}
+	return 0
+}
+
+func (m *ExitingNode) GetFailing() bool {
+	if m != nil {
+		return m.Failing
+	}
+	return false
+}
+
+type GetSelectionConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSelectionConfigRequest) Reset()         { *m = GetSelectionConfigRequest{} }
+func (m *GetSelectionConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSelectionConfigRequest) ProtoMessage()    {}
+func (*GetSelectionConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{77}
+}
+func (m *GetSelectionConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSelectionConfigRequest.Unmarshal(m, b)
+}
+func (m *GetSelectionConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSelectionConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSelectionConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSelectionConfigRequest.Merge(m, src)
+}
+func (m *GetSelectionConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSelectionConfigRequest.Size(m)
+}
+func (m *GetSelectionConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSelectionConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSelectionConfigRequest proto.InternalMessageInfo
+
+type GetSelectionConfigResponse struct {
+	Placements              []*PlacementDefinition `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+	UploadExcludedCountries []string               `protobuf:"bytes,2,rep,name=upload_excluded_countries,json=uploadExcludedCountries,proto3" json:"upload_excluded_countries,omitempty"`
+	RepairExcludedCountries []string               `protobuf:"bytes,3,rep,name=repair_excluded_countries,json=repairExcludedCountries,proto3" json:"repair_excluded_countries,omitempty"`
+	DistinctIp              bool                   `protobuf:"varint,4,opt,name=distinct_ip,json=distinctIp,proto3" json:"distinct_ip,omitempty"`
+	MinimumVersion          string                 `protobuf:"bytes,5,opt,name=minimum_version,json=minimumVersion,proto3" json:"minimum_version,omitempty"`
+	NewNodeFraction         float64                `protobuf:"fixed64,6,opt,name=new_node_fraction,json=newNodeFraction,proto3" json:"new_node_fraction,omitempty"`
+	MinimumDiskSpace        int64                  `protobuf:"varint,7,opt,name=minimum_disk_space,json=minimumDiskSpace,proto3" json:"minimum_disk_space,omitempty"`
+	OnlineWindow            time.Duration          `protobuf:"bytes,8,opt,name=online_window,json=onlineWindow,proto3,stdduration" json:"online_window"`
+	Text                    string                 `protobuf:"bytes,9,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}               `json:"-"`
+	XXX_unrecognized        []byte                 `json:"-"`
+	XXX_sizecache           int32                  `json:"-"`
+}
+
+func (m *GetSelectionConfigResponse) Reset()         { *m = GetSelectionConfigResponse{} }
+func (m *GetSelectionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSelectionConfigResponse) ProtoMessage()    {}
+func (*GetSelectionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{78}
+}
+func (m *GetSelectionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSelectionConfigResponse.Unmarshal(m, b)
+}
+func (m *GetSelectionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSelectionConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSelectionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSelectionConfigResponse.Merge(m, src)
+}
+func (m *GetSelectionConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSelectionConfigResponse.Size(m)
+}
+func (m *GetSelectionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSelectionConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSelectionConfigResponse proto.InternalMessageInfo
+
+func (m *GetSelectionConfigResponse) GetPlacements() []*PlacementDefinition {
+	if m != nil {
+		return m.Placements
+	}
+	return nil
+}
+
+func (m *GetSelectionConfigResponse) GetUploadExcludedCountries() []string {
+	if m != nil {
+		return m.UploadExcludedCountries
+	}
+	return nil
+}
+
+func (m *GetSelectionConfigResponse) GetRepairExcludedCountries() []string {
+	if m != nil {
+		return m.RepairExcludedCountries
+	}
+	return nil
+}
+
+func (m *GetSelectionConfigResponse) GetDistinctIp() bool {
+	if m != nil {
+		return m.DistinctIp
+	}
+	return false
+}
+
+func (m *GetSelectionConfigResponse) GetMinimumVersion() string {
+	if m != nil {
+		return m.MinimumVersion
+	}
+	return ""
+}
+
+func (m *GetSelectionConfigResponse) GetNewNodeFraction() float64 {
+	if m != nil {
+		return m.NewNodeFraction
+	}
+	return 0
+}
+
+func (m *GetSelectionConfigResponse) GetMinimumDiskSpace() int64 {
+	if m != nil {
+		return m.MinimumDiskSpace
+	}
+	return 0
+}
+
+func (m *GetSelectionConfigResponse) GetOnlineWindow() time.Duration {
+	if m != nil {
+		return m.OnlineWindow
+	}
+	return 0
+}
+
+func (m *GetSelectionConfigResponse) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type PlacementDefinition struct {
+	Placement            int32    `protobuf:"varint,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AllCountries         bool     `protobuf:"varint,3,opt,name=all_countries,json=allCountries,proto3" json:"all_countries,omitempty"`
+	Countries            []string `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementDefinition) Reset()         { *m = PlacementDefinition{} }
+func (m *PlacementDefinition) String() string { return proto.CompactTextString(m) }
+func (*PlacementDefinition) ProtoMessage()    {}
+func (*PlacementDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{79}
+}
+func (m *PlacementDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementDefinition.Unmarshal(m, b)
+}
+func (m *PlacementDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementDefinition.Marshal(b, m, deterministic)
+}
+func (m *PlacementDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementDefinition.Merge(m, src)
+}
+func (m *PlacementDefinition) XXX_Size() int {
+	return xxx_messageInfo_PlacementDefinition.Size(m)
+}
+func (m *PlacementDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementDefinition proto.InternalMessageInfo
+
+func (m *PlacementDefinition) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+func (m *PlacementDefinition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PlacementDefinition) GetAllCountries() bool {
+	if m != nil {
+		return m.AllCountries
+	}
+	return false
+}
+
+func (m *PlacementDefinition) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+type NodeCohortsRequest struct {
+	Granularity          NodeCohortsRequest_Granularity `protobuf:"varint,1,opt,name=granularity,proto3,enum=satellite.inspector.NodeCohortsRequest_Granularity" json:"granularity,omitempty"`
+	Filter               NodeCohortsRequest_Filter      `protobuf:"varint,2,opt,name=filter,proto3,enum=satellite.inspector.NodeCohortsRequest_Filter" json:"filter,omitempty"`
+	ChurnedAfter         time.Duration                  `protobuf:"bytes,3,opt,name=churned_after,json=churnedAfter,proto3,stdduration" json:"churned_after"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *NodeCohortsRequest) Reset()         { *m = NodeCohortsRequest{} }
+func (m *NodeCohortsRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeCohortsRequest) ProtoMessage()    {}
+func (*NodeCohortsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{80}
+}
+func (m *NodeCohortsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeCohortsRequest.Unmarshal(m, b)
+}
+func (m *NodeCohortsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeCohortsRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeCohortsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeCohortsRequest.Merge(m, src)
+}
+func (m *NodeCohortsRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeCohortsRequest.Size(m)
+}
+func (m *NodeCohortsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeCohortsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeCohortsRequest proto.InternalMessageInfo
+
+func (m *NodeCohortsRequest) GetGranularity() NodeCohortsRequest_Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return NodeCohortsRequest_MONTH
+}
+
+func (m *NodeCohortsRequest) GetFilter() NodeCohortsRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return NodeCohortsRequest_ALL
+}
+
+func (m *NodeCohortsRequest) GetChurnedAfter() time.Duration {
+	if m != nil {
+		return m.ChurnedAfter
+	}
+	return 0
+}
+
+type NodeCohortsResponse struct {
+	Cohorts              []*NodeCohort `protobuf:"bytes,1,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeCohortsResponse) Reset()         { *m = NodeCohortsResponse{} }
+func (m *NodeCohortsResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeCohortsResponse) ProtoMessage()    {}
+func (*NodeCohortsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{81}
+}
+func (m *NodeCohortsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeCohortsResponse.Unmarshal(m, b)
+}
+func (m *NodeCohortsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeCohortsResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeCohortsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeCohortsResponse.Merge(m, src)
+}
+func (m *NodeCohortsResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeCohortsResponse.Size(m)
+}
+func (m *NodeCohortsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeCohortsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeCohortsResponse proto.InternalMessageInfo
+
+func (m *NodeCohortsResponse) GetCohorts() []*NodeCohort {
+	if m != nil {
+		return m.Cohorts
+	}
+	return nil
+}
+
+type NodeCohort struct {
+	PeriodStart          time.Time `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	Nodes                int64     `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *NodeCohort) Reset()         { *m = NodeCohort{} }
+func (m *NodeCohort) String() string { return proto.CompactTextString(m) }
+func (*NodeCohort) ProtoMessage()    {}
+func (*NodeCohort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{82}
+}
+func (m *NodeCohort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeCohort.Unmarshal(m, b)
+}
+func (m *NodeCohort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeCohort.Marshal(b, m, deterministic)
+}
+func (m *NodeCohort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeCohort.Merge(m, src)
+}
+func (m *NodeCohort) XXX_Size() int {
+	return xxx_messageInfo_NodeCohort.Size(m)
+}
+func (m *NodeCohort) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeCohort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeCohort proto.InternalMessageInfo
+
+func (m *NodeCohort) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+func (m *NodeCohort) GetNodes() int64 {
+	if m != nil {
+		return m.Nodes
+	}
+	return 0
+}
+
+type ListContainedNodesRequest struct {
+	StartAfter           NodeID   `protobuf:"bytes,1,opt,name=start_after,json=startAfter,proto3,customtype=NodeID" json:"start_after"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListContainedNodesRequest) Reset()         { *m = ListContainedNodesRequest{} }
+func (m *ListContainedNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListContainedNodesRequest) ProtoMessage()    {}
+func (*ListContainedNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{83}
+}
+func (m *ListContainedNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListContainedNodesRequest.Unmarshal(m, b)
+}
+func (m *ListContainedNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListContainedNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListContainedNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContainedNodesRequest.Merge(m, src)
+}
+func (m *ListContainedNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListContainedNodesRequest.Size(m)
+}
+func (m *ListContainedNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContainedNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListContainedNodesRequest proto.InternalMessageInfo
+
+func (m *ListContainedNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListContainedNodesResponse struct {
+	Nodes                []*ContainedNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListContainedNodesResponse) Reset()         { *m = ListContainedNodesResponse{} }
+func (m *ListContainedNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListContainedNodesResponse) ProtoMessage()    {}
+func (*ListContainedNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{84}
+}
+func (m *ListContainedNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListContainedNodesResponse.Unmarshal(m, b)
+}
+func (m *ListContainedNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListContainedNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListContainedNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContainedNodesResponse.Merge(m, src)
+}
+func (m *ListContainedNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListContainedNodesResponse.Size(m)
+}
+func (m *ListContainedNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContainedNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListContainedNodesResponse proto.InternalMessageInfo
+
+func (m *ListContainedNodesResponse) GetNodes() []*ContainedNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ListContainedNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ContainedNode struct {
+	NodeId                   NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	StreamId                 []byte   `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position                 int64    `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	PieceId                  PieceID  `protobuf:"bytes,4,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	StripeIndex              int64    `protobuf:"varint,5,opt,name=stripe_index,json=stripeIndex,proto3" json:"stripe_index,omitempty"`
+	ReverifyCount            int32    `protobuf:"varint,6,opt,name=reverify_count,json=reverifyCount,proto3" json:"reverify_count,omitempty"`
+	ReverificationsRemaining int32    `protobuf:"varint,7,opt,name=reverifications_remaining,json=reverificationsRemaining,proto3" json:"reverifications_remaining,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *ContainedNode) Reset()         { *m = ContainedNode{} }
+func (m *ContainedNode) String() string { return proto.CompactTextString(m) }
+func (*ContainedNode) ProtoMessage()    {}
+func (*ContainedNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{85}
+}
+func (m *ContainedNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainedNode.Unmarshal(m, b)
+}
+func (m *ContainedNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainedNode.Marshal(b, m, deterministic)
+}
+func (m *ContainedNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainedNode.Merge(m, src)
+}
+func (m *ContainedNode) XXX_Size() int {
+	return xxx_messageInfo_ContainedNode.Size(m)
+}
+func (m *ContainedNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainedNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainedNode proto.InternalMessageInfo
+
+func (m *ContainedNode) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ContainedNode) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *ContainedNode) GetStripeIndex() int64 {
+	if m != nil {
+		return m.StripeIndex
+	}
+	return 0
+}
+
+func (m *ContainedNode) GetReverifyCount() int32 {
+	if m != nil {
+		return m.ReverifyCount
+	}
+	return 0
+}
+
+func (m *ContainedNode) GetReverificationsRemaining() int32 {
+	if m != nil {
+		return m.ReverificationsRemaining
+	}
+	return 0
+}
+
+type SelectionFairnessRequest struct {
+	Selections           int32    `protobuf:"varint,1,opt,name=selections,proto3" json:"selections,omitempty"`
+	Nodes                int32    `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Placement            int32    `protobuf:"varint,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	ZThreshold           float64  `protobuf:"fixed64,4,opt,name=z_threshold,json=zThreshold,proto3" json:"z_threshold,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectionFairnessRequest) Reset()         { *m = SelectionFairnessRequest{} }
+func (m *SelectionFairnessRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectionFairnessRequest) ProtoMessage()    {}
+func (*SelectionFairnessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{86}
+}
+func (m *SelectionFairnessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectionFairnessRequest.Unmarshal(m, b)
+}
+func (m *SelectionFairnessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectionFairnessRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectionFairnessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectionFairnessRequest.Merge(m, src)
+}
+func (m *SelectionFairnessRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectionFairnessRequest.Size(m)
+}
+func (m *SelectionFairnessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectionFairnessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectionFairnessRequest proto.InternalMessageInfo
+
+func (m *SelectionFairnessRequest) GetSelections() int32 {
+	if m != nil {
+		return m.Selections
+	}
+	return 0
+}
+
+func (m *SelectionFairnessRequest) GetNodes() int32 {
+	if m != nil {
+		return m.Nodes
+	}
+	return 0
+}
+
+func (m *SelectionFairnessRequest) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+func (m *SelectionFairnessRequest) GetZThreshold() float64 {
+	if m != nil {
+		return m.ZThreshold
+	}
+	return 0
+}
+
+func (m *SelectionFairnessRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SelectionFairnessResponse struct {
+	Selections           int64                   `protobuf:"varint,1,opt,name=selections,proto3" json:"selections,omitempty"`
+	NodesSelected        int64                   `protobuf:"varint,2,opt,name=nodes_selected,json=nodesSelected,proto3" json:"nodes_selected,omitempty"`
+	SubnetsSeen          int64                   `protobuf:"varint,3,opt,name=subnets_seen,json=subnetsSeen,proto3" json:"subnets_seen,omitempty"`
+	Subnets              []*SubnetSelectionCount `protobuf:"bytes,4,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	Nodes                []*NodeSelectionCount   `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	PartialSelections    int64                   `protobuf:"varint,6,opt,name=partial_selections,json=partialSelections,proto3" json:"partial_selections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SelectionFairnessResponse) Reset()         { *m = SelectionFairnessResponse{} }
+func (m *SelectionFairnessResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectionFairnessResponse) ProtoMessage()    {}
+func (*SelectionFairnessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{87}
+}
+func (m *SelectionFairnessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectionFairnessResponse.Unmarshal(m, b)
+}
+func (m *SelectionFairnessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectionFairnessResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectionFairnessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectionFairnessResponse.Merge(m, src)
+}
+func (m *SelectionFairnessResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectionFairnessResponse.Size(m)
+}
+func (m *SelectionFairnessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectionFairnessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectionFairnessResponse proto.InternalMessageInfo
+
+func (m *SelectionFairnessResponse) GetSelections() int64 {
+	if m != nil {
+		return m.Selections
+	}
+	return 0
+}
+
+func (m *SelectionFairnessResponse) GetNodesSelected() int64 {
+	if m != nil {
+		return m.NodesSelected
+	}
+	return 0
+}
+
+func (m *SelectionFairnessResponse) GetSubnetsSeen() int64 {
+	if m != nil {
+		return m.SubnetsSeen
+	}
+	return 0
+}
+
+func (m *SelectionFairnessResponse) GetSubnets() []*SubnetSelectionCount {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+func (m *SelectionFairnessResponse) GetNodes() []*NodeSelectionCount {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *SelectionFairnessResponse) GetPartialSelections() int64 {
+	if m != nil {
+		return m.PartialSelections
+	}
+	return 0
+}
+
+type SubnetSelectionCount struct {
+	Subnet               string   `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Selected             int64    `protobuf:"varint,2,opt,name=selected,proto3" json:"selected,omitempty"`
+	Expected             float64  `protobuf:"fixed64,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	ZScore               float64  `protobuf:"fixed64,4,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	OverRepresented      bool     `protobuf:"varint,5,opt,name=over_represented,json=overRepresented,proto3" json:"over_represented,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubnetSelectionCount) Reset()         { *m = SubnetSelectionCount{} }
+func (m *SubnetSelectionCount) String() string { return proto.CompactTextString(m) }
+func (*SubnetSelectionCount) ProtoMessage()    {}
+func (*SubnetSelectionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{88}
+}
+func (m *SubnetSelectionCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubnetSelectionCount.Unmarshal(m, b)
+}
+func (m *SubnetSelectionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubnetSelectionCount.Marshal(b, m, deterministic)
+}
+func (m *SubnetSelectionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetSelectionCount.Merge(m, src)
+}
+func (m *SubnetSelectionCount) XXX_Size() int {
+	return xxx_messageInfo_SubnetSelectionCount.Size(m)
+}
+func (m *SubnetSelectionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetSelectionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetSelectionCount proto.InternalMessageInfo
+
+func (m *SubnetSelectionCount) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *SubnetSelectionCount) GetSelected() int64 {
+	if m != nil {
+		return m.Selected
+	}
+	return 0
+}
+
+func (m *SubnetSelectionCount) GetExpected() float64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *SubnetSelectionCount) GetZScore() float64 {
+	if m != nil {
+		return m.ZScore
+	}
+	return 0
+}
+
+func (m *SubnetSelectionCount) GetOverRepresented() bool {
+	if m != nil {
+		return m.OverRepresented
+	}
+	return false
+}
+
+type NodeSelectionCount struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Subnet               string   `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Selected             int64    `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeSelectionCount) Reset()         { *m = NodeSelectionCount{} }
+func (m *NodeSelectionCount) String() string { return proto.CompactTextString(m) }
+func (*NodeSelectionCount) ProtoMessage()    {}
+func (*NodeSelectionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{89}
+}
+func (m *NodeSelectionCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeSelectionCount.Unmarshal(m, b)
+}
+func (m *NodeSelectionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeSelectionCount.Marshal(b, m, deterministic)
+}
+func (m *NodeSelectionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSelectionCount.Merge(m, src)
+}
+func (m *NodeSelectionCount) XXX_Size() int {
+	return xxx_messageInfo_NodeSelectionCount.Size(m)
+}
+func (m *NodeSelectionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSelectionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSelectionCount proto.InternalMessageInfo
+
+func (m *NodeSelectionCount) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *NodeSelectionCount) GetSelected() int64 {
+	if m != nil {
+		return m.Selected
+	}
+	return 0
+}
+
+type SpaceDiscrepancyNodesRequest struct {
+	MaxFreeRatio         float64  `protobuf:"fixed64,1,opt,name=max_free_ratio,json=maxFreeRatio,proto3" json:"max_free_ratio,omitempty"`
+	MinFreeBytes         int64    `protobuf:"varint,2,opt,name=min_free_bytes,json=minFreeBytes,proto3" json:"min_free_bytes,omitempty"`
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpaceDiscrepancyNodesRequest) Reset()         { *m = SpaceDiscrepancyNodesRequest{} }
+func (m *SpaceDiscrepancyNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*SpaceDiscrepancyNodesRequest) ProtoMessage()    {}
+func (*SpaceDiscrepancyNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{90}
+}
+func (m *SpaceDiscrepancyNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpaceDiscrepancyNodesRequest.Unmarshal(m, b)
+}
+func (m *SpaceDiscrepancyNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpaceDiscrepancyNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *SpaceDiscrepancyNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceDiscrepancyNodesRequest.Merge(m, src)
+}
+func (m *SpaceDiscrepancyNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_SpaceDiscrepancyNodesRequest.Size(m)
+}
+func (m *SpaceDiscrepancyNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceDiscrepancyNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpaceDiscrepancyNodesRequest proto.InternalMessageInfo
+
+func (m *SpaceDiscrepancyNodesRequest) GetMaxFreeRatio() float64 {
+	if m != nil {
+		return m.MaxFreeRatio
+	}
+	return 0
+}
+
+func (m *SpaceDiscrepancyNodesRequest) GetMinFreeBytes() int64 {
+	if m != nil {
+		return m.MinFreeBytes
+	}
+	return 0
+}
+
+func (m *SpaceDiscrepancyNodesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SpaceDiscrepancyNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SpaceDiscrepancyNodesResponse struct {
+	Nodes                []*SpaceDiscrepancy `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool                `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SpaceDiscrepancyNodesResponse) Reset()         { *m = SpaceDiscrepancyNodesResponse{} }
+func (m *SpaceDiscrepancyNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*SpaceDiscrepancyNodesResponse) ProtoMessage()    {}
+func (*SpaceDiscrepancyNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{91}
+}
+func (m *SpaceDiscrepancyNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpaceDiscrepancyNodesResponse.Unmarshal(m, b)
+}
+func (m *SpaceDiscrepancyNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpaceDiscrepancyNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *SpaceDiscrepancyNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceDiscrepancyNodesResponse.Merge(m, src)
+}
+func (m *SpaceDiscrepancyNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_SpaceDiscrepancyNodesResponse.Size(m)
+}
+func (m *SpaceDiscrepancyNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceDiscrepancyNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpaceDiscrepancyNodesResponse proto.InternalMessageInfo
+
+func (m *SpaceDiscrepancyNodesResponse) GetNodes() []*SpaceDiscrepancy {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *SpaceDiscrepancyNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type SpaceDiscrepancy struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	StoredBytes          int64    `protobuf:"varint,2,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty"`
+	FreeBytes            int64    `protobuf:"varint,3,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	ExcessBytes          int64    `protobuf:"varint,4,opt,name=excess_bytes,json=excessBytes,proto3" json:"excess_bytes,omitempty"`
+	FreeRatio            float64  `protobuf:"fixed64,5,opt,name=free_ratio,json=freeRatio,proto3" json:"free_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpaceDiscrepancy) Reset()         { *m = SpaceDiscrepancy{} }
+func (m *SpaceDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*SpaceDiscrepancy) ProtoMessage()    {}
+func (*SpaceDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{92}
+}
+func (m *SpaceDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpaceDiscrepancy.Unmarshal(m, b)
+}
+func (m *SpaceDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpaceDiscrepancy.Marshal(b, m, deterministic)
+}
+func (m *SpaceDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceDiscrepancy.Merge(m, src)
+}
+func (m *SpaceDiscrepancy) XXX_Size() int {
+	return xxx_messageInfo_SpaceDiscrepancy.Size(m)
+}
+func (m *SpaceDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpaceDiscrepancy proto.InternalMessageInfo
+
+func (m *SpaceDiscrepancy) GetStoredBytes() int64 {
+	if m != nil {
+		return m.StoredBytes
+	}
+	return 0
+}
+
+func (m *SpaceDiscrepancy) GetFreeBytes() int64 {
+	if m != nil {
+		return m.FreeBytes
+	}
+	return 0
+}
+
+func (m *SpaceDiscrepancy) GetExcessBytes() int64 {
+	if m != nil {
+		return m.ExcessBytes
+	}
+	return 0
+}
+
+func (m *SpaceDiscrepancy) GetFreeRatio() float64 {
+	if m != nil {
+		return m.FreeRatio
+	}
+	return 0
+}
+
+type NodesByLatencyTierRequest struct {
+	UpperBoundsMs        []int64  `protobuf:"varint,1,rep,packed,name=upper_bounds_ms,json=upperBoundsMs,proto3" json:"upper_bounds_ms,omitempty"`
+	ListTier             int32    `protobuf:"varint,2,opt,name=list_tier,json=listTier,proto3" json:"list_tier,omitempty"`
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodesByLatencyTierRequest) Reset()         { *m = NodesByLatencyTierRequest{} }
+func (m *NodesByLatencyTierRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesByLatencyTierRequest) ProtoMessage()    {}
+func (*NodesByLatencyTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{93}
+}
+func (m *NodesByLatencyTierRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesByLatencyTierRequest.Unmarshal(m, b)
+}
+func (m *NodesByLatencyTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesByLatencyTierRequest.Marshal(b, m, deterministic)
+}
+func (m *NodesByLatencyTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesByLatencyTierRequest.Merge(m, src)
+}
+func (m *NodesByLatencyTierRequest) XXX_Size() int {
+	return xxx_messageInfo_NodesByLatencyTierRequest.Size(m)
+}
+func (m *NodesByLatencyTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesByLatencyTierRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesByLatencyTierRequest proto.InternalMessageInfo
+
+func (m *NodesByLatencyTierRequest) GetUpperBoundsMs() []int64 {
+	if m != nil {
+		return m.UpperBoundsMs
+	}
+	return nil
+}
+
+func (m *NodesByLatencyTierRequest) GetListTier() int32 {
+	if m != nil {
+		return m.ListTier
+	}
+	return 0
+}
+
+func (m *NodesByLatencyTierRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NodesByLatencyTierRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodesByLatencyTierResponse struct {
+	Tiers                []*LatencyTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	Unmeasured           int64          `protobuf:"varint,2,opt,name=unmeasured,proto3" json:"unmeasured,omitempty"`
+	Nodes                []*NodeLatency `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool           `protobuf:"varint,4,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *NodesByLatencyTierResponse) Reset()         { *m = NodesByLatencyTierResponse{} }
+func (m *NodesByLatencyTierResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesByLatencyTierResponse) ProtoMessage()    {}
+func (*NodesByLatencyTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{94}
+}
+func (m *NodesByLatencyTierResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesByLatencyTierResponse.Unmarshal(m, b)
+}
+func (m *NodesByLatencyTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesByLatencyTierResponse.Marshal(b, m, deterministic)
+}
+func (m *NodesByLatencyTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesByLatencyTierResponse.Merge(m, src)
+}
+func (m *NodesByLatencyTierResponse) XXX_Size() int {
+	return xxx_messageInfo_NodesByLatencyTierResponse.Size(m)
+}
+func (m *NodesByLatencyTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesByLatencyTierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesByLatencyTierResponse proto.InternalMessageInfo
+
+func (m *NodesByLatencyTierResponse) GetTiers() []*LatencyTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *NodesByLatencyTierResponse) GetUnmeasured() int64 {
+	if m != nil {
+		return m.Unmeasured
+	}
+	return 0
+}
+
+func (m *NodesByLatencyTierResponse) GetNodes() []*NodeLatency {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NodesByLatencyTierResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type LatencyTier struct {
+	LowerBoundMs         int64    `protobuf:"varint,1,opt,name=lower_bound_ms,json=lowerBoundMs,proto3" json:"lower_bound_ms,omitempty"`
+	UpperBoundMs         int64    `protobuf:"varint,2,opt,name=upper_bound_ms,json=upperBoundMs,proto3" json:"upper_bound_ms,omitempty"`
+	NodeCount            int64    `protobuf:"varint,3,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LatencyTier) Reset()         { *m = LatencyTier{} }
+func (m *LatencyTier) String() string { return proto.CompactTextString(m) }
+func (*LatencyTier) ProtoMessage()    {}
+func (*LatencyTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{95}
+}
+func (m *LatencyTier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatencyTier.Unmarshal(m, b)
+}
+func (m *LatencyTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatencyTier.Marshal(b, m, deterministic)
+}
+func (m *LatencyTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyTier.Merge(m, src)
+}
+func (m *LatencyTier) XXX_Size() int {
+	return xxx_messageInfo_LatencyTier.Size(m)
+}
+func (m *LatencyTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyTier proto.InternalMessageInfo
+
+func (m *LatencyTier) GetLowerBoundMs() int64 {
+	if m != nil {
+		return m.LowerBoundMs
+	}
+	return 0
+}
+
+func (m *LatencyTier) GetUpperBoundMs() int64 {
+	if m != nil {
+		return m.UpperBoundMs
+	}
+	return 0
+}
+
+func (m *LatencyTier) GetNodeCount() int64 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+type NodeLatency struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	LatencyMs            int64    `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeLatency) Reset()         { *m = NodeLatency{} }
+func (m *NodeLatency) String() string { return proto.CompactTextString(m) }
+func (*NodeLatency) ProtoMessage()    {}
+func (*NodeLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{96}
+}
+func (m *NodeLatency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeLatency.Unmarshal(m, b)
+}
+func (m *NodeLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeLatency.Marshal(b, m, deterministic)
+}
+func (m *NodeLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLatency.Merge(m, src)
+}
+func (m *NodeLatency) XXX_Size() int {
+	return xxx_messageInfo_NodeLatency.Size(m)
+}
+func (m *NodeLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLatency proto.InternalMessageInfo
+
+func (m *NodeLatency) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+type NodesWithRecentWalletChangeRequest struct {
+	Lookback             time.Duration `protobuf:"bytes,1,opt,name=lookback,proto3,stdduration" json:"lookback"`
+	Offset               int32         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodesWithRecentWalletChangeRequest) Reset()         { *m = NodesWithRecentWalletChangeRequest{} }
+func (m *NodesWithRecentWalletChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesWithRecentWalletChangeRequest) ProtoMessage()    {}
+func (*NodesWithRecentWalletChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{97}
+}
+func (m *NodesWithRecentWalletChangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesWithRecentWalletChangeRequest.Unmarshal(m, b)
+}
+func (m *NodesWithRecentWalletChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesWithRecentWalletChangeRequest.Marshal(b, m, deterministic)
+}
+func (m *NodesWithRecentWalletChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesWithRecentWalletChangeRequest.Merge(m, src)
+}
+func (m *NodesWithRecentWalletChangeRequest) XXX_Size() int {
+	return xxx_messageInfo_NodesWithRecentWalletChangeRequest.Size(m)
+}
+func (m *NodesWithRecentWalletChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesWithRecentWalletChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesWithRecentWalletChangeRequest proto.InternalMessageInfo
+
+func (m *NodesWithRecentWalletChangeRequest) GetLookback() time.Duration {
+	if m != nil {
+		return m.Lookback
+	}
+	return 0
+}
+
+func (m *NodesWithRecentWalletChangeRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NodesWithRecentWalletChangeRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodesWithRecentWalletChangeResponse struct {
+	Changes              []*NodeWalletChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	More                 bool                `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *NodesWithRecentWalletChangeResponse) Reset()         { *m = NodesWithRecentWalletChangeResponse{} }
+func (m *NodesWithRecentWalletChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesWithRecentWalletChangeResponse) ProtoMessage()    {}
+func (*NodesWithRecentWalletChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{98}
+}
+func (m *NodesWithRecentWalletChangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesWithRecentWalletChangeResponse.Unmarshal(m, b)
+}
+func (m *NodesWithRecentWalletChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesWithRecentWalletChangeResponse.Marshal(b, m, deterministic)
+}
+func (m *NodesWithRecentWalletChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesWithRecentWalletChangeResponse.Merge(m, src)
+}
+func (m *NodesWithRecentWalletChangeResponse) XXX_Size() int {
+	return xxx_messageInfo_NodesWithRecentWalletChangeResponse.Size(m)
+}
+func (m *NodesWithRecentWalletChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesWithRecentWalletChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesWithRecentWalletChangeResponse proto.InternalMessageInfo
+
+func (m *NodesWithRecentWalletChangeResponse) GetChanges() []*NodeWalletChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *NodesWithRecentWalletChangeResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type NodeWalletChange struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	OldWallet            string    `protobuf:"bytes,2,opt,name=old_wallet,json=oldWallet,proto3" json:"old_wallet,omitempty"`
+	NewWallet            string    `protobuf:"bytes,3,opt,name=new_wallet,json=newWallet,proto3" json:"new_wallet,omitempty"`
+	ChangedAt            time.Time `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3,stdtime" json:"changed_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *NodeWalletChange) Reset()         { *m = NodeWalletChange{} }
+func (m *NodeWalletChange) String() string { return proto.CompactTextString(m) }
+func (*NodeWalletChange) ProtoMessage()    {}
+func (*NodeWalletChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{99}
+}
+func (m *NodeWalletChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeWalletChange.Unmarshal(m, b)
+}
+func (m *NodeWalletChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeWalletChange.Marshal(b, m, deterministic)
+}
+func (m *NodeWalletChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeWalletChange.Merge(m, src)
+}
+func (m *NodeWalletChange) XXX_Size() int {
+	return xxx_messageInfo_NodeWalletChange.Size(m)
+}
+func (m *NodeWalletChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeWalletChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeWalletChange proto.InternalMessageInfo
+
+func (m *NodeWalletChange) GetOldWallet() string {
+	if m != nil {
+		return m.OldWallet
+	}
+	return ""
+}
+
+func (m *NodeWalletChange) GetNewWallet() string {
+	if m != nil {
+		return m.NewWallet
+	}
+	return ""
+}
+
+func (m *NodeWalletChange) GetChangedAt() time.Time {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return time.Time{}
+}
+
+type ChurnRateRequest struct {
+	Interval             time.Duration `protobuf:"bytes,1,opt,name=interval,proto3,stdduration" json:"interval"`
+	Start                time.Time     `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+	End                  time.Time     `protobuf:"bytes,3,opt,name=end,proto3,stdtime" json:"end"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChurnRateRequest) Reset()         { *m = ChurnRateRequest{} }
+func (m *ChurnRateRequest) String() string { return proto.CompactTextString(m) }
+func (*ChurnRateRequest) ProtoMessage()    {}
+func (*ChurnRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{100}
+}
+func (m *ChurnRateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChurnRateRequest.Unmarshal(m, b)
+}
+func (m *ChurnRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChurnRateRequest.Marshal(b, m, deterministic)
+}
+func (m *ChurnRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChurnRateRequest.Merge(m, src)
+}
+func (m *ChurnRateRequest) XXX_Size() int {
+	return xxx_messageInfo_ChurnRateRequest.Size(m)
+}
+func (m *ChurnRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChurnRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChurnRateRequest proto.InternalMessageInfo
+
+func (m *ChurnRateRequest) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ChurnRateRequest) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *ChurnRateRequest) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+type ChurnRateResponse struct {
+	Intervals            []*ChurnInterval `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChurnRateResponse) Reset()         { *m = ChurnRateResponse{} }
+func (m *ChurnRateResponse) String() string { return proto.CompactTextString(m) }
+func (*ChurnRateResponse) ProtoMessage()    {}
+func (*ChurnRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{101}
+}
+func (m *ChurnRateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChurnRateResponse.Unmarshal(m, b)
+}
+func (m *ChurnRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChurnRateResponse.Marshal(b, m, deterministic)
+}
+func (m *ChurnRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChurnRateResponse.Merge(m, src)
+}
+func (m *ChurnRateResponse) XXX_Size() int {
+	return xxx_messageInfo_ChurnRateResponse.Size(m)
+}
+func (m *ChurnRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChurnRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChurnRateResponse proto.InternalMessageInfo
+
+func (m *ChurnRateResponse) GetIntervals() []*ChurnInterval {
+	if m != nil {
+		return m.Intervals
+	}
+	return nil
+}
+
+type ChurnInterval struct {
+	Start                time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	Joined               int64     `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"`
+	Disqualified         int64     `protobuf:"varint,3,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	Exited               int64     `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ChurnInterval) Reset()         { *m = ChurnInterval{} }
+func (m *ChurnInterval) String() string { return proto.CompactTextString(m) }
+func (*ChurnInterval) ProtoMessage()    {}
+func (*ChurnInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{102}
+}
+func (m *ChurnInterval) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChurnInterval.Unmarshal(m, b)
+}
+func (m *ChurnInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChurnInterval.Marshal(b, m, deterministic)
+}
+func (m *ChurnInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChurnInterval.Merge(m, src)
+}
+func (m *ChurnInterval) XXX_Size() int {
+	return xxx_messageInfo_ChurnInterval.Size(m)
+}
+func (m *ChurnInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChurnInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChurnInterval proto.InternalMessageInfo
+
+func (m *ChurnInterval) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *ChurnInterval) GetJoined() int64 {
+	if m != nil {
+		return m.Joined
+	}
+	return 0
+}
+
+func (m *ChurnInterval) GetDisqualified() int64 {
+	if m != nil {
+		return m.Disqualified
+	}
+	return 0
+}
+
+func (m *ChurnInterval) GetExited() int64 {
+	if m != nil {
+		return m.Exited
+	}
+	return 0
+}
+
+type ValidatePlacementRequest struct {
+	Countries            []string `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	MinimumFreeDisk      int64    `protobuf:"varint,2,opt,name=minimum_free_disk,json=minimumFreeDisk,proto3" json:"minimum_free_disk,omitempty"`
+	VettedOnly           bool     `protobuf:"varint,3,opt,name=vetted_only,json=vettedOnly,proto3" json:"vetted_only,omitempty"`
+	RequiredCount        int32    `protobuf:"varint,4,opt,name=required_count,json=requiredCount,proto3" json:"required_count,omitempty"`
+	DistinctSubnets      bool     `protobuf:"varint,5,opt,name=distinct_subnets,json=distinctSubnets,proto3" json:"distinct_subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePlacementRequest) Reset()         { *m = ValidatePlacementRequest{} }
+func (m *ValidatePlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePlacementRequest) ProtoMessage()    {}
+func (*ValidatePlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{103}
+}
+func (m *ValidatePlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePlacementRequest.Unmarshal(m, b)
+}
+func (m *ValidatePlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePlacementRequest.Merge(m, src)
+}
+func (m *ValidatePlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePlacementRequest.Size(m)
+}
+func (m *ValidatePlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePlacementRequest proto.InternalMessageInfo
+
+func (m *ValidatePlacementRequest) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *ValidatePlacementRequest) GetMinimumFreeDisk() int64 {
+	if m != nil {
+		return m.MinimumFreeDisk
+	}
+	return 0
+}
+
+func (m *ValidatePlacementRequest) GetVettedOnly() bool {
+	if m != nil {
+		return m.VettedOnly
+	}
+	return false
+}
+
+func (m *ValidatePlacementRequest) GetRequiredCount() int32 {
+	if m != nil {
+		return m.RequiredCount
+	}
+	return 0
+}
+
+func (m *ValidatePlacementRequest) GetDistinctSubnets() bool {
+	if m != nil {
+		return m.DistinctSubnets
+	}
+	return false
+}
+
+type ValidatePlacementResponse struct {
+	Satisfiable bool                                 `protobuf:"varint,1,opt,name=satisfiable,proto3" json:"satisfiable,omitempty"`
+	Binding     ValidatePlacementResponse_Constraint `protobuf:"varint,2,opt,name=binding,proto3,enum=satellite.inspector.ValidatePlacementResponse_Constraint" json:"binding,omitempty"`
+	// number of nodes left after applying each constraint on top of the previous ones
+	EligibleNodes        int64    `protobuf:"varint,3,opt,name=eligible_nodes,json=eligibleNodes,proto3" json:"eligible_nodes,omitempty"`
+	InCountries          int64    `protobuf:"varint,4,opt,name=in_countries,json=inCountries,proto3" json:"in_countries,omitempty"`
+	WithFreeDisk         int64    `protobuf:"varint,5,opt,name=with_free_disk,json=withFreeDisk,proto3" json:"with_free_disk,omitempty"`
+	Vetted               int64    `protobuf:"varint,6,opt,name=vetted,proto3" json:"vetted,omitempty"`
+	DistinctSubnets      int64    `protobuf:"varint,7,opt,name=distinct_subnets,json=distinctSubnets,proto3" json:"distinct_subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePlacementResponse) Reset()         { *m = ValidatePlacementResponse{} }
+func (m *ValidatePlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatePlacementResponse) ProtoMessage()    {}
+func (*ValidatePlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{104}
+}
+func (m *ValidatePlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePlacementResponse.Unmarshal(m, b)
+}
+func (m *ValidatePlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatePlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePlacementResponse.Merge(m, src)
+}
+func (m *ValidatePlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatePlacementResponse.Size(m)
+}
+func (m *ValidatePlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePlacementResponse proto.InternalMessageInfo
+
+func (m *ValidatePlacementResponse) GetSatisfiable() bool {
+	if m != nil {
+		return m.Satisfiable
+	}
+	return false
+}
+
+func (m *ValidatePlacementResponse) GetBinding() ValidatePlacementResponse_Constraint {
+	if m != nil {
+		return m.Binding
+	}
+	return ValidatePlacementResponse_NONE
+}
+
+func (m *ValidatePlacementResponse) GetEligibleNodes() int64 {
+	if m != nil {
+		return m.EligibleNodes
+	}
+	return 0
+}
+
+func (m *ValidatePlacementResponse) GetInCountries() int64 {
+	if m != nil {
+		return m.InCountries
+	}
+	return 0
+}
+
+func (m *ValidatePlacementResponse) GetWithFreeDisk() int64 {
+	if m != nil {
+		return m.WithFreeDisk
+	}
+	return 0
+}
+
+func (m *ValidatePlacementResponse) GetVetted() int64 {
+	if m != nil {
+		return m.Vetted
+	}
+	return 0
+}
+
+func (m *ValidatePlacementResponse) GetDistinctSubnets() int64 {
+	if m != nil {
+		return m.DistinctSubnets
+	}
+	return 0
+}
+
+type NodesBelowMinVersionRequest struct {
+	OnlineOnly           bool     `protobuf:"varint,1,opt,name=online_only,json=onlineOnly,proto3" json:"online_only,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodesBelowMinVersionRequest) Reset()         { *m = NodesBelowMinVersionRequest{} }
+func (m *NodesBelowMinVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesBelowMinVersionRequest) ProtoMessage()    {}
+func (*NodesBelowMinVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{105}
+}
+func (m *NodesBelowMinVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesBelowMinVersionRequest.Unmarshal(m, b)
+}
+func (m *NodesBelowMinVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesBelowMinVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *NodesBelowMinVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesBelowMinVersionRequest.Merge(m, src)
+}
+func (m *NodesBelowMinVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_NodesBelowMinVersionRequest.Size(m)
+}
+func (m *NodesBelowMinVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesBelowMinVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesBelowMinVersionRequest proto.InternalMessageInfo
+
+func (m *NodesBelowMinVersionRequest) GetOnlineOnly() bool {
+	if m != nil {
+		return m.OnlineOnly
+	}
+	return false
+}
+
+func (m *NodesBelowMinVersionRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NodesBelowMinVersionRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodesBelowMinVersionResponse struct {
+	MinimumVersion       string          `protobuf:"bytes,1,opt,name=minimum_version,json=minimumVersion,proto3" json:"minimum_version,omitempty"`
+	Nodes                []*OutdatedNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool            `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	TotalNodes           int64           `protobuf:"varint,4,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	TotalFreeDisk        int64           `protobuf:"varint,5,opt,name=total_free_disk,json=totalFreeDisk,proto3" json:"total_free_disk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NodesBelowMinVersionResponse) Reset()         { *m = NodesBelowMinVersionResponse{} }
+func (m *NodesBelowMinVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesBelowMinVersionResponse) ProtoMessage()    {}
+func (*NodesBelowMinVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{106}
+}
+func (m *NodesBelowMinVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodesBelowMinVersionResponse.Unmarshal(m, b)
+}
+func (m *NodesBelowMinVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodesBelowMinVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *NodesBelowMinVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesBelowMinVersionResponse.Merge(m, src)
+}
+func (m *NodesBelowMinVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_NodesBelowMinVersionResponse.Size(m)
+}
+func (m *NodesBelowMinVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesBelowMinVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesBelowMinVersionResponse proto.InternalMessageInfo
+
+func (m *NodesBelowMinVersionResponse) GetMinimumVersion() string {
+	if m != nil {
+		return m.MinimumVersion
+	}
+	return ""
+}
+
+func (m *NodesBelowMinVersionResponse) GetNodes() []*OutdatedNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NodesBelowMinVersionResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *NodesBelowMinVersionResponse) GetTotalNodes() int64 {
+	if m != nil {
+		return m.TotalNodes
+	}
+	return 0
+}
+
+func (m *NodesBelowMinVersionResponse) GetTotalFreeDisk() int64 {
+	if m != nil {
+		return m.TotalFreeDisk
+	}
+	return 0
+}
+
+type OutdatedNode struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Version              string    `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Release              bool      `protobuf:"varint,3,opt,name=release,proto3" json:"release,omitempty"`
+	FreeDisk             int64     `protobuf:"varint,4,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
+	LastContactSuccess   time.Time `protobuf:"bytes,5,opt,name=last_contact_success,json=lastContactSuccess,proto3,stdtime" json:"last_contact_success"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *OutdatedNode) Reset()         { *m = OutdatedNode{} }
+func (m *OutdatedNode) String() string { return proto.CompactTextString(m) }
+func (*OutdatedNode) ProtoMessage()    {}
+func (*OutdatedNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{107}
+}
+func (m *OutdatedNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutdatedNode.Unmarshal(m, b)
+}
+func (m *OutdatedNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutdatedNode.Marshal(b, m, deterministic)
+}
+func (m *OutdatedNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutdatedNode.Merge(m, src)
+}
+func (m *OutdatedNode) XXX_Size() int {
+	return xxx_messageInfo_OutdatedNode.Size(m)
+}
+func (m *OutdatedNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutdatedNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutdatedNode proto.InternalMessageInfo
+
+func (m *OutdatedNode) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *OutdatedNode) GetRelease() bool {
+	if m != nil {
+		return m.Release
+	}
+	return false
+}
+
+func (m *OutdatedNode) GetFreeDisk() int64 {
+	if m != nil {
+		return m.FreeDisk
+	}
+	return 0
+}
+
+func (m *OutdatedNode) GetLastContactSuccess() time.Time {
+	if m != nil {
+		return m.LastContactSuccess
+	}
+	return time.Time{}
+}
+
+type GetReputationThresholdsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReputationThresholdsRequest) Reset()         { *m = GetReputationThresholdsRequest{} }
+func (m *GetReputationThresholdsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReputationThresholdsRequest) ProtoMessage()    {}
+func (*GetReputationThresholdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{108}
+}
+func (m *GetReputationThresholdsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReputationThresholdsRequest.Unmarshal(m, b)
+}
+func (m *GetReputationThresholdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReputationThresholdsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReputationThresholdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReputationThresholdsRequest.Merge(m, src)
+}
+func (m *GetReputationThresholdsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReputationThresholdsRequest.Size(m)
+}
+func (m *GetReputationThresholdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReputationThresholdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReputationThresholdsRequest proto.InternalMessageInfo
+
+type GetReputationThresholdsResponse struct {
+	OnlineWindow             time.Duration     `protobuf:"bytes,1,opt,name=online_window,json=onlineWindow,proto3,stdduration" json:"online_window"`
+	AuditDq                  float64           `protobuf:"fixed64,2,opt,name=audit_dq,json=auditDq,proto3" json:"audit_dq,omitempty"`
+	UnknownAuditDq           float64           `protobuf:"fixed64,3,opt,name=unknown_audit_dq,json=unknownAuditDq,proto3" json:"unknown_audit_dq,omitempty"`
+	SuspensionGracePeriod    time.Duration     `protobuf:"bytes,4,opt,name=suspension_grace_period,json=suspensionGracePeriod,proto3,stdduration" json:"suspension_grace_period"`
+	SuspensionDqEnabled      bool              `protobuf:"varint,5,opt,name=suspension_dq_enabled,json=suspensionDqEnabled,proto3" json:"suspension_dq_enabled,omitempty"`
+	OfflineThreshold         float64           `protobuf:"fixed64,6,opt,name=offline_threshold,json=offlineThreshold,proto3" json:"offline_threshold,omitempty"`
+	OfflineSuspensionEnabled bool              `protobuf:"varint,7,opt,name=offline_suspension_enabled,json=offlineSuspensionEnabled,proto3" json:"offline_suspension_enabled,omitempty"`
+	OfflineDqEnabled         bool              `protobuf:"varint,8,opt,name=offline_dq_enabled,json=offlineDqEnabled,proto3" json:"offline_dq_enabled,omitempty"`
+	OfflineGracePeriod       time.Duration     `protobuf:"bytes,9,opt,name=offline_grace_period,json=offlineGracePeriod,proto3,stdduration" json:"offline_grace_period"`
+	TrackingPeriod           time.Duration     `protobuf:"bytes,10,opt,name=tracking_period,json=trackingPeriod,proto3,stdduration" json:"tracking_period"`
+	AuditWindowSize          time.Duration     `protobuf:"bytes,11,opt,name=audit_window_size,json=auditWindowSize,proto3,stdduration" json:"audit_window_size"`
+	VettingAuditCount        int64             `protobuf:"varint,12,opt,name=vetting_audit_count,json=vettingAuditCount,proto3" json:"vetting_audit_count,omitempty"`
+	Version                  *SatelliteVersion `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
+	XXX_unrecognized         []byte            `json:"-"`
+	XXX_sizecache            int32             `json:"-"`
+}
+
+func (m *GetReputationThresholdsResponse) Reset()         { *m = GetReputationThresholdsResponse{} }
+func (m *GetReputationThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReputationThresholdsResponse) ProtoMessage()    {}
+func (*GetReputationThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{109}
+}
+func (m *GetReputationThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReputationThresholdsResponse.Unmarshal(m, b)
+}
+func (m *GetReputationThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReputationThresholdsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReputationThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReputationThresholdsResponse.Merge(m, src)
+}
+func (m *GetReputationThresholdsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReputationThresholdsResponse.Size(m)
+}
+func (m *GetReputationThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReputationThresholdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReputationThresholdsResponse proto.InternalMessageInfo
+
+func (m *GetReputationThresholdsResponse) GetOnlineWindow() time.Duration {
+	if m != nil {
+		return m.OnlineWindow
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetAuditDq() float64 {
+	if m != nil {
+		return m.AuditDq
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetUnknownAuditDq() float64 {
+	if m != nil {
+		return m.UnknownAuditDq
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetSuspensionGracePeriod() time.Duration {
+	if m != nil {
+		return m.SuspensionGracePeriod
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetSuspensionDqEnabled() bool {
+	if m != nil {
+		return m.SuspensionDqEnabled
+	}
+	return false
+}
+
+func (m *GetReputationThresholdsResponse) GetOfflineThreshold() float64 {
+	if m != nil {
+		return m.OfflineThreshold
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetOfflineSuspensionEnabled() bool {
+	if m != nil {
+		return m.OfflineSuspensionEnabled
+	}
+	return false
+}
+
+func (m *GetReputationThresholdsResponse) GetOfflineDqEnabled() bool {
+	if m != nil {
+		return m.OfflineDqEnabled
+	}
+	return false
+}
+
+func (m *GetReputationThresholdsResponse) GetOfflineGracePeriod() time.Duration {
+	if m != nil {
+		return m.OfflineGracePeriod
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetTrackingPeriod() time.Duration {
+	if m != nil {
+		return m.TrackingPeriod
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetAuditWindowSize() time.Duration {
+	if m != nil {
+		return m.AuditWindowSize
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetVettingAuditCount() int64 {
+	if m != nil {
+		return m.VettingAuditCount
+	}
+	return 0
+}
+
+func (m *GetReputationThresholdsResponse) GetVersion() *SatelliteVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type SatelliteVersion struct {
+	Version              string    `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	CommitHash           string    `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Timestamp            time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Release              bool      `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SatelliteVersion) Reset()         { *m = SatelliteVersion{} }
+func (m *SatelliteVersion) String() string { return proto.CompactTextString(m) }
+func (*SatelliteVersion) ProtoMessage()    {}
+func (*SatelliteVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{110}
+}
+func (m *SatelliteVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteVersion.Unmarshal(m, b)
+}
+func (m *SatelliteVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteVersion.Marshal(b, m, deterministic)
+}
+func (m *SatelliteVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteVersion.Merge(m, src)
+}
+func (m *SatelliteVersion) XXX_Size() int {
+	return xxx_messageInfo_SatelliteVersion.Size(m)
+}
+func (m *SatelliteVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteVersion proto.InternalMessageInfo
+
+func (m *SatelliteVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *SatelliteVersion) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
+	}
+	return ""
+}
+
+func (m *SatelliteVersion) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *SatelliteVersion) GetRelease() bool {
+	if m != nil {
+		return m.Release
+	}
+	return false
+}
+
+type StaleGeoNodesRequest struct {
+	StaleAfter           time.Duration `protobuf:"bytes,1,opt,name=stale_after,json=staleAfter,proto3,stdduration" json:"stale_after"`
+	Offset               int32         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StaleGeoNodesRequest) Reset()         { *m = StaleGeoNodesRequest{} }
+func (m *StaleGeoNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*StaleGeoNodesRequest) ProtoMessage()    {}
+func (*StaleGeoNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{111}
+}
+func (m *StaleGeoNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StaleGeoNodesRequest.Unmarshal(m, b)
+}
+func (m *StaleGeoNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StaleGeoNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *StaleGeoNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleGeoNodesRequest.Merge(m, src)
+}
+func (m *StaleGeoNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_StaleGeoNodesRequest.Size(m)
+}
+func (m *StaleGeoNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleGeoNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleGeoNodesRequest proto.InternalMessageInfo
+
+func (m *StaleGeoNodesRequest) GetStaleAfter() time.Duration {
+	if m != nil {
+		return m.StaleAfter
+	}
+	return 0
+}
+
+func (m *StaleGeoNodesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *StaleGeoNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type StaleGeoNodesResponse struct {
+	Nodes                []*StaleGeoNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool            `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	TotalNodes           int64           `protobuf:"varint,3,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StaleGeoNodesResponse) Reset()         { *m = StaleGeoNodesResponse{} }
+func (m *StaleGeoNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*StaleGeoNodesResponse) ProtoMessage()    {}
+func (*StaleGeoNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{112}
+}
+func (m *StaleGeoNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StaleGeoNodesResponse.Unmarshal(m, b)
+}
+func (m *StaleGeoNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StaleGeoNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *StaleGeoNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleGeoNodesResponse.Merge(m, src)
+}
+func (m *StaleGeoNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_StaleGeoNodesResponse.Size(m)
+}
+func (m *StaleGeoNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleGeoNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleGeoNodesResponse proto.InternalMessageInfo
+
+func (m *StaleGeoNodesResponse) GetNodes() []*StaleGeoNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *StaleGeoNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *StaleGeoNodesResponse) GetTotalNodes() int64 {
+	if m != nil {
+		return m.TotalNodes
+	}
+	return 0
+}
+
+type StaleGeoNode struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	LastIpPort           string    `protobuf:"bytes,2,opt,name=last_ip_port,json=lastIpPort,proto3" json:"last_ip_port,omitempty"`
+	CountryCode          string    `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	GeoRefreshedAt       time.Time `protobuf:"bytes,4,opt,name=geo_refreshed_at,json=geoRefreshedAt,proto3,stdtime" json:"geo_refreshed_at"`
+	LastContactSuccess   time.Time `protobuf:"bytes,5,opt,name=last_contact_success,json=lastContactSuccess,proto3,stdtime" json:"last_contact_success"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *StaleGeoNode) Reset()         { *m = StaleGeoNode{} }
+func (m *StaleGeoNode) String() string { return proto.CompactTextString(m) }
+func (*StaleGeoNode) ProtoMessage()    {}
+func (*StaleGeoNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{113}
+}
+func (m *StaleGeoNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StaleGeoNode.Unmarshal(m, b)
+}
+func (m *StaleGeoNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StaleGeoNode.Marshal(b, m, deterministic)
+}
+func (m *StaleGeoNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleGeoNode.Merge(m, src)
+}
+func (m *StaleGeoNode) XXX_Size() int {
+	return xxx_messageInfo_StaleGeoNode.Size(m)
+}
+func (m *StaleGeoNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleGeoNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleGeoNode proto.InternalMessageInfo
+
+func (m *StaleGeoNode) GetLastIpPort() string {
+	if m != nil {
+		return m.LastIpPort
+	}
+	return ""
+}
+
+func (m *StaleGeoNode) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *StaleGeoNode) GetGeoRefreshedAt() time.Time {
+	if m != nil {
+		return m.GeoRefreshedAt
+	}
+	return time.Time{}
+}
+
+func (m *StaleGeoNode) GetLastContactSuccess() time.Time {
+	if m != nil {
+		return m.LastContactSuccess
+	}
+	return time.Time{}
+}
+
+type NodeFreeSpaceTrendRequest struct {
+	Window               time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	Offset               int32         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeFreeSpaceTrendRequest) Reset()         { *m = NodeFreeSpaceTrendRequest{} }
+func (m *NodeFreeSpaceTrendRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeFreeSpaceTrendRequest) ProtoMessage()    {}
+func (*NodeFreeSpaceTrendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{114}
+}
+func (m *NodeFreeSpaceTrendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeFreeSpaceTrendRequest.Unmarshal(m, b)
+}
+func (m *NodeFreeSpaceTrendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeFreeSpaceTrendRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeFreeSpaceTrendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeFreeSpaceTrendRequest.Merge(m, src)
+}
+func (m *NodeFreeSpaceTrendRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeFreeSpaceTrendRequest.Size(m)
+}
+func (m *NodeFreeSpaceTrendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeFreeSpaceTrendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeFreeSpaceTrendRequest proto.InternalMessageInfo
+
+func (m *NodeFreeSpaceTrendRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrendRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrendRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NodeFreeSpaceTrendResponse struct {
+	Nodes                   []*NodeFreeSpaceTrend `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                    bool                  `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	TotalNodes              int64                 `protobuf:"varint,3,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	NetworkFreeBytes        int64                 `protobuf:"varint,4,opt,name=network_free_bytes,json=networkFreeBytes,proto3" json:"network_free_bytes,omitempty"`
+	NetworkFreeChangePerDay int64                 `protobuf:"varint,5,opt,name=network_free_change_per_day,json=networkFreeChangePerDay,proto3" json:"network_free_change_per_day,omitempty"`
+	NetworkDaysUntilFull    float64               `protobuf:"fixed64,6,opt,name=network_days_until_full,json=networkDaysUntilFull,proto3" json:"network_days_until_full,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}              `json:"-"`
+	XXX_unrecognized        []byte                `json:"-"`
+	XXX_sizecache           int32                 `json:"-"`
+}
+
+func (m *NodeFreeSpaceTrendResponse) Reset()         { *m = NodeFreeSpaceTrendResponse{} }
+func (m *NodeFreeSpaceTrendResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeFreeSpaceTrendResponse) ProtoMessage()    {}
+func (*NodeFreeSpaceTrendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{115}
+}
+func (m *NodeFreeSpaceTrendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeFreeSpaceTrendResponse.Unmarshal(m, b)
+}
+func (m *NodeFreeSpaceTrendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeFreeSpaceTrendResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeFreeSpaceTrendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeFreeSpaceTrendResponse.Merge(m, src)
+}
+func (m *NodeFreeSpaceTrendResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeFreeSpaceTrendResponse.Size(m)
+}
+func (m *NodeFreeSpaceTrendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeFreeSpaceTrendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeFreeSpaceTrendResponse proto.InternalMessageInfo
+
+func (m *NodeFreeSpaceTrendResponse) GetNodes() []*NodeFreeSpaceTrend {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NodeFreeSpaceTrendResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *NodeFreeSpaceTrendResponse) GetTotalNodes() int64 {
+	if m != nil {
+		return m.TotalNodes
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrendResponse) GetNetworkFreeBytes() int64 {
+	if m != nil {
+		return m.NetworkFreeBytes
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrendResponse) GetNetworkFreeChangePerDay() int64 {
+	if m != nil {
+		return m.NetworkFreeChangePerDay
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrendResponse) GetNetworkDaysUntilFull() float64 {
+	if m != nil {
+		return m.NetworkDaysUntilFull
+	}
+	return 0
+}
+
+type NodeFreeSpaceTrend struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	FreeBytes            int64    `protobuf:"varint,2,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	StoredBytes          int64    `protobuf:"varint,3,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty"`
+	FreeChangePerDay     int64    `protobuf:"varint,4,opt,name=free_change_per_day,json=freeChangePerDay,proto3" json:"free_change_per_day,omitempty"`
+	DaysUntilFull        float64  `protobuf:"fixed64,5,opt,name=days_until_full,json=daysUntilFull,proto3" json:"days_until_full,omitempty"`
+	Rollups              int32    `protobuf:"varint,6,opt,name=rollups,proto3" json:"rollups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeFreeSpaceTrend) Reset()         { *m = NodeFreeSpaceTrend{} }
+func (m *NodeFreeSpaceTrend) String() string { return proto.CompactTextString(m) }
+func (*NodeFreeSpaceTrend) ProtoMessage()    {}
+func (*NodeFreeSpaceTrend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{116}
+}
+func (m *NodeFreeSpaceTrend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeFreeSpaceTrend.Unmarshal(m, b)
+}
+func (m *NodeFreeSpaceTrend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeFreeSpaceTrend.Marshal(b, m, deterministic)
+}
+func (m *NodeFreeSpaceTrend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeFreeSpaceTrend.Merge(m, src)
+}
+func (m *NodeFreeSpaceTrend) XXX_Size() int {
+	return xxx_messageInfo_NodeFreeSpaceTrend.Size(m)
+}
+func (m *NodeFreeSpaceTrend) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeFreeSpaceTrend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeFreeSpaceTrend proto.InternalMessageInfo
+
+func (m *NodeFreeSpaceTrend) GetFreeBytes() int64 {
+	if m != nil {
+		return m.FreeBytes
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrend) GetStoredBytes() int64 {
+	if m != nil {
+		return m.StoredBytes
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrend) GetFreeChangePerDay() int64 {
+	if m != nil {
+		return m.FreeChangePerDay
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrend) GetDaysUntilFull() float64 {
+	if m != nil {
+		return m.DaysUntilFull
+	}
+	return 0
+}
+
+func (m *NodeFreeSpaceTrend) GetRollups() int32 {
+	if m != nil {
+		return m.Rollups
+	}
+	return 0
+}
+
+type PlacementSelectionCountsRequest struct {
+	Window               time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PlacementSelectionCountsRequest) Reset()         { *m = PlacementSelectionCountsRequest{} }
+func (m *PlacementSelectionCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*PlacementSelectionCountsRequest) ProtoMessage()    {}
+func (*PlacementSelectionCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{117}
+}
+func (m *PlacementSelectionCountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementSelectionCountsRequest.Unmarshal(m, b)
+}
+func (m *PlacementSelectionCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementSelectionCountsRequest.Marshal(b, m, deterministic)
+}
+func (m *PlacementSelectionCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementSelectionCountsRequest.Merge(m, src)
+}
+func (m *PlacementSelectionCountsRequest) XXX_Size() int {
+	return xxx_messageInfo_PlacementSelectionCountsRequest.Size(m)
+}
+func (m *PlacementSelectionCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementSelectionCountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementSelectionCountsRequest proto.InternalMessageInfo
+
+func (m *PlacementSelectionCountsRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type PlacementSelectionCountsResponse struct {
+	Placements           []*PlacementSelectionCount `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+	CountedSince         time.Time                  `protobuf:"bytes,2,opt,name=counted_since,json=countedSince,proto3,stdtime" json:"counted_since"`
+	TotalSelectedNodes   int64                      `protobuf:"varint,3,opt,name=total_selected_nodes,json=totalSelectedNodes,proto3" json:"total_selected_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *PlacementSelectionCountsResponse) Reset()         { *m = PlacementSelectionCountsResponse{} }
+func (m *PlacementSelectionCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*PlacementSelectionCountsResponse) ProtoMessage()    {}
+func (*PlacementSelectionCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{118}
+}
+func (m *PlacementSelectionCountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementSelectionCountsResponse.Unmarshal(m, b)
+}
+func (m *PlacementSelectionCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementSelectionCountsResponse.Marshal(b, m, deterministic)
+}
+func (m *PlacementSelectionCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementSelectionCountsResponse.Merge(m, src)
+}
+func (m *PlacementSelectionCountsResponse) XXX_Size() int {
+	return xxx_messageInfo_PlacementSelectionCountsResponse.Size(m)
+}
+func (m *PlacementSelectionCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementSelectionCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementSelectionCountsResponse proto.InternalMessageInfo
+
+func (m *PlacementSelectionCountsResponse) GetPlacements() []*PlacementSelectionCount {
+	if m != nil {
+		return m.Placements
+	}
+	return nil
+}
+
+func (m *PlacementSelectionCountsResponse) GetCountedSince() time.Time {
+	if m != nil {
+		return m.CountedSince
+	}
+	return time.Time{}
+}
+
+func (m *PlacementSelectionCountsResponse) GetTotalSelectedNodes() int64 {
+	if m != nil {
+		return m.TotalSelectedNodes
+	}
+	return 0
+}
+
+type PlacementSelectionCount struct {
+	Placement            uint32   `protobuf:"varint,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	Selections           int64    `protobuf:"varint,2,opt,name=selections,proto3" json:"selections,omitempty"`
+	SelectedNodes        int64    `protobuf:"varint,3,opt,name=selected_nodes,json=selectedNodes,proto3" json:"selected_nodes,omitempty"`
+	Shortfalls           int64    `protobuf:"varint,4,opt,name=shortfalls,proto3" json:"shortfalls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementSelectionCount) Reset()         { *m = PlacementSelectionCount{} }
+func (m *PlacementSelectionCount) String() string { return proto.CompactTextString(m) }
+func (*PlacementSelectionCount) ProtoMessage()    {}
+func (*PlacementSelectionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{119}
+}
+func (m *PlacementSelectionCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementSelectionCount.Unmarshal(m, b)
+}
+func (m *PlacementSelectionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementSelectionCount.Marshal(b, m, deterministic)
+}
+func (m *PlacementSelectionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementSelectionCount.Merge(m, src)
+}
+func (m *PlacementSelectionCount) XXX_Size() int {
+	return xxx_messageInfo_PlacementSelectionCount.Size(m)
+}
+func (m *PlacementSelectionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementSelectionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementSelectionCount proto.InternalMessageInfo
+
+func (m *PlacementSelectionCount) GetPlacement() uint32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+func (m *PlacementSelectionCount) GetSelections() int64 {
+	if m != nil {
+		return m.Selections
+	}
+	return 0
+}
+
+func (m *PlacementSelectionCount) GetSelectedNodes() int64 {
+	if m != nil {
+		return m.SelectedNodes
+	}
+	return 0
+}
+
+func (m *PlacementSelectionCount) GetShortfalls() int64 {
+	if m != nil {
+		return m.Shortfalls
+	}
+	return 0
+}
+
+type ReinstateNodeRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	UnknownAudit         bool     `protobuf:"varint,2,opt,name=unknown_audit,json=unknownAudit,proto3" json:"unknown_audit,omitempty"`
+	Offline              bool     `protobuf:"varint,3,opt,name=offline,proto3" json:"offline,omitempty"`
+	Operator             string   `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReinstateNodeRequest) Reset()         { *m = ReinstateNodeRequest{} }
+func (m *ReinstateNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*ReinstateNodeRequest) ProtoMessage()    {}
+func (*ReinstateNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{120}
+}
+func (m *ReinstateNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReinstateNodeRequest.Unmarshal(m, b)
+}
+func (m *ReinstateNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReinstateNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *ReinstateNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReinstateNodeRequest.Merge(m, src)
+}
+func (m *ReinstateNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_ReinstateNodeRequest.Size(m)
+}
+func (m *ReinstateNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReinstateNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReinstateNodeRequest proto.InternalMessageInfo
+
+func (m *ReinstateNodeRequest) GetUnknownAudit() bool {
+	if m != nil {
+		return m.UnknownAudit
+	}
+	return false
+}
+
+func (m *ReinstateNodeRequest) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *ReinstateNodeRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *ReinstateNodeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ReinstateNodeResponse struct {
+	Status               *NodeStatus     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Before               *NodeReputation `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After                *NodeReputation `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReinstateNodeResponse) Reset()         { *m = ReinstateNodeResponse{} }
+func (m *ReinstateNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*ReinstateNodeResponse) ProtoMessage()    {}
+func (*ReinstateNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{121}
+}
+func (m *ReinstateNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReinstateNodeResponse.Unmarshal(m, b)
+}
+func (m *ReinstateNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReinstateNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *ReinstateNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReinstateNodeResponse.Merge(m, src)
+}
+func (m *ReinstateNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_ReinstateNodeResponse.Size(m)
+}
+func (m *ReinstateNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReinstateNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReinstateNodeResponse proto.InternalMessageInfo
+
+func (m *ReinstateNodeResponse) GetStatus() *NodeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReinstateNodeResponse) GetBefore() *NodeReputation {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *ReinstateNodeResponse) GetAfter() *NodeReputation {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type CapacityByCountryRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CapacityByCountryRequest) Reset()         { *m = CapacityByCountryRequest{} }
+func (m *CapacityByCountryRequest) String() string { return proto.CompactTextString(m) }
+func (*CapacityByCountryRequest) ProtoMessage()    {}
+func (*CapacityByCountryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{122}
+}
+func (m *CapacityByCountryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityByCountryRequest.Unmarshal(m, b)
+}
+func (m *CapacityByCountryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityByCountryRequest.Marshal(b, m, deterministic)
+}
+func (m *CapacityByCountryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityByCountryRequest.Merge(m, src)
+}
+func (m *CapacityByCountryRequest) XXX_Size() int {
+	return xxx_messageInfo_CapacityByCountryRequest.Size(m)
+}
+func (m *CapacityByCountryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityByCountryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapacityByCountryRequest proto.InternalMessageInfo
+
+type CapacityByCountryResponse struct {
+	Countries            []*CountryCapacity `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	Unknown              *CountryCapacity   `protobuf:"bytes,2,opt,name=unknown,proto3" json:"unknown,omitempty"`
+	TotalNodes           int64              `protobuf:"varint,3,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	TotalFreeDisk        int64              `protobuf:"varint,4,opt,name=total_free_disk,json=totalFreeDisk,proto3" json:"total_free_disk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CapacityByCountryResponse) Reset()         { *m = CapacityByCountryResponse{} }
+func (m *CapacityByCountryResponse) String() string { return proto.CompactTextString(m) }
+func (*CapacityByCountryResponse) ProtoMessage()    {}
+func (*CapacityByCountryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{123}
+}
+func (m *CapacityByCountryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityByCountryResponse.Unmarshal(m, b)
+}
+func (m *CapacityByCountryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityByCountryResponse.Marshal(b, m, deterministic)
+}
+func (m *CapacityByCountryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityByCountryResponse.Merge(m, src)
+}
+func (m *CapacityByCountryResponse) XXX_Size() int {
+	return xxx_messageInfo_CapacityByCountryResponse.Size(m)
+}
+func (m *CapacityByCountryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityByCountryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapacityByCountryResponse proto.InternalMessageInfo
+
+func (m *CapacityByCountryResponse) GetCountries() []*CountryCapacity {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *CapacityByCountryResponse) GetUnknown() *CountryCapacity {
+	if m != nil {
+		return m.Unknown
+	}
+	return nil
+}
+
+func (m *CapacityByCountryResponse) GetTotalNodes() int64 {
+	if m != nil {
+		return m.TotalNodes
+	}
+	return 0
+}
+
+func (m *CapacityByCountryResponse) GetTotalFreeDisk() int64 {
+	if m != nil {
+		return m.TotalFreeDisk
+	}
+	return 0
+}
+
+type CountryCapacity struct {
+	CountryCode          string   `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Nodes                int64    `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	VettedNodes          int64    `protobuf:"varint,3,opt,name=vetted_nodes,json=vettedNodes,proto3" json:"vetted_nodes,omitempty"`
+	FreeDisk             int64    `protobuf:"varint,4,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
+	UploadExcluded       bool     `protobuf:"varint,5,opt,name=upload_excluded,json=uploadExcluded,proto3" json:"upload_excluded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountryCapacity) Reset()         { *m = CountryCapacity{} }
+func (m *CountryCapacity) String() string { return proto.CompactTextString(m) }
+func (*CountryCapacity) ProtoMessage()    {}
+func (*CountryCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{124}
+}
+func (m *CountryCapacity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountryCapacity.Unmarshal(m, b)
+}
+func (m *CountryCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountryCapacity.Marshal(b, m, deterministic)
+}
+func (m *CountryCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountryCapacity.Merge(m, src)
+}
+func (m *CountryCapacity) XXX_Size() int {
+	return xxx_messageInfo_CountryCapacity.Size(m)
+}
+func (m *CountryCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountryCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountryCapacity proto.InternalMessageInfo
+
+func (m *CountryCapacity) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *CountryCapacity) GetNodes() int64 {
+	if m != nil {
+		return m.Nodes
+	}
+	return 0
+}
+
+func (m *CountryCapacity) GetVettedNodes() int64 {
+	if m != nil {
+		return m.VettedNodes
+	}
+	return 0
+}
+
+func (m *CountryCapacity) GetFreeDisk() int64 {
+	if m != nil {
+		return m.FreeDisk
+	}
+	return 0
+}
+
+func (m *CountryCapacity) GetUploadExcluded() bool {
+	if m != nil {
+		return m.UploadExcluded
+	}
+	return false
+}
+
+type RecentAuditFailuresRequest struct {
+	Audits               int32    `protobuf:"varint,1,opt,name=audits,proto3" json:"audits,omitempty"`
+	StartAfterFailures   int32    `protobuf:"varint,2,opt,name=start_after_failures,json=startAfterFailures,proto3" json:"start_after_failures,omitempty"`
+	StartAfter           NodeID   `protobuf:"bytes,3,opt,name=start_after,json=startAfter,proto3,customtype=NodeID" json:"start_after"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecentAuditFailuresRequest) Reset()         { *m = RecentAuditFailuresRequest{} }
+func (m *RecentAuditFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*RecentAuditFailuresRequest) ProtoMessage()    {}
+func (*RecentAuditFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{125}
+}
+func (m *RecentAuditFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentAuditFailuresRequest.Unmarshal(m, b)
+}
+func (m *RecentAuditFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentAuditFailuresRequest.Marshal(b, m, deterministic)
+}
+func (m *RecentAuditFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentAuditFailuresRequest.Merge(m, src)
+}
+func (m *RecentAuditFailuresRequest) XXX_Size() int {
+	return xxx_messageInfo_RecentAuditFailuresRequest.Size(m)
+}
+func (m *RecentAuditFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentAuditFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentAuditFailuresRequest proto.InternalMessageInfo
+
+func (m *RecentAuditFailuresRequest) GetAudits() int32 {
+	if m != nil {
+		return m.Audits
+	}
+	return 0
+}
+
+func (m *RecentAuditFailuresRequest) GetStartAfterFailures() int32 {
+	if m != nil {
+		return m.StartAfterFailures
+	}
+	return 0
+}
+
+func (m *RecentAuditFailuresRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type RecentAuditFailuresResponse struct {
+	Nodes                []*AuditFailureStreak `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool                  `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RecentAuditFailuresResponse) Reset()         { *m = RecentAuditFailuresResponse{} }
+func (m *RecentAuditFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentAuditFailuresResponse) ProtoMessage()    {}
+func (*RecentAuditFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{126}
+}
+func (m *RecentAuditFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentAuditFailuresResponse.Unmarshal(m, b)
+}
+func (m *RecentAuditFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentAuditFailuresResponse.Marshal(b, m, deterministic)
+}
+func (m *RecentAuditFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentAuditFailuresResponse.Merge(m, src)
+}
+func (m *RecentAuditFailuresResponse) XXX_Size() int {
+	return xxx_messageInfo_RecentAuditFailuresResponse.Size(m)
+}
+func (m *RecentAuditFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentAuditFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentAuditFailuresResponse proto.InternalMessageInfo
+
+func (m *RecentAuditFailuresResponse) GetNodes() []*AuditFailureStreak {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *RecentAuditFailuresResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type AuditFailureStreak struct {
+	NodeId               NodeID         `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Failures             int32          `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	Recent               []*FailedAudit `protobuf:"bytes,3,rep,name=recent,proto3" json:"recent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuditFailureStreak) Reset()         { *m = AuditFailureStreak{} }
+func (m *AuditFailureStreak) String() string { return proto.CompactTextString(m) }
+func (*AuditFailureStreak) ProtoMessage()    {}
+func (*AuditFailureStreak) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{127}
+}
+func (m *AuditFailureStreak) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditFailureStreak.Unmarshal(m, b)
+}
+func (m *AuditFailureStreak) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditFailureStreak.Marshal(b, m, deterministic)
+}
+func (m *AuditFailureStreak) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditFailureStreak.Merge(m, src)
+}
+func (m *AuditFailureStreak) XXX_Size() int {
+	return xxx_messageInfo_AuditFailureStreak.Size(m)
+}
+func (m *AuditFailureStreak) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditFailureStreak.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditFailureStreak proto.InternalMessageInfo
+
+func (m *AuditFailureStreak) GetFailures() int32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *AuditFailureStreak) GetRecent() []*FailedAudit {
+	if m != nil {
+		return m.Recent
+	}
+	return nil
+}
+
+type FailedAudit struct {
+	StreamId             []byte    `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position             int64     `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	FailedAt             time.Time `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3,stdtime" json:"failed_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *FailedAudit) Reset()         { *m = FailedAudit{} }
+func (m *FailedAudit) String() string { return proto.CompactTextString(m) }
+func (*FailedAudit) ProtoMessage()    {}
+func (*FailedAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{128}
+}
+func (m *FailedAudit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailedAudit.Unmarshal(m, b)
+}
+func (m *FailedAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailedAudit.Marshal(b, m, deterministic)
+}
+func (m *FailedAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedAudit.Merge(m, src)
+}
+func (m *FailedAudit) XXX_Size() int {
+	return xxx_messageInfo_FailedAudit.Size(m)
+}
+func (m *FailedAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedAudit proto.InternalMessageInfo
+
+func (m *FailedAudit) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *FailedAudit) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *FailedAudit) GetFailedAt() time.Time {
+	if m != nil {
+		return m.FailedAt
+	}
+	return time.Time{}
+}
+
+type ClockSkewNodesRequest struct {
+	Threshold            time.Duration `protobuf:"bytes,1,opt,name=threshold,proto3,stdduration" json:"threshold"`
+	Offset               int32         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClockSkewNodesRequest) Reset()         { *m = ClockSkewNodesRequest{} }
+func (m *ClockSkewNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ClockSkewNodesRequest) ProtoMessage()    {}
+func (*ClockSkewNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{129}
+}
+func (m *ClockSkewNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClockSkewNodesRequest.Unmarshal(m, b)
+}
+func (m *ClockSkewNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClockSkewNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *ClockSkewNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockSkewNodesRequest.Merge(m, src)
+}
+func (m *ClockSkewNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ClockSkewNodesRequest.Size(m)
+}
+func (m *ClockSkewNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockSkewNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClockSkewNodesRequest proto.InternalMessageInfo
+
+func (m *ClockSkewNodesRequest) GetThreshold() time.Duration {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ClockSkewNodesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ClockSkewNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ClockSkewNodesResponse struct {
+	Nodes                []*NodeClockSkew `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ClockSkewNodesResponse) Reset()         { *m = ClockSkewNodesResponse{} }
+func (m *ClockSkewNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ClockSkewNodesResponse) ProtoMessage()    {}
+func (*ClockSkewNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{130}
+}
+func (m *ClockSkewNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClockSkewNodesResponse.Unmarshal(m, b)
+}
+func (m *ClockSkewNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClockSkewNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *ClockSkewNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockSkewNodesResponse.Merge(m, src)
+}
+func (m *ClockSkewNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ClockSkewNodesResponse.Size(m)
+}
+func (m *ClockSkewNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockSkewNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClockSkewNodesResponse proto.InternalMessageInfo
+
+func (m *ClockSkewNodesResponse) GetNodes() []*NodeClockSkew {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ClockSkewNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type NodeClockSkew struct {
+	NodeId               NodeID        `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Skew                 time.Duration `protobuf:"bytes,2,opt,name=skew,proto3,stdduration" json:"skew"`
+	MeasuredAt           time.Time     `protobuf:"bytes,3,opt,name=measured_at,json=measuredAt,proto3,stdtime" json:"measured_at"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeClockSkew) Reset()         { *m = NodeClockSkew{} }
+func (m *NodeClockSkew) String() string { return proto.CompactTextString(m) }
+func (*NodeClockSkew) ProtoMessage()    {}
+func (*NodeClockSkew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{131}
+}
+func (m *NodeClockSkew) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeClockSkew.Unmarshal(m, b)
+}
+func (m *NodeClockSkew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeClockSkew.Marshal(b, m, deterministic)
+}
+func (m *NodeClockSkew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeClockSkew.Merge(m, src)
+}
+func (m *NodeClockSkew) XXX_Size() int {
+	return xxx_messageInfo_NodeClockSkew.Size(m)
+}
+func (m *NodeClockSkew) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeClockSkew.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeClockSkew proto.InternalMessageInfo
+
+func (m *NodeClockSkew) GetSkew() time.Duration {
+	if m != nil {
+		return m.Skew
+	}
+	return 0
+}
+
+func (m *NodeClockSkew) GetMeasuredAt() time.Time {
+	if m != nil {
+		return m.MeasuredAt
+	}
+	return time.Time{}
+}
+
+type CheckExitEligibilityRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckExitEligibilityRequest) Reset()         { *m = CheckExitEligibilityRequest{} }
+func (m *CheckExitEligibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckExitEligibilityRequest) ProtoMessage()    {}
+func (*CheckExitEligibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{132}
+}
+func (m *CheckExitEligibilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckExitEligibilityRequest.Unmarshal(m, b)
+}
+func (m *CheckExitEligibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckExitEligibilityRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckExitEligibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckExitEligibilityRequest.Merge(m, src)
+}
+func (m *CheckExitEligibilityRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckExitEligibilityRequest.Size(m)
+}
+func (m *CheckExitEligibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckExitEligibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckExitEligibilityRequest proto.InternalMessageInfo
+
+type CheckExitEligibilityResponse struct {
+	Eligible             bool                                `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Reason               CheckExitEligibilityResponse_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=satellite.inspector.CheckExitEligibilityResponse_Reason" json:"reason,omitempty"`
+	JoinedAt             time.Time                           `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3,stdtime" json:"joined_at"`
+	OldEnoughAt          time.Time                           `protobuf:"bytes,4,opt,name=old_enough_at,json=oldEnoughAt,proto3,stdtime" json:"old_enough_at"`
+	MonthsRequired       int32                               `protobuf:"varint,5,opt,name=months_required,json=monthsRequired,proto3" json:"months_required,omitempty"`
+	Suspended            bool                                `protobuf:"varint,6,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *CheckExitEligibilityResponse) Reset()         { *m = CheckExitEligibilityResponse{} }
+func (m *CheckExitEligibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckExitEligibilityResponse) ProtoMessage()    {}
+func (*CheckExitEligibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{133}
+}
+func (m *CheckExitEligibilityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckExitEligibilityResponse.Unmarshal(m, b)
+}
+func (m *CheckExitEligibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckExitEligibilityResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckExitEligibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckExitEligibilityResponse.Merge(m, src)
+}
+func (m *CheckExitEligibilityResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckExitEligibilityResponse.Size(m)
+}
+func (m *CheckExitEligibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckExitEligibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckExitEligibilityResponse proto.InternalMessageInfo
+
+func (m *CheckExitEligibilityResponse) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+func (m *CheckExitEligibilityResponse) GetReason() CheckExitEligibilityResponse_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return CheckExitEligibilityResponse_ELIGIBLE
+}
+
+func (m *CheckExitEligibilityResponse) GetJoinedAt() time.Time {
+	if m != nil {
+		return m.JoinedAt
+	}
+	return time.Time{}
+}
+
+func (m *CheckExitEligibilityResponse) GetOldEnoughAt() time.Time {
+	if m != nil {
+		return m.OldEnoughAt
+	}
+	return time.Time{}
+}
+
+func (m *CheckExitEligibilityResponse) GetMonthsRequired() int32 {
+	if m != nil {
+		return m.MonthsRequired
+	}
+	return 0
+}
+
+func (m *CheckExitEligibilityResponse) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+type NodeRepairStatsRequest struct {
+	NodeId               NodeID        `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Window               time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeRepairStatsRequest) Reset()         { *m = NodeRepairStatsRequest{} }
+func (m *NodeRepairStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeRepairStatsRequest) ProtoMessage()    {}
+func (*NodeRepairStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{134}
+}
+func (m *NodeRepairStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeRepairStatsRequest.Unmarshal(m, b)
+}
+func (m *NodeRepairStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeRepairStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeRepairStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRepairStatsRequest.Merge(m, src)
+}
+func (m *NodeRepairStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeRepairStatsRequest.Size(m)
+}
+func (m *NodeRepairStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRepairStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeRepairStatsRequest proto.InternalMessageInfo
+
+func (m *NodeRepairStatsRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type NodeRepairStatsResponse struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Since                time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
+	PiecesRemoved        int64     `protobuf:"varint,3,opt,name=pieces_removed,json=piecesRemoved,proto3" json:"pieces_removed,omitempty"`
+	PiecesAdded          int64     `protobuf:"varint,4,opt,name=pieces_added,json=piecesAdded,proto3" json:"pieces_added,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *NodeRepairStatsResponse) Reset()         { *m = NodeRepairStatsResponse{} }
+func (m *NodeRepairStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeRepairStatsResponse) ProtoMessage()    {}
+func (*NodeRepairStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{135}
+}
+func (m *NodeRepairStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeRepairStatsResponse.Unmarshal(m, b)
+}
+func (m *NodeRepairStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeRepairStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeRepairStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRepairStatsResponse.Merge(m, src)
+}
+func (m *NodeRepairStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeRepairStatsResponse.Size(m)
+}
+func (m *NodeRepairStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRepairStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeRepairStatsResponse proto.InternalMessageInfo
+
+func (m *NodeRepairStatsResponse) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+func (m *NodeRepairStatsResponse) GetPiecesRemoved() int64 {
+	if m != nil {
+		return m.PiecesRemoved
+	}
+	return 0
+}
+
+func (m *NodeRepairStatsResponse) GetPiecesAdded() int64 {
+	if m != nil {
+		return m.PiecesAdded
+	}
+	return 0
+}
+
+type TrustingNodesRequest struct {
+	Window               time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	Limit                int32         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	StartAfterCheckIn    time.Time     `protobuf:"bytes,3,opt,name=start_after_check_in,json=startAfterCheckIn,proto3,stdtime" json:"start_after_check_in"`
+	StartAfter           NodeID        `protobuf:"bytes,4,opt,name=start_after,json=startAfter,proto3,customtype=NodeID" json:"start_after"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TrustingNodesRequest) Reset()         { *m = TrustingNodesRequest{} }
+func (m *TrustingNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustingNodesRequest) ProtoMessage()    {}
+func (*TrustingNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{136}
+}
+func (m *TrustingNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustingNodesRequest.Unmarshal(m, b)
+}
+func (m *TrustingNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustingNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *TrustingNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustingNodesRequest.Merge(m, src)
+}
+func (m *TrustingNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_TrustingNodesRequest.Size(m)
+}
+func (m *TrustingNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustingNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustingNodesRequest proto.InternalMessageInfo
+
+func (m *TrustingNodesRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *TrustingNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *TrustingNodesRequest) GetStartAfterCheckIn() time.Time {
+	if m != nil {
+		return m.StartAfterCheckIn
+	}
+	return time.Time{}
+}
+
+type TrustingNodesResponse struct {
+	Nodes                []*TrustingNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool            `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	TrustingNodes        int64           `protobuf:"varint,3,opt,name=trusting_nodes,json=trustingNodes,proto3" json:"trusting_nodes,omitempty"`
+	TotalNodes           int64           `protobuf:"varint,4,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	Since                time.Time       `protobuf:"bytes,5,opt,name=since,proto3,stdtime" json:"since"`
+	LatestCheckIn        time.Time       `protobuf:"bytes,6,opt,name=latest_check_in,json=latestCheckIn,proto3,stdtime" json:"latest_check_in"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TrustingNodesResponse) Reset()         { *m = TrustingNodesResponse{} }
+func (m *TrustingNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustingNodesResponse) ProtoMessage()    {}
+func (*TrustingNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{137}
+}
+func (m *TrustingNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustingNodesResponse.Unmarshal(m, b)
+}
+func (m *TrustingNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustingNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *TrustingNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustingNodesResponse.Merge(m, src)
+}
+func (m *TrustingNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_TrustingNodesResponse.Size(m)
+}
+func (m *TrustingNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustingNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustingNodesResponse proto.InternalMessageInfo
+
+func (m *TrustingNodesResponse) GetNodes() []*TrustingNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *TrustingNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *TrustingNodesResponse) GetTrustingNodes() int64 {
+	if m != nil {
+		return m.TrustingNodes
+	}
+	return 0
+}
+
+func (m *TrustingNodesResponse) GetTotalNodes() int64 {
+	if m != nil {
+		return m.TotalNodes
+	}
+	return 0
+}
+
+func (m *TrustingNodesResponse) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+func (m *TrustingNodesResponse) GetLatestCheckIn() time.Time {
+	if m != nil {
+		return m.LatestCheckIn
+	}
+	return time.Time{}
+}
+
+type TrustingNode struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	LastIpPort           string    `protobuf:"bytes,2,opt,name=last_ip_port,json=lastIpPort,proto3" json:"last_ip_port,omitempty"`
+	LastContactSuccess   time.Time `protobuf:"bytes,3,opt,name=last_contact_success,json=lastContactSuccess,proto3,stdtime" json:"last_contact_success"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *TrustingNode) Reset()         { *m = TrustingNode{} }
+func (m *TrustingNode) String() string { return proto.CompactTextString(m) }
+func (*TrustingNode) ProtoMessage()    {}
+func (*TrustingNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{138}
+}
+func (m *TrustingNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustingNode.Unmarshal(m, b)
+}
+func (m *TrustingNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustingNode.Marshal(b, m, deterministic)
+}
+func (m *TrustingNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustingNode.Merge(m, src)
+}
+func (m *TrustingNode) XXX_Size() int {
+	return xxx_messageInfo_TrustingNode.Size(m)
+}
+func (m *TrustingNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustingNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustingNode proto.InternalMessageInfo
+
+func (m *TrustingNode) GetLastIpPort() string {
+	if m != nil {
+		return m.LastIpPort
+	}
+	return ""
+}
+
+func (m *TrustingNode) GetLastContactSuccess() time.Time {
+	if m != nil {
+		return m.LastContactSuccess
+	}
+	return time.Time{}
+}
+
+type SubnetSaturationStatsRequest struct {
+	RequiredCount        int32    `protobuf:"varint,1,opt,name=required_count,json=requiredCount,proto3" json:"required_count,omitempty"`
+	Placement            int32    `protobuf:"varint,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubnetSaturationStatsRequest) Reset()         { *m = SubnetSaturationStatsRequest{} }
+func (m *SubnetSaturationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubnetSaturationStatsRequest) ProtoMessage()    {}
+func (*SubnetSaturationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{139}
+}
+func (m *SubnetSaturationStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubnetSaturationStatsRequest.Unmarshal(m, b)
+}
+func (m *SubnetSaturationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubnetSaturationStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *SubnetSaturationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetSaturationStatsRequest.Merge(m, src)
+}
+func (m *SubnetSaturationStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubnetSaturationStatsRequest.Size(m)
+}
+func (m *SubnetSaturationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetSaturationStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetSaturationStatsRequest proto.InternalMessageInfo
+
+func (m *SubnetSaturationStatsRequest) GetRequiredCount() int32 {
+	if m != nil {
+		return m.RequiredCount
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsRequest) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+type SubnetSaturationStatsResponse struct {
+	EligibleNodes            int64    `protobuf:"varint,1,opt,name=eligible_nodes,json=eligibleNodes,proto3" json:"eligible_nodes,omitempty"`
+	DistinctSubnets          int64    `protobuf:"varint,2,opt,name=distinct_subnets,json=distinctSubnets,proto3" json:"distinct_subnets,omitempty"`
+	SaturatedSubnets         int64    `protobuf:"varint,3,opt,name=saturated_subnets,json=saturatedSubnets,proto3" json:"saturated_subnets,omitempty"`
+	LargestSubnet            int64    `protobuf:"varint,4,opt,name=largest_subnet,json=largestSubnet,proto3" json:"largest_subnet,omitempty"`
+	ExcludedNodes            int64    `protobuf:"varint,5,opt,name=excluded_nodes,json=excludedNodes,proto3" json:"excluded_nodes,omitempty"`
+	ExcludedFreeDisk         int64    `protobuf:"varint,6,opt,name=excluded_free_disk,json=excludedFreeDisk,proto3" json:"excluded_free_disk,omitempty"`
+	Selectable               int64    `protobuf:"varint,7,opt,name=selectable,proto3" json:"selectable,omitempty"`
+	SelectableWithoutSubnets int64    `protobuf:"varint,8,opt,name=selectable_without_subnets,json=selectableWithoutSubnets,proto3" json:"selectable_without_subnets,omitempty"`
+	DistinctIp               bool     `protobuf:"varint,9,opt,name=distinct_ip,json=distinctIp,proto3" json:"distinct_ip,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *SubnetSaturationStatsResponse) Reset()         { *m = SubnetSaturationStatsResponse{} }
+func (m *SubnetSaturationStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubnetSaturationStatsResponse) ProtoMessage()    {}
+func (*SubnetSaturationStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{140}
+}
+func (m *SubnetSaturationStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubnetSaturationStatsResponse.Unmarshal(m, b)
+}
+func (m *SubnetSaturationStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubnetSaturationStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *SubnetSaturationStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetSaturationStatsResponse.Merge(m, src)
+}
+func (m *SubnetSaturationStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_SubnetSaturationStatsResponse.Size(m)
+}
+func (m *SubnetSaturationStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetSaturationStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetSaturationStatsResponse proto.InternalMessageInfo
+
+func (m *SubnetSaturationStatsResponse) GetEligibleNodes() int64 {
+	if m != nil {
+		return m.EligibleNodes
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetDistinctSubnets() int64 {
+	if m != nil {
+		return m.DistinctSubnets
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetSaturatedSubnets() int64 {
+	if m != nil {
+		return m.SaturatedSubnets
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetLargestSubnet() int64 {
+	if m != nil {
+		return m.LargestSubnet
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetExcludedNodes() int64 {
+	if m != nil {
+		return m.ExcludedNodes
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetExcludedFreeDisk() int64 {
+	if m != nil {
+		return m.ExcludedFreeDisk
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetSelectable() int64 {
+	if m != nil {
+		return m.Selectable
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetSelectableWithoutSubnets() int64 {
+	if m != nil {
+		return m.SelectableWithoutSubnets
+	}
+	return 0
+}
+
+func (m *SubnetSaturationStatsResponse) GetDistinctIp() bool {
+	if m != nil {
+		return m.DistinctIp
+	}
+	return false
+}
+
+type PendingGCStatsRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingGCStatsRequest) Reset()         { *m = PendingGCStatsRequest{} }
+func (m *PendingGCStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingGCStatsRequest) ProtoMessage()    {}
+func (*PendingGCStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{141}
+}
+func (m *PendingGCStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingGCStatsRequest.Unmarshal(m, b)
+}
+func (m *PendingGCStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingGCStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *PendingGCStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingGCStatsRequest.Merge(m, src)
+}
+func (m *PendingGCStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingGCStatsRequest.Size(m)
+}
+func (m *PendingGCStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingGCStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingGCStatsRequest proto.InternalMessageInfo
+
+type PendingGCStatsResponse struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	FilterSent           bool      `protobuf:"varint,2,opt,name=filter_sent,json=filterSent,proto3" json:"filter_sent,omitempty"`
+	LastSentAt           time.Time `protobuf:"bytes,3,opt,name=last_sent_at,json=lastSentAt,proto3,stdtime" json:"last_sent_at"`
+	FilterCreatedAt      time.Time `protobuf:"bytes,4,opt,name=filter_created_at,json=filterCreatedAt,proto3,stdtime" json:"filter_created_at"`
+	PiecesRetained       int64     `protobuf:"varint,5,opt,name=pieces_retained,json=piecesRetained,proto3" json:"pieces_retained,omitempty"`
+	PendingPieces        int64     `protobuf:"varint,6,opt,name=pending_pieces,json=pendingPieces,proto3" json:"pending_pieces,omitempty"`
+	PendingSince         time.Time `protobuf:"bytes,7,opt,name=pending_since,json=pendingSince,proto3,stdtime" json:"pending_since"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PendingGCStatsResponse) Reset()         { *m = PendingGCStatsResponse{} }
+func (m *PendingGCStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingGCStatsResponse) ProtoMessage()    {}
+func (*PendingGCStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{142}
+}
+func (m *PendingGCStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingGCStatsResponse.Unmarshal(m, b)
+}
+func (m *PendingGCStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingGCStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *PendingGCStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingGCStatsResponse.Merge(m, src)
+}
+func (m *PendingGCStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingGCStatsResponse.Size(m)
+}
+func (m *PendingGCStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingGCStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingGCStatsResponse proto.InternalMessageInfo
+
+func (m *PendingGCStatsResponse) GetFilterSent() bool {
+	if m != nil {
+		return m.FilterSent
+	}
+	return false
+}
+
+func (m *PendingGCStatsResponse) GetLastSentAt() time.Time {
+	if m != nil {
+		return m.LastSentAt
+	}
+	return time.Time{}
+}
+
+func (m *PendingGCStatsResponse) GetFilterCreatedAt() time.Time {
+	if m != nil {
+		return m.FilterCreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *PendingGCStatsResponse) GetPiecesRetained() int64 {
+	if m != nil {
+		return m.PiecesRetained
+	}
+	return 0
+}
+
+func (m *PendingGCStatsResponse) GetPendingPieces() int64 {
+	if m != nil {
+		return m.PendingPieces
+	}
+	return 0
+}
+
+func (m *PendingGCStatsResponse) GetPendingSince() time.Time {
+	if m != nil {
+		return m.PendingSince
+	}
+	return time.Time{}
+}
+
+type ProjectsOverLimitRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	CursorOverage        int64    `protobuf:"varint,2,opt,name=cursor_overage,json=cursorOverage,proto3" json:"cursor_overage,omitempty"`
+	CursorProjectId      []byte   `protobuf:"bytes,3,opt,name=cursor_project_id,json=cursorProjectId,proto3" json:"cursor_project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectsOverLimitRequest) Reset()         { *m = ProjectsOverLimitRequest{} }
+func (m *ProjectsOverLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectsOverLimitRequest) ProtoMessage()    {}
+func (*ProjectsOverLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{143}
+}
+func (m *ProjectsOverLimitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectsOverLimitRequest.Unmarshal(m, b)
+}
+func (m *ProjectsOverLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectsOverLimitRequest.Marshal(b, m, deterministic)
+}
+func (m *ProjectsOverLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectsOverLimitRequest.Merge(m, src)
+}
+func (m *ProjectsOverLimitRequest) XXX_Size() int {
+	return xxx_messageInfo_ProjectsOverLimitRequest.Size(m)
+}
+func (m *ProjectsOverLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectsOverLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectsOverLimitRequest proto.InternalMessageInfo
+
+func (m *ProjectsOverLimitRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ProjectsOverLimitRequest) GetCursorOverage() int64 {
+	if m != nil {
+		return m.CursorOverage
+	}
+	return 0
+}
+
+func (m *ProjectsOverLimitRequest) GetCursorProjectId() []byte {
+	if m != nil {
+		return m.CursorProjectId
+	}
+	return nil
+}
+
+type ProjectsOverLimitResponse struct {
+	Projects             []*ProjectOverLimit `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	More                 bool                `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	ProjectsChecked      int64               `protobuf:"varint,3,opt,name=projects_checked,json=projectsChecked,proto3" json:"projects_checked,omitempty"`
+	OverStorageLimit     int64               `protobuf:"varint,4,opt,name=over_storage_limit,json=overStorageLimit,proto3" json:"over_storage_limit,omitempty"`
+	OverBandwidthLimit   int64               `protobuf:"varint,5,opt,name=over_bandwidth_limit,json=overBandwidthLimit,proto3" json:"over_bandwidth_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ProjectsOverLimitResponse) Reset()         { *m = ProjectsOverLimitResponse{} }
+func (m *ProjectsOverLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectsOverLimitResponse) ProtoMessage()    {}
+func (*ProjectsOverLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{144}
+}
+func (m *ProjectsOverLimitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectsOverLimitResponse.Unmarshal(m, b)
+}
+func (m *ProjectsOverLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectsOverLimitResponse.Marshal(b, m, deterministic)
+}
+func (m *ProjectsOverLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectsOverLimitResponse.Merge(m, src)
+}
+func (m *ProjectsOverLimitResponse) XXX_Size() int {
+	return xxx_messageInfo_ProjectsOverLimitResponse.Size(m)
+}
+func (m *ProjectsOverLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectsOverLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectsOverLimitResponse proto.InternalMessageInfo
+
+func (m *ProjectsOverLimitResponse) GetProjects() []*ProjectOverLimit {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ProjectsOverLimitResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *ProjectsOverLimitResponse) GetProjectsChecked() int64 {
+	if m != nil {
+		return m.ProjectsChecked
+	}
+	return 0
+}
+
+func (m *ProjectsOverLimitResponse) GetOverStorageLimit() int64 {
+	if m != nil {
+		return m.OverStorageLimit
+	}
+	return 0
+}
+
+func (m *ProjectsOverLimitResponse) GetOverBandwidthLimit() int64 {
+	if m != nil {
+		return m.OverBandwidthLimit
+	}
+	return 0
+}
+
+type ProjectOverLimit struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	OwnerId              []byte   `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StorageUsed          int64    `protobuf:"varint,4,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
+	StorageLimit         int64    `protobuf:"varint,5,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`
+	BandwidthUsed        int64    `protobuf:"varint,6,opt,name=bandwidth_used,json=bandwidthUsed,proto3" json:"bandwidth_used,omitempty"`
+	BandwidthLimit       int64    `protobuf:"varint,7,opt,name=bandwidth_limit,json=bandwidthLimit,proto3" json:"bandwidth_limit,omitempty"`
+	Overage              int64    `protobuf:"varint,8,opt,name=overage,proto3" json:"overage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectOverLimit) Reset()         { *m = ProjectOverLimit{} }
+func (m *ProjectOverLimit) String() string { return proto.CompactTextString(m) }
+func (*ProjectOverLimit) ProtoMessage()    {}
+func (*ProjectOverLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{145}
+}
+func (m *ProjectOverLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectOverLimit.Unmarshal(m, b)
+}
+func (m *ProjectOverLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectOverLimit.Marshal(b, m, deterministic)
+}
+func (m *ProjectOverLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectOverLimit.Merge(m, src)
+}
+func (m *ProjectOverLimit) XXX_Size() int {
+	return xxx_messageInfo_ProjectOverLimit.Size(m)
+}
+func (m *ProjectOverLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectOverLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectOverLimit proto.InternalMessageInfo
+
+func (m *ProjectOverLimit) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *ProjectOverLimit) GetOwnerId() []byte {
+	if m != nil {
+		return m.OwnerId
+	}
+	return nil
+}
+
+func (m *ProjectOverLimit) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProjectOverLimit) GetStorageUsed() int64 {
+	if m != nil {
+		return m.StorageUsed
+	}
+	return 0
+}
+
+func (m *ProjectOverLimit) GetStorageLimit() int64 {
+	if m != nil {
+		return m.StorageLimit
+	}
+	return 0
+}
+
+func (m *ProjectOverLimit) GetBandwidthUsed() int64 {
+	if m != nil {
+		return m.BandwidthUsed
+	}
+	return 0
+}
+
+func (m *ProjectOverLimit) GetBandwidthLimit() int64 {
+	if m != nil {
+		return m.BandwidthLimit
+	}
+	return 0
+}
+
+func (m *ProjectOverLimit) GetOverage() int64 {
+	if m != nil {
+		return m.Overage
+	}
+	return 0
+}
+
+type SimulateBulkDisqualificationRequest struct {
+	NodeIds              []NodeID `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3,customtype=NodeID" json:"node_ids,omitempty"`
+	SampleSize           int32    `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	StartStreamId        []byte   `protobuf:"bytes,3,opt,name=start_stream_id,json=startStreamId,proto3" json:"start_stream_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateBulkDisqualificationRequest) Reset()         { *m = SimulateBulkDisqualificationRequest{} }
+func (m *SimulateBulkDisqualificationRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBulkDisqualificationRequest) ProtoMessage()    {}
+func (*SimulateBulkDisqualificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{146}
+}
+func (m *SimulateBulkDisqualificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateBulkDisqualificationRequest.Unmarshal(m, b)
+}
+func (m *SimulateBulkDisqualificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateBulkDisqualificationRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateBulkDisqualificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBulkDisqualificationRequest.Merge(m, src)
+}
+func (m *SimulateBulkDisqualificationRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateBulkDisqualificationRequest.Size(m)
+}
+func (m *SimulateBulkDisqualificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBulkDisqualificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBulkDisqualificationRequest proto.InternalMessageInfo
+
+func (m *SimulateBulkDisqualificationRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationRequest) GetStartStreamId() []byte {
+	if m != nil {
+		return m.StartStreamId
+	}
+	return nil
+}
+
+type SimulateBulkDisqualificationResponse struct {
+	SegmentsScanned           int64    `protobuf:"varint,1,opt,name=segments_scanned,json=segmentsScanned,proto3" json:"segments_scanned,omitempty"`
+	AffectedSegments          int64    `protobuf:"varint,2,opt,name=affected_segments,json=affectedSegments,proto3" json:"affected_segments,omitempty"`
+	RemovedPieces             int64    `protobuf:"varint,3,opt,name=removed_pieces,json=removedPieces,proto3" json:"removed_pieces,omitempty"`
+	WouldQueue                int64    `protobuf:"varint,4,opt,name=would_queue,json=wouldQueue,proto3" json:"would_queue,omitempty"`
+	NewlyQueued               int64    `protobuf:"varint,5,opt,name=newly_queued,json=newlyQueued,proto3" json:"newly_queued,omitempty"`
+	Irreparable               int64    `protobuf:"varint,6,opt,name=irreparable,proto3" json:"irreparable,omitempty"`
+	NewlyIrreparable          int64    `protobuf:"varint,7,opt,name=newly_irreparable,json=newlyIrreparable,proto3" json:"newly_irreparable,omitempty"`
+	EstimatedNewlyQueued      int64    `protobuf:"varint,8,opt,name=estimated_newly_queued,json=estimatedNewlyQueued,proto3" json:"estimated_newly_queued,omitempty"`
+	EstimatedNewlyIrreparable int64    `protobuf:"varint,9,opt,name=estimated_newly_irreparable,json=estimatedNewlyIrreparable,proto3" json:"estimated_newly_irreparable,omitempty"`
+	SampleFraction            float64  `protobuf:"fixed64,10,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+	Exact                     bool     `protobuf:"varint,11,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *SimulateBulkDisqualificationResponse) Reset()         { *m = SimulateBulkDisqualificationResponse{} }
+func (m *SimulateBulkDisqualificationResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateBulkDisqualificationResponse) ProtoMessage()    {}
+func (*SimulateBulkDisqualificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{147}
+}
+func (m *SimulateBulkDisqualificationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateBulkDisqualificationResponse.Unmarshal(m, b)
+}
+func (m *SimulateBulkDisqualificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateBulkDisqualificationResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulateBulkDisqualificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBulkDisqualificationResponse.Merge(m, src)
+}
+func (m *SimulateBulkDisqualificationResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateBulkDisqualificationResponse.Size(m)
+}
+func (m *SimulateBulkDisqualificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBulkDisqualificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBulkDisqualificationResponse proto.InternalMessageInfo
+
+func (m *SimulateBulkDisqualificationResponse) GetSegmentsScanned() int64 {
+	if m != nil {
+		return m.SegmentsScanned
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetAffectedSegments() int64 {
+	if m != nil {
+		return m.AffectedSegments
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetRemovedPieces() int64 {
+	if m != nil {
+		return m.RemovedPieces
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetWouldQueue() int64 {
+	if m != nil {
+		return m.WouldQueue
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetNewlyQueued() int64 {
+	if m != nil {
+		return m.NewlyQueued
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetIrreparable() int64 {
+	if m != nil {
+		return m.Irreparable
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetNewlyIrreparable() int64 {
+	if m != nil {
+		return m.NewlyIrreparable
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetEstimatedNewlyQueued() int64 {
+	if m != nil {
+		return m.EstimatedNewlyQueued
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetEstimatedNewlyIrreparable() int64 {
+	if m != nil {
+		return m.EstimatedNewlyIrreparable
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetSampleFraction() float64 {
+	if m != nil {
+		return m.SampleFraction
+	}
+	return 0
+}
+
+func (m *SimulateBulkDisqualificationResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+type AuditVsTrafficAnomalyRequest struct {
+	Window               time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	MinDivergence        float64       `protobuf:"fixed64,2,opt,name=min_divergence,json=minDivergence,proto3" json:"min_divergence,omitempty"`
+	MinDownloads         int64         `protobuf:"varint,3,opt,name=min_downloads,json=minDownloads,proto3" json:"min_downloads,omitempty"`
+	Limit                int32         `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32         `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditVsTrafficAnomalyRequest) Reset()         { *m = AuditVsTrafficAnomalyRequest{} }
+func (m *AuditVsTrafficAnomalyRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditVsTrafficAnomalyRequest) ProtoMessage()    {}
+func (*AuditVsTrafficAnomalyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{148}
+}
+func (m *AuditVsTrafficAnomalyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditVsTrafficAnomalyRequest.Unmarshal(m, b)
+}
+func (m *AuditVsTrafficAnomalyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditVsTrafficAnomalyRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditVsTrafficAnomalyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditVsTrafficAnomalyRequest.Merge(m, src)
+}
+func (m *AuditVsTrafficAnomalyRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditVsTrafficAnomalyRequest.Size(m)
+}
+func (m *AuditVsTrafficAnomalyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditVsTrafficAnomalyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditVsTrafficAnomalyRequest proto.InternalMessageInfo
+
+func (m *AuditVsTrafficAnomalyRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficAnomalyRequest) GetMinDivergence() float64 {
+	if m != nil {
+		return m.MinDivergence
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficAnomalyRequest) GetMinDownloads() int64 {
+	if m != nil {
+		return m.MinDownloads
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficAnomalyRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficAnomalyRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type AuditVsTrafficAnomalyResponse struct {
+	Nodes                []*AuditVsTrafficNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool                  `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	NodesChecked         int64                 `protobuf:"varint,3,opt,name=nodes_checked,json=nodesChecked,proto3" json:"nodes_checked,omitempty"`
+	Since                time.Time             `protobuf:"bytes,4,opt,name=since,proto3,stdtime" json:"since"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AuditVsTrafficAnomalyResponse) Reset()         { *m = AuditVsTrafficAnomalyResponse{} }
+func (m *AuditVsTrafficAnomalyResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditVsTrafficAnomalyResponse) ProtoMessage()    {}
+func (*AuditVsTrafficAnomalyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{149}
+}
+func (m *AuditVsTrafficAnomalyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditVsTrafficAnomalyResponse.Unmarshal(m, b)
+}
+func (m *AuditVsTrafficAnomalyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditVsTrafficAnomalyResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditVsTrafficAnomalyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditVsTrafficAnomalyResponse.Merge(m, src)
+}
+func (m *AuditVsTrafficAnomalyResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditVsTrafficAnomalyResponse.Size(m)
+}
+func (m *AuditVsTrafficAnomalyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditVsTrafficAnomalyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditVsTrafficAnomalyResponse proto.InternalMessageInfo
+
+func (m *AuditVsTrafficAnomalyResponse) GetNodes() []*AuditVsTrafficNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *AuditVsTrafficAnomalyResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *AuditVsTrafficAnomalyResponse) GetNodesChecked() int64 {
+	if m != nil {
+		return m.NodesChecked
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficAnomalyResponse) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+type AuditVsTrafficNode struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	AuditScore           float64  `protobuf:"fixed64,2,opt,name=audit_score,json=auditScore,proto3" json:"audit_score,omitempty"`
+	DownloadSuccessRate  float64  `protobuf:"fixed64,3,opt,name=download_success_rate,json=downloadSuccessRate,proto3" json:"download_success_rate,omitempty"`
+	DownloadsSucceeded   int64    `protobuf:"varint,4,opt,name=downloads_succeeded,json=downloadsSucceeded,proto3" json:"downloads_succeeded,omitempty"`
+	DownloadsFailed      int64    `protobuf:"varint,5,opt,name=downloads_failed,json=downloadsFailed,proto3" json:"downloads_failed,omitempty"`
+	Divergence           float64  `protobuf:"fixed64,6,opt,name=divergence,proto3" json:"divergence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditVsTrafficNode) Reset()         { *m = AuditVsTrafficNode{} }
+func (m *AuditVsTrafficNode) String() string { return proto.CompactTextString(m) }
+func (*AuditVsTrafficNode) ProtoMessage()    {}
+func (*AuditVsTrafficNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{150}
+}
+func (m *AuditVsTrafficNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditVsTrafficNode.Unmarshal(m, b)
+}
+func (m *AuditVsTrafficNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditVsTrafficNode.Marshal(b, m, deterministic)
+}
+func (m *AuditVsTrafficNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditVsTrafficNode.Merge(m, src)
+}
+func (m *AuditVsTrafficNode) XXX_Size() int {
+	return xxx_messageInfo_AuditVsTrafficNode.Size(m)
+}
+func (m *AuditVsTrafficNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditVsTrafficNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditVsTrafficNode proto.InternalMessageInfo
+
+func (m *AuditVsTrafficNode) GetAuditScore() float64 {
+	if m != nil {
+		return m.AuditScore
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficNode) GetDownloadSuccessRate() float64 {
+	if m != nil {
+		return m.DownloadSuccessRate
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficNode) GetDownloadsSucceeded() int64 {
+	if m != nil {
+		return m.DownloadsSucceeded
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficNode) GetDownloadsFailed() int64 {
+	if m != nil {
+		return m.DownloadsFailed
+	}
+	return 0
+}
+
+func (m *AuditVsTrafficNode) GetDivergence() float64 {
+	if m != nil {
+		return m.Divergence
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("satellite.inspector.UnhealthyPiece_Failure", UnhealthyPiece_Failure_name, UnhealthyPiece_Failure_value)
+	proto.RegisterEnum("satellite.inspector.OrphanedProject_Reason", OrphanedProject_Reason_name, OrphanedProject_Reason_value)
+	proto.RegisterEnum("satellite.inspector.ExplainNodeSelectionResponse_Reason", ExplainNodeSelectionResponse_Reason_name, ExplainNodeSelectionResponse_Reason_value)
+	proto.RegisterEnum("satellite.inspector.ListExitingNodesRequest_Order", ListExitingNodesRequest_Order_name, ListExitingNodesRequest_Order_value)
+	proto.RegisterEnum("satellite.inspector.NodeCohortsRequest_Granularity", NodeCohortsRequest_Granularity_name, NodeCohortsRequest_Granularity_value)
+	proto.RegisterEnum("satellite.inspector.NodeCohortsRequest_Filter", NodeCohortsRequest_Filter_name, NodeCohortsRequest_Filter_value)
+	proto.RegisterEnum("satellite.inspector.ValidatePlacementResponse_Constraint", ValidatePlacementResponse_Constraint_name, ValidatePlacementResponse_Constraint_value)
+	proto.RegisterEnum("satellite.inspector.CheckExitEligibilityResponse_Reason", CheckExitEligibilityResponse_Reason_name, CheckExitEligibilityResponse_Reason_value)
 	proto.RegisterType((*ObjectHealthRequest)(nil), "satellite.inspector.ObjectHealthRequest")
 	proto.RegisterType((*ObjectHealthResponse)(nil), "satellite.inspector.ObjectHealthResponse")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "satellite.inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "satellite.inspector.SegmentHealthResponse")
 	proto.RegisterType((*SegmentHealth)(nil), "satellite.inspector.SegmentHealth")
+	proto.RegisterType((*SegmentsAtRiskRequest)(nil), "satellite.inspector.SegmentsAtRiskRequest")
+	proto.RegisterType((*SegmentsAtRiskResponse)(nil), "satellite.inspector.SegmentsAtRiskResponse")
+	proto.RegisterType((*SegmentMarginCount)(nil), "satellite.inspector.SegmentMarginCount")
+	proto.RegisterType((*SegmentGeoSpreadRequest)(nil), "satellite.inspector.SegmentGeoSpreadRequest")
+	proto.RegisterType((*SegmentGeoSpreadResponse)(nil), "satellite.inspector.SegmentGeoSpreadResponse")
+	proto.RegisterType((*PieceLocation)(nil), "satellite.inspector.PieceLocation")
+	proto.RegisterType((*LocationGroup)(nil), "satellite.inspector.LocationGroup")
+	proto.RegisterType((*RepairQueueStatsRequest)(nil), "satellite.inspector.RepairQueueStatsRequest")
+	proto.RegisterType((*RepairQueueStatsResponse)(nil), "satellite.inspector.RepairQueueStatsResponse")
+	proto.RegisterType((*RepairHealthBucket)(nil), "satellite.inspector.RepairHealthBucket")
+	proto.RegisterType((*DetectOrphanedPiecesRequest)(nil), "satellite.inspector.DetectOrphanedPiecesRequest")
+	proto.RegisterType((*DetectOrphanedPiecesResponse)(nil), "satellite.inspector.DetectOrphanedPiecesResponse")
+	proto.RegisterType((*SegmentRepairReasonRequest)(nil), "satellite.inspector.SegmentRepairReasonRequest")
+	proto.RegisterType((*SegmentRepairReasonResponse)(nil), "satellite.inspector.SegmentRepairReasonResponse")
+	proto.RegisterType((*UnhealthyPiece)(nil), "satellite.inspector.UnhealthyPiece")
+	proto.RegisterType((*SegmentSizeHistogramRequest)(nil), "satellite.inspector.SegmentSizeHistogramRequest")
+	proto.RegisterType((*SegmentSizeHistogramResponse)(nil), "satellite.inspector.SegmentSizeHistogramResponse")
+	proto.RegisterType((*SegmentSizeRange)(nil), "satellite.inspector.SegmentSizeRange")
+	proto.RegisterType((*RedundancyDistributionRequest)(nil), "satellite.inspector.RedundancyDistributionRequest")
+	proto.RegisterType((*RedundancyDistributionResponse)(nil), "satellite.inspector.RedundancyDistributionResponse")
+	proto.RegisterType((*RedundancySchemeCount)(nil), "satellite.inspector.RedundancySchemeCount")
+	proto.RegisterType((*SegmentPieceNodesRequest)(nil), "satellite.inspector.SegmentPieceNodesRequest")
+	proto.RegisterType((*SegmentPieceNodesResponse)(nil), "satellite.inspector.SegmentPieceNodesResponse")
+	proto.RegisterType((*PieceNode)(nil), "satellite.inspector.PieceNode")
+	proto.RegisterType((*InlineRemoteRatioRequest)(nil), "satellite.inspector.InlineRemoteRatioRequest")
+	proto.RegisterType((*InlineRemoteRatioResponse)(nil), "satellite.inspector.InlineRemoteRatioResponse")
+	proto.RegisterType((*SegmentTotals)(nil), "satellite.inspector.SegmentTotals")
+	proto.RegisterType((*DryRunRepairCheckerRequest)(nil), "satellite.inspector.DryRunRepairCheckerRequest")
+	proto.RegisterType((*DryRunRepairCheckerResponse)(nil), "satellite.inspector.DryRunRepairCheckerResponse")
+	proto.RegisterType((*DuplicatePieceNodesRequest)(nil), "satellite.inspector.DuplicatePieceNodesRequest")
+	proto.RegisterType((*DuplicatePieceNodesResponse)(nil), "satellite.inspector.DuplicatePieceNodesResponse")
+	proto.RegisterType((*DuplicatePieceNode)(nil), "satellite.inspector.DuplicatePieceNode")
+	proto.RegisterType((*OrphanedProjectSegmentsRequest)(nil), "satellite.inspector.OrphanedProjectSegmentsRequest")
+	proto.RegisterType((*OrphanedProjectSegmentsResponse)(nil), "satellite.inspector.OrphanedProjectSegmentsResponse")
+	proto.RegisterType((*OrphanedProject)(nil), "satellite.inspector.OrphanedProject")
+	proto.RegisterType((*OrphanedProjectSegment)(nil), "satellite.inspector.OrphanedProjectSegment")
+	proto.RegisterType((*NodeStorageByProjectRequest)(nil), "satellite.inspector.NodeStorageByProjectRequest")
+	proto.RegisterType((*NodeStorageByProjectResponse)(nil), "satellite.inspector.NodeStorageByProjectResponse")
+	proto.RegisterType((*NodeProjectStorage)(nil), "satellite.inspector.NodeProjectStorage")
+	proto.RegisterType((*RepairQueueAgeHistogramRequest)(nil), "satellite.inspector.RepairQueueAgeHistogramRequest")
+	proto.RegisterType((*RepairQueueAgeHistogramResponse)(nil), "satellite.inspector.RepairQueueAgeHistogramResponse")
+	proto.RegisterType((*RepairQueueAgeRange)(nil), "satellite.inspector.RepairQueueAgeRange")
+	proto.RegisterType((*NodeStatus)(nil), "satellite.inspector.NodeStatus")
+	proto.RegisterType((*OverlayNode)(nil), "satellite.inspector.OverlayNode")
+	proto.RegisterType((*NodesByIPRequest)(nil), "satellite.inspector.NodesByIPRequest")
+	proto.RegisterType((*NodesByIPResponse)(nil), "satellite.inspector.NodesByIPResponse")
+	proto.RegisterType((*IPsWithManyNodesRequest)(nil), "satellite.inspector.IPsWithManyNodesRequest")
+	proto.RegisterType((*IPsWithManyNodesResponse)(nil), "satellite.inspector.IPsWithManyNodesResponse")
+	proto.RegisterType((*IPNodes)(nil), "satellite.inspector.IPNodes")
+	proto.RegisterType((*ExplainNodeSelectionRequest)(nil), "satellite.inspector.ExplainNodeSelectionRequest")
+	proto.RegisterType((*ExplainNodeSelectionResponse)(nil), "satellite.inspector.ExplainNodeSelectionResponse")
+	proto.RegisterType((*TopNodesByStorageRequest)(nil), "satellite.inspector.TopNodesByStorageRequest")
+	proto.RegisterType((*TopNodesByStorageResponse)(nil), "satellite.inspector.TopNodesByStorageResponse")
+	proto.RegisterType((*NodeStorage)(nil), "satellite.inspector.NodeStorage")
+	proto.RegisterType((*RecalculateReputationRequest)(nil), "satellite.inspector.RecalculateReputationRequest")
+	proto.RegisterType((*RecalculateReputationResponse)(nil), "satellite.inspector.RecalculateReputationResponse")
+	proto.RegisterType((*NodeReputation)(nil), "satellite.inspector.NodeReputation")
+	proto.RegisterType((*NodesNearOfflineDQRequest)(nil), "satellite.inspector.NodesNearOfflineDQRequest")
+	proto.RegisterType((*NodesNearOfflineDQResponse)(nil), "satellite.inspector.NodesNearOfflineDQResponse")
+	proto.RegisterType((*NodeOfflineRisk)(nil), "satellite.inspector.NodeOfflineRisk")
+	proto.RegisterType((*GetOperatorContactRequest)(nil), "satellite.inspector.GetOperatorContactRequest")
+	proto.RegisterType((*GetOperatorContactResponse)(nil), "satellite.inspector.GetOperatorContactResponse")
+	proto.RegisterType((*NodesByUptimeBandRequest)(nil), "satellite.inspector.NodesByUptimeBandRequest")
+	proto.RegisterType((*NodesByUptimeBandResponse)(nil), "satellite.inspector.NodesByUptimeBandResponse")
+	proto.RegisterType((*UptimeBand)(nil), "satellite.inspector.UptimeBand")
+	proto.RegisterType((*NodeUptime)(nil), "satellite.inspector.NodeUptime")
+	proto.RegisterType((*CompareNodesRequest)(nil), "satellite.inspector.CompareNodesRequest")
+	proto.RegisterType((*CompareNodesResponse)(nil), "satellite.inspector.CompareNodesResponse")
+	proto.RegisterType((*NodeComparison)(nil), "satellite.inspector.NodeComparison")
+	proto.RegisterType((*ListExitingNodesRequest)(nil), "satellite.inspector.ListExitingNodesRequest")
+	proto.RegisterType((*ListExitingNodesResponse)(nil), "satellite.inspector.ListExitingNodesResponse")
+	proto.RegisterType((*ExitingNode)(nil), "satellite.inspector.ExitingNode")
+	proto.RegisterType((*GetSelectionConfigRequest)(nil), "satellite.inspector.GetSelectionConfigRequest")
+	proto.RegisterType((*GetSelectionConfigResponse)(nil), "satellite.inspector.GetSelectionConfigResponse")
+	proto.RegisterType((*PlacementDefinition)(nil), "satellite.inspector.PlacementDefinition")
+	proto.RegisterType((*NodeCohortsRequest)(nil), "satellite.inspector.NodeCohortsRequest")
+	proto.RegisterType((*NodeCohortsResponse)(nil), "satellite.inspector.NodeCohortsResponse")
+	proto.RegisterType((*NodeCohort)(nil), "satellite.inspector.NodeCohort")
+	proto.RegisterType((*ListContainedNodesRequest)(nil), "satellite.inspector.ListContainedNodesRequest")
+	proto.RegisterType((*ListContainedNodesResponse)(nil), "satellite.inspector.ListContainedNodesResponse")
+	proto.RegisterType((*ContainedNode)(nil), "satellite.inspector.ContainedNode")
+	proto.RegisterType((*SelectionFairnessRequest)(nil), "satellite.inspector.SelectionFairnessRequest")
+	proto.RegisterType((*SelectionFairnessResponse)(nil), "satellite.inspector.SelectionFairnessResponse")
+	proto.RegisterType((*SubnetSelectionCount)(nil), "satellite.inspector.SubnetSelectionCount")
+	proto.RegisterType((*NodeSelectionCount)(nil), "satellite.inspector.NodeSelectionCount")
+	proto.RegisterType((*SpaceDiscrepancyNodesRequest)(nil), "satellite.inspector.SpaceDiscrepancyNodesRequest")
+	proto.RegisterType((*SpaceDiscrepancyNodesResponse)(nil), "satellite.inspector.SpaceDiscrepancyNodesResponse")
+	proto.RegisterType((*SpaceDiscrepancy)(nil), "satellite.inspector.SpaceDiscrepancy")
+	proto.RegisterType((*NodesByLatencyTierRequest)(nil), "satellite.inspector.NodesByLatencyTierRequest")
+	proto.RegisterType((*NodesByLatencyTierResponse)(nil), "satellite.inspector.NodesByLatencyTierResponse")
+	proto.RegisterType((*LatencyTier)(nil), "satellite.inspector.LatencyTier")
+	proto.RegisterType((*NodeLatency)(nil), "satellite.inspector.NodeLatency")
+	proto.RegisterType((*NodesWithRecentWalletChangeRequest)(nil), "satellite.inspector.NodesWithRecentWalletChangeRequest")
+	proto.RegisterType((*NodesWithRecentWalletChangeResponse)(nil), "satellite.inspector.NodesWithRecentWalletChangeResponse")
+	proto.RegisterType((*NodeWalletChange)(nil), "satellite.inspector.NodeWalletChange")
+	proto.RegisterType((*ChurnRateRequest)(nil), "satellite.inspector.ChurnRateRequest")
+	proto.RegisterType((*ChurnRateResponse)(nil), "satellite.inspector.ChurnRateResponse")
+	proto.RegisterType((*ChurnInterval)(nil), "satellite.inspector.ChurnInterval")
+	proto.RegisterType((*ValidatePlacementRequest)(nil), "satellite.inspector.ValidatePlacementRequest")
+	proto.RegisterType((*ValidatePlacementResponse)(nil), "satellite.inspector.ValidatePlacementResponse")
+	proto.RegisterType((*NodesBelowMinVersionRequest)(nil), "satellite.inspector.NodesBelowMinVersionRequest")
+	proto.RegisterType((*NodesBelowMinVersionResponse)(nil), "satellite.inspector.NodesBelowMinVersionResponse")
+	proto.RegisterType((*OutdatedNode)(nil), "satellite.inspector.OutdatedNode")
+	proto.RegisterType((*GetReputationThresholdsRequest)(nil), "satellite.inspector.GetReputationThresholdsRequest")
+	proto.RegisterType((*GetReputationThresholdsResponse)(nil), "satellite.inspector.GetReputationThresholdsResponse")
+	proto.RegisterType((*SatelliteVersion)(nil), "satellite.inspector.SatelliteVersion")
+	proto.RegisterType((*StaleGeoNodesRequest)(nil), "satellite.inspector.StaleGeoNodesRequest")
+	proto.RegisterType((*StaleGeoNodesResponse)(nil), "satellite.inspector.StaleGeoNodesResponse")
+	proto.RegisterType((*StaleGeoNode)(nil), "satellite.inspector.StaleGeoNode")
+	proto.RegisterType((*NodeFreeSpaceTrendRequest)(nil), "satellite.inspector.NodeFreeSpaceTrendRequest")
+	proto.RegisterType((*NodeFreeSpaceTrendResponse)(nil), "satellite.inspector.NodeFreeSpaceTrendResponse")
+	proto.RegisterType((*NodeFreeSpaceTrend)(nil), "satellite.inspector.NodeFreeSpaceTrend")
+	proto.RegisterType((*PlacementSelectionCountsRequest)(nil), "satellite.inspector.PlacementSelectionCountsRequest")
+	proto.RegisterType((*PlacementSelectionCountsResponse)(nil), "satellite.inspector.PlacementSelectionCountsResponse")
+	proto.RegisterType((*PlacementSelectionCount)(nil), "satellite.inspector.PlacementSelectionCount")
+	proto.RegisterType((*ReinstateNodeRequest)(nil), "satellite.inspector.ReinstateNodeRequest")
+	proto.RegisterType((*ReinstateNodeResponse)(nil), "satellite.inspector.ReinstateNodeResponse")
+	proto.RegisterType((*CapacityByCountryRequest)(nil), "satellite.inspector.CapacityByCountryRequest")
+	proto.RegisterType((*CapacityByCountryResponse)(nil), "satellite.inspector.CapacityByCountryResponse")
+	proto.RegisterType((*CountryCapacity)(nil), "satellite.inspector.CountryCapacity")
+	proto.RegisterType((*RecentAuditFailuresRequest)(nil), "satellite.inspector.RecentAuditFailuresRequest")
+	proto.RegisterType((*RecentAuditFailuresResponse)(nil), "satellite.inspector.RecentAuditFailuresResponse")
+	proto.RegisterType((*AuditFailureStreak)(nil), "satellite.inspector.AuditFailureStreak")
+	proto.RegisterType((*FailedAudit)(nil), "satellite.inspector.FailedAudit")
+	proto.RegisterType((*ClockSkewNodesRequest)(nil), "satellite.inspector.ClockSkewNodesRequest")
+	proto.RegisterType((*ClockSkewNodesResponse)(nil), "satellite.inspector.ClockSkewNodesResponse")
+	proto.RegisterType((*NodeClockSkew)(nil), "satellite.inspector.NodeClockSkew")
+	proto.RegisterType((*CheckExitEligibilityRequest)(nil), "satellite.inspector.CheckExitEligibilityRequest")
+	proto.RegisterType((*CheckExitEligibilityResponse)(nil), "satellite.inspector.CheckExitEligibilityResponse")
+	proto.RegisterType((*NodeRepairStatsRequest)(nil), "satellite.inspector.NodeRepairStatsRequest")
+	proto.RegisterType((*NodeRepairStatsResponse)(nil), "satellite.inspector.NodeRepairStatsResponse")
+	proto.RegisterType((*TrustingNodesRequest)(nil), "satellite.inspector.TrustingNodesRequest")
+	proto.RegisterType((*TrustingNodesResponse)(nil), "satellite.inspector.TrustingNodesResponse")
+	proto.RegisterType((*TrustingNode)(nil), "satellite.inspector.TrustingNode")
+	proto.RegisterType((*SubnetSaturationStatsRequest)(nil), "satellite.inspector.SubnetSaturationStatsRequest")
+	proto.RegisterType((*SubnetSaturationStatsResponse)(nil), "satellite.inspector.SubnetSaturationStatsResponse")
+	proto.RegisterType((*PendingGCStatsRequest)(nil), "satellite.inspector.PendingGCStatsRequest")
+	proto.RegisterType((*PendingGCStatsResponse)(nil), "satellite.inspector.PendingGCStatsResponse")
+	proto.RegisterType((*ProjectsOverLimitRequest)(nil), "satellite.inspector.ProjectsOverLimitRequest")
+	proto.RegisterType((*ProjectsOverLimitResponse)(nil), "satellite.inspector.ProjectsOverLimitResponse")
+	proto.RegisterType((*ProjectOverLimit)(nil), "satellite.inspector.ProjectOverLimit")
+	proto.RegisterType((*SimulateBulkDisqualificationRequest)(nil), "satellite.inspector.SimulateBulkDisqualificationRequest")
+	proto.RegisterType((*SimulateBulkDisqualificationResponse)(nil), "satellite.inspector.SimulateBulkDisqualificationResponse")
+	proto.RegisterType((*AuditVsTrafficAnomalyRequest)(nil), "satellite.inspector.AuditVsTrafficAnomalyRequest")
+	proto.RegisterType((*AuditVsTrafficAnomalyResponse)(nil), "satellite.inspector.AuditVsTrafficAnomalyResponse")
+	proto.RegisterType((*AuditVsTrafficNode)(nil), "satellite.inspector.AuditVsTrafficNode")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x8f, 0xd3, 0x30,
-	0x10, 0xc5, 0xdb, 0x6d, 0x81, 0x69, 0x4a, 0xc1, 0x2d, 0x28, 0x2a, 0x42, 0x54, 0x59, 0xad, 0xd4,
-	0x65, 0x51, 0x2a, 0x95, 0x1b, 0x48, 0x48, 0x54, 0x1c, 0xe8, 0x05, 0x50, 0xf6, 0xc6, 0x25, 0x4a,
-	0xe2, 0x69, 0x93, 0x25, 0xb5, 0x83, 0xed, 0x4a, 0xf4, 0x5f, 0x20, 0x71, 0xe2, 0x7f, 0xf0, 0x6b,
-	0x38, 0x70, 0xe0, 0xc2, 0xdf, 0x40, 0x75, 0xdc, 0x6c, 0xbf, 0x0e, 0x95, 0xb8, 0x65, 0xe6, 0xbd,
-	0x79, 0x9e, 0xbc, 0xe7, 0x04, 0xda, 0x19, 0x57, 0x05, 0x26, 0x5a, 0x48, 0xbf, 0x90, 0x42, 0x0b,
-	0xda, 0x51, 0x91, 0xc6, 0x3c, 0xcf, 0x34, 0xfa, 0x15, 0xd4, 0x83, 0x99, 0x98, 0x89, 0x92, 0xd0,
-	0x6b, 0x17, 0x22, 0xe3, 0x1a, 0x25, 0x8b, 0xcb, 0x86, 0xf7, 0x97, 0x40, 0xe7, 0x43, 0x7c, 0x8d,
-	0x89, 0x7e, 0x87, 0x51, 0xae, 0xd3, 0x00, 0xbf, 0x2c, 0x50, 0x69, 0x7a, 0x0e, 0xf7, 0x90, 0x27,
-	0x72, 0x59, 0x68, 0x64, 0x61, 0x11, 0xe9, 0xd4, 0x25, 0x7d, 0x32, 0x70, 0x82, 0x56, 0xd5, 0xfd,
-	0x18, 0xe9, 0x94, 0x3e, 0x82, 0x46, 0xbc, 0x48, 0x3e, 0xa3, 0x76, 0x4f, 0x0c, 0x6c, 0x2b, 0xfa,
-	0x04, 0xa0, 0x90, 0x62, 0x25, 0x1b, 0x66, 0xcc, 0xad, 0x19, 0xec, 0xae, 0xed, 0x4c, 0x18, 0xf5,
-	0xa1, 0xa3, 0x74, 0x24, 0x75, 0x18, 0x4d, 0x35, 0xca, 0x50, 0xe1, 0x6c, 0x8e, 0x5c, 0xbb, 0xa7,
-	0x7d, 0x32, 0xa8, 0x05, 0x0f, 0x0c, 0xf4, 0x66, 0x85, 0x5c, 0x95, 0x00, 0x7d, 0x0e, 0x14, 0x39,
-	0x0b, 0x63, 0x9c, 0x0a, 0x89, 0x15, 0xbd, 0x6e, 0xe8, 0xf7, 0x91, 0xb3, 0xb1, 0x01, 0xd6, 0xec,
-	0x2e, 0xd4, 0xf3, 0x6c, 0x9e, 0x69, 0xb7, 0xd1, 0x27, 0x83, 0x7a, 0x50, 0x16, 0xde, 0x77, 0x02,
-	0xdd, 0xed, 0x37, 0x55, 0x85, 0xe0, 0x0a, 0xe9, 0x6b, 0xb8, 0x63, 0x15, 0x95, 0x4b, 0xfa, 0xb5,
-	0x41, 0x73, 0xe4, 0xf9, 0x07, 0x7c, 0xf4, 0xad, 0xbc, 0x9d, 0xae, 0x66, 0xe8, 0x2b, 0x00, 0x89,
-	0x6c, 0xc1, 0x59, 0xc4, 0x93, 0xa5, 0xf1, 0xa1, 0x39, 0x7a, 0xec, 0xdf, 0x18, 0x1d, 0x54, 0xe0,
-	0x55, 0x92, 0xe2, 0x1c, 0x83, 0x0d, 0xba, 0xf7, 0x83, 0x40, 0x77, 0x5b, 0xd8, 0x06, 0x70, 0xe3,
-	0x2c, 0xd9, 0x72, 0x76, 0x3f, 0x98, 0x93, 0x43, 0xc1, 0x9c, 0x41, 0xcb, 0x2e, 0x18, 0x66, 0x9c,
-	0xe1, 0x57, 0x93, 0x41, 0x2d, 0x70, 0x6c, 0x73, 0xb2, 0xea, 0xed, 0xa4, 0x74, 0xba, 0x93, 0x92,
-	0xf7, 0x8d, 0xc0, 0xc3, 0x9d, 0xdd, 0xac, 0x65, 0x2f, 0xa1, 0x91, 0x9a, 0x8e, 0x59, 0xee, 0x38,
-	0xc3, 0xec, 0xc4, 0xff, 0xd9, 0xf5, 0x93, 0x40, 0x6b, 0x4b, 0x96, 0x5e, 0x42, 0xb3, 0x14, 0x5e,
-	0x86, 0x19, 0x2b, 0x03, 0x74, 0xc6, 0xf0, 0xeb, 0xf7, 0xd3, 0xc6, 0x7b, 0xc1, 0x70, 0xf2, 0x36,
-	0x00, 0x0b, 0x4f, 0x98, 0xa2, 0x43, 0x68, 0x2d, 0xf8, 0x26, 0xfd, 0x64, 0x8f, 0xee, 0x54, 0x84,
-	0xd5, 0xc0, 0x25, 0x34, 0xc5, 0x74, 0x9a, 0x67, 0x1c, 0x0d, 0xbd, 0xb6, 0xaf, 0x6e, 0xe1, 0x15,
-	0xd9, 0x85, 0xdb, 0x9b, 0x37, 0xd9, 0x09, 0xd6, 0xe5, 0xe8, 0x0f, 0x81, 0x76, 0xb9, 0xef, 0x64,
-	0xed, 0x0e, 0x45, 0x70, 0x36, 0xaf, 0x23, 0x1d, 0x1c, 0xf4, 0xf0, 0xc0, 0xb7, 0xd9, 0xbb, 0x38,
-	0x82, 0x59, 0x06, 0xe5, 0xdd, 0xa2, 0xe9, 0xae, 0x61, 0x17, 0x47, 0x64, 0x65, 0x0f, 0x7a, 0x76,
-	0x0c, 0x75, 0x7d, 0xd2, 0xf8, 0xfc, 0xd3, 0x99, 0xd2, 0x42, 0x5e, 0xfb, 0x99, 0x18, 0x9a, 0x87,
-	0x61, 0x35, 0x3d, 0x34, 0xe1, 0xf2, 0x28, 0x2f, 0xe2, 0xb8, 0x61, 0x7e, 0x3c, 0x2f, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x03, 0xb4, 0x8a, 0x34, 0xbd, 0x04, 0x00, 0x00,
+	// 9181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x5b, 0x6c, 0x24, 0x59,
+	0x96, 0x90, 0x23, 0xd3, 0x69, 0x67, 0x9e, 0xcc, 0xb4, 0xd3, 0x51, 0x2f, 0x97, 0xab, 0xba, 0xab,
+	0x3a, 0xaa, 0xab, 0xab, 0xfa, 0x31, 0xae, 0xde, 0xea, 0x9e, 0xe9, 0x9e, 0xee, 0x79, 0x74, 0xda,
+	0x99, 0xae, 0xca, 0x1d, 0x97, 0xed, 0x8e, 0xb4, 0xbb, 0x06, 0x58, 0x4d, 0x28, 0x9c, 0x79, 0x6d,
+	0xc7, 0x54, 0x64, 0x44, 0x56, 0x44, 0x64, 0xb9, 0xdc, 0x08, 0x58, 0x69, 0x60, 0xc5, 0xee, 0xc7,
+	0xb2, 0x9a, 0xd1, 0x6a, 0x66, 0x41, 0x82, 0xfd, 0xd8, 0xf9, 0x61, 0x05, 0x02, 0x76, 0x81, 0x91,
+	0x90, 0x58, 0xd0, 0x22, 0x98, 0x3f, 0x90, 0xd0, 0x6a, 0xd1, 0x20, 0x96, 0x45, 0x7c, 0x80, 0x90,
+	0x46, 0xb0, 0x08, 0x89, 0x2f, 0x24, 0x74, 0xef, 0x39, 0x37, 0x5e, 0x19, 0x91, 0xce, 0xac, 0xee,
+	0xd9, 0xfd, 0xcb, 0x38, 0xf7, 0x9e, 0xfb, 0x3c, 0xf7, 0xbc, 0xee, 0xb9, 0x27, 0x61, 0xd9, 0x72,
+	0xfc, 0x21, 0xeb, 0x05, 0xae, 0xb7, 0x3e, 0xf4, 0xdc, 0xc0, 0x55, 0x2f, 0xf8, 0x66, 0xc0, 0x6c,
+	0xdb, 0x0a, 0xd8, 0x7a, 0x58, 0xb4, 0x06, 0xc7, 0xee, 0xb1, 0x8b, 0x15, 0xd6, 0x5e, 0x3e, 0x76,
+	0xdd, 0x63, 0x9b, 0xdd, 0x13, 0x5f, 0x87, 0xa3, 0xa3, 0x7b, 0xfd, 0x91, 0x67, 0x06, 0x96, 0xeb,
+	0x50, 0xf9, 0x8d, 0x74, 0x79, 0x60, 0x0d, 0x98, 0x1f, 0x98, 0x83, 0x21, 0x55, 0x58, 0x1e, 0xba,
+	0x96, 0x13, 0x30, 0xaf, 0x7f, 0x88, 0x00, 0xed, 0xbf, 0x29, 0x70, 0x61, 0xf7, 0xf0, 0xdb, 0xac,
+	0x17, 0x3c, 0x64, 0xa6, 0x1d, 0x9c, 0xe8, 0xec, 0xe9, 0x88, 0xf9, 0x81, 0x7a, 0x1b, 0x96, 0x98,
+	0xd3, 0xf3, 0xce, 0x86, 0x01, 0xeb, 0x1b, 0x43, 0x33, 0x38, 0x59, 0x55, 0x6e, 0x2a, 0x77, 0x6b,
+	0x7a, 0x3d, 0x84, 0xee, 0x99, 0xc1, 0x89, 0x7a, 0x19, 0x16, 0x0e, 0x47, 0xbd, 0x27, 0x2c, 0x58,
+	0x2d, 0x88, 0x62, 0xfa, 0x52, 0x5f, 0x02, 0x18, 0x7a, 0x2e, 0x6f, 0xd6, 0xb0, 0xfa, 0xab, 0x45,
+	0x51, 0x56, 0x21, 0x48, 0xa7, 0xaf, 0xae, 0xc3, 0x05, 0x3f, 0x30, 0xbd, 0xc0, 0x30, 0x8f, 0x02,
+	0xe6, 0x19, 0x3e, 0x3b, 0x1e, 0x30, 0x27, 0x58, 0x9d, 0xbf, 0xa9, 0xdc, 0x2d, 0xea, 0x2b, 0xa2,
+	0xa8, 0xc9, 0x4b, 0xba, 0x58, 0xa0, 0xbe, 0x05, 0x2a, 0x73, 0xfa, 0xc6, 0x21, 0x3b, 0x72, 0x3d,
+	0x16, 0x56, 0x2f, 0x89, 0xea, 0x0d, 0xe6, 0xf4, 0x37, 0x44, 0x81, 0xac, 0x7d, 0x11, 0x4a, 0xb6,
+	0x35, 0xb0, 0x82, 0xd5, 0x85, 0x9b, 0xca, 0xdd, 0x92, 0x8e, 0x1f, 0xda, 0xf7, 0x14, 0xb8, 0x98,
+	0x9c, 0xa9, 0x3f, 0x74, 0x1d, 0x9f, 0xa9, 0x5f, 0x83, 0x32, 0xb5, 0xe8, 0xaf, 0x2a, 0x37, 0x8b,
+	0x77, 0xab, 0xf7, 0xb5, 0xf5, 0x8c, 0x8d, 0x58, 0xa7, 0xe6, 0x09, 0x3b, 0xc4, 0x51, 0x3f, 0x04,
+	0xf0, 0x58, 0x7f, 0xe4, 0xf4, 0x4d, 0xa7, 0x77, 0x26, 0xd6, 0xa1, 0x7a, 0xff, 0xda, 0x7a, 0xb4,
+	0xd0, 0x7a, 0x58, 0xd8, 0xed, 0x9d, 0xb0, 0x01, 0xd3, 0x63, 0xd5, 0xb5, 0xdf, 0x50, 0xe0, 0x62,
+	0xb2, 0x61, 0xda, 0x80, 0x68, 0x65, 0x95, 0xc4, 0xca, 0x8e, 0x6f, 0x4c, 0x21, 0x6b, 0x63, 0x6e,
+	0x41, 0x9d, 0x06, 0x68, 0x58, 0x4e, 0x9f, 0x3d, 0x17, 0x7b, 0x50, 0xd4, 0x6b, 0x04, 0xec, 0x70,
+	0x58, 0x6a, 0x97, 0xe6, 0x53, 0xbb, 0xa4, 0xfd, 0x9a, 0x02, 0x97, 0x52, 0x63, 0xa3, 0x25, 0xfb,
+	0x00, 0x16, 0x4e, 0x04, 0x44, 0x0c, 0x6e, 0xba, 0x05, 0x23, 0x8c, 0xcf, 0xb6, 0x5c, 0xbf, 0xab,
+	0x40, 0x3d, 0xd1, 0xac, 0xfa, 0x26, 0x54, 0xb1, 0xe1, 0x33, 0xc3, 0xea, 0xe3, 0x06, 0xd6, 0x36,
+	0xe0, 0x27, 0x7f, 0x74, 0x63, 0x61, 0xc7, 0xed, 0xb3, 0x4e, 0x4b, 0x07, 0x2a, 0xee, 0xf4, 0x7d,
+	0xf5, 0x1e, 0xd4, 0x47, 0x4e, 0xbc, 0x7a, 0x61, 0xac, 0x7a, 0x2d, 0xac, 0xc0, 0x11, 0xde, 0x84,
+	0xaa, 0x7b, 0x74, 0x64, 0x5b, 0x0e, 0x13, 0xd5, 0x8b, 0xe3, 0xad, 0x53, 0x31, 0xaf, 0xbc, 0x0a,
+	0x8b, 0x71, 0x4a, 0xae, 0xe9, 0xf2, 0x53, 0xfb, 0xc5, 0x68, 0x25, 0xfd, 0x66, 0xa0, 0x5b, 0xfe,
+	0x13, 0xb9, 0xcd, 0x77, 0xa1, 0xd1, 0x1b, 0x79, 0xbe, 0xeb, 0x19, 0x7e, 0xe0, 0x31, 0x73, 0xc0,
+	0x37, 0x02, 0x37, 0x7c, 0x09, 0xe1, 0x5d, 0x01, 0xee, 0xf4, 0xd5, 0x3b, 0xb0, 0x4c, 0x35, 0x87,
+	0xae, 0x6f, 0xf1, 0x43, 0x2f, 0x16, 0xaf, 0x28, 0x2b, 0xee, 0x11, 0x34, 0x22, 0xff, 0x62, 0x9c,
+	0xfc, 0x7f, 0xaa, 0xc0, 0xe5, 0xf4, 0x10, 0x68, 0x37, 0x9b, 0xb0, 0x38, 0x30, 0xbd, 0x63, 0xcb,
+	0x91, 0xf4, 0x7f, 0x67, 0xd2, 0x76, 0x3e, 0x12, 0x55, 0x37, 0xdd, 0x91, 0x13, 0xe8, 0x12, 0x4f,
+	0x7d, 0x1d, 0x1a, 0xf2, 0x3c, 0x18, 0x7e, 0xcf, 0x74, 0x1c, 0xd6, 0xa7, 0xd1, 0x2d, 0x4b, 0x78,
+	0x17, 0xc1, 0x99, 0x33, 0x2e, 0x4e, 0x3b, 0xe3, 0xf9, 0xcc, 0x19, 0xab, 0x30, 0xdf, 0x77, 0x1d,
+	0x26, 0x18, 0x42, 0x59, 0x17, 0xbf, 0xb5, 0x0d, 0x50, 0xc7, 0x07, 0xcc, 0x4f, 0x15, 0x0e, 0x59,
+	0x2c, 0x72, 0x49, 0xa7, 0x2f, 0xbe, 0x66, 0x3d, 0x5e, 0x81, 0x06, 0x8d, 0x1f, 0xda, 0xff, 0x50,
+	0xe0, 0x0a, 0x35, 0xf2, 0x80, 0xb9, 0xdd, 0xa1, 0xc7, 0xcc, 0xbe, 0xdc, 0xb8, 0xe4, 0xd9, 0x51,
+	0xd2, 0x1c, 0x2e, 0x8f, 0x31, 0x8e, 0x1f, 0xdf, 0xe2, 0x54, 0xc7, 0x77, 0x3e, 0xe3, 0xf8, 0xbe,
+	0x06, 0xcb, 0x03, 0xf3, 0xb9, 0x31, 0x64, 0x9e, 0x21, 0xc6, 0xeb, 0x9d, 0x89, 0x15, 0x28, 0xe9,
+	0xf5, 0x81, 0xf9, 0x7c, 0x8f, 0x79, 0x9b, 0x08, 0x54, 0x5f, 0x85, 0x25, 0x59, 0xcf, 0x1f, 0x1d,
+	0x3a, 0x4c, 0x32, 0xc6, 0x1a, 0x56, 0xeb, 0x0a, 0x98, 0xf6, 0x7f, 0x14, 0x58, 0x1d, 0x9f, 0x6c,
+	0x74, 0xe0, 0x87, 0x16, 0xeb, 0xb1, 0xc9, 0x1c, 0x72, 0x8f, 0x57, 0xd9, 0x76, 0x7b, 0x42, 0x24,
+	0xe9, 0x84, 0xa1, 0xee, 0xc2, 0x4a, 0xcf, 0x73, 0x4f, 0xfb, 0xac, 0x4f, 0xc3, 0xb4, 0x18, 0x1e,
+	0xbc, 0xbc, 0x66, 0x64, 0x0b, 0x0f, 0x3c, 0x77, 0x34, 0xd4, 0x1b, 0x84, 0xbc, 0x29, 0x71, 0xd5,
+	0x6f, 0xc0, 0xb2, 0x6c, 0x10, 0xe7, 0x83, 0x07, 0x73, 0xba, 0xe6, 0x96, 0x08, 0x15, 0x67, 0xed,
+	0x73, 0xb1, 0x50, 0x4f, 0x8c, 0x5b, 0xbd, 0x06, 0x15, 0x31, 0x72, 0xc3, 0x19, 0x0d, 0x88, 0x4c,
+	0xca, 0x02, 0xb0, 0x33, 0x1a, 0xa8, 0x77, 0x60, 0xd1, 0x71, 0xfb, 0x9c, 0x1b, 0xe0, 0xc6, 0x6e,
+	0x2c, 0xfd, 0xf8, 0x8f, 0x6e, 0xcc, 0xc5, 0x18, 0xc2, 0x02, 0x2f, 0xee, 0xf4, 0xd5, 0x57, 0xa0,
+	0x46, 0x9b, 0x62, 0xf4, 0xdc, 0x3e, 0x13, 0xdb, 0x5c, 0xd1, 0xab, 0x04, 0xdb, 0x74, 0xfb, 0x4c,
+	0xbd, 0x0a, 0x65, 0xdb, 0xf4, 0x03, 0x83, 0xef, 0xc8, 0xbc, 0x28, 0x5e, 0xe4, 0xdf, 0x3b, 0x2c,
+	0xd0, 0x7e, 0x1e, 0xea, 0x89, 0x61, 0xab, 0x6b, 0x50, 0xb6, 0x09, 0x20, 0xc6, 0x54, 0xd1, 0xc3,
+	0x6f, 0x41, 0x8a, 0x72, 0xc0, 0xb8, 0xb2, 0x25, 0xbd, 0x22, 0x47, 0xec, 0x6b, 0x1f, 0xc1, 0x15,
+	0x9d, 0x0d, 0x4d, 0xcb, 0xfb, 0x78, 0xc4, 0x46, 0xac, 0x1b, 0x98, 0x81, 0x1f, 0x93, 0xf2, 0xc8,
+	0xec, 0x0c, 0x24, 0x4f, 0x9f, 0xe6, 0x5b, 0x47, 0xe8, 0x06, 0x02, 0xb5, 0xbf, 0x5a, 0x80, 0xd5,
+	0xf1, 0x26, 0x88, 0x34, 0x2e, 0xc3, 0x82, 0xcd, 0x9c, 0x63, 0x92, 0x05, 0x45, 0x9d, 0xbe, 0xd4,
+	0x0d, 0x00, 0xd7, 0xee, 0x33, 0x3f, 0x30, 0xcc, 0x63, 0x46, 0x7c, 0xfe, 0xea, 0x3a, 0x2a, 0x28,
+	0xeb, 0x52, 0x41, 0x59, 0x6f, 0x91, 0x02, 0xb3, 0x51, 0xe6, 0xeb, 0xf8, 0x83, 0xff, 0x7c, 0x43,
+	0xd1, 0x2b, 0x88, 0xd6, 0x3c, 0x66, 0x7c, 0x66, 0x03, 0xcb, 0x31, 0x48, 0xd6, 0xf0, 0x25, 0x54,
+	0xf4, 0xca, 0xc0, 0x72, 0x88, 0xf7, 0xf3, 0x62, 0xf3, 0xb9, 0x2c, 0x9e, 0xa7, 0x62, 0xf3, 0x39,
+	0x15, 0xef, 0x8c, 0xcd, 0xae, 0x34, 0x81, 0xbd, 0xe1, 0x04, 0x1f, 0xc6, 0x26, 0x9e, 0x5e, 0x86,
+	0x4f, 0x40, 0x1d, 0xaf, 0x24, 0xd8, 0xad, 0x7b, 0xca, 0x3c, 0x31, 0x7d, 0x45, 0xc7, 0x0f, 0x0e,
+	0x1d, 0x0d, 0x87, 0xcc, 0x13, 0x13, 0x57, 0x74, 0xfc, 0x88, 0xd8, 0x4c, 0x31, 0xce, 0x66, 0xfe,
+	0x86, 0x02, 0xd7, 0x5a, 0x2c, 0x60, 0xbd, 0x60, 0xd7, 0x1b, 0x9e, 0x98, 0x0e, 0xeb, 0x0b, 0x82,
+	0x0c, 0x77, 0x29, 0x46, 0x73, 0xca, 0x44, 0x9a, 0xbb, 0x01, 0x55, 0xdf, 0x1c, 0x0c, 0x6d, 0x66,
+	0xf8, 0xd6, 0xa7, 0xb8, 0xe6, 0x25, 0x1d, 0x10, 0xd4, 0xb5, 0x3e, 0x65, 0x9c, 0x63, 0xa0, 0xde,
+	0x95, 0x66, 0xbd, 0x75, 0x01, 0x96, 0x9c, 0x57, 0xfb, 0x93, 0x02, 0x5c, 0xcf, 0x1e, 0x11, 0x6d,
+	0xfa, 0xd4, 0x43, 0xba, 0x03, 0xcb, 0x1e, 0xeb, 0xb9, 0x1e, 0x3f, 0xac, 0xc4, 0x41, 0x48, 0x6a,
+	0x49, 0x30, 0xb6, 0x9c, 0x29, 0x41, 0x8a, 0xd9, 0x12, 0xe4, 0x36, 0x2c, 0xe1, 0x9c, 0xc2, 0x26,
+	0x91, 0x3b, 0xd6, 0x09, 0x4a, 0x2d, 0xde, 0x81, 0x65, 0x5a, 0x8d, 0x23, 0xcf, 0xec, 0x89, 0x93,
+	0x53, 0x12, 0x9b, 0x41, 0xd8, 0x5b, 0x04, 0xe5, 0xbb, 0xc2, 0x9e, 0x9b, 0x3d, 0x64, 0x8b, 0x65,
+	0x1d, 0x3f, 0xd4, 0xfb, 0x70, 0x89, 0xf9, 0x81, 0x35, 0x30, 0x39, 0xa7, 0xb6, 0xad, 0x67, 0x4c,
+	0x76, 0xb6, 0x28, 0x3a, 0xbb, 0x10, 0x16, 0x6e, 0x5b, 0xcf, 0x18, 0x75, 0xf9, 0x01, 0x5c, 0x8d,
+	0x70, 0x5c, 0x5a, 0x3a, 0x89, 0x57, 0x16, 0x78, 0x57, 0xc2, 0x0a, 0xc9, 0xa5, 0xd5, 0x0e, 0x60,
+	0x8d, 0xd8, 0x2f, 0x12, 0x99, 0xce, 0x4c, 0xdf, 0x75, 0x24, 0x0d, 0x5c, 0x83, 0x4a, 0x5a, 0x41,
+	0x28, 0xfb, 0x52, 0x50, 0xae, 0x41, 0x39, 0xa5, 0x13, 0x84, 0xdf, 0xda, 0x7f, 0x2c, 0xc2, 0xb5,
+	0xcc, 0x76, 0x69, 0x27, 0xf9, 0x62, 0x92, 0xa4, 0x89, 0xa9, 0x74, 0x8a, 0x2e, 0xe5, 0x0f, 0x9d,
+	0xa5, 0x36, 0x54, 0x2d, 0xc7, 0x67, 0x1e, 0x9f, 0x98, 0x19, 0xd0, 0x71, 0x5e, 0x1b, 0x3b, 0xce,
+	0xfb, 0xd2, 0xde, 0xc0, 0xf3, 0xfc, 0x6b, 0xfc, 0x3c, 0x83, 0x44, 0x6c, 0x06, 0xea, 0x26, 0xc0,
+	0x68, 0xd8, 0x37, 0xa9, 0x95, 0xe2, 0x0c, 0xad, 0x54, 0x08, 0xaf, 0x19, 0xe3, 0x5a, 0x67, 0xf1,
+	0xfd, 0x0f, 0xb9, 0xd6, 0x19, 0x6d, 0x46, 0x52, 0xd1, 0x2c, 0xcd, 0xa4, 0x68, 0xaa, 0x3b, 0xd0,
+	0x88, 0x34, 0x45, 0xea, 0x65, 0x41, 0x70, 0x8f, 0x5b, 0x99, 0xdc, 0xe3, 0xc0, 0x89, 0x77, 0xae,
+	0x2f, 0x8f, 0x9c, 0xe4, 0x60, 0x6e, 0xc3, 0x52, 0xef, 0x64, 0xe4, 0xc5, 0xc8, 0x61, 0x11, 0xc7,
+	0x4c, 0x50, 0xaa, 0xb6, 0x0e, 0x17, 0xcc, 0x51, 0xdf, 0x0a, 0x8c, 0x23, 0xd3, 0xb2, 0x93, 0xa4,
+	0x53, 0xd2, 0x57, 0x44, 0xd1, 0x96, 0x28, 0x21, 0xa2, 0xf9, 0xfb, 0x05, 0x58, 0x4a, 0x76, 0xfd,
+	0x39, 0x89, 0xaf, 0x36, 0x2c, 0xf2, 0x21, 0x8c, 0x3c, 0x94, 0x5c, 0x4b, 0xf7, 0xdf, 0x9c, 0x62,
+	0xda, 0xeb, 0x5b, 0x88, 0xa2, 0x4b, 0x5c, 0xae, 0x12, 0xd3, 0x04, 0xc5, 0x1e, 0x95, 0x75, 0xf9,
+	0xa9, 0x8d, 0x60, 0x91, 0x6a, 0xab, 0x55, 0x58, 0x7c, 0xd4, 0xe9, 0x76, 0x3b, 0x3b, 0x0f, 0x1a,
+	0x73, 0x6a, 0x03, 0x6a, 0xad, 0x4e, 0xf7, 0xe3, 0x83, 0xe6, 0x76, 0x67, 0xab, 0xd3, 0x6e, 0x35,
+	0x14, 0x15, 0x60, 0xa1, 0xfd, 0xcd, 0xce, 0x7e, 0xbb, 0xd5, 0x28, 0xa8, 0xd7, 0xe0, 0xca, 0xc1,
+	0xce, 0x37, 0x76, 0x76, 0x1f, 0xef, 0x18, 0xcd, 0x83, 0x56, 0x67, 0xdf, 0xe8, 0x1e, 0x74, 0xf7,
+	0xda, 0x3b, 0xad, 0x76, 0xab, 0x51, 0x54, 0x2f, 0xc1, 0xca, 0xee, 0xd6, 0xd6, 0x76, 0x67, 0xa7,
+	0x1d, 0x03, 0xcf, 0xf3, 0xe6, 0x09, 0xdc, 0x28, 0x69, 0x3f, 0x50, 0xc2, 0xe3, 0xc0, 0x39, 0xe2,
+	0x43, 0xcb, 0x0f, 0xdc, 0x63, 0xcf, 0x1c, 0x7c, 0x46, 0xb5, 0x2e, 0xe2, 0xbc, 0x9e, 0x19, 0x30,
+	0x92, 0x54, 0xc4, 0x79, 0x75, 0x33, 0x60, 0x5c, 0x1d, 0x10, 0x22, 0xc0, 0x38, 0x74, 0x47, 0x4e,
+	0x9f, 0x53, 0x6c, 0xf1, 0x6e, 0x51, 0xaf, 0x0a, 0xd8, 0x86, 0x00, 0x69, 0xff, 0x45, 0x81, 0xeb,
+	0xd9, 0x43, 0xa3, 0xa3, 0xfa, 0x55, 0x58, 0xf0, 0x4c, 0xe7, 0x38, 0x54, 0xc2, 0x6e, 0x4f, 0x52,
+	0xd3, 0x79, 0x13, 0x3a, 0xaf, 0xad, 0x13, 0x52, 0x7a, 0x8c, 0x85, 0xb1, 0x31, 0x72, 0x16, 0x4c,
+	0x7c, 0x35, 0x34, 0x88, 0x25, 0x0b, 0x46, 0xb8, 0x34, 0x20, 0xd4, 0x2f, 0xc1, 0x15, 0x59, 0xd5,
+	0x72, 0x84, 0x79, 0x14, 0x62, 0x20, 0x2f, 0xbe, 0x44, 0xc5, 0x1d, 0x51, 0x2a, 0xf1, 0xb4, 0x3f,
+	0x54, 0xa0, 0x91, 0x1e, 0x20, 0x1f, 0x98, 0x10, 0x9a, 0xb8, 0x36, 0xa4, 0x46, 0x80, 0x00, 0x89,
+	0xa5, 0xe1, 0x15, 0x62, 0x8b, 0x47, 0x2c, 0x0e, 0xa2, 0xb5, 0x9b, 0x65, 0xe4, 0x77, 0x60, 0x39,
+	0x7b, 0xc4, 0x4b, 0x56, 0x62, 0xa8, 0xea, 0x17, 0x40, 0x8d, 0x78, 0x79, 0x58, 0x17, 0x7d, 0x0e,
+	0x2b, 0x61, 0x49, 0x38, 0xb3, 0x13, 0x78, 0x29, 0x62, 0x28, 0x2d, 0xcb, 0x0f, 0x3c, 0xeb, 0x70,
+	0x24, 0xf4, 0x60, 0xa2, 0xac, 0x94, 0x70, 0x56, 0xa6, 0x11, 0xce, 0x85, 0x2c, 0xe1, 0xfc, 0xef,
+	0x14, 0x78, 0x39, 0xaf, 0x2b, 0xa2, 0x94, 0x16, 0x2c, 0xfa, 0x82, 0xa7, 0x49, 0x52, 0x79, 0x23,
+	0x47, 0xe5, 0x49, 0x72, 0x40, 0x32, 0xea, 0x08, 0x75, 0x16, 0xa3, 0x2e, 0x43, 0xd6, 0x16, 0x27,
+	0xcb, 0xda, 0xf9, 0x98, 0xac, 0xd5, 0x7e, 0xb7, 0x00, 0x97, 0x32, 0x07, 0x83, 0xfa, 0xc3, 0xd3,
+	0x91, 0xe5, 0xf1, 0x4d, 0x38, 0x31, 0x3d, 0x26, 0x55, 0xd4, 0x25, 0x09, 0xee, 0x0a, 0x28, 0xb7,
+	0x98, 0x3c, 0x21, 0xdf, 0x64, 0x35, 0xd4, 0x7e, 0x6a, 0x08, 0xa4, 0x4a, 0xb7, 0x61, 0xc9, 0x1d,
+	0xf2, 0x9d, 0xb3, 0x65, 0x2d, 0xb4, 0x91, 0xeb, 0x04, 0xa5, 0x6a, 0xaf, 0x40, 0x2d, 0x70, 0x83,
+	0xa8, 0x12, 0x8a, 0x97, 0xaa, 0x80, 0x51, 0x95, 0x2c, 0x8a, 0x2b, 0x65, 0x53, 0x5c, 0x36, 0x21,
+	0x2d, 0xe4, 0x10, 0x12, 0x6f, 0x99, 0x3d, 0x1f, 0x9a, 0x8e, 0x6f, 0xb9, 0x8e, 0x71, 0x64, 0xf2,
+	0x8d, 0x12, 0xb2, 0x42, 0xd1, 0x97, 0x43, 0xf8, 0x96, 0x00, 0x6b, 0xdd, 0xd0, 0x62, 0x13, 0xec,
+	0x97, 0xb3, 0x70, 0xff, 0x33, 0x2b, 0x0c, 0x5d, 0xb8, 0x9a, 0xd1, 0x28, 0x11, 0xd6, 0x97, 0x52,
+	0x76, 0xe0, 0xcb, 0xf9, 0x76, 0x20, 0x47, 0x94, 0x36, 0xa0, 0xf6, 0xcf, 0x0a, 0x50, 0x09, 0xa1,
+	0x9f, 0x93, 0x88, 0x5a, 0x85, 0xc5, 0x81, 0xe5, 0xfb, 0x96, 0x73, 0x2c, 0x76, 0xb1, 0xac, 0xcb,
+	0x4f, 0x5e, 0x62, 0xf6, 0xfb, 0x1e, 0xf3, 0x7d, 0x69, 0x57, 0xd1, 0xa7, 0x7a, 0x13, 0x6a, 0xc2,
+	0xe4, 0xb2, 0x86, 0xc6, 0xd0, 0xf5, 0xd0, 0x85, 0x58, 0xd1, 0x81, 0xc3, 0x3a, 0xc3, 0x3d, 0xd7,
+	0x0b, 0xd4, 0x4f, 0xe0, 0xa2, 0xa8, 0xd1, 0x73, 0x9d, 0xc0, 0xec, 0x05, 0x86, 0x3f, 0xea, 0xf5,
+	0x78, 0x43, 0x0b, 0x33, 0xe8, 0x2a, 0x2a, 0x6f, 0x61, 0x13, 0x1b, 0xe8, 0x22, 0x3e, 0x97, 0x1c,
+	0xae, 0x60, 0x30, 0x62, 0x33, 0xcb, 0x3a, 0x7d, 0xa9, 0x1a, 0xd4, 0xfa, 0x96, 0xff, 0x74, 0x64,
+	0xda, 0xd6, 0x91, 0xc5, 0xfa, 0x42, 0xd4, 0x97, 0xf5, 0x04, 0x4c, 0xf3, 0x60, 0x15, 0xf9, 0xa8,
+	0xce, 0x06, 0x6e, 0xc0, 0x99, 0xb5, 0xe5, 0xfe, 0x8c, 0x05, 0x96, 0xf6, 0x9b, 0x05, 0xb8, 0x9a,
+	0xd1, 0x69, 0xe4, 0x0f, 0x40, 0x76, 0x39, 0x8d, 0x03, 0x70, 0x9f, 0x9f, 0x1b, 0x5f, 0x27, 0x0c,
+	0x8e, 0xeb, 0x89, 0x26, 0x49, 0x8b, 0x9c, 0x0a, 0x17, 0x31, 0xce, 0x97, 0xb3, 0x5f, 0x82, 0x2b,
+	0x49, 0xf6, 0x1e, 0x31, 0x24, 0xb4, 0x0f, 0x2f, 0x25, 0xd8, 0x7c, 0xc8, 0x97, 0xee, 0x03, 0x15,
+	0x18, 0x87, 0x67, 0x01, 0xf3, 0xd3, 0x26, 0xc3, 0x05, 0x2c, 0xdc, 0xe0, 0x65, 0x12, 0x47, 0xfb,
+	0xa7, 0x91, 0x33, 0x12, 0x87, 0x99, 0xc9, 0x15, 0x94, 0x6c, 0xae, 0x70, 0x0b, 0xa4, 0xb9, 0x82,
+	0x3d, 0xd2, 0x39, 0xac, 0x11, 0x50, 0xf4, 0x94, 0xc3, 0x3a, 0x8a, 0x79, 0xac, 0xe3, 0x0e, 0x2c,
+	0x47, 0xd5, 0xb1, 0x55, 0x92, 0x6d, 0x21, 0x58, 0xb4, 0xab, 0xfd, 0xbe, 0x02, 0x6b, 0x2d, 0xef,
+	0x4c, 0x1f, 0x39, 0x68, 0x13, 0x6c, 0x9e, 0xb0, 0xde, 0x13, 0xe6, 0x7d, 0x6e, 0x34, 0x25, 0x24,
+	0x5c, 0x71, 0x1a, 0x09, 0x37, 0x9f, 0x21, 0xe1, 0x32, 0xdc, 0x12, 0xa5, 0x2c, 0xb7, 0xc4, 0xbf,
+	0x2d, 0xc2, 0xb5, 0xcc, 0x59, 0x10, 0x91, 0xc6, 0xe5, 0x57, 0x4f, 0x94, 0xf5, 0xc3, 0xdd, 0x20,
+	0x38, 0xa2, 0x08, 0x0d, 0xe3, 0xd4, 0x1d, 0xd9, 0x7d, 0xe3, 0xe9, 0x88, 0x8d, 0x98, 0xd4, 0x30,
+	0x04, 0x48, 0xb8, 0x3c, 0xd4, 0x9b, 0x50, 0xb5, 0x3c, 0x2e, 0x4b, 0x3c, 0xf3, 0xd0, 0x66, 0xb4,
+	0x05, 0x71, 0x50, 0xd2, 0x5e, 0x8c, 0x37, 0x36, 0x9f, 0xb2, 0x17, 0x1f, 0x47, 0xad, 0xc6, 0x3c,
+	0xaf, 0xa5, 0x17, 0xf4, 0xbc, 0x26, 0x5d, 0x24, 0x0b, 0x93, 0x5d, 0x24, 0x8b, 0xe7, 0xbb, 0x48,
+	0xca, 0x9f, 0xc5, 0x45, 0x92, 0xa5, 0x07, 0x54, 0x26, 0xeb, 0x01, 0x10, 0xd7, 0x03, 0xfe, 0x22,
+	0xac, 0xb5, 0x46, 0x43, 0xdb, 0xea, 0x99, 0x01, 0x1b, 0x17, 0x69, 0x9f, 0x97, 0x06, 0x95, 0xe3,
+	0x21, 0xff, 0x83, 0x02, 0x5c, 0xcb, 0xec, 0x9d, 0xc8, 0xe9, 0x01, 0xc0, 0x33, 0xcb, 0xb5, 0x85,
+	0xbb, 0x6a, 0xb2, 0xa7, 0x7c, 0xbc, 0x15, 0x3d, 0x86, 0xaa, 0xaa, 0x30, 0x3f, 0x70, 0x3d, 0xa4,
+	0xb2, 0xb2, 0x2e, 0x7e, 0xcf, 0xe2, 0xfe, 0xf8, 0x02, 0xa8, 0xd4, 0x98, 0x73, 0x9c, 0x56, 0x62,
+	0x57, 0xc2, 0x92, 0x90, 0x29, 0x7c, 0x04, 0xd7, 0x23, 0xba, 0xcc, 0x40, 0x44, 0xad, 0x65, 0x2d,
+	0xac, 0xf3, 0xc9, 0x58, 0x0b, 0x19, 0x9b, 0xba, 0x30, 0x79, 0x53, 0x17, 0xe3, 0x9b, 0xfa, 0xb7,
+	0x14, 0x50, 0xc7, 0x57, 0xe4, 0x85, 0x15, 0x94, 0xb8, 0x82, 0x50, 0x9c, 0xa8, 0x20, 0xdc, 0x82,
+	0x7a, 0xa8, 0x66, 0x1c, 0x32, 0x0f, 0x8d, 0xae, 0x92, 0x5e, 0x93, 0xaa, 0x06, 0x87, 0x69, 0x7f,
+	0x05, 0x5e, 0x0e, 0x1d, 0x31, 0xc8, 0xe1, 0xe4, 0xbc, 0xff, 0x94, 0xc8, 0xee, 0xfb, 0x45, 0xb8,
+	0x91, 0x3b, 0x82, 0x90, 0xf4, 0xd2, 0x57, 0x94, 0xd9, 0xe6, 0x78, 0x76, 0x3b, 0xb1, 0xbb, 0xca,
+	0x2c, 0xd2, 0xfb, 0x08, 0xca, 0xc4, 0xdb, 0xa5, 0x1f, 0xfd, 0xd5, 0x69, 0x1a, 0xd7, 0x43, 0xac,
+	0x4c, 0xe2, 0x9d, 0xcf, 0x26, 0xde, 0x37, 0x61, 0x25, 0xf4, 0x8b, 0xa5, 0x48, 0xb0, 0x21, 0x0b,
+	0x42, 0xc2, 0xfb, 0x1a, 0x5c, 0xcb, 0x70, 0xa7, 0xa5, 0x54, 0xe8, 0xab, 0x63, 0x0e, 0xb5, 0x49,
+	0x84, 0xbb, 0x38, 0x99, 0x70, 0xcb, 0x71, 0xc2, 0xfd, 0x7d, 0x05, 0x96, 0x53, 0x93, 0x3e, 0x4f,
+	0x34, 0x6e, 0x72, 0xdd, 0xc6, 0xf4, 0x89, 0x6a, 0x97, 0xa6, 0xdb, 0xa6, 0x75, 0x72, 0xc9, 0x11,
+	0x2a, 0x27, 0xfe, 0x94, 0xac, 0x0f, 0xbf, 0xb5, 0xb7, 0x61, 0x01, 0x6b, 0xab, 0x17, 0x60, 0x79,
+	0x4f, 0xdf, 0xfd, 0xf9, 0xf6, 0xe6, 0xbe, 0xd1, 0x6a, 0x6f, 0xb7, 0xf7, 0xdb, 0xad, 0xc6, 0x9c,
+	0xba, 0x02, 0xf5, 0xdd, 0xc7, 0x3b, 0x6d, 0x3d, 0x04, 0x29, 0xda, 0x3f, 0x56, 0xe0, 0x72, 0x36,
+	0x5d, 0xbc, 0xf8, 0x11, 0x3c, 0xe7, 0x7a, 0x3f, 0x5a, 0x85, 0xf9, 0x17, 0x5e, 0x05, 0xed, 0x87,
+	0x0a, 0x5c, 0xe3, 0x07, 0xba, 0x1b, 0xb8, 0x9e, 0x79, 0xcc, 0x36, 0xce, 0x24, 0xdd, 0xfd, 0x59,
+	0x79, 0xc5, 0xa3, 0xf3, 0x3b, 0x1f, 0x3f, 0xbf, 0xdf, 0x29, 0xc2, 0xf5, 0xec, 0x71, 0xce, 0xea,
+	0x2b, 0xdf, 0x8c, 0x1d, 0xc4, 0xc2, 0x04, 0xf1, 0xc2, 0xd1, 0xe4, 0x4e, 0x62, 0xa7, 0xb1, 0xb3,
+	0x28, 0x4f, 0x78, 0xf1, 0x1c, 0xe1, 0x32, 0x3f, 0xad, 0x6f, 0xbd, 0x94, 0xe5, 0x5b, 0xbf, 0x0d,
+	0x4b, 0x23, 0xc7, 0x3d, 0x8d, 0xb9, 0x33, 0xf1, 0x30, 0xd6, 0x09, 0x1a, 0x39, 0xf5, 0xa3, 0x03,
+	0x9c, 0x70, 0x9f, 0x47, 0x8a, 0x6a, 0xbe, 0xb7, 0xbe, 0x3c, 0xf9, 0xac, 0x56, 0xd2, 0x42, 0x66,
+	0x7c, 0x5d, 0xce, 0x3b, 0xae, 0xe3, 0xb3, 0x2d, 0x64, 0xcd, 0x36, 0x6b, 0x1a, 0xc5, 0xec, 0x69,
+	0x5c, 0x84, 0x92, 0x70, 0x1a, 0x90, 0xb5, 0x81, 0x1f, 0xda, 0x09, 0xbc, 0x1c, 0xbb, 0x3f, 0x6b,
+	0x1e, 0x8f, 0xfb, 0x1d, 0xb7, 0x52, 0xfe, 0x41, 0xe4, 0xf2, 0x53, 0xdd, 0x97, 0x25, 0x9c, 0x88,
+	0xbf, 0xa7, 0xc0, 0x8d, 0xdc, 0xae, 0xfe, 0x14, 0x6e, 0xec, 0x3e, 0x0a, 0x7d, 0x94, 0x28, 0x4a,
+	0xee, 0x4e, 0x50, 0x24, 0xe5, 0x08, 0x13, 0x6e, 0x4a, 0x6e, 0x55, 0x5d, 0xc8, 0x28, 0x57, 0x5b,
+	0xe3, 0x5e, 0xc2, 0x29, 0x87, 0x17, 0x77, 0x25, 0xb6, 0xc6, 0x5d, 0x89, 0xd3, 0xb6, 0x12, 0xf3,
+	0x37, 0x66, 0xdf, 0xe3, 0xfd, 0x5f, 0x05, 0x00, 0x39, 0x81, 0x19, 0x8c, 0xe2, 0x16, 0xbf, 0x92,
+	0xb0, 0xf8, 0x2f, 0xc3, 0xc2, 0x33, 0x16, 0x04, 0xe4, 0x4c, 0x2b, 0xeb, 0xf4, 0x35, 0xe6, 0x09,
+	0x28, 0x8e, 0x7b, 0x02, 0xb8, 0x79, 0x3b, 0x72, 0x9e, 0xf0, 0x33, 0x66, 0xe0, 0x3d, 0x81, 0x3f,
+	0xf2, 0x87, 0xcc, 0xe9, 0x87, 0xfe, 0xf5, 0x4b, 0x54, 0xdc, 0xe4, 0xa5, 0x5d, 0x59, 0x28, 0xc4,
+	0x2e, 0xc5, 0xb1, 0x44, 0x18, 0x18, 0x2e, 0xd1, 0xa0, 0x82, 0xa8, 0xf2, 0x2a, 0x2c, 0xb2, 0xe7,
+	0x16, 0x57, 0x01, 0xe9, 0x46, 0x4c, 0x7e, 0xf2, 0xa1, 0xf3, 0x9f, 0xac, 0x2f, 0x9d, 0x18, 0xf8,
+	0xa5, 0xfd, 0x6b, 0x05, 0xaa, 0xbb, 0xcf, 0x98, 0x67, 0x9b, 0x67, 0x42, 0xb7, 0x9b, 0x9a, 0xe5,
+	0xc5, 0x3c, 0x35, 0x85, 0xc9, 0x9e, 0x9a, 0xe2, 0x98, 0xa7, 0x26, 0xff, 0xfa, 0x5c, 0x7d, 0x0f,
+	0x16, 0x7c, 0xb1, 0x09, 0x74, 0xed, 0x73, 0x23, 0x97, 0x8f, 0xe2, 0x5e, 0xe9, 0x54, 0x5d, 0xb3,
+	0xa0, 0x21, 0x94, 0xfe, 0x8d, 0xb3, 0xce, 0x9e, 0x3c, 0x9a, 0x4b, 0x50, 0xb0, 0x86, 0x74, 0xe9,
+	0x5e, 0xb0, 0x86, 0xea, 0x3d, 0xa8, 0xc6, 0x82, 0xd7, 0x72, 0x9c, 0x54, 0x10, 0x05, 0xb1, 0xe5,
+	0xe8, 0x7d, 0x06, 0xac, 0xc4, 0xba, 0x0a, 0xfd, 0x6b, 0x25, 0xbe, 0x32, 0xf2, 0xfc, 0xdf, 0xcc,
+	0x16, 0x9c, 0xd1, 0x4a, 0xeb, 0x58, 0x3d, 0x4b, 0xaf, 0xd3, 0x06, 0x70, 0xa5, 0xb3, 0xe7, 0x3f,
+	0xb6, 0x82, 0x93, 0x47, 0xa6, 0x73, 0x96, 0x76, 0x0e, 0x72, 0xa3, 0x51, 0x76, 0x25, 0x1c, 0x70,
+	0x03, 0xcb, 0x11, 0x75, 0x84, 0xbc, 0x4c, 0xcd, 0xaf, 0x32, 0xc5, 0x7c, 0xbe, 0x05, 0xab, 0xe3,
+	0xdd, 0xd1, 0xb4, 0xd6, 0xa1, 0x68, 0x0d, 0xe5, 0xa4, 0xae, 0x67, 0x4e, 0xaa, 0xb3, 0x87, 0x28,
+	0xbc, 0x62, 0xe6, 0x74, 0x3e, 0x86, 0x45, 0xaa, 0x33, 0xb6, 0x23, 0xe1, 0xaa, 0x15, 0x66, 0x5a,
+	0x35, 0xad, 0x0f, 0xd7, 0xda, 0xcf, 0x87, 0xb6, 0x89, 0x33, 0xef, 0x32, 0x9b, 0xf5, 0xe2, 0x1e,
+	0xfb, 0xa9, 0xa9, 0xf8, 0x3a, 0x54, 0x86, 0xb6, 0xd9, 0x63, 0x22, 0xf4, 0x0b, 0xf5, 0x8b, 0x08,
+	0xa0, 0xfd, 0xcf, 0x02, 0x5c, 0xcf, 0xee, 0x86, 0x56, 0x67, 0x2f, 0x54, 0x97, 0x14, 0xa1, 0x2e,
+	0xbd, 0x9f, 0x39, 0xfe, 0x49, 0x4d, 0xa4, 0x35, 0xc8, 0x77, 0x61, 0x9e, 0x0f, 0x8d, 0xd8, 0xdb,
+	0xf9, 0xeb, 0x21, 0x6a, 0xf3, 0x53, 0x2c, 0x95, 0xcb, 0x4b, 0xb0, 0xf2, 0x78, 0xf7, 0x60, 0xbb,
+	0x65, 0x6c, 0xb4, 0x8d, 0x6e, 0x7b, 0xbb, 0xbd, 0x89, 0xea, 0x65, 0xec, 0x2a, 0x4d, 0x19, 0xbb,
+	0xa9, 0x2b, 0xa8, 0x75, 0xa8, 0xc4, 0xef, 0xe3, 0xaa, 0xb0, 0xd8, 0xfe, 0x66, 0x67, 0xbf, 0xb3,
+	0xf3, 0xa0, 0x31, 0xaf, 0x5e, 0x83, 0x2b, 0x9d, 0x9d, 0xee, 0xc1, 0xd6, 0x56, 0x67, 0xb3, 0xd3,
+	0xde, 0xd9, 0x37, 0xb6, 0xf4, 0x76, 0xdb, 0xe8, 0xee, 0x35, 0x37, 0xdb, 0x8d, 0x92, 0x7a, 0x11,
+	0x1a, 0xbb, 0x07, 0xfb, 0xad, 0xe6, 0x7e, 0xbb, 0x65, 0x7c, 0xd2, 0xd6, 0xbb, 0x9d, 0xdd, 0x9d,
+	0xc6, 0x02, 0x87, 0xee, 0x6d, 0x37, 0x37, 0xdb, 0x8f, 0x44, 0xfd, 0xce, 0xf6, 0x7e, 0x5b, 0x6f,
+	0x2c, 0xaa, 0x35, 0x28, 0x1f, 0xec, 0x7c, 0xd2, 0xde, 0xe7, 0x23, 0x2a, 0x73, 0x2d, 0xb8, 0x7b,
+	0xb0, 0xb1, 0xd3, 0xde, 0x37, 0x36, 0x77, 0x77, 0xb6, 0xb6, 0x3b, 0x9b, 0xfb, 0x8d, 0x8a, 0x66,
+	0xc1, 0xea, 0xbe, 0x3b, 0xa4, 0xd3, 0x25, 0x55, 0xa4, 0xc8, 0x9a, 0x43, 0x3e, 0x6c, 0xb8, 0x8e,
+	0x7d, 0x46, 0xac, 0x19, 0x10, 0xb4, 0xeb, 0xd8, 0x67, 0x82, 0x6d, 0x1f, 0x1d, 0xf9, 0x4c, 0xee,
+	0x24, 0x7d, 0xe5, 0x50, 0xfd, 0x31, 0x5c, 0xcd, 0xe8, 0x6a, 0x96, 0xd3, 0x1c, 0xd3, 0x1d, 0x27,
+	0x9d, 0xe6, 0xef, 0x2a, 0x50, 0x8d, 0x55, 0x9d, 0x9e, 0x38, 0x5f, 0x81, 0x9a, 0x1f, 0xb8, 0x5e,
+	0xca, 0xcf, 0x58, 0x45, 0x18, 0xba, 0x19, 0x6f, 0x40, 0x15, 0x0d, 0xe5, 0xb8, 0x50, 0xc3, 0x98,
+	0xa2, 0x30, 0x6c, 0x8e, 0x44, 0xd9, 0x7c, 0x5c, 0x94, 0x69, 0x0f, 0xe0, 0xba, 0xce, 0x7a, 0xa6,
+	0xdd, 0x1b, 0xd9, 0x66, 0xc0, 0x74, 0x36, 0x1c, 0x05, 0xe6, 0x8b, 0x9c, 0x20, 0xed, 0xfb, 0x0a,
+	0xbc, 0x94, 0xd3, 0x12, 0xad, 0xe5, 0x87, 0xb0, 0x80, 0xe1, 0xbf, 0x24, 0xf9, 0x6f, 0xe5, 0x2e,
+	0x66, 0x0c, 0x99, 0x50, 0xd4, 0x2f, 0x43, 0x29, 0x62, 0x66, 0x53, 0xe2, 0x22, 0x86, 0xf6, 0xdb,
+	0x0a, 0x2c, 0x25, 0x4b, 0xf8, 0x72, 0x91, 0xf0, 0xed, 0xc9, 0xf1, 0x28, 0x3a, 0x08, 0x50, 0x97,
+	0x43, 0xd4, 0x75, 0xb8, 0x90, 0x92, 0xd2, 0x3d, 0xb9, 0x9d, 0x8a, 0xbe, 0x92, 0x90, 0xd0, 0xa2,
+	0xfe, 0x2b, 0x50, 0x23, 0x9a, 0xc4, 0x8a, 0xe8, 0xd6, 0x26, 0x3a, 0xc5, 0x2a, 0xb7, 0x61, 0x89,
+	0xaa, 0x9c, 0x5a, 0x4e, 0xdf, 0x3d, 0x0d, 0x63, 0x1e, 0x10, 0xfa, 0x18, 0x81, 0x9c, 0x1c, 0x05,
+	0x2d, 0xee, 0x30, 0xd3, 0xdb, 0x45, 0xb9, 0xde, 0xfa, 0x58, 0xee, 0xc6, 0x75, 0xa8, 0x04, 0x27,
+	0x1e, 0xf3, 0x4f, 0x5c, 0xbb, 0x4f, 0xa3, 0x8e, 0x00, 0x33, 0xd2, 0xfd, 0xdf, 0x54, 0x60, 0x2d,
+	0xab, 0xa7, 0xf0, 0x7e, 0x20, 0x41, 0xf9, 0xaf, 0xe6, 0x2e, 0x38, 0xa1, 0x8a, 0x78, 0xd4, 0x7c,
+	0xea, 0x57, 0xdf, 0x02, 0x55, 0xea, 0x2f, 0xfd, 0xa7, 0x06, 0x73, 0xcc, 0x43, 0x3b, 0xd4, 0x90,
+	0xa4, 0x02, 0xd3, 0x7a, 0xda, 0x46, 0xb8, 0xf6, 0xbf, 0x15, 0x58, 0x4e, 0x35, 0x3e, 0xd3, 0x79,
+	0x49, 0x6c, 0x46, 0x61, 0x7c, 0x33, 0x36, 0xa1, 0x46, 0x36, 0x04, 0xeb, 0x1b, 0xfd, 0xa7, 0x53,
+	0xc4, 0xb1, 0xcc, 0x8b, 0x7b, 0xa1, 0x6a, 0x88, 0xd5, 0x7a, 0x2a, 0x22, 0x02, 0x9c, 0x3e, 0xf3,
+	0x0c, 0x8f, 0x3d, 0xb3, 0xd8, 0x29, 0x9d, 0xac, 0xaa, 0x80, 0xe9, 0x02, 0x34, 0x93, 0xd6, 0xa6,
+	0xb5, 0xe0, 0xea, 0x03, 0x16, 0xec, 0x0e, 0x99, 0x67, 0x06, 0xae, 0x47, 0xb7, 0x4f, 0x33, 0x1f,
+	0x44, 0xbe, 0xaf, 0x59, 0xcd, 0xd0, 0xbe, 0x72, 0xe3, 0x6b, 0x60, 0x5a, 0x36, 0x09, 0x5f, 0xfc,
+	0x10, 0x41, 0xad, 0xfc, 0x87, 0xe1, 0xb1, 0xbe, 0xd9, 0x8b, 0x34, 0xdb, 0xba, 0x80, 0xea, 0x04,
+	0xe4, 0x14, 0x76, 0x6a, 0xda, 0x36, 0x93, 0xca, 0x1c, 0x7d, 0x71, 0xd3, 0x0f, 0x7f, 0x19, 0x47,
+	0xcc, 0x0c, 0x46, 0x78, 0xe3, 0x5a, 0xbc, 0x5b, 0xd1, 0x97, 0x10, 0xbc, 0x45, 0x50, 0x7e, 0x16,
+	0x57, 0x89, 0xd5, 0x1e, 0x0c, 0x03, 0x6b, 0xc0, 0x36, 0x4c, 0x27, 0x0c, 0xc8, 0x7d, 0x05, 0x6a,
+	0x78, 0x34, 0x8c, 0x13, 0x77, 0xe4, 0x49, 0xb5, 0xa6, 0x8a, 0xb0, 0x87, 0x1c, 0xc4, 0xab, 0xc4,
+	0x4c, 0x08, 0x54, 0x17, 0x14, 0xbd, 0x1a, 0x99, 0x07, 0x3e, 0xd7, 0x8c, 0x6c, 0xcb, 0x0f, 0x8c,
+	0x43, 0xd3, 0xe9, 0x13, 0xc5, 0x97, 0x39, 0x80, 0xf7, 0x14, 0x3b, 0x22, 0xf3, 0xd9, 0x47, 0xa4,
+	0x14, 0x3f, 0x22, 0xff, 0x4a, 0xa1, 0xc3, 0x98, 0x1c, 0x2d, 0xad, 0xe4, 0x17, 0xa1, 0xc4, 0xfb,
+	0x90, 0x27, 0x24, 0x5b, 0x43, 0x8d, 0xe1, 0x61, 0x6d, 0xbe, 0xd4, 0xa7, 0x56, 0x70, 0xe2, 0x8e,
+	0x02, 0x64, 0x2d, 0xa1, 0xc5, 0x4a, 0x50, 0xc1, 0x55, 0x7c, 0xde, 0x3a, 0x9e, 0xbf, 0xe2, 0x84,
+	0xd6, 0xf9, 0xe0, 0xb0, 0x87, 0xf4, 0xd1, 0x9b, 0x4f, 0xa8, 0x91, 0x10, 0x0d, 0x23, 0x2b, 0x56,
+	0x43, 0x39, 0x2f, 0x56, 0x23, 0x69, 0x3b, 0xbd, 0x04, 0x20, 0x48, 0x31, 0x2e, 0x6b, 0x2a, 0x1c,
+	0x22, 0x44, 0x8d, 0xc6, 0xd0, 0x86, 0xc2, 0x2e, 0xa7, 0x3f, 0xb5, 0x97, 0x61, 0x61, 0x24, 0x50,
+	0xa8, 0x47, 0xfa, 0xe2, 0x70, 0x5a, 0x27, 0xec, 0x89, 0xbe, 0xb4, 0x1e, 0x5c, 0xd8, 0x74, 0x07,
+	0x43, 0xd3, 0x4b, 0x5e, 0x31, 0xbc, 0x0a, 0xa5, 0x23, 0xcb, 0xf3, 0x83, 0x9c, 0xde, 0xb0, 0x50,
+	0x7d, 0x0d, 0x16, 0x7c, 0xd6, 0x73, 0x9d, 0xdc, 0x1b, 0x6a, 0x2c, 0xd5, 0xfe, 0x81, 0x02, 0x17,
+	0x93, 0xbd, 0xd0, 0xe6, 0x7f, 0x39, 0xde, 0xcd, 0x24, 0x79, 0x84, 0xd8, 0x16, 0xd7, 0xed, 0xa8,
+	0xef, 0x0f, 0x13, 0x7d, 0x4f, 0x89, 0x4b, 0x28, 0xea, 0x4d, 0xa8, 0xf6, 0xad, 0xa3, 0x23, 0xe6,
+	0x31, 0xa7, 0x47, 0xc4, 0x51, 0xd1, 0xe3, 0x20, 0xed, 0x7b, 0x45, 0x14, 0x77, 0x11, 0xf2, 0x2c,
+	0xfe, 0x2b, 0xf0, 0x42, 0x29, 0x39, 0x8b, 0xa8, 0x8d, 0xa1, 0xc5, 0x4c, 0xb7, 0xe2, 0x4c, 0xa6,
+	0x9b, 0xfa, 0x06, 0xac, 0x60, 0xd0, 0x06, 0x8a, 0x5c, 0x24, 0x2f, 0xf2, 0x72, 0x89, 0x02, 0x71,
+	0x34, 0x50, 0x9f, 0x09, 0xc3, 0xec, 0xe8, 0x76, 0x9f, 0x6a, 0x53, 0x70, 0x0f, 0x4a, 0x72, 0x2c,
+	0xc1, 0xfa, 0x5f, 0x85, 0x0a, 0x1a, 0xe9, 0x86, 0x19, 0x4c, 0x11, 0x09, 0x80, 0xdc, 0xbe, 0x8c,
+	0x28, 0xcd, 0x40, 0xfd, 0x3a, 0x08, 0xbb, 0x15, 0x47, 0x26, 0x4c, 0xe7, 0x69, 0xf0, 0x2b, 0x1c,
+	0x47, 0x0c, 0x5a, 0xfb, 0x89, 0x02, 0x57, 0xb6, 0x2d, 0x3f, 0x68, 0xa3, 0x1d, 0x9e, 0x20, 0xd9,
+	0x87, 0x50, 0x72, 0xbd, 0x3e, 0xc5, 0x1f, 0x2f, 0xdd, 0xbf, 0x9f, 0x1d, 0x03, 0x9f, 0x8d, 0xbc,
+	0xbe, 0xcb, 0x31, 0x75, 0x6c, 0x40, 0x7d, 0x19, 0xa0, 0xcf, 0xfc, 0x1e, 0x73, 0xfa, 0xdc, 0xf4,
+	0x47, 0x16, 0x1e, 0x83, 0xc4, 0xd8, 0x5f, 0x31, 0x9b, 0xfd, 0x25, 0xfc, 0xa2, 0x77, 0xa0, 0x24,
+	0x5a, 0xe7, 0x76, 0x42, 0x67, 0xa7, 0xb3, 0xdf, 0x11, 0xda, 0x7d, 0x73, 0xbf, 0x31, 0xc7, 0x55,
+	0xf8, 0x3d, 0x7d, 0xf7, 0x81, 0xde, 0xee, 0x76, 0x1b, 0x8a, 0x76, 0x04, 0xab, 0xe3, 0xc3, 0x9b,
+	0x45, 0x83, 0x8e, 0x61, 0x4e, 0xd2, 0xa0, 0x7f, 0xb3, 0x08, 0xd5, 0x58, 0xd5, 0xe9, 0xe9, 0x7a,
+	0x1b, 0x56, 0xd8, 0x73, 0x2b, 0x30, 0x2c, 0xc7, 0x0a, 0x2c, 0x73, 0xea, 0x08, 0x58, 0xdc, 0xc5,
+	0x65, 0x8e, 0xda, 0x91, 0x98, 0x4d, 0x61, 0x80, 0x88, 0x7b, 0x61, 0xe3, 0x70, 0x64, 0xd9, 0x01,
+	0xe9, 0x30, 0x20, 0x40, 0x1b, 0x1c, 0xa2, 0xbe, 0x03, 0x97, 0x7a, 0xee, 0x60, 0x68, 0x33, 0x7e,
+	0x1e, 0x8c, 0x21, 0xf3, 0x7a, 0xcc, 0x09, 0xcc, 0x63, 0xe9, 0x52, 0xbc, 0x18, 0x15, 0xee, 0x85,
+	0x65, 0x5c, 0x55, 0xc0, 0xc0, 0x85, 0xc0, 0x33, 0x1d, 0xff, 0x88, 0x79, 0x1e, 0xa9, 0x0a, 0x45,
+	0xbd, 0x21, 0x0a, 0xf6, 0x23, 0xb8, 0xfa, 0x05, 0x50, 0xd1, 0x8b, 0x99, 0xa8, 0x4d, 0x11, 0x49,
+	0x58, 0x12, 0xaf, 0x2e, 0xef, 0xd1, 0x7c, 0x8a, 0x4a, 0x25, 0x17, 0x2e, 0xde, 0xa3, 0xf9, 0x18,
+	0x8f, 0xaa, 0xbe, 0x0e, 0x0d, 0xaa, 0xe4, 0x71, 0xa9, 0xef, 0x70, 0x12, 0xc2, 0x88, 0xe7, 0xe5,
+	0x21, 0xc5, 0x8e, 0x13, 0x58, 0x5d, 0xc5, 0xd8, 0x52, 0x5e, 0x03, 0x7d, 0xb8, 0xf2, 0x53, 0xbb,
+	0x26, 0x74, 0x98, 0xd0, 0xbc, 0xdd, 0x74, 0x9d, 0x23, 0xeb, 0x98, 0x68, 0x55, 0xfb, 0xe3, 0xa2,
+	0x50, 0x4d, 0xc6, 0x4a, 0x89, 0x54, 0x1e, 0x02, 0x84, 0x36, 0xb7, 0xa4, 0x97, 0x6c, 0xef, 0xe3,
+	0x9e, 0xac, 0xd6, 0x62, 0x47, 0x62, 0x4f, 0x39, 0x0b, 0x8a, 0x70, 0xd5, 0x0f, 0xe0, 0xea, 0x68,
+	0x68, 0xbb, 0x66, 0xdf, 0x60, 0xcf, 0x7b, 0xf6, 0x68, 0xfc, 0xe1, 0x4a, 0x45, 0xbf, 0x82, 0x15,
+	0xda, 0x54, 0x1e, 0xbd, 0x4d, 0xf9, 0x00, 0xae, 0x52, 0x18, 0x5a, 0x06, 0x2e, 0xf2, 0xdb, 0x2b,
+	0x58, 0x61, 0x1c, 0xf7, 0x06, 0xe7, 0xce, 0x7e, 0x60, 0x39, 0xbd, 0xc0, 0xb0, 0x86, 0x24, 0x84,
+	0x41, 0x82, 0x3a, 0x43, 0xae, 0x28, 0x0d, 0x2c, 0xc7, 0x1a, 0x8c, 0x06, 0xc6, 0x33, 0xe6, 0xf9,
+	0x32, 0x3c, 0xa5, 0xa2, 0x2f, 0x11, 0xf8, 0x13, 0x84, 0x72, 0x5e, 0xe8, 0xb0, 0x53, 0xe1, 0xdf,
+	0x49, 0xdf, 0xd9, 0x2e, 0x3b, 0xec, 0x94, 0xd3, 0x77, 0xe8, 0x4f, 0x7f, 0x0b, 0x54, 0xd9, 0x68,
+	0xdf, 0xf2, 0x9f, 0x18, 0xfe, 0xd0, 0xec, 0x31, 0xda, 0xe2, 0x06, 0x95, 0xb4, 0x2c, 0xff, 0x49,
+	0x97, 0xc3, 0xd5, 0x87, 0x50, 0x4f, 0xd8, 0x21, 0x62, 0x8f, 0xa7, 0xf4, 0xa0, 0xd6, 0xe2, 0xb6,
+	0x0a, 0x3f, 0xa2, 0x01, 0x7b, 0x8e, 0x6e, 0xfc, 0x8a, 0x2e, 0x7e, 0x6b, 0xbf, 0xa2, 0xc0, 0x85,
+	0x8c, 0xdd, 0x49, 0x3a, 0x58, 0x94, 0x94, 0x83, 0x85, 0xb7, 0xe4, 0x98, 0x24, 0xf9, 0x2b, 0xba,
+	0xf8, 0xcd, 0x69, 0xd6, 0xb4, 0xed, 0xc4, 0xda, 0x0b, 0x6f, 0xaa, 0x69, 0xdb, 0xd1, 0x82, 0x5f,
+	0x87, 0x4a, 0x54, 0x01, 0x55, 0xce, 0x08, 0xa0, 0xfd, 0xd7, 0x02, 0x5e, 0x29, 0x6c, 0xba, 0x27,
+	0xae, 0x17, 0x5d, 0x07, 0x1f, 0x40, 0xf5, 0xd8, 0x33, 0x9d, 0x91, 0x6d, 0x7a, 0x56, 0x70, 0x46,
+	0x5c, 0xf7, 0x9d, 0x09, 0x52, 0x38, 0x8e, 0xbd, 0xfe, 0x20, 0x42, 0xd5, 0xe3, 0xed, 0xa8, 0x5b,
+	0xb0, 0x70, 0x64, 0xd9, 0xd2, 0x46, 0x5d, 0xba, 0xbf, 0x3e, 0x6d, 0x8b, 0x5b, 0x02, 0x4b, 0x27,
+	0x6c, 0xbe, 0x41, 0x32, 0xd0, 0x1c, 0x4d, 0xde, 0xe2, 0x0c, 0x1b, 0x44, 0x98, 0xc2, 0xcd, 0xa7,
+	0xbd, 0x0f, 0xd5, 0xd8, 0x68, 0xd5, 0x0a, 0x94, 0x1e, 0xed, 0xee, 0xec, 0x3f, 0x6c, 0xcc, 0xa9,
+	0x8b, 0x50, 0x6c, 0x35, 0xff, 0x5c, 0x43, 0x51, 0xcb, 0x30, 0xff, 0xb8, 0xdd, 0xfe, 0x46, 0xa3,
+	0xa0, 0x56, 0x61, 0xf1, 0xe3, 0x83, 0xa6, 0xbe, 0xdf, 0xd6, 0x1b, 0x45, 0xed, 0x0d, 0x58, 0xc0,
+	0x51, 0xf1, 0x9a, 0xcd, 0xed, 0xed, 0xc6, 0x9c, 0x0a, 0xb0, 0xd0, 0xdc, 0xdc, 0xef, 0x7c, 0xd2,
+	0x6e, 0x28, 0xbc, 0xee, 0xe6, 0xc3, 0x03, 0x7d, 0xa7, 0xdd, 0x6a, 0x14, 0xb4, 0x3d, 0xb8, 0x90,
+	0x98, 0x54, 0xa8, 0x21, 0x2d, 0xf6, 0x10, 0x34, 0x51, 0x41, 0x8e, 0x50, 0x75, 0x59, 0x5f, 0x7b,
+	0x82, 0x1a, 0x24, 0x82, 0xd5, 0x07, 0x50, 0x1b, 0x32, 0xcf, 0x72, 0xfb, 0x86, 0xf0, 0x60, 0x92,
+	0xc6, 0x35, 0x5d, 0x1c, 0x5f, 0x15, 0x31, 0xbb, 0x1c, 0x91, 0x4b, 0x39, 0xe9, 0x64, 0x14, 0x3e,
+	0x7f, 0x74, 0x21, 0x1e, 0xc2, 0x55, 0x2e, 0xbc, 0x84, 0x9d, 0x64, 0x39, 0xac, 0x9f, 0x10, 0xcd,
+	0x29, 0x4f, 0xb1, 0x32, 0xbd, 0xa7, 0xb8, 0x10, 0x97, 0xa4, 0xdf, 0x86, 0xb5, 0xac, 0x3e, 0x68,
+	0xa5, 0xde, 0x4f, 0x8a, 0xc8, 0xec, 0x68, 0xba, 0x04, 0xee, 0x24, 0x21, 0xf9, 0x5b, 0x05, 0xa8,
+	0x27, 0x2a, 0x4f, 0x2f, 0x26, 0x13, 0xb7, 0xc9, 0x85, 0x09, 0xb7, 0xc9, 0xc5, 0xd4, 0x6d, 0xf2,
+	0x1b, 0x80, 0xd1, 0x9f, 0x61, 0x3c, 0xd8, 0xc6, 0x32, 0x75, 0xb1, 0x28, 0x6e, 0xd5, 0x3a, 0x2d,
+	0x7d, 0x51, 0x54, 0x90, 0xde, 0x2c, 0xcf, 0x1a, 0x32, 0x7a, 0x17, 0x59, 0x92, 0xde, 0x2c, 0x0e,
+	0xc3, 0x67, 0x91, 0xb7, 0x61, 0xc9, 0x63, 0xcf, 0x98, 0x67, 0x1d, 0x9d, 0x91, 0x5e, 0x87, 0xcf,
+	0x1d, 0xeb, 0x12, 0x8a, 0x3a, 0xdd, 0x87, 0x9c, 0x53, 0x0b, 0x80, 0x85, 0xef, 0xe8, 0xe2, 0x92,
+	0x0b, 0x1f, 0x67, 0xac, 0xa6, 0x2a, 0x84, 0x22, 0x4c, 0xfb, 0xa1, 0x78, 0x2c, 0x49, 0x82, 0x68,
+	0xcb, 0xb4, 0x3c, 0x87, 0xf9, 0xe1, 0xb6, 0xbf, 0x0c, 0xe0, 0xcb, 0x32, 0x3f, 0x8c, 0x17, 0x09,
+	0x21, 0x49, 0x4a, 0x2a, 0xc9, 0xdd, 0x48, 0xf0, 0xb8, 0x62, 0x9a, 0xc7, 0xdd, 0x80, 0xea, 0xa7,
+	0x46, 0xe4, 0xbd, 0x41, 0x55, 0x00, 0x3e, 0xdd, 0x0f, 0xdd, 0x37, 0xd9, 0x36, 0xe8, 0x8f, 0x0a,
+	0x70, 0x35, 0x63, 0x9c, 0x44, 0x3a, 0xe3, 0x03, 0x2d, 0x26, 0x06, 0x7a, 0x1b, 0x96, 0xc4, 0xd8,
+	0x0c, 0x84, 0x85, 0xe1, 0xdf, 0x75, 0x01, 0xed, 0x12, 0x50, 0xec, 0x09, 0xbe, 0xa6, 0x34, 0x7c,
+	0xc6, 0xe4, 0xfe, 0x56, 0x09, 0xd6, 0x65, 0xcc, 0x51, 0x37, 0x61, 0x51, 0x3e, 0xd5, 0x9c, 0x17,
+	0x64, 0xfa, 0x7a, 0x76, 0xa0, 0x9b, 0xa8, 0x13, 0x93, 0xf0, 0x18, 0x8f, 0x8e, 0x98, 0xea, 0x57,
+	0xe5, 0xba, 0x95, 0xce, 0xb9, 0x1c, 0x4f, 0x35, 0x40, 0x0b, 0xcc, 0xb5, 0x1e, 0xd3, 0x0b, 0x2c,
+	0xd3, 0x36, 0x62, 0xb3, 0x96, 0x5a, 0x0f, 0x96, 0x84, 0x98, 0xbe, 0xf6, 0x77, 0x15, 0xb8, 0x98,
+	0x35, 0x1e, 0xae, 0x06, 0xd3, 0x33, 0x5a, 0x74, 0x82, 0xd0, 0x17, 0x86, 0x6d, 0x24, 0xd6, 0x29,
+	0xfc, 0xe6, 0x65, 0xec, 0xf9, 0x10, 0xcb, 0xd0, 0xbb, 0x17, 0x7e, 0xab, 0x57, 0x60, 0xf1, 0x53,
+	0xf2, 0x35, 0xe1, 0xb6, 0x2e, 0x7c, 0x8a, 0x6e, 0xa6, 0xd7, 0xa1, 0xe1, 0x3e, 0x13, 0x0e, 0xa2,
+	0xa1, 0xc7, 0x7c, 0xe6, 0x04, 0xa1, 0xf7, 0x67, 0x99, 0xc3, 0xf5, 0x08, 0xac, 0x3d, 0x45, 0x51,
+	0x95, 0x1a, 0xe9, 0x2c, 0xd6, 0x33, 0x4d, 0xa9, 0x90, 0x3b, 0xa5, 0x62, 0x72, 0x4a, 0xda, 0x0f,
+	0x14, 0xb8, 0x2e, 0x74, 0x82, 0x96, 0xe5, 0xf7, 0xb8, 0x4a, 0xe3, 0xf4, 0xce, 0x52, 0xb6, 0xb4,
+	0x78, 0x76, 0x7c, 0xe4, 0x31, 0x11, 0xad, 0x6b, 0xb9, 0xe4, 0x2d, 0xa8, 0x0d, 0xcc, 0xe7, 0x5b,
+	0x1e, 0xc3, 0x88, 0x62, 0x51, 0xcb, 0x72, 0xb0, 0x56, 0x22, 0x10, 0x76, 0x60, 0x39, 0xbc, 0x16,
+	0x7a, 0xa8, 0x67, 0x33, 0x3d, 0x86, 0xf0, 0x52, 0xce, 0xc8, 0x42, 0x67, 0x72, 0x82, 0x67, 0xe6,
+	0x3c, 0xa4, 0x49, 0x35, 0x31, 0x89, 0x6d, 0xfe, 0x9e, 0x02, 0x8d, 0x74, 0xfd, 0xcf, 0xd5, 0x45,
+	0xff, 0x12, 0x40, 0x6c, 0x89, 0xc8, 0x6b, 0x72, 0x14, 0xae, 0xcf, 0x2b, 0x50, 0x63, 0xcf, 0x85,
+	0x25, 0x1b, 0x0f, 0xfb, 0xad, 0x22, 0x2c, 0xd9, 0x02, 0x6e, 0x05, 0x86, 0x35, 0x8b, 0x16, 0xc4,
+	0x3e, 0x68, 0xbf, 0x1a, 0x79, 0xab, 0xb6, 0xcd, 0x80, 0x39, 0xbd, 0xb3, 0x7d, 0x2b, 0x8a, 0x08,
+	0x7e, 0x0d, 0x96, 0xe3, 0xe1, 0x09, 0xc6, 0x00, 0x97, 0xae, 0xa8, 0xd7, 0x63, 0xc1, 0x07, 0x8f,
+	0x22, 0xf7, 0x59, 0x60, 0x91, 0x22, 0x43, 0xee, 0x33, 0xde, 0xd6, 0x8c, 0x9b, 0xf8, 0x2f, 0xa4,
+	0x87, 0x39, 0x35, 0xa0, 0xc8, 0x32, 0xe4, 0x9d, 0x4c, 0xb6, 0x0c, 0xe3, 0x88, 0x58, 0x9d, 0xf3,
+	0xbc, 0x91, 0x33, 0x60, 0xa6, 0x3f, 0xf2, 0x58, 0xf4, 0x94, 0x28, 0x84, 0x44, 0x16, 0x67, 0xf1,
+	0x9c, 0x3b, 0x1b, 0x6a, 0x7b, 0x92, 0xeb, 0xec, 0x39, 0x54, 0x63, 0x23, 0xe0, 0xa4, 0x1e, 0xf3,
+	0x9d, 0xe1, 0x1a, 0x0a, 0x52, 0x8f, 0xdc, 0x67, 0x8f, 0x7c, 0x5e, 0x2b, 0xb6, 0xd4, 0xc6, 0x20,
+	0x3c, 0x10, 0xd1, 0x4a, 0x3f, 0xf2, 0xcf, 0xf3, 0xa2, 0x1d, 0xe0, 0x65, 0x11, 0xf5, 0x3e, 0x3d,
+	0x25, 0xbe, 0x04, 0x60, 0x23, 0x4e, 0xd4, 0x71, 0x85, 0x20, 0x8f, 0xc4, 0x63, 0x79, 0x4d, 0xec,
+	0xc9, 0x63, 0x2b, 0x38, 0xd1, 0x19, 0x37, 0x3e, 0x1f, 0x0b, 0x17, 0xed, 0xe6, 0x89, 0x88, 0xe1,
+	0x20, 0x6a, 0xf9, 0x3a, 0x94, 0x6d, 0xd7, 0x7d, 0x72, 0x68, 0xf6, 0x9e, 0xcc, 0x12, 0xa7, 0x11,
+	0x22, 0xcd, 0x78, 0x17, 0xf1, 0x29, 0xdc, 0x9a, 0x38, 0x28, 0xa2, 0x98, 0xaf, 0xc3, 0x62, 0xef,
+	0xe4, 0xfc, 0xf7, 0x73, 0xbc, 0xa9, 0x04, 0xbe, 0xc4, 0xca, 0x3c, 0xf8, 0xff, 0x5c, 0xc1, 0x88,
+	0x81, 0x38, 0xc6, 0x4c, 0xcb, 0xed, 0xda, 0x7d, 0x83, 0xbc, 0xe2, 0xc8, 0x7b, 0x2b, 0xae, 0xdd,
+	0xc7, 0xd6, 0xc4, 0x26, 0xb3, 0x53, 0x23, 0xe1, 0x34, 0xaf, 0x38, 0xec, 0x94, 0x8a, 0x37, 0x01,
+	0x70, 0x68, 0xc2, 0x21, 0x31, 0x3f, 0xcb, 0x63, 0x5a, 0xc2, 0x6b, 0x06, 0xda, 0xbf, 0x51, 0xa0,
+	0xb1, 0xc9, 0xd5, 0x7e, 0x5d, 0xdc, 0xbb, 0x85, 0x1b, 0x28, 0x5e, 0xc9, 0x3e, 0x33, 0xed, 0x99,
+	0x36, 0x50, 0x22, 0xa9, 0x1f, 0x40, 0x09, 0xd5, 0xed, 0x59, 0x1e, 0x0a, 0x23, 0x8a, 0xfa, 0x25,
+	0x28, 0x32, 0x72, 0xbe, 0x4f, 0x8b, 0xc9, 0x11, 0xb4, 0x03, 0x58, 0x89, 0x4d, 0x84, 0x36, 0xfd,
+	0x23, 0xa8, 0xc8, 0x41, 0x9d, 0xa3, 0x21, 0x73, 0xd4, 0x0e, 0x55, 0xd5, 0x23, 0x24, 0xed, 0xef,
+	0x28, 0x50, 0x4f, 0x14, 0x46, 0x93, 0x53, 0x66, 0x9f, 0xdc, 0x65, 0x58, 0xf8, 0xb6, 0x6b, 0x45,
+	0x2f, 0xe9, 0xe8, 0x2b, 0x33, 0xf8, 0xa7, 0x98, 0x0a, 0xfe, 0x89, 0xa2, 0x6f, 0x90, 0xbd, 0xcb,
+	0xe8, 0x9b, 0x3f, 0x54, 0x60, 0xf5, 0x13, 0xd3, 0xb6, 0xfa, 0x66, 0xc0, 0x42, 0xeb, 0x39, 0x76,
+	0xe9, 0x17, 0xd9, 0xb8, 0x4a, 0xca, 0xc6, 0x55, 0xdf, 0x80, 0x15, 0x69, 0xfc, 0x0b, 0xe1, 0xd0,
+	0xb7, 0xfc, 0x27, 0xf2, 0x8d, 0x1f, 0x15, 0x70, 0x21, 0xcc, 0xed, 0x7f, 0xae, 0x82, 0x92, 0x13,
+	0x54, 0xdc, 0x9c, 0x93, 0xe3, 0x0a, 0x41, 0xe2, 0xe6, 0x5c, 0x28, 0xde, 0xf4, 0x56, 0x2f, 0x72,
+	0xbf, 0x0a, 0xc5, 0x1b, 0xa1, 0xa8, 0x95, 0xbc, 0x0e, 0x8d, 0xd0, 0xcd, 0x21, 0x95, 0x42, 0x52,
+	0x6b, 0x24, 0x5c, 0x26, 0xe7, 0xf8, 0x61, 0x11, 0xae, 0x66, 0xcc, 0x8c, 0xf6, 0xf6, 0x26, 0x54,
+	0x7d, 0x33, 0xb0, 0xfc, 0x23, 0x4b, 0xbc, 0xc9, 0xc0, 0xab, 0xfc, 0x38, 0x48, 0xed, 0xc2, 0xe2,
+	0xa1, 0x15, 0xb9, 0x33, 0x97, 0xee, 0x7f, 0x39, 0x73, 0xef, 0x73, 0xbb, 0xe0, 0x76, 0x93, 0x1f,
+	0x78, 0xa6, 0xc5, 0xd5, 0x50, 0x6a, 0x49, 0xdc, 0x76, 0xd9, 0xd6, 0xb1, 0x75, 0x68, 0x33, 0x43,
+	0x8a, 0x0a, 0xa1, 0x15, 0x4b, 0x28, 0x06, 0xa9, 0xbc, 0x02, 0x35, 0xcb, 0x31, 0xe2, 0xfe, 0x05,
+	0x7c, 0x32, 0xe2, 0x44, 0xfe, 0x87, 0x57, 0xf1, 0x32, 0x27, 0xb6, 0xf4, 0x68, 0xce, 0xd4, 0x38,
+	0x34, 0x5c, 0xf7, 0x28, 0x5e, 0x0c, 0x75, 0x55, 0x19, 0x2f, 0x96, 0xb5, 0x8e, 0x14, 0x5c, 0x99,
+	0x5e, 0xc7, 0x6f, 0x01, 0x44, 0x33, 0xe1, 0x56, 0xfb, 0xce, 0xee, 0x4e, 0xbb, 0x31, 0xa7, 0x2e,
+	0x43, 0xb5, 0xbd, 0xdd, 0x79, 0xd0, 0xd9, 0xe8, 0x6c, 0x77, 0xf6, 0xb9, 0x41, 0x5f, 0x87, 0xca,
+	0xe6, 0xee, 0xc1, 0xce, 0xbe, 0xde, 0x69, 0x77, 0x31, 0xa0, 0x43, 0xc4, 0x69, 0xb4, 0x3a, 0xdd,
+	0x6f, 0x34, 0x8a, 0xdc, 0x88, 0xa7, 0xc0, 0x0b, 0xf1, 0xaa, 0x1a, 0x03, 0x2f, 0xba, 0x8d, 0x92,
+	0x66, 0x63, 0xa8, 0xae, 0xbf, 0xc1, 0x6c, 0xf7, 0xf4, 0x91, 0xe5, 0x90, 0x1f, 0xea, 0x67, 0x14,
+	0x73, 0xf1, 0x9f, 0x14, 0x8c, 0xb8, 0x1d, 0xef, 0x2e, 0x8c, 0xb8, 0x1d, 0xf3, 0x93, 0x29, 0x99,
+	0x7e, 0xb2, 0xf7, 0x92, 0x81, 0x43, 0xaf, 0x64, 0x07, 0xca, 0x8c, 0x02, 0x91, 0x79, 0x20, 0xcb,
+	0x74, 0x8e, 0x47, 0xd9, 0xde, 0x00, 0x7c, 0x21, 0x4a, 0x44, 0x81, 0xfb, 0x0d, 0x02, 0x84, 0x14,
+	0xf1, 0x1a, 0xe0, 0x45, 0xc4, 0xd8, 0x7e, 0xd7, 0x05, 0x58, 0x6e, 0xb8, 0xf6, 0xc7, 0x0a, 0xd4,
+	0xe2, 0x9d, 0xce, 0x14, 0x4e, 0x27, 0x27, 0x4c, 0xe1, 0x74, 0xf4, 0xc9, 0x4b, 0x3c, 0x66, 0x33,
+	0xd3, 0x97, 0x63, 0x96, 0x9f, 0x5c, 0x65, 0x8b, 0xc6, 0x83, 0x83, 0x2e, 0x1f, 0x49, 0xda, 0xcb,
+	0x7b, 0x0d, 0x59, 0xfa, 0x6c, 0xaf, 0x21, 0xb5, 0x9b, 0xf0, 0xf2, 0x03, 0x16, 0x44, 0x57, 0x40,
+	0xa1, 0x1d, 0x2b, 0xad, 0x07, 0xed, 0x5f, 0x2e, 0xc0, 0x8d, 0xdc, 0x2a, 0xa1, 0xcb, 0x37, 0xe5,
+	0x8c, 0x54, 0x5e, 0xd4, 0x19, 0x79, 0x15, 0xca, 0x78, 0x21, 0xd4, 0x7f, 0x4a, 0x17, 0x88, 0x8b,
+	0xe2, 0xbb, 0xf5, 0x54, 0xbd, 0x0b, 0x8d, 0x64, 0x30, 0x07, 0x5d, 0xf8, 0x2b, 0xfa, 0x52, 0x3c,
+	0x92, 0xa3, 0xf5, 0x54, 0xfd, 0x0b, 0x70, 0x05, 0xaf, 0xe9, 0xc5, 0xd3, 0xdd, 0x63, 0xcf, 0xec,
+	0x31, 0x03, 0x3d, 0x48, 0x24, 0x9c, 0xa7, 0x1a, 0xd8, 0xa5, 0xa8, 0x8d, 0x07, 0xbc, 0x89, 0x3d,
+	0xd1, 0x82, 0x7a, 0x1f, 0x62, 0x05, 0xf1, 0x20, 0x08, 0x64, 0x9d, 0x17, 0xa2, 0xc2, 0x30, 0x0e,
+	0x22, 0x1e, 0x3f, 0x10, 0xb9, 0x0e, 0xd0, 0x0d, 0x2c, 0xe3, 0x07, 0x22, 0x07, 0xc2, 0x57, 0x60,
+	0x2d, 0x19, 0x6c, 0x20, 0x3a, 0x92, 0xbd, 0x60, 0xbc, 0xe7, 0x6a, 0x22, 0xea, 0x80, 0x57, 0x90,
+	0x5d, 0x65, 0x07, 0x68, 0x94, 0xb3, 0x03, 0x34, 0xd4, 0x03, 0xb8, 0x28, 0x6b, 0x27, 0x96, 0xa9,
+	0x32, 0xfd, 0x32, 0xc9, 0xee, 0xe2, 0x6b, 0xb4, 0x0d, 0xcb, 0x81, 0x67, 0xf6, 0x9e, 0x58, 0xce,
+	0xb1, 0x6c, 0x11, 0xa6, 0x6f, 0x71, 0x49, 0xe2, 0x52, 0x6b, 0xbb, 0x80, 0x37, 0x81, 0x44, 0x5c,
+	0xf8, 0x7a, 0xa0, 0x3a, 0x7d, 0x7b, 0xcb, 0x02, 0x1b, 0x09, 0x4c, 0xbc, 0x33, 0x58, 0x87, 0x0b,
+	0x9c, 0x75, 0xf3, 0xd1, 0xc5, 0xef, 0x28, 0x6b, 0xf4, 0x72, 0x0b, 0x8b, 0x62, 0xb7, 0x94, 0x5f,
+	0x8f, 0x4e, 0x73, 0x5d, 0x74, 0x9b, 0x63, 0xa7, 0x4a, 0x98, 0x64, 0x83, 0x12, 0x4b, 0xfb, 0x6d,
+	0x6e, 0x95, 0xa6, 0x4a, 0xe3, 0x3c, 0x42, 0x49, 0xf2, 0x88, 0x1b, 0x50, 0xed, 0xb9, 0x83, 0x81,
+	0x15, 0x18, 0x27, 0xa6, 0x7f, 0x22, 0x03, 0x3f, 0x11, 0xf4, 0xd0, 0xf4, 0x4f, 0xd4, 0x0d, 0xa8,
+	0x84, 0x09, 0x25, 0x67, 0x4b, 0xde, 0x12, 0xa2, 0xc5, 0x19, 0xd1, 0x7c, 0x82, 0x11, 0x69, 0xbf,
+	0xa2, 0xc0, 0xc5, 0x6e, 0x60, 0xda, 0xec, 0x01, 0x73, 0x13, 0x8e, 0x84, 0x96, 0x70, 0xa3, 0xda,
+	0x2c, 0xe6, 0x46, 0x9d, 0x36, 0x66, 0x5b, 0xe0, 0xa1, 0x6f, 0x75, 0x36, 0x19, 0xf3, 0xd7, 0x14,
+	0xb8, 0x94, 0x1a, 0x0c, 0x31, 0x9d, 0xf7, 0x92, 0xbe, 0x83, 0x6c, 0x99, 0x11, 0x47, 0x9d, 0x14,
+	0xd7, 0x94, 0x92, 0x19, 0xc5, 0xb4, 0xcc, 0xd0, 0x7e, 0xab, 0x00, 0xb5, 0x78, 0x63, 0xd3, 0xcb,
+	0x82, 0x74, 0x00, 0x75, 0x61, 0x2c, 0x80, 0x7a, 0x8a, 0x14, 0x65, 0x3b, 0xd0, 0x38, 0x66, 0xae,
+	0xe1, 0xb1, 0x23, 0xce, 0x26, 0x66, 0x37, 0x34, 0x96, 0x8e, 0x99, 0xab, 0x4b, 0xe4, 0x66, 0xf0,
+	0x33, 0x93, 0x27, 0xbf, 0x44, 0xde, 0x0b, 0x2e, 0x43, 0x85, 0x1f, 0x66, 0xdf, 0x63, 0x51, 0x68,
+	0xd0, 0x87, 0xb0, 0x30, 0xbb, 0x80, 0x20, 0x94, 0x19, 0xe9, 0xe6, 0x77, 0x0a, 0xe8, 0xb5, 0x48,
+	0x0f, 0x24, 0x4c, 0xe1, 0x92, 0x20, 0x9e, 0x7c, 0x17, 0x66, 0x0a, 0xff, 0x33, 0x90, 0x10, 0x67,
+	0xcd, 0x0e, 0x0b, 0x4e, 0x5d, 0xef, 0x49, 0xdc, 0xcb, 0x86, 0x92, 0xbe, 0x41, 0x25, 0x91, 0xa7,
+	0xed, 0x2b, 0x70, 0x2d, 0x51, 0x1b, 0x2d, 0x45, 0x91, 0x3c, 0xb0, 0x6f, 0x9e, 0x91, 0xc2, 0x72,
+	0x25, 0x86, 0x86, 0x36, 0xef, 0x1e, 0xf3, 0x5a, 0xe6, 0x99, 0xfa, 0x45, 0x90, 0x45, 0xbc, 0xb6,
+	0x6f, 0x8c, 0x9c, 0xc0, 0xb2, 0x8d, 0xa3, 0x91, 0x6d, 0x93, 0xdc, 0xb9, 0x48, 0xc5, 0x2d, 0xf3,
+	0xcc, 0x3f, 0xe0, 0x85, 0x5b, 0x23, 0xdb, 0xd6, 0xfe, 0x17, 0xbd, 0xde, 0x49, 0xce, 0x7a, 0x26,
+	0x3b, 0x7a, 0xcc, 0x81, 0x98, 0xf4, 0x8e, 0x25, 0xfc, 0x6b, 0xc5, 0x71, 0xff, 0xda, 0x17, 0xe0,
+	0x42, 0xd6, 0x74, 0x69, 0x95, 0x8e, 0xd2, 0xf3, 0x7c, 0x0d, 0x96, 0xd3, 0xf3, 0x43, 0x8f, 0x5a,
+	0xbd, 0x1f, 0x9f, 0x98, 0xe0, 0x76, 0xae, 0x6d, 0x8f, 0x86, 0x3e, 0x5d, 0x42, 0xc8, 0x4f, 0xed,
+	0x5b, 0x70, 0x23, 0x34, 0x37, 0x92, 0x6e, 0x5b, 0xff, 0xf3, 0x20, 0x5b, 0xed, 0xa7, 0x0a, 0xdc,
+	0xcc, 0xef, 0x80, 0xc8, 0x71, 0x3b, 0xe3, 0xce, 0xfc, 0xad, 0xc9, 0x77, 0xe6, 0x29, 0xdf, 0x7a,
+	0xfc, 0xde, 0xbc, 0x03, 0x75, 0xc1, 0x3b, 0x58, 0xdf, 0xf0, 0x2d, 0xa7, 0xc7, 0x66, 0x32, 0xfe,
+	0x6b, 0x84, 0xda, 0xe5, 0x98, 0xea, 0xdb, 0x70, 0x91, 0x32, 0xb0, 0x90, 0xbb, 0x39, 0x41, 0xdd,
+	0x2a, 0x66, 0x62, 0xa1, 0x22, 0x64, 0x94, 0x7f, 0x5b, 0x81, 0x2b, 0x39, 0x83, 0x1c, 0xbf, 0x3e,
+	0xae, 0xc7, 0xaf, 0x56, 0x92, 0xb7, 0x20, 0x85, 0xac, 0x5b, 0x90, 0xcc, 0x51, 0xd4, 0xfd, 0xf8,
+	0x00, 0x44, 0x33, 0x27, 0xae, 0x17, 0x1c, 0x99, 0xb6, 0x1d, 0x6a, 0xff, 0x11, 0x44, 0xfb, 0x47,
+	0x0a, 0x5c, 0xd4, 0x99, 0xe5, 0xf8, 0x81, 0x19, 0xe0, 0x9b, 0xf0, 0x59, 0x9f, 0x19, 0xdc, 0x82,
+	0x7a, 0x42, 0x13, 0x25, 0x36, 0x50, 0x8b, 0xab, 0xa1, 0x9c, 0xe2, 0x48, 0x33, 0x92, 0x8a, 0x3e,
+	0x7d, 0xaa, 0x6b, 0x50, 0x76, 0x29, 0xac, 0x93, 0xde, 0xcb, 0x84, 0xdf, 0x9c, 0xc9, 0xd1, 0x13,
+	0x04, 0x0c, 0x28, 0x90, 0x8f, 0x30, 0x7f, 0xac, 0xc0, 0xa5, 0xd4, 0xa0, 0x43, 0x31, 0x28, 0xe3,
+	0xb4, 0x94, 0xd9, 0xe2, 0xb4, 0xa2, 0x40, 0xee, 0xc2, 0x67, 0x08, 0xe4, 0x2e, 0xce, 0x1c, 0xc8,
+	0xbd, 0x06, 0xab, 0x9b, 0xe6, 0xd0, 0xec, 0x59, 0xc1, 0xd9, 0xc6, 0x19, 0xa5, 0x46, 0x95, 0xc6,
+	0xc6, 0x7f, 0x57, 0xe0, 0x6a, 0x46, 0x21, 0x4d, 0x75, 0x23, 0xed, 0x42, 0xc9, 0x0b, 0x68, 0x26,
+	0x44, 0xd9, 0x52, 0xdc, 0xd1, 0xf2, 0x35, 0x58, 0xa4, 0x6d, 0xa2, 0x69, 0x4f, 0xd7, 0x82, 0x44,
+	0x3a, 0x9f, 0xcb, 0x67, 0x18, 0x97, 0xf3, 0x59, 0xc6, 0xe5, 0xef, 0x28, 0xb0, 0x9c, 0xea, 0x65,
+	0x4c, 0x11, 0x50, 0xc6, 0x15, 0x81, 0xcc, 0xdb, 0x6f, 0x8e, 0x48, 0x2e, 0xa1, 0xf8, 0xb0, 0xc8,
+	0x4d, 0x84, 0xe3, 0x9a, 0x68, 0x5e, 0xde, 0x81, 0xe5, 0x54, 0xa4, 0x0d, 0x99, 0x33, 0x4b, 0xc9,
+	0xf8, 0x1a, 0xed, 0xef, 0x29, 0xb0, 0x86, 0xae, 0xdd, 0xa6, 0xcc, 0x81, 0x37, 0xf2, 0x22, 0x0d,
+	0x31, 0x8a, 0xf2, 0xa4, 0xb4, 0xbe, 0xf8, 0xc5, 0xd9, 0x48, 0x3c, 0xcf, 0x38, 0x65, 0xa5, 0x93,
+	0x17, 0xaf, 0x6a, 0x74, 0xf3, 0x2e, 0x1b, 0x4c, 0x5f, 0xd9, 0x17, 0xa7, 0xbf, 0xb2, 0x4f, 0xdd,
+	0x40, 0x5d, 0xcb, 0x1c, 0xee, 0x2c, 0x6a, 0x40, 0x1c, 0x55, 0xbc, 0x41, 0x9e, 0x14, 0x21, 0xaf,
+	0x7d, 0x4f, 0x01, 0x75, 0x1c, 0x63, 0x7a, 0xe6, 0xb2, 0x06, 0xe5, 0xd4, 0xf2, 0x84, 0xdf, 0xea,
+	0xfb, 0x9c, 0x3b, 0xf4, 0xf0, 0x5e, 0x3a, 0xff, 0x52, 0x04, 0x03, 0xc1, 0xc4, 0x18, 0x74, 0xaa,
+	0xaf, 0xfd, 0xb2, 0x02, 0xd5, 0x18, 0xfc, 0xc5, 0x5f, 0x9c, 0x37, 0xa1, 0x42, 0x29, 0x11, 0x67,
+	0xcc, 0x1b, 0x59, 0x46, 0xb4, 0x66, 0xa0, 0xfd, 0x75, 0x05, 0x2e, 0x6d, 0xda, 0x6e, 0xef, 0x49,
+	0xf7, 0x09, 0x86, 0x40, 0x85, 0xe4, 0xd3, 0x4c, 0x3f, 0x8c, 0x98, 0xf6, 0xdd, 0xeb, 0x8b, 0xbe,
+	0x9e, 0x38, 0x82, 0xcb, 0xe9, 0x91, 0xcc, 0x12, 0xcd, 0x21, 0xc2, 0x5b, 0x24, 0xfe, 0x24, 0xa2,
+	0xf8, 0x91, 0x02, 0xf5, 0x44, 0xe5, 0xe9, 0xe9, 0xe1, 0x3d, 0x98, 0xf7, 0x9f, 0xb0, 0xd3, 0x59,
+	0x5e, 0xc8, 0x0a, 0x04, 0xb5, 0x0d, 0x55, 0x79, 0x99, 0x36, 0xeb, 0x5e, 0x81, 0x44, 0x6c, 0x06,
+	0xda, 0x16, 0x5c, 0x13, 0xc9, 0x79, 0xda, 0xcf, 0xad, 0xa0, 0x2d, 0x1c, 0xab, 0x96, 0xcd, 0x39,
+	0xe2, 0xac, 0x0f, 0x1a, 0xfe, 0x43, 0x11, 0xae, 0x67, 0x37, 0x44, 0x2b, 0xbe, 0x06, 0x65, 0xe9,
+	0xb8, 0x25, 0xcf, 0x64, 0xf8, 0x1d, 0x7b, 0x99, 0x57, 0x98, 0xf0, 0x32, 0x6f, 0x52, 0xf3, 0xe9,
+	0x97, 0x79, 0x4d, 0xa8, 0xa0, 0xc7, 0x7f, 0x66, 0x3a, 0x46, 0xb4, 0x66, 0x20, 0xbc, 0x5e, 0x76,
+	0xdf, 0x60, 0x8e, 0x3b, 0x3a, 0x3e, 0x99, 0xd5, 0x20, 0xab, 0xba, 0x76, 0xbf, 0x2d, 0x30, 0x9b,
+	0xe2, 0xe1, 0xc5, 0xc0, 0x75, 0x82, 0x13, 0xdf, 0x90, 0x1e, 0x7a, 0x8a, 0x1e, 0x59, 0x42, 0xb0,
+	0x4e, 0x50, 0xae, 0x40, 0x45, 0x0f, 0x50, 0xf0, 0x4d, 0x70, 0x04, 0xd0, 0x9e, 0x85, 0xcf, 0x06,
+	0x6b, 0x50, 0x46, 0x7f, 0xf2, 0x76, 0xbb, 0x31, 0xa7, 0xae, 0xc1, 0xe5, 0x07, 0x7a, 0x73, 0xb3,
+	0xbd, 0x75, 0xb0, 0x6d, 0xb4, 0xbf, 0xd9, 0xd9, 0x37, 0x5a, 0x9d, 0x6e, 0x73, 0x63, 0x5b, 0x24,
+	0xf5, 0x1c, 0x7f, 0x3c, 0xb8, 0x02, 0x75, 0x51, 0x69, 0xab, 0xb3, 0xd3, 0xe9, 0x3e, 0x14, 0x0f,
+	0x08, 0x1b, 0x50, 0x13, 0xa0, 0xee, 0x7e, 0x53, 0x0f, 0xbd, 0xce, 0xfb, 0xbb, 0xbb, 0xc6, 0x4e,
+	0xfb, 0x71, 0xa3, 0xa4, 0xfd, 0x65, 0xb8, 0x4c, 0xa2, 0xde, 0xb4, 0xbc, 0x44, 0x5e, 0xeb, 0xa9,
+	0xa9, 0x3c, 0x52, 0xb1, 0x0b, 0xb3, 0xab, 0xd8, 0x3f, 0x56, 0xe0, 0xca, 0xd8, 0x00, 0x66, 0x4d,
+	0xfa, 0xf0, 0x01, 0x94, 0x66, 0x57, 0x96, 0x11, 0x85, 0x6b, 0xa6, 0x51, 0xcc, 0xad, 0xfb, 0x2c,
+	0xbc, 0x36, 0xaa, 0x87, 0x11, 0xb7, 0x1c, 0xc8, 0xa5, 0x34, 0x55, 0x33, 0xfb, 0xfd, 0xf0, 0xf6,
+	0x08, 0x9f, 0xfc, 0xf9, 0x4d, 0x0e, 0xd2, 0xfe, 0x44, 0x81, 0x8b, 0xfb, 0xde, 0xc8, 0x1f, 0x8b,
+	0x2e, 0xff, 0x4c, 0xa6, 0x73, 0x66, 0x38, 0x9b, 0x7a, 0x90, 0x14, 0xca, 0x22, 0x33, 0x97, 0x61,
+	0x39, 0x33, 0x9d, 0x86, 0xd8, 0x7f, 0x84, 0x88, 0xd3, 0xd7, 0x71, 0xd2, 0x92, 0x7b, 0xfe, 0x3c,
+	0xc9, 0xad, 0xfd, 0x93, 0x02, 0x5c, 0x4a, 0xcd, 0x79, 0x16, 0x17, 0x4f, 0x1c, 0x75, 0x92, 0x7d,
+	0x7e, 0x1b, 0x96, 0x02, 0xaa, 0x9a, 0x34, 0x1f, 0x82, 0x78, 0xdf, 0xe7, 0xdf, 0x1e, 0x84, 0x84,
+	0x52, 0x9a, 0x9d, 0x50, 0xb6, 0x61, 0xd9, 0x36, 0x03, 0xe6, 0x07, 0xd1, 0x6a, 0xcf, 0x92, 0xcf,
+	0xb0, 0x8e, 0xc8, 0xb4, 0xd2, 0xda, 0x3f, 0x54, 0xa0, 0x16, 0x9f, 0xfd, 0xe7, 0xe9, 0x93, 0xca,
+	0x73, 0x10, 0x15, 0x3f, 0xa3, 0x83, 0xa8, 0x07, 0xd7, 0x29, 0x98, 0xcb, 0x0c, 0x88, 0x60, 0xd3,
+	0x99, 0xf0, 0x53, 0x77, 0x97, 0x4a, 0xd6, 0xdd, 0xe5, 0xe4, 0x97, 0xde, 0xbf, 0x51, 0x84, 0x97,
+	0x72, 0x7a, 0x89, 0xb2, 0x6d, 0xa7, 0xee, 0x0e, 0x95, 0xac, 0xbb, 0xc3, 0xac, 0xab, 0xbd, 0x42,
+	0xe6, 0xd5, 0x9e, 0xfa, 0x26, 0xac, 0xf8, 0xd8, 0x59, 0xe2, 0xef, 0x10, 0x84, 0xdb, 0x22, 0x2c,
+	0x90, 0x95, 0x6f, 0xc3, 0x92, 0x6d, 0x7a, 0xc7, 0x9c, 0x10, 0x28, 0xde, 0x8b, 0x6c, 0x04, 0x82,
+	0x62, 0x3d, 0x31, 0x4a, 0x19, 0xbd, 0x2e, 0x23, 0xee, 0x70, 0x94, 0x04, 0x0d, 0x1d, 0x4b, 0x61,
+	0xb5, 0x48, 0xc7, 0x5f, 0xa0, 0x7f, 0xe5, 0xa1, 0x92, 0xf0, 0x1a, 0x33, 0x34, 0xb3, 0xc5, 0x65,
+	0xed, 0x62, 0xdc, 0xcc, 0x16, 0x77, 0xb5, 0x5f, 0x81, 0xb5, 0xe8, 0xcb, 0x90, 0x8f, 0xdc, 0xe4,
+	0x8c, 0xf0, 0x29, 0xc1, 0x6a, 0x54, 0xe3, 0x31, 0x56, 0x90, 0x33, 0x4b, 0xc5, 0xce, 0x57, 0xd2,
+	0xb1, 0xf3, 0xda, 0x47, 0x70, 0x69, 0x0f, 0xdf, 0xb1, 0x3c, 0xd8, 0x7c, 0x21, 0x59, 0xa1, 0x7d,
+	0xbf, 0x08, 0x97, 0xd3, 0x4d, 0xcc, 0xca, 0xed, 0x6f, 0x40, 0x15, 0xe3, 0xb4, 0x0d, 0x5f, 0x52,
+	0x50, 0x59, 0x07, 0x04, 0x75, 0x99, 0x23, 0xf2, 0xc0, 0x08, 0xfa, 0xe7, 0xc5, 0x33, 0xab, 0x4f,
+	0x1c, 0x93, 0xb7, 0xd2, 0x0c, 0xd4, 0x3d, 0x58, 0xa1, 0x8e, 0x7a, 0x1e, 0x93, 0x6f, 0x56, 0x66,
+	0x51, 0x14, 0x96, 0x11, 0x7d, 0x13, 0xb1, 0x51, 0x59, 0x08, 0x85, 0x0d, 0x86, 0x07, 0x13, 0x55,
+	0x2c, 0x49, 0x69, 0x83, 0x50, 0x21, 0x95, 0x70, 0x99, 0x52, 0xb9, 0x81, 0x08, 0x4a, 0x99, 0x72,
+	0x3a, 0x20, 0x01, 0xe4, 0x2d, 0x5a, 0x9c, 0xc5, 0x5b, 0x44, 0xa8, 0xc2, 0x5b, 0xa4, 0x7d, 0x47,
+	0x81, 0x55, 0x4a, 0xfc, 0xe3, 0xef, 0x3e, 0x63, 0xde, 0x36, 0x97, 0x33, 0x72, 0x7f, 0x43, 0x21,
+	0xa4, 0xc4, 0x85, 0xd0, 0x6d, 0xa0, 0x3f, 0x91, 0x31, 0xdc, 0x67, 0xcc, 0x93, 0xf9, 0x6e, 0x8a,
+	0x7a, 0x1d, 0xa1, 0xbb, 0x08, 0x54, 0xdf, 0x80, 0x15, 0xf9, 0x17, 0x34, 0xe9, 0x7c, 0x57, 0xf4,
+	0xdf, 0x34, 0x7b, 0xe1, 0xdf, 0x25, 0xfd, 0x3f, 0x05, 0xae, 0x66, 0x8c, 0x22, 0xfc, 0x93, 0x9d,
+	0x28, 0xb9, 0xd3, 0xa4, 0xf0, 0x23, 0x6a, 0x21, 0x6a, 0x60, 0x3c, 0xb5, 0x53, 0x2a, 0x6f, 0xa0,
+	0x2c, 0x0f, 0x73, 0x5c, 0x52, 0x6a, 0x22, 0x09, 0x97, 0x39, 0x2e, 0xdf, 0x02, 0x55, 0x84, 0x93,
+	0xfa, 0x98, 0x41, 0xc0, 0x88, 0xcc, 0xd6, 0xa2, 0x2e, 0x02, 0x4d, 0x29, 0xb5, 0x80, 0xe8, 0x96,
+	0x9b, 0xce, 0xa2, 0xf6, 0xa1, 0xe9, 0xf4, 0x4f, 0xad, 0x7e, 0x70, 0x62, 0x44, 0xe1, 0xc5, 0x45,
+	0x5d, 0xb4, 0xb4, 0x21, 0x8b, 0x04, 0x86, 0xf6, 0xdd, 0x02, 0x34, 0xd2, 0xa3, 0x3f, 0x2f, 0x01,
+	0xd3, 0x55, 0x28, 0xbb, 0xa7, 0x0e, 0xf3, 0xa2, 0x90, 0xf1, 0x45, 0xf1, 0xdd, 0xe9, 0x87, 0xaf,
+	0x3a, 0x8a, 0xb1, 0x57, 0x1d, 0xe4, 0xc8, 0xe5, 0xa3, 0x1f, 0xf9, 0x91, 0x26, 0x43, 0xb0, 0x03,
+	0x1f, 0x1f, 0x2b, 0x25, 0x27, 0x48, 0x21, 0x15, 0x7e, 0x7c, 0x72, 0xb7, 0x61, 0x29, 0x9a, 0x97,
+	0x68, 0x89, 0x48, 0x34, 0x84, 0x8a, 0xb6, 0xee, 0xc0, 0x72, 0x7a, 0xfa, 0xc8, 0xb7, 0x22, 0x6c,
+	0x6c, 0x6f, 0x15, 0x16, 0x25, 0x19, 0x21, 0xa3, 0x92, 0x9f, 0xda, 0xaf, 0x2b, 0x70, 0xab, 0x6b,
+	0x0d, 0x44, 0x56, 0x83, 0x8d, 0x91, 0xfd, 0xa4, 0x15, 0x06, 0xf4, 0xf4, 0x12, 0x99, 0x12, 0x6e,
+	0x43, 0x99, 0x38, 0x48, 0xd6, 0x7f, 0x58, 0x2d, 0x22, 0xfb, 0xf0, 0x3f, 0xbf, 0x7f, 0xf8, 0xf8,
+	0x69, 0x11, 0x5e, 0x9d, 0x3c, 0xae, 0x8c, 0x24, 0xaa, 0x32, 0x77, 0x98, 0x92, 0x9b, 0xdb, 0xcf,
+	0x3c, 0x3a, 0x42, 0x47, 0x69, 0x98, 0xc0, 0x0e, 0x8f, 0x55, 0x43, 0x16, 0x84, 0xb9, 0xf9, 0x84,
+	0xc0, 0x15, 0xfa, 0x69, 0x32, 0xa3, 0x56, 0x9d, 0xa0, 0xc4, 0x25, 0x52, 0x89, 0x59, 0xe7, 0xc7,
+	0x12, 0xb3, 0xbe, 0x02, 0x35, 0x87, 0x9d, 0xda, 0x67, 0x58, 0x41, 0xf2, 0xa4, 0xaa, 0x80, 0x89,
+	0x1a, 0xfd, 0x74, 0xee, 0xd6, 0x85, 0xf1, 0xdc, 0xad, 0x6f, 0x8a, 0xf7, 0x52, 0xf6, 0x99, 0x11,
+	0xaf, 0xb7, 0x28, 0x6f, 0x48, 0x4e, 0xed, 0xb3, 0x4e, 0xac, 0xf2, 0xbb, 0x70, 0x39, 0xca, 0x06,
+	0x96, 0xe8, 0x1b, 0xf7, 0xfe, 0x62, 0x58, 0xba, 0x13, 0x1b, 0x44, 0x22, 0x97, 0xe1, 0x78, 0x67,
+	0x95, 0x54, 0x2e, 0xc3, 0x9d, 0x74, 0xaf, 0x19, 0xf9, 0xd1, 0x60, 0x72, 0x7e, 0xb4, 0x6a, 0x3c,
+	0x3f, 0xda, 0x1f, 0x28, 0x70, 0x5d, 0xb8, 0x60, 0x3e, 0xf1, 0xf7, 0x3d, 0xf3, 0xe8, 0xc8, 0xea,
+	0x35, 0x1d, 0x77, 0x60, 0xda, 0x67, 0x9f, 0x8b, 0xa2, 0x7f, 0x1b, 0x83, 0xb8, 0xfb, 0xd6, 0x33,
+	0xe6, 0x1d, 0x33, 0x69, 0xcd, 0x28, 0x7a, 0x7d, 0x60, 0x39, 0xad, 0x10, 0xc8, 0xcf, 0xa6, 0xa8,
+	0xe6, 0x9e, 0x3a, 0xb6, 0x6b, 0xf6, 0xe5, 0x96, 0xd7, 0x78, 0x2d, 0x09, 0xcb, 0x76, 0xa8, 0xc5,
+	0xfc, 0x2b, 0xa5, 0xb8, 0x7f, 0x45, 0xfb, 0xf7, 0x0a, 0xbc, 0x94, 0x33, 0xaf, 0x99, 0x7d, 0x6d,
+	0x61, 0x13, 0xe7, 0xa9, 0xf4, 0xb7, 0x00, 0x5f, 0x40, 0xa4, 0x38, 0x6e, 0x4d, 0x00, 0x25, 0xbb,
+	0x0d, 0xf5, 0xf5, 0xf9, 0x99, 0xf5, 0x75, 0xed, 0x57, 0x0b, 0xe4, 0xcc, 0x4b, 0x0c, 0x69, 0x26,
+	0x35, 0x63, 0x3c, 0xf1, 0x48, 0x3c, 0x43, 0xc9, 0x7d, 0xb8, 0x24, 0x77, 0x21, 0x7c, 0x03, 0x1d,
+	0xcb, 0xa8, 0x7d, 0x41, 0x16, 0x92, 0xfa, 0x2c, 0x52, 0x6b, 0xdf, 0x83, 0x10, 0xec, 0x23, 0x12,
+	0x8b, 0xac, 0x49, 0x35, 0x2c, 0xea, 0xca, 0x12, 0xa1, 0xc5, 0x86, 0x08, 0xf4, 0x74, 0x94, 0x72,
+	0xe4, 0x87, 0x70, 0x7a, 0x3d, 0xfa, 0x32, 0x40, 0x8c, 0x78, 0xf0, 0xaa, 0x30, 0x06, 0xb9, 0xff,
+	0xa3, 0x15, 0x58, 0xc6, 0xbc, 0xc3, 0x1d, 0xb9, 0x67, 0x2a, 0x83, 0x5a, 0xfc, 0xff, 0x1c, 0xd5,
+	0xec, 0xc7, 0x9e, 0x19, 0x7f, 0x6e, 0xb9, 0xf6, 0xfa, 0x14, 0x35, 0x91, 0x7a, 0xb4, 0x39, 0xf5,
+	0x24, 0xfd, 0x8f, 0x83, 0xaf, 0x4f, 0xf1, 0x67, 0x87, 0xd4, 0xd1, 0x1b, 0xd3, 0x54, 0x0d, 0x7b,
+	0x7a, 0x02, 0x4b, 0xc9, 0x7f, 0xe8, 0x53, 0x27, 0xe2, 0x27, 0xff, 0x49, 0x70, 0xed, 0xcd, 0xa9,
+	0xea, 0x86, 0x9d, 0x3d, 0x0d, 0xff, 0x88, 0x23, 0xfc, 0xb7, 0x37, 0xf5, 0xad, 0x49, 0x4d, 0xa4,
+	0xff, 0x01, 0x6f, 0xed, 0x0b, 0x53, 0xd6, 0x8e, 0x77, 0x99, 0xfe, 0x17, 0xb1, 0x9c, 0x2e, 0x73,
+	0xfe, 0xaf, 0x2c, 0xa7, 0xcb, 0xbc, 0xbf, 0x26, 0xd3, 0xe6, 0xd4, 0xbf, 0x04, 0x17, 0xb3, 0xfe,
+	0xc7, 0x4a, 0x7d, 0x3b, 0x3b, 0x6f, 0x73, 0xfe, 0x9f, 0x70, 0xad, 0xfd, 0xdc, 0x0c, 0x18, 0x61,
+	0xf7, 0x9f, 0xc2, 0x85, 0x8c, 0xff, 0x5e, 0x52, 0xef, 0x4d, 0x5a, 0xb9, 0x8c, 0x7f, 0x7f, 0x5a,
+	0x7b, 0x7b, 0x7a, 0x84, 0xf8, 0xd4, 0xb3, 0xfe, 0x4d, 0x46, 0x7d, 0xfb, 0xbc, 0x7f, 0x8d, 0x49,
+	0xe7, 0xa6, 0xcc, 0x99, 0xfa, 0xa4, 0xbf, 0xaa, 0xd1, 0xe6, 0xd4, 0xef, 0x28, 0x70, 0x39, 0xfb,
+	0x5f, 0x4a, 0xd4, 0xfb, 0xe7, 0xfc, 0x19, 0x49, 0xc6, 0xbf, 0xa7, 0xac, 0xbd, 0x33, 0x13, 0x4e,
+	0x38, 0x8a, 0x00, 0x56, 0xc6, 0xfe, 0xcc, 0x42, 0x9d, 0x48, 0xb8, 0x63, 0x69, 0xc7, 0xd7, 0xd6,
+	0xa7, 0xad, 0x1e, 0xef, 0x75, 0xec, 0xaf, 0x13, 0x72, 0x7a, 0xcd, 0xfb, 0x5f, 0x87, 0x9c, 0x5e,
+	0x73, 0xff, 0x91, 0x01, 0x89, 0x2d, 0x23, 0x1b, 0x7e, 0x0e, 0xb1, 0xe5, 0x67, 0xff, 0xcf, 0x21,
+	0xb6, 0x09, 0x89, 0xf6, 0xa9, 0xef, 0xf1, 0xd4, 0xe9, 0x79, 0x7d, 0xe7, 0xa6, 0x78, 0xcf, 0xeb,
+	0x3b, 0x3f, 0x2b, 0xbb, 0x36, 0xa7, 0xfe, 0x92, 0x02, 0x57, 0x72, 0x12, 0x68, 0xab, 0xef, 0xcc,
+	0x90, 0x26, 0x3b, 0x1c, 0xc4, 0xbb, 0xb3, 0x21, 0xc5, 0x4f, 0x5c, 0x56, 0x22, 0xe0, 0x9c, 0x13,
+	0x37, 0x21, 0xb7, 0x71, 0xce, 0x89, 0x9b, 0x94, 0x65, 0x98, 0xd6, 0x21, 0x27, 0xf5, 0xab, 0xfa,
+	0xce, 0x14, 0x69, 0x58, 0xc7, 0xce, 0xfd, 0xbb, 0xb3, 0x21, 0xc5, 0xc9, 0x7f, 0xcc, 0x0e, 0xce,
+	0x21, 0xff, 0x3c, 0xab, 0x3d, 0x87, 0xfc, 0x73, 0xcd, 0x6b, 0x6d, 0x4e, 0xfd, 0x75, 0x05, 0xae,
+	0x4f, 0xb2, 0x68, 0xd4, 0xec, 0xcb, 0x9b, 0x29, 0x8c, 0xb3, 0xb5, 0x2f, 0xbf, 0x00, 0xa6, 0x1c,
+	0xd7, 0xfd, 0x9f, 0xdc, 0x80, 0x06, 0xe5, 0x5a, 0x8c, 0x74, 0x97, 0x5f, 0x80, 0x4a, 0x98, 0xfc,
+	0x53, 0xcd, 0x7f, 0x87, 0x14, 0xcf, 0x43, 0xba, 0xf6, 0xda, 0x79, 0xd5, 0xe2, 0x82, 0x36, 0x9d,
+	0x8a, 0x33, 0x47, 0xd0, 0xe6, 0x24, 0x08, 0xcd, 0x11, 0xb4, 0x79, 0xf9, 0x3d, 0x91, 0xf6, 0xb3,
+	0x12, 0x54, 0xe6, 0xd0, 0xfe, 0x84, 0xac, 0x9b, 0x39, 0xb4, 0x3f, 0x29, 0xfb, 0x25, 0x92, 0xdc,
+	0x58, 0x1a, 0xc6, 0x1c, 0x92, 0xcb, 0xcb, 0x0c, 0x99, 0x43, 0x72, 0xb9, 0xd9, 0x1d, 0xb5, 0x39,
+	0xf5, 0x17, 0x45, 0x74, 0x4c, 0x46, 0xd6, 0x42, 0xf5, 0xe7, 0x72, 0x8e, 0x4e, 0x7e, 0xae, 0xc4,
+	0xb5, 0xfb, 0xb3, 0xa0, 0x84, 0x43, 0x38, 0xc5, 0xc0, 0xb9, 0x64, 0x1a, 0x3e, 0x35, 0x3f, 0x79,
+	0x44, 0x66, 0x66, 0xc0, 0xb5, 0x7b, 0x53, 0xd7, 0x8f, 0x77, 0x3c, 0x9e, 0x27, 0x2e, 0xa7, 0xe3,
+	0xdc, 0xbc, 0x74, 0x39, 0x1d, 0xe7, 0x27, 0xa0, 0xc3, 0xad, 0x1e, 0xcb, 0xaa, 0x96, 0xb3, 0xd5,
+	0x79, 0xb9, 0xe2, 0xd6, 0xd6, 0xa7, 0xad, 0x1e, 0xf6, 0xca, 0xa0, 0x16, 0xcf, 0xe4, 0x95, 0x63,
+	0x6c, 0x64, 0xa4, 0x14, 0xcb, 0x31, 0x36, 0xb2, 0xd2, 0x82, 0xe1, 0xc9, 0x4d, 0xe7, 0x42, 0xca,
+	0x39, 0xb9, 0x39, 0x19, 0x9d, 0x72, 0x4e, 0x6e, 0x5e, 0x82, 0xa5, 0x70, 0x23, 0x53, 0x59, 0x75,
+	0xf2, 0x37, 0x32, 0x3b, 0x39, 0x4f, 0xfe, 0x46, 0xe6, 0xa4, 0xeb, 0xd1, 0xe6, 0xd4, 0x43, 0x7c,
+	0xa3, 0x4a, 0x99, 0x3f, 0xd4, 0x3b, 0x53, 0x26, 0x3c, 0x59, 0xbb, 0x7b, 0x7e, 0xc5, 0xf8, 0xe4,
+	0xc6, 0x53, 0x67, 0xe4, 0x4c, 0x2e, 0x37, 0x8f, 0x47, 0xce, 0xe4, 0xf2, 0x73, 0x72, 0x48, 0xc5,
+	0x33, 0x95, 0x77, 0x21, 0x57, 0xf1, 0xcc, 0xce, 0x23, 0x91, 0xab, 0x78, 0xe6, 0xa4, 0x73, 0x20,
+	0x86, 0x94, 0xf9, 0xf2, 0x3d, 0x87, 0x21, 0x4d, 0x7a, 0xbf, 0x9f, 0xc3, 0x90, 0x26, 0x3e, 0xac,
+	0x8f, 0x31, 0xa4, 0xc4, 0xab, 0x6d, 0x75, 0xe2, 0x81, 0x1b, 0x7f, 0x6f, 0x3e, 0x89, 0x21, 0x65,
+	0x3e, 0x07, 0xd7, 0xe6, 0xd4, 0xef, 0xd2, 0xff, 0x45, 0xe4, 0x3c, 0x03, 0x56, 0xdf, 0xcb, 0x6f,
+	0x72, 0xe2, 0x6b, 0xe6, 0xb5, 0xf7, 0x67, 0x47, 0x0c, 0x07, 0xf5, 0x0b, 0x50, 0x09, 0xdf, 0xa4,
+	0xe6, 0xc8, 0xf9, 0xf4, 0xe3, 0xdb, 0x1c, 0x39, 0x3f, 0xf6, 0xb4, 0x15, 0x89, 0x6c, 0xec, 0xe9,
+	0x62, 0x0e, 0x91, 0xe5, 0xbd, 0x0f, 0xcd, 0x21, 0xb2, 0xdc, 0x17, 0x91, 0x91, 0x9a, 0x9b, 0x7e,
+	0x7d, 0x37, 0x41, 0xcd, 0xcd, 0x79, 0x17, 0x38, 0x41, 0xcd, 0xcd, 0x7b, 0xda, 0x47, 0x6a, 0x6e,
+	0xce, 0xc3, 0xb0, 0x1c, 0x35, 0x77, 0xf2, 0x4b, 0xb3, 0x1c, 0x35, 0xf7, 0x9c, 0xb7, 0x67, 0xe4,
+	0x18, 0x8a, 0xbf, 0x10, 0xc9, 0x73, 0x0c, 0x65, 0x3c, 0x69, 0xc9, 0x73, 0x0c, 0x65, 0x3d, 0x38,
+	0x89, 0xce, 0x54, 0x2a, 0x3a, 0x7e, 0x7d, 0xda, 0xc7, 0x03, 0xe7, 0x9e, 0xa9, 0xec, 0xc7, 0x0a,
+	0xda, 0x9c, 0xfa, 0xcb, 0x0a, 0xac, 0xe6, 0x05, 0x91, 0xab, 0xef, 0xce, 0x12, 0x28, 0x1e, 0xce,
+	0xfc, 0x8b, 0x33, 0x62, 0xc5, 0x97, 0x3b, 0x11, 0x89, 0x9c, 0xb3, 0xdc, 0x59, 0x21, 0xd6, 0x6b,
+	0x6f, 0x4c, 0x53, 0x35, 0x7e, 0xac, 0xc6, 0x82, 0x81, 0x73, 0x8e, 0x55, 0x5e, 0x44, 0x71, 0xce,
+	0xb1, 0xca, 0x8d, 0x31, 0x46, 0x13, 0x3a, 0x23, 0x64, 0x34, 0xc7, 0x84, 0xce, 0x8f, 0x85, 0xcd,
+	0x31, 0xa1, 0x27, 0x44, 0xa3, 0xa2, 0xe7, 0x31, 0x19, 0x8f, 0x98, 0xe3, 0x79, 0xcc, 0x0c, 0x9f,
+	0xcc, 0xf1, 0x3c, 0x66, 0x07, 0x38, 0x22, 0xff, 0xc8, 0x8a, 0x98, 0xcb, 0xe1, 0x1f, 0x13, 0x82,
+	0x00, 0x73, 0xf8, 0xc7, 0xa4, 0x70, 0x3c, 0x6d, 0x4e, 0x75, 0x30, 0x37, 0x74, 0x2c, 0x68, 0x4b,
+	0x7d, 0x73, 0x52, 0x18, 0x79, 0x2a, 0xb6, 0x6c, 0xed, 0xad, 0xe9, 0x2a, 0xc7, 0xe9, 0x36, 0x11,
+	0x65, 0x94, 0x43, 0xb7, 0x59, 0xd1, 0x57, 0x39, 0x74, 0x9b, 0x19, 0xb4, 0x24, 0xa5, 0x7f, 0x56,
+	0xf8, 0x49, 0x9e, 0xf4, 0x9f, 0x10, 0x10, 0x93, 0x27, 0xfd, 0x27, 0x45, 0xb7, 0x20, 0x21, 0x25,
+	0x43, 0x24, 0x72, 0x08, 0x29, 0x33, 0x14, 0x23, 0x87, 0x90, 0xb2, 0x63, 0x2e, 0x68, 0xbe, 0x99,
+	0x77, 0x3f, 0x39, 0xf3, 0x9d, 0x74, 0xff, 0x95, 0x33, 0xdf, 0x89, 0x57, 0x4b, 0xda, 0xdc, 0xc6,
+	0xed, 0x3f, 0x7f, 0xcb, 0x0f, 0x5c, 0xef, 0xdb, 0xeb, 0x96, 0x7b, 0x4f, 0xfc, 0xb8, 0x17, 0xb6,
+	0x72, 0x4f, 0xa4, 0x90, 0x70, 0x4c, 0x7b, 0x78, 0x78, 0xb8, 0x20, 0x2e, 0x7d, 0xde, 0xf9, 0xff,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x51, 0x7f, 0x34, 0xf2, 0x8b, 0x00, 0x00,
 }
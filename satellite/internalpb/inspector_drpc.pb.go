@@ -44,6 +44,22 @@ type DRPCHealthInspectorClient interface {
 
 	ObjectHealth(ctx context.Context, in *ObjectHealthRequest) (*ObjectHealthResponse, error)
 	SegmentHealth(ctx context.Context, in *SegmentHealthRequest) (*SegmentHealthResponse, error)
+	SegmentsAtRisk(ctx context.Context, in *SegmentsAtRiskRequest) (*SegmentsAtRiskResponse, error)
+	SegmentGeoSpread(ctx context.Context, in *SegmentGeoSpreadRequest) (*SegmentGeoSpreadResponse, error)
+	RepairQueueStats(ctx context.Context, in *RepairQueueStatsRequest) (*RepairQueueStatsResponse, error)
+	DetectOrphanedPieces(ctx context.Context, in *DetectOrphanedPiecesRequest) (*DetectOrphanedPiecesResponse, error)
+	SegmentRepairReason(ctx context.Context, in *SegmentRepairReasonRequest) (*SegmentRepairReasonResponse, error)
+	SegmentSizeHistogram(ctx context.Context, in *SegmentSizeHistogramRequest) (*SegmentSizeHistogramResponse, error)
+	RedundancyDistribution(ctx context.Context, in *RedundancyDistributionRequest) (*RedundancyDistributionResponse, error)
+	SegmentPieceNodes(ctx context.Context, in *SegmentPieceNodesRequest) (*SegmentPieceNodesResponse, error)
+	InlineRemoteRatio(ctx context.Context, in *InlineRemoteRatioRequest) (*InlineRemoteRatioResponse, error)
+	DryRunRepairChecker(ctx context.Context, in *DryRunRepairCheckerRequest) (*DryRunRepairCheckerResponse, error)
+	DuplicatePieceNodes(ctx context.Context, in *DuplicatePieceNodesRequest) (*DuplicatePieceNodesResponse, error)
+	OrphanedProjectSegments(ctx context.Context, in *OrphanedProjectSegmentsRequest) (*OrphanedProjectSegmentsResponse, error)
+	NodeStorageByProject(ctx context.Context, in *NodeStorageByProjectRequest) (*NodeStorageByProjectResponse, error)
+	RepairQueueAgeHistogram(ctx context.Context, in *RepairQueueAgeHistogramRequest) (*RepairQueueAgeHistogramResponse, error)
+	ProjectsOverLimit(ctx context.Context, in *ProjectsOverLimitRequest) (*ProjectsOverLimitResponse, error)
+	SimulateBulkDisqualification(ctx context.Context, in *SimulateBulkDisqualificationRequest) (*SimulateBulkDisqualificationResponse, error)
 }
 
 type drpcHealthInspectorClient struct {
@@ -74,9 +90,169 @@ func (c *drpcHealthInspectorClient) SegmentHealth(ctx context.Context, in *Segme
 	return out, nil
 }
 
+func (c *drpcHealthInspectorClient) SegmentsAtRisk(ctx context.Context, in *SegmentsAtRiskRequest) (*SegmentsAtRiskResponse, error) {
+	out := new(SegmentsAtRiskResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/SegmentsAtRisk", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) SegmentGeoSpread(ctx context.Context, in *SegmentGeoSpreadRequest) (*SegmentGeoSpreadResponse, error) {
+	out := new(SegmentGeoSpreadResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/SegmentGeoSpread", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) RepairQueueStats(ctx context.Context, in *RepairQueueStatsRequest) (*RepairQueueStatsResponse, error) {
+	out := new(RepairQueueStatsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/RepairQueueStats", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) DetectOrphanedPieces(ctx context.Context, in *DetectOrphanedPiecesRequest) (*DetectOrphanedPiecesResponse, error) {
+	out := new(DetectOrphanedPiecesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/DetectOrphanedPieces", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) SegmentRepairReason(ctx context.Context, in *SegmentRepairReasonRequest) (*SegmentRepairReasonResponse, error) {
+	out := new(SegmentRepairReasonResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/SegmentRepairReason", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) SegmentSizeHistogram(ctx context.Context, in *SegmentSizeHistogramRequest) (*SegmentSizeHistogramResponse, error) {
+	out := new(SegmentSizeHistogramResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/SegmentSizeHistogram", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) RedundancyDistribution(ctx context.Context, in *RedundancyDistributionRequest) (*RedundancyDistributionResponse, error) {
+	out := new(RedundancyDistributionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/RedundancyDistribution", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) SegmentPieceNodes(ctx context.Context, in *SegmentPieceNodesRequest) (*SegmentPieceNodesResponse, error) {
+	out := new(SegmentPieceNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/SegmentPieceNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) InlineRemoteRatio(ctx context.Context, in *InlineRemoteRatioRequest) (*InlineRemoteRatioResponse, error) {
+	out := new(InlineRemoteRatioResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/InlineRemoteRatio", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) DryRunRepairChecker(ctx context.Context, in *DryRunRepairCheckerRequest) (*DryRunRepairCheckerResponse, error) {
+	out := new(DryRunRepairCheckerResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/DryRunRepairChecker", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) DuplicatePieceNodes(ctx context.Context, in *DuplicatePieceNodesRequest) (*DuplicatePieceNodesResponse, error) {
+	out := new(DuplicatePieceNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/DuplicatePieceNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) OrphanedProjectSegments(ctx context.Context, in *OrphanedProjectSegmentsRequest) (*OrphanedProjectSegmentsResponse, error) {
+	out := new(OrphanedProjectSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/OrphanedProjectSegments", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) NodeStorageByProject(ctx context.Context, in *NodeStorageByProjectRequest) (*NodeStorageByProjectResponse, error) {
+	out := new(NodeStorageByProjectResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/NodeStorageByProject", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) RepairQueueAgeHistogram(ctx context.Context, in *RepairQueueAgeHistogramRequest) (*RepairQueueAgeHistogramResponse, error) {
+	out := new(RepairQueueAgeHistogramResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/RepairQueueAgeHistogram", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) ProjectsOverLimit(ctx context.Context, in *ProjectsOverLimitRequest) (*ProjectsOverLimitResponse, error) {
+	out := new(ProjectsOverLimitResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/ProjectsOverLimit", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcHealthInspectorClient) SimulateBulkDisqualification(ctx context.Context, in *SimulateBulkDisqualificationRequest) (*SimulateBulkDisqualificationResponse, error) {
+	out := new(SimulateBulkDisqualificationResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/SimulateBulkDisqualification", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCHealthInspectorServer interface {
 	ObjectHealth(context.Context, *ObjectHealthRequest) (*ObjectHealthResponse, error)
 	SegmentHealth(context.Context, *SegmentHealthRequest) (*SegmentHealthResponse, error)
+	SegmentsAtRisk(context.Context, *SegmentsAtRiskRequest) (*SegmentsAtRiskResponse, error)
+	SegmentGeoSpread(context.Context, *SegmentGeoSpreadRequest) (*SegmentGeoSpreadResponse, error)
+	RepairQueueStats(context.Context, *RepairQueueStatsRequest) (*RepairQueueStatsResponse, error)
+	DetectOrphanedPieces(context.Context, *DetectOrphanedPiecesRequest) (*DetectOrphanedPiecesResponse, error)
+	SegmentRepairReason(context.Context, *SegmentRepairReasonRequest) (*SegmentRepairReasonResponse, error)
+	SegmentSizeHistogram(context.Context, *SegmentSizeHistogramRequest) (*SegmentSizeHistogramResponse, error)
+	RedundancyDistribution(context.Context, *RedundancyDistributionRequest) (*RedundancyDistributionResponse, error)
+	SegmentPieceNodes(context.Context, *SegmentPieceNodesRequest) (*SegmentPieceNodesResponse, error)
+	InlineRemoteRatio(context.Context, *InlineRemoteRatioRequest) (*InlineRemoteRatioResponse, error)
+	DryRunRepairChecker(context.Context, *DryRunRepairCheckerRequest) (*DryRunRepairCheckerResponse, error)
+	DuplicatePieceNodes(context.Context, *DuplicatePieceNodesRequest) (*DuplicatePieceNodesResponse, error)
+	OrphanedProjectSegments(context.Context, *OrphanedProjectSegmentsRequest) (*OrphanedProjectSegmentsResponse, error)
+	NodeStorageByProject(context.Context, *NodeStorageByProjectRequest) (*NodeStorageByProjectResponse, error)
+	RepairQueueAgeHistogram(context.Context, *RepairQueueAgeHistogramRequest) (*RepairQueueAgeHistogramResponse, error)
+	ProjectsOverLimit(context.Context, *ProjectsOverLimitRequest) (*ProjectsOverLimitResponse, error)
+	SimulateBulkDisqualification(context.Context, *SimulateBulkDisqualificationRequest) (*SimulateBulkDisqualificationResponse, error)
 }
 
 type DRPCHealthInspectorUnimplementedServer struct{}
@@ -89,9 +265,73 @@ func (s *DRPCHealthInspectorUnimplementedServer) SegmentHealth(context.Context,
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCHealthInspectorUnimplementedServer) SegmentsAtRisk(context.Context, *SegmentsAtRiskRequest) (*SegmentsAtRiskResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) SegmentGeoSpread(context.Context, *SegmentGeoSpreadRequest) (*SegmentGeoSpreadResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) RepairQueueStats(context.Context, *RepairQueueStatsRequest) (*RepairQueueStatsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) DetectOrphanedPieces(context.Context, *DetectOrphanedPiecesRequest) (*DetectOrphanedPiecesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) SegmentRepairReason(context.Context, *SegmentRepairReasonRequest) (*SegmentRepairReasonResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) SegmentSizeHistogram(context.Context, *SegmentSizeHistogramRequest) (*SegmentSizeHistogramResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) RedundancyDistribution(context.Context, *RedundancyDistributionRequest) (*RedundancyDistributionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) SegmentPieceNodes(context.Context, *SegmentPieceNodesRequest) (*SegmentPieceNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) InlineRemoteRatio(context.Context, *InlineRemoteRatioRequest) (*InlineRemoteRatioResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) DryRunRepairChecker(context.Context, *DryRunRepairCheckerRequest) (*DryRunRepairCheckerResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) DuplicatePieceNodes(context.Context, *DuplicatePieceNodesRequest) (*DuplicatePieceNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) OrphanedProjectSegments(context.Context, *OrphanedProjectSegmentsRequest) (*OrphanedProjectSegmentsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) NodeStorageByProject(context.Context, *NodeStorageByProjectRequest) (*NodeStorageByProjectResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) RepairQueueAgeHistogram(context.Context, *RepairQueueAgeHistogramRequest) (*RepairQueueAgeHistogramResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) ProjectsOverLimit(context.Context, *ProjectsOverLimitRequest) (*ProjectsOverLimitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCHealthInspectorUnimplementedServer) SimulateBulkDisqualification(context.Context, *SimulateBulkDisqualificationRequest) (*SimulateBulkDisqualificationResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCHealthInspectorDescription struct{}
 
-func (DRPCHealthInspectorDescription) NumMethods() int { return 2 }
+func (DRPCHealthInspectorDescription) NumMethods() int { return 18 }
 
 func (DRPCHealthInspectorDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -113,6 +353,150 @@ func (DRPCHealthInspectorDescription) Method(n int) (string, drpc.Encoding, drpc
 						in1.(*SegmentHealthRequest),
 					)
 			}, DRPCHealthInspectorServer.SegmentHealth, true
+	case 2:
+		return "/satellite.inspector.HealthInspector/SegmentsAtRisk", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					SegmentsAtRisk(
+						ctx,
+						in1.(*SegmentsAtRiskRequest),
+					)
+			}, DRPCHealthInspectorServer.SegmentsAtRisk, true
+	case 3:
+		return "/satellite.inspector.HealthInspector/SegmentGeoSpread", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					SegmentGeoSpread(
+						ctx,
+						in1.(*SegmentGeoSpreadRequest),
+					)
+			}, DRPCHealthInspectorServer.SegmentGeoSpread, true
+	case 4:
+		return "/satellite.inspector.HealthInspector/RepairQueueStats", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					RepairQueueStats(
+						ctx,
+						in1.(*RepairQueueStatsRequest),
+					)
+			}, DRPCHealthInspectorServer.RepairQueueStats, true
+	case 5:
+		return "/satellite.inspector.HealthInspector/DetectOrphanedPieces", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					DetectOrphanedPieces(
+						ctx,
+						in1.(*DetectOrphanedPiecesRequest),
+					)
+			}, DRPCHealthInspectorServer.DetectOrphanedPieces, true
+	case 6:
+		return "/satellite.inspector.HealthInspector/SegmentRepairReason", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					SegmentRepairReason(
+						ctx,
+						in1.(*SegmentRepairReasonRequest),
+					)
+			}, DRPCHealthInspectorServer.SegmentRepairReason, true
+	case 7:
+		return "/satellite.inspector.HealthInspector/SegmentSizeHistogram", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					SegmentSizeHistogram(
+						ctx,
+						in1.(*SegmentSizeHistogramRequest),
+					)
+			}, DRPCHealthInspectorServer.SegmentSizeHistogram, true
+	case 8:
+		return "/satellite.inspector.HealthInspector/RedundancyDistribution", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					RedundancyDistribution(
+						ctx,
+						in1.(*RedundancyDistributionRequest),
+					)
+			}, DRPCHealthInspectorServer.RedundancyDistribution, true
+	case 9:
+		return "/satellite.inspector.HealthInspector/SegmentPieceNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					SegmentPieceNodes(
+						ctx,
+						in1.(*SegmentPieceNodesRequest),
+					)
+			}, DRPCHealthInspectorServer.SegmentPieceNodes, true
+	case 10:
+		return "/satellite.inspector.HealthInspector/InlineRemoteRatio", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					InlineRemoteRatio(
+						ctx,
+						in1.(*InlineRemoteRatioRequest),
+					)
+			}, DRPCHealthInspectorServer.InlineRemoteRatio, true
+	case 11:
+		return "/satellite.inspector.HealthInspector/DryRunRepairChecker", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					DryRunRepairChecker(
+						ctx,
+						in1.(*DryRunRepairCheckerRequest),
+					)
+			}, DRPCHealthInspectorServer.DryRunRepairChecker, true
+	case 12:
+		return "/satellite.inspector.HealthInspector/DuplicatePieceNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					DuplicatePieceNodes(
+						ctx,
+						in1.(*DuplicatePieceNodesRequest),
+					)
+			}, DRPCHealthInspectorServer.DuplicatePieceNodes, true
+	case 13:
+		return "/satellite.inspector.HealthInspector/OrphanedProjectSegments", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					OrphanedProjectSegments(
+						ctx,
+						in1.(*OrphanedProjectSegmentsRequest),
+					)
+			}, DRPCHealthInspectorServer.OrphanedProjectSegments, true
+	case 14:
+		return "/satellite.inspector.HealthInspector/NodeStorageByProject", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					NodeStorageByProject(
+						ctx,
+						in1.(*NodeStorageByProjectRequest),
+					)
+			}, DRPCHealthInspectorServer.NodeStorageByProject, true
+	case 15:
+		return "/satellite.inspector.HealthInspector/RepairQueueAgeHistogram", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					RepairQueueAgeHistogram(
+						ctx,
+						in1.(*RepairQueueAgeHistogramRequest),
+					)
+			}, DRPCHealthInspectorServer.RepairQueueAgeHistogram, true
+	case 16:
+		return "/satellite.inspector.HealthInspector/ProjectsOverLimit", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					ProjectsOverLimit(
+						ctx,
+						in1.(*ProjectsOverLimitRequest),
+					)
+			}, DRPCHealthInspectorServer.ProjectsOverLimit, true
+	case 17:
+		return "/satellite.inspector.HealthInspector/SimulateBulkDisqualification", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					SimulateBulkDisqualification(
+						ctx,
+						in1.(*SimulateBulkDisqualificationRequest),
+					)
+			}, DRPCHealthInspectorServer.SimulateBulkDisqualification, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -153,3 +537,1654 @@ func (x *drpcHealthInspector_SegmentHealthStream) SendAndClose(m *SegmentHealthR
 	}
 	return x.CloseSend()
 }
+
+type DRPCHealthInspector_SegmentsAtRiskStream interface {
+	drpc.Stream
+	SendAndClose(*SegmentsAtRiskResponse) error
+}
+
+type drpcHealthInspector_SegmentsAtRiskStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_SegmentsAtRiskStream) SendAndClose(m *SegmentsAtRiskResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_SegmentGeoSpreadStream interface {
+	drpc.Stream
+	SendAndClose(*SegmentGeoSpreadResponse) error
+}
+
+type drpcHealthInspector_SegmentGeoSpreadStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_SegmentGeoSpreadStream) SendAndClose(m *SegmentGeoSpreadResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_RepairQueueStatsStream interface {
+	drpc.Stream
+	SendAndClose(*RepairQueueStatsResponse) error
+}
+
+type drpcHealthInspector_RepairQueueStatsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_RepairQueueStatsStream) SendAndClose(m *RepairQueueStatsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_DetectOrphanedPiecesStream interface {
+	drpc.Stream
+	SendAndClose(*DetectOrphanedPiecesResponse) error
+}
+
+type drpcHealthInspector_DetectOrphanedPiecesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_DetectOrphanedPiecesStream) SendAndClose(m *DetectOrphanedPiecesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_SegmentRepairReasonStream interface {
+	drpc.Stream
+	SendAndClose(*SegmentRepairReasonResponse) error
+}
+
+type drpcHealthInspector_SegmentRepairReasonStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_SegmentRepairReasonStream) SendAndClose(m *SegmentRepairReasonResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_SegmentSizeHistogramStream interface {
+	drpc.Stream
+	SendAndClose(*SegmentSizeHistogramResponse) error
+}
+
+type drpcHealthInspector_SegmentSizeHistogramStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_SegmentSizeHistogramStream) SendAndClose(m *SegmentSizeHistogramResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_RedundancyDistributionStream interface {
+	drpc.Stream
+	SendAndClose(*RedundancyDistributionResponse) error
+}
+
+type drpcHealthInspector_RedundancyDistributionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_RedundancyDistributionStream) SendAndClose(m *RedundancyDistributionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_SegmentPieceNodesStream interface {
+	drpc.Stream
+	SendAndClose(*SegmentPieceNodesResponse) error
+}
+
+type drpcHealthInspector_SegmentPieceNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_SegmentPieceNodesStream) SendAndClose(m *SegmentPieceNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_InlineRemoteRatioStream interface {
+	drpc.Stream
+	SendAndClose(*InlineRemoteRatioResponse) error
+}
+
+type drpcHealthInspector_InlineRemoteRatioStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_InlineRemoteRatioStream) SendAndClose(m *InlineRemoteRatioResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_DryRunRepairCheckerStream interface {
+	drpc.Stream
+	SendAndClose(*DryRunRepairCheckerResponse) error
+}
+
+type drpcHealthInspector_DryRunRepairCheckerStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_DryRunRepairCheckerStream) SendAndClose(m *DryRunRepairCheckerResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_DuplicatePieceNodesStream interface {
+	drpc.Stream
+	SendAndClose(*DuplicatePieceNodesResponse) error
+}
+
+type drpcHealthInspector_DuplicatePieceNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_DuplicatePieceNodesStream) SendAndClose(m *DuplicatePieceNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_OrphanedProjectSegmentsStream interface {
+	drpc.Stream
+	SendAndClose(*OrphanedProjectSegmentsResponse) error
+}
+
+type drpcHealthInspector_OrphanedProjectSegmentsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_OrphanedProjectSegmentsStream) SendAndClose(m *OrphanedProjectSegmentsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_NodeStorageByProjectStream interface {
+	drpc.Stream
+	SendAndClose(*NodeStorageByProjectResponse) error
+}
+
+type drpcHealthInspector_NodeStorageByProjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_NodeStorageByProjectStream) SendAndClose(m *NodeStorageByProjectResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_RepairQueueAgeHistogramStream interface {
+	drpc.Stream
+	SendAndClose(*RepairQueueAgeHistogramResponse) error
+}
+
+type drpcHealthInspector_RepairQueueAgeHistogramStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_RepairQueueAgeHistogramStream) SendAndClose(m *RepairQueueAgeHistogramResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_ProjectsOverLimitStream interface {
+	drpc.Stream
+	SendAndClose(*ProjectsOverLimitResponse) error
+}
+
+type drpcHealthInspector_ProjectsOverLimitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_ProjectsOverLimitStream) SendAndClose(m *ProjectsOverLimitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCHealthInspector_SimulateBulkDisqualificationStream interface {
+	drpc.Stream
+	SendAndClose(*SimulateBulkDisqualificationResponse) error
+}
+
+type drpcHealthInspector_SimulateBulkDisqualificationStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_SimulateBulkDisqualificationStream) SendAndClose(m *SimulateBulkDisqualificationResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspectorClient interface {
+	DRPCConn() drpc.Conn
+
+	NodesByIP(ctx context.Context, in *NodesByIPRequest) (*NodesByIPResponse, error)
+	IPsWithManyNodes(ctx context.Context, in *IPsWithManyNodesRequest) (*IPsWithManyNodesResponse, error)
+	ExplainNodeSelection(ctx context.Context, in *ExplainNodeSelectionRequest) (*ExplainNodeSelectionResponse, error)
+	TopNodesByStorage(ctx context.Context, in *TopNodesByStorageRequest) (*TopNodesByStorageResponse, error)
+	RecalculateReputation(ctx context.Context, in *RecalculateReputationRequest) (*RecalculateReputationResponse, error)
+	NodesNearOfflineDQ(ctx context.Context, in *NodesNearOfflineDQRequest) (*NodesNearOfflineDQResponse, error)
+	GetOperatorContact(ctx context.Context, in *GetOperatorContactRequest) (*GetOperatorContactResponse, error)
+	NodesByUptimeBand(ctx context.Context, in *NodesByUptimeBandRequest) (*NodesByUptimeBandResponse, error)
+	CompareNodes(ctx context.Context, in *CompareNodesRequest) (*CompareNodesResponse, error)
+	ListExitingNodes(ctx context.Context, in *ListExitingNodesRequest) (*ListExitingNodesResponse, error)
+	GetSelectionConfig(ctx context.Context, in *GetSelectionConfigRequest) (*GetSelectionConfigResponse, error)
+	NodeCohorts(ctx context.Context, in *NodeCohortsRequest) (*NodeCohortsResponse, error)
+	ListContainedNodes(ctx context.Context, in *ListContainedNodesRequest) (*ListContainedNodesResponse, error)
+	SelectionFairness(ctx context.Context, in *SelectionFairnessRequest) (*SelectionFairnessResponse, error)
+	SpaceDiscrepancyNodes(ctx context.Context, in *SpaceDiscrepancyNodesRequest) (*SpaceDiscrepancyNodesResponse, error)
+	NodesByLatencyTier(ctx context.Context, in *NodesByLatencyTierRequest) (*NodesByLatencyTierResponse, error)
+	NodesWithRecentWalletChange(ctx context.Context, in *NodesWithRecentWalletChangeRequest) (*NodesWithRecentWalletChangeResponse, error)
+	ChurnRate(ctx context.Context, in *ChurnRateRequest) (*ChurnRateResponse, error)
+	ValidatePlacement(ctx context.Context, in *ValidatePlacementRequest) (*ValidatePlacementResponse, error)
+	NodesBelowMinVersion(ctx context.Context, in *NodesBelowMinVersionRequest) (*NodesBelowMinVersionResponse, error)
+	GetReputationThresholds(ctx context.Context, in *GetReputationThresholdsRequest) (*GetReputationThresholdsResponse, error)
+	StaleGeoNodes(ctx context.Context, in *StaleGeoNodesRequest) (*StaleGeoNodesResponse, error)
+	NodeFreeSpaceTrend(ctx context.Context, in *NodeFreeSpaceTrendRequest) (*NodeFreeSpaceTrendResponse, error)
+	PlacementSelectionCounts(ctx context.Context, in *PlacementSelectionCountsRequest) (*PlacementSelectionCountsResponse, error)
+	ReinstateNode(ctx context.Context, in *ReinstateNodeRequest) (*ReinstateNodeResponse, error)
+	CapacityByCountry(ctx context.Context, in *CapacityByCountryRequest) (*CapacityByCountryResponse, error)
+	RecentAuditFailures(ctx context.Context, in *RecentAuditFailuresRequest) (*RecentAuditFailuresResponse, error)
+	ClockSkewNodes(ctx context.Context, in *ClockSkewNodesRequest) (*ClockSkewNodesResponse, error)
+	CheckExitEligibility(ctx context.Context, in *CheckExitEligibilityRequest) (*CheckExitEligibilityResponse, error)
+	NodeRepairStats(ctx context.Context, in *NodeRepairStatsRequest) (*NodeRepairStatsResponse, error)
+	TrustingNodes(ctx context.Context, in *TrustingNodesRequest) (*TrustingNodesResponse, error)
+	SubnetSaturationStats(ctx context.Context, in *SubnetSaturationStatsRequest) (*SubnetSaturationStatsResponse, error)
+	PendingGCStats(ctx context.Context, in *PendingGCStatsRequest) (*PendingGCStatsResponse, error)
+	AuditVsTrafficAnomaly(ctx context.Context, in *AuditVsTrafficAnomalyRequest) (*AuditVsTrafficAnomalyResponse, error)
+}
+
+type drpcOverlayInspectorClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCOverlayInspectorClient(cc drpc.Conn) DRPCOverlayInspectorClient {
+	return &drpcOverlayInspectorClient{cc}
+}
+
+func (c *drpcOverlayInspectorClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcOverlayInspectorClient) NodesByIP(ctx context.Context, in *NodesByIPRequest) (*NodesByIPResponse, error) {
+	out := new(NodesByIPResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodesByIP", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) IPsWithManyNodes(ctx context.Context, in *IPsWithManyNodesRequest) (*IPsWithManyNodesResponse, error) {
+	out := new(IPsWithManyNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/IPsWithManyNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ExplainNodeSelection(ctx context.Context, in *ExplainNodeSelectionRequest) (*ExplainNodeSelectionResponse, error) {
+	out := new(ExplainNodeSelectionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ExplainNodeSelection", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) TopNodesByStorage(ctx context.Context, in *TopNodesByStorageRequest) (*TopNodesByStorageResponse, error) {
+	out := new(TopNodesByStorageResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/TopNodesByStorage", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) RecalculateReputation(ctx context.Context, in *RecalculateReputationRequest) (*RecalculateReputationResponse, error) {
+	out := new(RecalculateReputationResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/RecalculateReputation", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodesNearOfflineDQ(ctx context.Context, in *NodesNearOfflineDQRequest) (*NodesNearOfflineDQResponse, error) {
+	out := new(NodesNearOfflineDQResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodesNearOfflineDQ", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) GetOperatorContact(ctx context.Context, in *GetOperatorContactRequest) (*GetOperatorContactResponse, error) {
+	out := new(GetOperatorContactResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/GetOperatorContact", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodesByUptimeBand(ctx context.Context, in *NodesByUptimeBandRequest) (*NodesByUptimeBandResponse, error) {
+	out := new(NodesByUptimeBandResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodesByUptimeBand", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) CompareNodes(ctx context.Context, in *CompareNodesRequest) (*CompareNodesResponse, error) {
+	out := new(CompareNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/CompareNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ListExitingNodes(ctx context.Context, in *ListExitingNodesRequest) (*ListExitingNodesResponse, error) {
+	out := new(ListExitingNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ListExitingNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) GetSelectionConfig(ctx context.Context, in *GetSelectionConfigRequest) (*GetSelectionConfigResponse, error) {
+	out := new(GetSelectionConfigResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/GetSelectionConfig", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodeCohorts(ctx context.Context, in *NodeCohortsRequest) (*NodeCohortsResponse, error) {
+	out := new(NodeCohortsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodeCohorts", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ListContainedNodes(ctx context.Context, in *ListContainedNodesRequest) (*ListContainedNodesResponse, error) {
+	out := new(ListContainedNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ListContainedNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) SelectionFairness(ctx context.Context, in *SelectionFairnessRequest) (*SelectionFairnessResponse, error) {
+	out := new(SelectionFairnessResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/SelectionFairness", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) SpaceDiscrepancyNodes(ctx context.Context, in *SpaceDiscrepancyNodesRequest) (*SpaceDiscrepancyNodesResponse, error) {
+	out := new(SpaceDiscrepancyNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/SpaceDiscrepancyNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodesByLatencyTier(ctx context.Context, in *NodesByLatencyTierRequest) (*NodesByLatencyTierResponse, error) {
+	out := new(NodesByLatencyTierResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodesByLatencyTier", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodesWithRecentWalletChange(ctx context.Context, in *NodesWithRecentWalletChangeRequest) (*NodesWithRecentWalletChangeResponse, error) {
+	out := new(NodesWithRecentWalletChangeResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodesWithRecentWalletChange", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ChurnRate(ctx context.Context, in *ChurnRateRequest) (*ChurnRateResponse, error) {
+	out := new(ChurnRateResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ChurnRate", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ValidatePlacement(ctx context.Context, in *ValidatePlacementRequest) (*ValidatePlacementResponse, error) {
+	out := new(ValidatePlacementResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ValidatePlacement", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodesBelowMinVersion(ctx context.Context, in *NodesBelowMinVersionRequest) (*NodesBelowMinVersionResponse, error) {
+	out := new(NodesBelowMinVersionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodesBelowMinVersion", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) GetReputationThresholds(ctx context.Context, in *GetReputationThresholdsRequest) (*GetReputationThresholdsResponse, error) {
+	out := new(GetReputationThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/GetReputationThresholds", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) StaleGeoNodes(ctx context.Context, in *StaleGeoNodesRequest) (*StaleGeoNodesResponse, error) {
+	out := new(StaleGeoNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/StaleGeoNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodeFreeSpaceTrend(ctx context.Context, in *NodeFreeSpaceTrendRequest) (*NodeFreeSpaceTrendResponse, error) {
+	out := new(NodeFreeSpaceTrendResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodeFreeSpaceTrend", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) PlacementSelectionCounts(ctx context.Context, in *PlacementSelectionCountsRequest) (*PlacementSelectionCountsResponse, error) {
+	out := new(PlacementSelectionCountsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/PlacementSelectionCounts", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ReinstateNode(ctx context.Context, in *ReinstateNodeRequest) (*ReinstateNodeResponse, error) {
+	out := new(ReinstateNodeResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ReinstateNode", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) CapacityByCountry(ctx context.Context, in *CapacityByCountryRequest) (*CapacityByCountryResponse, error) {
+	out := new(CapacityByCountryResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/CapacityByCountry", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) RecentAuditFailures(ctx context.Context, in *RecentAuditFailuresRequest) (*RecentAuditFailuresResponse, error) {
+	out := new(RecentAuditFailuresResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/RecentAuditFailures", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) ClockSkewNodes(ctx context.Context, in *ClockSkewNodesRequest) (*ClockSkewNodesResponse, error) {
+	out := new(ClockSkewNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/ClockSkewNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) CheckExitEligibility(ctx context.Context, in *CheckExitEligibilityRequest) (*CheckExitEligibilityResponse, error) {
+	out := new(CheckExitEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/CheckExitEligibility", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) NodeRepairStats(ctx context.Context, in *NodeRepairStatsRequest) (*NodeRepairStatsResponse, error) {
+	out := new(NodeRepairStatsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/NodeRepairStats", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) TrustingNodes(ctx context.Context, in *TrustingNodesRequest) (*TrustingNodesResponse, error) {
+	out := new(TrustingNodesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/TrustingNodes", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) SubnetSaturationStats(ctx context.Context, in *SubnetSaturationStatsRequest) (*SubnetSaturationStatsResponse, error) {
+	out := new(SubnetSaturationStatsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/SubnetSaturationStats", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) PendingGCStats(ctx context.Context, in *PendingGCStatsRequest) (*PendingGCStatsResponse, error) {
+	out := new(PendingGCStatsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/PendingGCStats", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcOverlayInspectorClient) AuditVsTrafficAnomaly(ctx context.Context, in *AuditVsTrafficAnomalyRequest) (*AuditVsTrafficAnomalyResponse, error) {
+	out := new(AuditVsTrafficAnomalyResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.OverlayInspector/AuditVsTrafficAnomaly", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCOverlayInspectorServer interface {
+	NodesByIP(context.Context, *NodesByIPRequest) (*NodesByIPResponse, error)
+	IPsWithManyNodes(context.Context, *IPsWithManyNodesRequest) (*IPsWithManyNodesResponse, error)
+	ExplainNodeSelection(context.Context, *ExplainNodeSelectionRequest) (*ExplainNodeSelectionResponse, error)
+	TopNodesByStorage(context.Context, *TopNodesByStorageRequest) (*TopNodesByStorageResponse, error)
+	RecalculateReputation(context.Context, *RecalculateReputationRequest) (*RecalculateReputationResponse, error)
+	NodesNearOfflineDQ(context.Context, *NodesNearOfflineDQRequest) (*NodesNearOfflineDQResponse, error)
+	GetOperatorContact(context.Context, *GetOperatorContactRequest) (*GetOperatorContactResponse, error)
+	NodesByUptimeBand(context.Context, *NodesByUptimeBandRequest) (*NodesByUptimeBandResponse, error)
+	CompareNodes(context.Context, *CompareNodesRequest) (*CompareNodesResponse, error)
+	ListExitingNodes(context.Context, *ListExitingNodesRequest) (*ListExitingNodesResponse, error)
+	GetSelectionConfig(context.Context, *GetSelectionConfigRequest) (*GetSelectionConfigResponse, error)
+	NodeCohorts(context.Context, *NodeCohortsRequest) (*NodeCohortsResponse, error)
+	ListContainedNodes(context.Context, *ListContainedNodesRequest) (*ListContainedNodesResponse, error)
+	SelectionFairness(context.Context, *SelectionFairnessRequest) (*SelectionFairnessResponse, error)
+	SpaceDiscrepancyNodes(context.Context, *SpaceDiscrepancyNodesRequest) (*SpaceDiscrepancyNodesResponse, error)
+	NodesByLatencyTier(context.Context, *NodesByLatencyTierRequest) (*NodesByLatencyTierResponse, error)
+	NodesWithRecentWalletChange(context.Context, *NodesWithRecentWalletChangeRequest) (*NodesWithRecentWalletChangeResponse, error)
+	ChurnRate(context.Context, *ChurnRateRequest) (*ChurnRateResponse, error)
+	ValidatePlacement(context.Context, *ValidatePlacementRequest) (*ValidatePlacementResponse, error)
+	NodesBelowMinVersion(context.Context, *NodesBelowMinVersionRequest) (*NodesBelowMinVersionResponse, error)
+	GetReputationThresholds(context.Context, *GetReputationThresholdsRequest) (*GetReputationThresholdsResponse, error)
+	StaleGeoNodes(context.Context, *StaleGeoNodesRequest) (*StaleGeoNodesResponse, error)
+	NodeFreeSpaceTrend(context.Context, *NodeFreeSpaceTrendRequest) (*NodeFreeSpaceTrendResponse, error)
+	PlacementSelectionCounts(context.Context, *PlacementSelectionCountsRequest) (*PlacementSelectionCountsResponse, error)
+	ReinstateNode(context.Context, *ReinstateNodeRequest) (*ReinstateNodeResponse, error)
+	CapacityByCountry(context.Context, *CapacityByCountryRequest) (*CapacityByCountryResponse, error)
+	RecentAuditFailures(context.Context, *RecentAuditFailuresRequest) (*RecentAuditFailuresResponse, error)
+	ClockSkewNodes(context.Context, *ClockSkewNodesRequest) (*ClockSkewNodesResponse, error)
+	CheckExitEligibility(context.Context, *CheckExitEligibilityRequest) (*CheckExitEligibilityResponse, error)
+	NodeRepairStats(context.Context, *NodeRepairStatsRequest) (*NodeRepairStatsResponse, error)
+	TrustingNodes(context.Context, *TrustingNodesRequest) (*TrustingNodesResponse, error)
+	SubnetSaturationStats(context.Context, *SubnetSaturationStatsRequest) (*SubnetSaturationStatsResponse, error)
+	PendingGCStats(context.Context, *PendingGCStatsRequest) (*PendingGCStatsResponse, error)
+	AuditVsTrafficAnomaly(context.Context, *AuditVsTrafficAnomalyRequest) (*AuditVsTrafficAnomalyResponse, error)
+}
+
+type DRPCOverlayInspectorUnimplementedServer struct{}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodesByIP(context.Context, *NodesByIPRequest) (*NodesByIPResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) IPsWithManyNodes(context.Context, *IPsWithManyNodesRequest) (*IPsWithManyNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ExplainNodeSelection(context.Context, *ExplainNodeSelectionRequest) (*ExplainNodeSelectionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) TopNodesByStorage(context.Context, *TopNodesByStorageRequest) (*TopNodesByStorageResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) RecalculateReputation(context.Context, *RecalculateReputationRequest) (*RecalculateReputationResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodesNearOfflineDQ(context.Context, *NodesNearOfflineDQRequest) (*NodesNearOfflineDQResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) GetOperatorContact(context.Context, *GetOperatorContactRequest) (*GetOperatorContactResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodesByUptimeBand(context.Context, *NodesByUptimeBandRequest) (*NodesByUptimeBandResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) CompareNodes(context.Context, *CompareNodesRequest) (*CompareNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ListExitingNodes(context.Context, *ListExitingNodesRequest) (*ListExitingNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) GetSelectionConfig(context.Context, *GetSelectionConfigRequest) (*GetSelectionConfigResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodeCohorts(context.Context, *NodeCohortsRequest) (*NodeCohortsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ListContainedNodes(context.Context, *ListContainedNodesRequest) (*ListContainedNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) SelectionFairness(context.Context, *SelectionFairnessRequest) (*SelectionFairnessResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) SpaceDiscrepancyNodes(context.Context, *SpaceDiscrepancyNodesRequest) (*SpaceDiscrepancyNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodesByLatencyTier(context.Context, *NodesByLatencyTierRequest) (*NodesByLatencyTierResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodesWithRecentWalletChange(context.Context, *NodesWithRecentWalletChangeRequest) (*NodesWithRecentWalletChangeResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ChurnRate(context.Context, *ChurnRateRequest) (*ChurnRateResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ValidatePlacement(context.Context, *ValidatePlacementRequest) (*ValidatePlacementResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodesBelowMinVersion(context.Context, *NodesBelowMinVersionRequest) (*NodesBelowMinVersionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) GetReputationThresholds(context.Context, *GetReputationThresholdsRequest) (*GetReputationThresholdsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) StaleGeoNodes(context.Context, *StaleGeoNodesRequest) (*StaleGeoNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodeFreeSpaceTrend(context.Context, *NodeFreeSpaceTrendRequest) (*NodeFreeSpaceTrendResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) PlacementSelectionCounts(context.Context, *PlacementSelectionCountsRequest) (*PlacementSelectionCountsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ReinstateNode(context.Context, *ReinstateNodeRequest) (*ReinstateNodeResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) CapacityByCountry(context.Context, *CapacityByCountryRequest) (*CapacityByCountryResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) RecentAuditFailures(context.Context, *RecentAuditFailuresRequest) (*RecentAuditFailuresResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) ClockSkewNodes(context.Context, *ClockSkewNodesRequest) (*ClockSkewNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) CheckExitEligibility(context.Context, *CheckExitEligibilityRequest) (*CheckExitEligibilityResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) NodeRepairStats(context.Context, *NodeRepairStatsRequest) (*NodeRepairStatsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) TrustingNodes(context.Context, *TrustingNodesRequest) (*TrustingNodesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) SubnetSaturationStats(context.Context, *SubnetSaturationStatsRequest) (*SubnetSaturationStatsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) PendingGCStats(context.Context, *PendingGCStatsRequest) (*PendingGCStatsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCOverlayInspectorUnimplementedServer) AuditVsTrafficAnomaly(context.Context, *AuditVsTrafficAnomalyRequest) (*AuditVsTrafficAnomalyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCOverlayInspectorDescription struct{}
+
+func (DRPCOverlayInspectorDescription) NumMethods() int { return 34 }
+
+func (DRPCOverlayInspectorDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.inspector.OverlayInspector/NodesByIP", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodesByIP(
+						ctx,
+						in1.(*NodesByIPRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodesByIP, true
+	case 1:
+		return "/satellite.inspector.OverlayInspector/IPsWithManyNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					IPsWithManyNodes(
+						ctx,
+						in1.(*IPsWithManyNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.IPsWithManyNodes, true
+	case 2:
+		return "/satellite.inspector.OverlayInspector/ExplainNodeSelection", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ExplainNodeSelection(
+						ctx,
+						in1.(*ExplainNodeSelectionRequest),
+					)
+			}, DRPCOverlayInspectorServer.ExplainNodeSelection, true
+	case 3:
+		return "/satellite.inspector.OverlayInspector/TopNodesByStorage", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					TopNodesByStorage(
+						ctx,
+						in1.(*TopNodesByStorageRequest),
+					)
+			}, DRPCOverlayInspectorServer.TopNodesByStorage, true
+	case 4:
+		return "/satellite.inspector.OverlayInspector/RecalculateReputation", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					RecalculateReputation(
+						ctx,
+						in1.(*RecalculateReputationRequest),
+					)
+			}, DRPCOverlayInspectorServer.RecalculateReputation, true
+	case 5:
+		return "/satellite.inspector.OverlayInspector/NodesNearOfflineDQ", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodesNearOfflineDQ(
+						ctx,
+						in1.(*NodesNearOfflineDQRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodesNearOfflineDQ, true
+	case 6:
+		return "/satellite.inspector.OverlayInspector/GetOperatorContact", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					GetOperatorContact(
+						ctx,
+						in1.(*GetOperatorContactRequest),
+					)
+			}, DRPCOverlayInspectorServer.GetOperatorContact, true
+	case 7:
+		return "/satellite.inspector.OverlayInspector/NodesByUptimeBand", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodesByUptimeBand(
+						ctx,
+						in1.(*NodesByUptimeBandRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodesByUptimeBand, true
+	case 8:
+		return "/satellite.inspector.OverlayInspector/CompareNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					CompareNodes(
+						ctx,
+						in1.(*CompareNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.CompareNodes, true
+	case 9:
+		return "/satellite.inspector.OverlayInspector/ListExitingNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ListExitingNodes(
+						ctx,
+						in1.(*ListExitingNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.ListExitingNodes, true
+	case 10:
+		return "/satellite.inspector.OverlayInspector/GetSelectionConfig", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					GetSelectionConfig(
+						ctx,
+						in1.(*GetSelectionConfigRequest),
+					)
+			}, DRPCOverlayInspectorServer.GetSelectionConfig, true
+	case 11:
+		return "/satellite.inspector.OverlayInspector/NodeCohorts", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodeCohorts(
+						ctx,
+						in1.(*NodeCohortsRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodeCohorts, true
+	case 12:
+		return "/satellite.inspector.OverlayInspector/ListContainedNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ListContainedNodes(
+						ctx,
+						in1.(*ListContainedNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.ListContainedNodes, true
+	case 13:
+		return "/satellite.inspector.OverlayInspector/SelectionFairness", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					SelectionFairness(
+						ctx,
+						in1.(*SelectionFairnessRequest),
+					)
+			}, DRPCOverlayInspectorServer.SelectionFairness, true
+	case 14:
+		return "/satellite.inspector.OverlayInspector/SpaceDiscrepancyNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					SpaceDiscrepancyNodes(
+						ctx,
+						in1.(*SpaceDiscrepancyNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.SpaceDiscrepancyNodes, true
+	case 15:
+		return "/satellite.inspector.OverlayInspector/NodesByLatencyTier", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodesByLatencyTier(
+						ctx,
+						in1.(*NodesByLatencyTierRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodesByLatencyTier, true
+	case 16:
+		return "/satellite.inspector.OverlayInspector/NodesWithRecentWalletChange", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodesWithRecentWalletChange(
+						ctx,
+						in1.(*NodesWithRecentWalletChangeRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodesWithRecentWalletChange, true
+	case 17:
+		return "/satellite.inspector.OverlayInspector/ChurnRate", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ChurnRate(
+						ctx,
+						in1.(*ChurnRateRequest),
+					)
+			}, DRPCOverlayInspectorServer.ChurnRate, true
+	case 18:
+		return "/satellite.inspector.OverlayInspector/ValidatePlacement", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ValidatePlacement(
+						ctx,
+						in1.(*ValidatePlacementRequest),
+					)
+			}, DRPCOverlayInspectorServer.ValidatePlacement, true
+	case 19:
+		return "/satellite.inspector.OverlayInspector/NodesBelowMinVersion", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodesBelowMinVersion(
+						ctx,
+						in1.(*NodesBelowMinVersionRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodesBelowMinVersion, true
+	case 20:
+		return "/satellite.inspector.OverlayInspector/GetReputationThresholds", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					GetReputationThresholds(
+						ctx,
+						in1.(*GetReputationThresholdsRequest),
+					)
+			}, DRPCOverlayInspectorServer.GetReputationThresholds, true
+	case 21:
+		return "/satellite.inspector.OverlayInspector/StaleGeoNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					StaleGeoNodes(
+						ctx,
+						in1.(*StaleGeoNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.StaleGeoNodes, true
+	case 22:
+		return "/satellite.inspector.OverlayInspector/NodeFreeSpaceTrend", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodeFreeSpaceTrend(
+						ctx,
+						in1.(*NodeFreeSpaceTrendRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodeFreeSpaceTrend, true
+	case 23:
+		return "/satellite.inspector.OverlayInspector/PlacementSelectionCounts", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					PlacementSelectionCounts(
+						ctx,
+						in1.(*PlacementSelectionCountsRequest),
+					)
+			}, DRPCOverlayInspectorServer.PlacementSelectionCounts, true
+	case 24:
+		return "/satellite.inspector.OverlayInspector/ReinstateNode", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ReinstateNode(
+						ctx,
+						in1.(*ReinstateNodeRequest),
+					)
+			}, DRPCOverlayInspectorServer.ReinstateNode, true
+	case 25:
+		return "/satellite.inspector.OverlayInspector/CapacityByCountry", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					CapacityByCountry(
+						ctx,
+						in1.(*CapacityByCountryRequest),
+					)
+			}, DRPCOverlayInspectorServer.CapacityByCountry, true
+	case 26:
+		return "/satellite.inspector.OverlayInspector/RecentAuditFailures", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					RecentAuditFailures(
+						ctx,
+						in1.(*RecentAuditFailuresRequest),
+					)
+			}, DRPCOverlayInspectorServer.RecentAuditFailures, true
+	case 27:
+		return "/satellite.inspector.OverlayInspector/ClockSkewNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					ClockSkewNodes(
+						ctx,
+						in1.(*ClockSkewNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.ClockSkewNodes, true
+	case 28:
+		return "/satellite.inspector.OverlayInspector/CheckExitEligibility", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					CheckExitEligibility(
+						ctx,
+						in1.(*CheckExitEligibilityRequest),
+					)
+			}, DRPCOverlayInspectorServer.CheckExitEligibility, true
+	case 29:
+		return "/satellite.inspector.OverlayInspector/NodeRepairStats", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					NodeRepairStats(
+						ctx,
+						in1.(*NodeRepairStatsRequest),
+					)
+			}, DRPCOverlayInspectorServer.NodeRepairStats, true
+	case 30:
+		return "/satellite.inspector.OverlayInspector/TrustingNodes", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					TrustingNodes(
+						ctx,
+						in1.(*TrustingNodesRequest),
+					)
+			}, DRPCOverlayInspectorServer.TrustingNodes, true
+	case 31:
+		return "/satellite.inspector.OverlayInspector/SubnetSaturationStats", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					SubnetSaturationStats(
+						ctx,
+						in1.(*SubnetSaturationStatsRequest),
+					)
+			}, DRPCOverlayInspectorServer.SubnetSaturationStats, true
+	case 32:
+		return "/satellite.inspector.OverlayInspector/PendingGCStats", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					PendingGCStats(
+						ctx,
+						in1.(*PendingGCStatsRequest),
+					)
+			}, DRPCOverlayInspectorServer.PendingGCStats, true
+	case 33:
+		return "/satellite.inspector.OverlayInspector/AuditVsTrafficAnomaly", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCOverlayInspectorServer).
+					AuditVsTrafficAnomaly(
+						ctx,
+						in1.(*AuditVsTrafficAnomalyRequest),
+					)
+			}, DRPCOverlayInspectorServer.AuditVsTrafficAnomaly, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterOverlayInspector(mux drpc.Mux, impl DRPCOverlayInspectorServer) error {
+	return mux.Register(impl, DRPCOverlayInspectorDescription{})
+}
+
+type DRPCOverlayInspector_NodesByIPStream interface {
+	drpc.Stream
+	SendAndClose(*NodesByIPResponse) error
+}
+
+type drpcOverlayInspector_NodesByIPStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodesByIPStream) SendAndClose(m *NodesByIPResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_IPsWithManyNodesStream interface {
+	drpc.Stream
+	SendAndClose(*IPsWithManyNodesResponse) error
+}
+
+type drpcOverlayInspector_IPsWithManyNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_IPsWithManyNodesStream) SendAndClose(m *IPsWithManyNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ExplainNodeSelectionStream interface {
+	drpc.Stream
+	SendAndClose(*ExplainNodeSelectionResponse) error
+}
+
+type drpcOverlayInspector_ExplainNodeSelectionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ExplainNodeSelectionStream) SendAndClose(m *ExplainNodeSelectionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_TopNodesByStorageStream interface {
+	drpc.Stream
+	SendAndClose(*TopNodesByStorageResponse) error
+}
+
+type drpcOverlayInspector_TopNodesByStorageStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_TopNodesByStorageStream) SendAndClose(m *TopNodesByStorageResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_RecalculateReputationStream interface {
+	drpc.Stream
+	SendAndClose(*RecalculateReputationResponse) error
+}
+
+type drpcOverlayInspector_RecalculateReputationStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_RecalculateReputationStream) SendAndClose(m *RecalculateReputationResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodesNearOfflineDQStream interface {
+	drpc.Stream
+	SendAndClose(*NodesNearOfflineDQResponse) error
+}
+
+type drpcOverlayInspector_NodesNearOfflineDQStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodesNearOfflineDQStream) SendAndClose(m *NodesNearOfflineDQResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_GetOperatorContactStream interface {
+	drpc.Stream
+	SendAndClose(*GetOperatorContactResponse) error
+}
+
+type drpcOverlayInspector_GetOperatorContactStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_GetOperatorContactStream) SendAndClose(m *GetOperatorContactResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodesByUptimeBandStream interface {
+	drpc.Stream
+	SendAndClose(*NodesByUptimeBandResponse) error
+}
+
+type drpcOverlayInspector_NodesByUptimeBandStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodesByUptimeBandStream) SendAndClose(m *NodesByUptimeBandResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_CompareNodesStream interface {
+	drpc.Stream
+	SendAndClose(*CompareNodesResponse) error
+}
+
+type drpcOverlayInspector_CompareNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_CompareNodesStream) SendAndClose(m *CompareNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ListExitingNodesStream interface {
+	drpc.Stream
+	SendAndClose(*ListExitingNodesResponse) error
+}
+
+type drpcOverlayInspector_ListExitingNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ListExitingNodesStream) SendAndClose(m *ListExitingNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_GetSelectionConfigStream interface {
+	drpc.Stream
+	SendAndClose(*GetSelectionConfigResponse) error
+}
+
+type drpcOverlayInspector_GetSelectionConfigStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_GetSelectionConfigStream) SendAndClose(m *GetSelectionConfigResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodeCohortsStream interface {
+	drpc.Stream
+	SendAndClose(*NodeCohortsResponse) error
+}
+
+type drpcOverlayInspector_NodeCohortsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodeCohortsStream) SendAndClose(m *NodeCohortsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ListContainedNodesStream interface {
+	drpc.Stream
+	SendAndClose(*ListContainedNodesResponse) error
+}
+
+type drpcOverlayInspector_ListContainedNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ListContainedNodesStream) SendAndClose(m *ListContainedNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_SelectionFairnessStream interface {
+	drpc.Stream
+	SendAndClose(*SelectionFairnessResponse) error
+}
+
+type drpcOverlayInspector_SelectionFairnessStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_SelectionFairnessStream) SendAndClose(m *SelectionFairnessResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_SpaceDiscrepancyNodesStream interface {
+	drpc.Stream
+	SendAndClose(*SpaceDiscrepancyNodesResponse) error
+}
+
+type drpcOverlayInspector_SpaceDiscrepancyNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_SpaceDiscrepancyNodesStream) SendAndClose(m *SpaceDiscrepancyNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodesByLatencyTierStream interface {
+	drpc.Stream
+	SendAndClose(*NodesByLatencyTierResponse) error
+}
+
+type drpcOverlayInspector_NodesByLatencyTierStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodesByLatencyTierStream) SendAndClose(m *NodesByLatencyTierResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodesWithRecentWalletChangeStream interface {
+	drpc.Stream
+	SendAndClose(*NodesWithRecentWalletChangeResponse) error
+}
+
+type drpcOverlayInspector_NodesWithRecentWalletChangeStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodesWithRecentWalletChangeStream) SendAndClose(m *NodesWithRecentWalletChangeResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ChurnRateStream interface {
+	drpc.Stream
+	SendAndClose(*ChurnRateResponse) error
+}
+
+type drpcOverlayInspector_ChurnRateStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ChurnRateStream) SendAndClose(m *ChurnRateResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ValidatePlacementStream interface {
+	drpc.Stream
+	SendAndClose(*ValidatePlacementResponse) error
+}
+
+type drpcOverlayInspector_ValidatePlacementStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ValidatePlacementStream) SendAndClose(m *ValidatePlacementResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodesBelowMinVersionStream interface {
+	drpc.Stream
+	SendAndClose(*NodesBelowMinVersionResponse) error
+}
+
+type drpcOverlayInspector_NodesBelowMinVersionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodesBelowMinVersionStream) SendAndClose(m *NodesBelowMinVersionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_GetReputationThresholdsStream interface {
+	drpc.Stream
+	SendAndClose(*GetReputationThresholdsResponse) error
+}
+
+type drpcOverlayInspector_GetReputationThresholdsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_GetReputationThresholdsStream) SendAndClose(m *GetReputationThresholdsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_StaleGeoNodesStream interface {
+	drpc.Stream
+	SendAndClose(*StaleGeoNodesResponse) error
+}
+
+type drpcOverlayInspector_StaleGeoNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_StaleGeoNodesStream) SendAndClose(m *StaleGeoNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodeFreeSpaceTrendStream interface {
+	drpc.Stream
+	SendAndClose(*NodeFreeSpaceTrendResponse) error
+}
+
+type drpcOverlayInspector_NodeFreeSpaceTrendStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodeFreeSpaceTrendStream) SendAndClose(m *NodeFreeSpaceTrendResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_PlacementSelectionCountsStream interface {
+	drpc.Stream
+	SendAndClose(*PlacementSelectionCountsResponse) error
+}
+
+type drpcOverlayInspector_PlacementSelectionCountsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_PlacementSelectionCountsStream) SendAndClose(m *PlacementSelectionCountsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ReinstateNodeStream interface {
+	drpc.Stream
+	SendAndClose(*ReinstateNodeResponse) error
+}
+
+type drpcOverlayInspector_ReinstateNodeStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ReinstateNodeStream) SendAndClose(m *ReinstateNodeResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_CapacityByCountryStream interface {
+	drpc.Stream
+	SendAndClose(*CapacityByCountryResponse) error
+}
+
+type drpcOverlayInspector_CapacityByCountryStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_CapacityByCountryStream) SendAndClose(m *CapacityByCountryResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_RecentAuditFailuresStream interface {
+	drpc.Stream
+	SendAndClose(*RecentAuditFailuresResponse) error
+}
+
+type drpcOverlayInspector_RecentAuditFailuresStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_RecentAuditFailuresStream) SendAndClose(m *RecentAuditFailuresResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_ClockSkewNodesStream interface {
+	drpc.Stream
+	SendAndClose(*ClockSkewNodesResponse) error
+}
+
+type drpcOverlayInspector_ClockSkewNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_ClockSkewNodesStream) SendAndClose(m *ClockSkewNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_CheckExitEligibilityStream interface {
+	drpc.Stream
+	SendAndClose(*CheckExitEligibilityResponse) error
+}
+
+type drpcOverlayInspector_CheckExitEligibilityStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_CheckExitEligibilityStream) SendAndClose(m *CheckExitEligibilityResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_NodeRepairStatsStream interface {
+	drpc.Stream
+	SendAndClose(*NodeRepairStatsResponse) error
+}
+
+type drpcOverlayInspector_NodeRepairStatsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_NodeRepairStatsStream) SendAndClose(m *NodeRepairStatsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_TrustingNodesStream interface {
+	drpc.Stream
+	SendAndClose(*TrustingNodesResponse) error
+}
+
+type drpcOverlayInspector_TrustingNodesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_TrustingNodesStream) SendAndClose(m *TrustingNodesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_SubnetSaturationStatsStream interface {
+	drpc.Stream
+	SendAndClose(*SubnetSaturationStatsResponse) error
+}
+
+type drpcOverlayInspector_SubnetSaturationStatsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_SubnetSaturationStatsStream) SendAndClose(m *SubnetSaturationStatsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_PendingGCStatsStream interface {
+	drpc.Stream
+	SendAndClose(*PendingGCStatsResponse) error
+}
+
+type drpcOverlayInspector_PendingGCStatsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_PendingGCStatsStream) SendAndClose(m *PendingGCStatsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCOverlayInspector_AuditVsTrafficAnomalyStream interface {
+	drpc.Stream
+	SendAndClose(*AuditVsTrafficAnomalyResponse) error
+}
+
+type drpcOverlayInspector_AuditVsTrafficAnomalyStream struct {
+	drpc.Stream
+}
+
+func (x *drpcOverlayInspector_AuditVsTrafficAnomalyStream) SendAndClose(m *AuditVsTrafficAnomalyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// ListBucketVerifySegments contains arguments necessary for listing the remote segments of a bucket.
+type ListBucketVerifySegments struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	CursorStreamID uuid.UUID
+	CursorPosition SegmentPosition
+	Limit          int
+}
+
+// ListBucketVerifySegments lists the remote segments of a bucket that haven't expired, in the same order as
+// ListVerifySegments.
+func (db *DB) ListBucketVerifySegments(ctx context.Context, opts ListBucketVerifySegments) (result ListVerifySegmentsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.ProjectID.IsZero():
+		return ListVerifySegmentsResult{}, ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ListVerifySegmentsResult{}, ErrInvalidRequest.New("BucketName missing")
+	case opts.Limit <= 0:
+		return ListVerifySegmentsResult{}, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	ListVerifyLimit.Ensure(&opts.Limit)
+	result.Segments = make([]VerifySegment, 0, opts.Limit)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			segments.stream_id, segments.position,
+			segments.created_at, segments.repaired_at,
+			segments.root_piece_id, segments.redundancy,
+			segments.remote_alias_pieces
+		FROM objects
+		JOIN segments ON segments.stream_id = objects.stream_id
+		WHERE
+			objects.project_id = $1 AND
+			objects.bucket_name = $2 AND
+			(segments.stream_id, segments.position) > ($3, $4) AND
+			segments.inline_data IS NULL AND
+			segments.remote_alias_pieces IS NOT NULL AND
+			(segments.expires_at IS NULL OR segments.expires_at > now())
+		ORDER BY segments.stream_id ASC, segments.position ASC
+		LIMIT $5
+	`, opts.ProjectID, []byte(opts.BucketName), opts.CursorStreamID, opts.CursorPosition, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var seg VerifySegment
+			err := rows.Scan(
+				&seg.StreamID,
+				&seg.Position,
+
+				&seg.CreatedAt,
+				&seg.RepairedAt,
+
+				&seg.RootPieceID,
+				redundancyScheme{&seg.Redundancy},
+				&seg.AliasPieces,
+			)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			result.Segments = append(result.Segments, seg)
+		}
+		return nil
+	})
+
+	return result, Error.Wrap(err)
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListBucketVerifySegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ListBucketVerifySegments{
+				Opts: metabase.ListBucketVerifySegments{
+					BucketName: obj.BucketName,
+					Limit:      1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListBucketVerifySegments{
+				Opts: metabase.ListBucketVerifySegments{
+					ProjectID: obj.ProjectID,
+					Limit:     1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListBucketVerifySegments{
+				Opts: metabase.ListBucketVerifySegments{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Limit:      -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Invalid limit: -1",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("bucket segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_ = metabasetest.CreateObject(ctx, t, db, obj, 3)
+
+			// segments of other buckets and expired segments aren't listed
+			other := metabasetest.RandObjectStream()
+			other.ProjectID = obj.ProjectID
+			_ = metabasetest.CreateObject(ctx, t, db, other, 2)
+
+			expired := metabasetest.RandObjectStream()
+			expired.ProjectID = obj.ProjectID
+			expired.BucketName = obj.BucketName
+			_ = metabasetest.CreateExpiredObject(ctx, t, db, expired, 2, time.Now().Add(-time.Hour))
+
+			expectedSegments := make([]metabase.VerifySegment, 3)
+			for i := range expectedSegments {
+				expectedSegments[i] = defaultVerifySegment(obj.StreamID, uint32(i))
+			}
+
+			metabasetest.ListBucketVerifySegments{
+				Opts: metabase.ListBucketVerifySegments{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Limit:      10,
+				},
+				Result: metabase.ListVerifySegmentsResult{
+					Segments: expectedSegments,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListBucketVerifySegments{
+				Opts: metabase.ListBucketVerifySegments{
+					ProjectID:      obj.ProjectID,
+					BucketName:     obj.BucketName,
+					CursorStreamID: obj.StreamID,
+					CursorPosition: metabase.SegmentPosition{Index: 0},
+					Limit:          1,
+				},
+				Result: metabase.ListVerifySegmentsResult{
+					Segments: expectedSegments[1:2],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListBucketVerifySegments{
+				Opts: metabase.ListBucketVerifySegments{
+					ProjectID:  testrand.UUID(),
+					BucketName: obj.BucketName,
+					Limit:      10,
+				},
+				Result: metabase.ListVerifySegmentsResult{},
+			}.Check(ctx, t, db)
+		})
+	})
+}
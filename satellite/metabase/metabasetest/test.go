@@ -17,6 +17,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -322,6 +323,23 @@ func (step ListVerifySegments) Check(ctx *testcontext.Context, t testing.TB, db
 	require.Zero(t, diff)
 }
 
+// ListBucketVerifySegments is for testing metabase.ListBucketVerifySegments.
+type ListBucketVerifySegments struct {
+	Opts     metabase.ListBucketVerifySegments
+	Result   metabase.ListVerifySegmentsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ListBucketVerifySegments) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ListBucketVerifySegments(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
 // ListObjects is for testing metabase.ListObjects.
 type ListObjects struct {
 	Opts     metabase.ListObjects
@@ -373,6 +391,23 @@ func (step GetStreamPieceCountByNodeID) Check(ctx *testcontext.Context, t testin
 	require.Zero(t, diff)
 }
 
+// GetStreamProjectIDs is for testing metabase.GetStreamProjectIDs.
+type GetStreamProjectIDs struct {
+	Opts     metabase.GetStreamProjectIDs
+	Result   map[uuid.UUID]uuid.UUID
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetStreamProjectIDs) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetStreamProjectIDs(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
 // IterateLoopSegments is for testing metabase.IterateLoopSegments.
 type IterateLoopSegments struct {
 	Opts     metabase.IterateLoopSegments
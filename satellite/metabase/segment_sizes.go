@@ -0,0 +1,73 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// SampleSegmentSizes contains arguments necessary for sampling the sizes of the segments of a project or bucket.
+type SampleSegmentSizes struct {
+	ProjectID  uuid.UUID
+	BucketName string // all buckets of the project are sampled when empty
+
+	// SampleRate is the fraction of the committed objects whose segments are sampled.
+	SampleRate float64
+}
+
+// SegmentSize is the size of a sampled segment.
+type SegmentSize struct {
+	EncryptedSize int32
+	Inline        bool
+}
+
+// SampleSegmentSizes calls fn with the size of every segment of a sample of the committed objects. Objects are
+// sampled by their stream id, which is random, so that the sample is representative and the same rate always samples
+// the same objects.
+func (db *DB) SampleSegmentSizes(ctx context.Context, opts SampleSegmentSizes, fn func(SegmentSize)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.SampleRate <= 0 || opts.SampleRate > 1:
+		return ErrInvalidRequest.New("Invalid sample rate: %v", opts.SampleRate)
+	}
+
+	// stream ids below the threshold make up the sampled fraction of the id space
+	var threshold uuid.UUID
+	if bound := opts.SampleRate * math.Pow(2, 64); bound < math.Pow(2, 64) {
+		binary.BigEndian.PutUint64(threshold[:8], uint64(bound))
+	} else {
+		for i := range threshold {
+			threshold[i] = 0xff
+		}
+	}
+
+	return withRows(db.db.QueryContext(ctx, `
+		SELECT segments.encrypted_size, segments.redundancy = 0
+		FROM objects
+		JOIN segments ON segments.stream_id = objects.stream_id
+		WHERE
+			objects.project_id = $1 AND
+			($2 = '' OR objects.bucket_name = $2) AND
+			objects.status = `+committedStatus+` AND
+			objects.stream_id <= $3
+	`, opts.ProjectID, []byte(opts.BucketName), threshold))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var size SegmentSize
+			err := rows.Scan(&size.EncryptedSize, &size.Inline)
+			if err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+			fn(size)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestSampleSegmentSizes(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		sample := func(opts metabase.SampleSegmentSizes) []metabase.SegmentSize {
+			var sizes []metabase.SegmentSize
+			require.NoError(t, db.SampleSegmentSizes(ctx, opts, func(size metabase.SegmentSize) {
+				sizes = append(sizes, size)
+			}))
+			return sizes
+		}
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			err := db.SampleSegmentSizes(ctx, metabase.SampleSegmentSizes{SampleRate: 1}, func(metabase.SegmentSize) {})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			err = db.SampleSegmentSizes(ctx, metabase.SampleSegmentSizes{ProjectID: testrand.UUID(), SampleRate: 0}, func(metabase.SegmentSize) {})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			err = db.SampleSegmentSizes(ctx, metabase.SampleSegmentSizes{ProjectID: testrand.UUID(), SampleRate: 1.5}, func(metabase.SegmentSize) {})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("project and bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			remote := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, remote, 2)
+
+			inline := metabasetest.RandObjectStream()
+			inline.ProjectID = remote.ProjectID
+			inline.BucketName = "other-bucket"
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: inline,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: inline.Version,
+			}.Check(ctx, t, db)
+			metabasetest.CommitInlineSegment{
+				Opts: metabase.CommitInlineSegment{
+					ObjectStream: inline,
+					InlineData:   []byte{1, 2, 3},
+
+					EncryptedKey:      testrand.Bytes(32),
+					EncryptedKeyNonce: testrand.Bytes(32),
+
+					PlainSize: 3,
+				},
+			}.Check(ctx, t, db)
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{ObjectStream: inline},
+			}.Check(ctx, t, db)
+
+			// neither pending objects nor objects of other projects are sampled
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID = remote.ProjectID
+			pending.BucketName = remote.BucketName
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 1)
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			sizes := sample(metabase.SampleSegmentSizes{ProjectID: remote.ProjectID, SampleRate: 1})
+			require.ElementsMatch(t, []metabase.SegmentSize{
+				{EncryptedSize: 1024},
+				{EncryptedSize: 1024},
+				{EncryptedSize: 3, Inline: true},
+			}, sizes)
+
+			sizes = sample(metabase.SampleSegmentSizes{ProjectID: remote.ProjectID, BucketName: remote.BucketName, SampleRate: 1})
+			require.Equal(t, []metabase.SegmentSize{{EncryptedSize: 1024}, {EncryptedSize: 1024}}, sizes)
+
+			require.Empty(t, sample(metabase.SampleSegmentSizes{ProjectID: testrand.UUID(), SampleRate: 1}))
+		})
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// GetStreamProjectIDs contains arguments for GetStreamProjectIDs.
+type GetStreamProjectIDs struct {
+	StreamIDs []uuid.UUID
+}
+
+// GetStreamProjectIDs returns the ids of the projects owning the objects of the streams, by stream id. Streams without
+// an object are left out.
+func (db *DB) GetStreamProjectIDs(ctx context.Context, opts GetStreamProjectIDs) (result map[uuid.UUID]uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result = make(map[uuid.UUID]uuid.UUID, len(opts.StreamIDs))
+	if len(opts.StreamIDs) == 0 {
+		return result, nil
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT stream_id, project_id
+		FROM objects
+		WHERE stream_id = ANY($1::BYTEA[])
+	`, pgutil.UUIDArray(opts.StreamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID, projectID uuid.UUID
+			if err := rows.Scan(&streamID, &projectID); err != nil {
+				return Error.New("failed to scan objects: %w", err)
+			}
+			result[streamID] = projectID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to fetch stream projects: %w", err)
+	}
+
+	return result, nil
+}
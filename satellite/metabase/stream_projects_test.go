@@ -0,0 +1,50 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestGetStreamProjectIDs(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("no streams", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetStreamProjectIDs{
+				Opts:   metabase.GetStreamProjectIDs{},
+				Result: map[uuid.UUID]uuid.UUID{},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("stream projects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.RandObjectStream()
+			_ = metabasetest.CreateObject(ctx, t, db, first, 1)
+
+			second := metabasetest.RandObjectStream()
+			_ = metabasetest.CreateObject(ctx, t, db, second, 2)
+
+			// streams without an object are left out
+			metabasetest.GetStreamProjectIDs{
+				Opts: metabase.GetStreamProjectIDs{
+					StreamIDs: []uuid.UUID{first.StreamID, second.StreamID, testrand.UUID()},
+				},
+				Result: map[uuid.UUID]uuid.UUID{
+					first.StreamID:  first.ProjectID,
+					second.StreamID: second.ProjectID,
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -223,13 +223,24 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, errMsg)
 	}
 
+	// the nodes signed their piece hashes after the order limits were created, and before the segment was committed
+	committedAt := time.Now()
+
 	pieces := metabase.Pieces{}
+	timestamps := make([]overlay.SignedTimestamp, 0, len(validPieces))
 	for _, result := range validPieces {
 		pieces = append(pieces, metabase.Piece{
 			Number:      uint16(result.PieceNum),
 			StorageNode: result.NodeId,
 		})
+
+		timestamps = append(timestamps, overlay.SignedTimestamp{
+			NodeID:   result.NodeId,
+			SignedAt: result.Hash.Timestamp,
+			Earliest: originalLimits[result.PieceNum].OrderCreation,
+		})
 	}
+	endpoint.overlay.RecordClockSkews(timestamps, committedAt)
 
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
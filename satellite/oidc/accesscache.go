@@ -0,0 +1,208 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// defaultAccessTokenCacheCapacity limits how many access tokens are cached at once when no capacity is configured.
+const defaultAccessTokenCacheCapacity = 10000
+
+// accessTokenCache remembers the access tokens that were looked up for a short while, so that resource servers
+// validating the same token on every request don't look it up in the database each time. Once the cache is full, the
+// expired entries are evicted first, then the least recently used ones.
+//
+// Revoking tokens through the cache invalidates their entries right away. Revocations made elsewhere, such as by
+// another satellite process, are only picked up once the entries expire, so the cache is off unless a ttl is
+// configured.
+type accessTokenCache struct {
+	OAuthTokens
+
+	ttl      time.Duration
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// recent orders the entries from the most to the least recently used.
+	recent *list.List
+	// nextExpiry is the earliest an entry expires at, so that a full cache is only swept once an entry expired.
+	nextExpiry time.Time
+	// generation changes with every invalidation, so that lookups racing an invalidation don't cache what it revoked.
+	generation uint64
+}
+
+func newAccessTokenCache(tokens OAuthTokens, ttl time.Duration, capacity int) *accessTokenCache {
+	if capacity <= 0 {
+		capacity = defaultAccessTokenCacheCapacity
+	}
+
+	return &accessTokenCache{
+		OAuthTokens: tokens,
+		ttl:         ttl,
+		capacity:    capacity,
+		entries:     make(map[string]*list.Element),
+		recent:      list.New(),
+	}
+}
+
+// accessTokenEntry is a cached access token, which is served until the entry expires.
+type accessTokenEntry struct {
+	token     OAuthToken
+	expiresAt time.Time
+}
+
+// Get retrieves access tokens from the cache when they were looked up recently. Other kinds of tokens are always
+// looked up.
+func (cache *accessTokenCache) Get(ctx context.Context, kind OAuthTokenKind, token string) (OAuthToken, error) {
+	if kind != KindAccessToken {
+		return cache.OAuthTokens.Get(ctx, kind, token)
+	}
+
+	cached, generation, ok := cache.lookup(token, time.Now())
+	if ok {
+		mon.Counter("oidc_access_cache_hit").Inc(1)
+		return cached, nil
+	}
+	mon.Counter("oidc_access_cache_miss").Inc(1)
+
+	oauthToken, err := cache.OAuthTokens.Get(ctx, kind, token)
+	if err != nil {
+		return oauthToken, err
+	}
+
+	cache.add(oauthToken, generation, time.Now())
+	return oauthToken, nil
+}
+
+// Expire invalidates the cached token along with shortening its lifetime.
+func (cache *accessTokenCache) Expire(ctx context.Context, kind OAuthTokenKind, token string, expiresAt time.Time) error {
+	if kind == KindAccessToken {
+		defer cache.invalidate(func(cached OAuthToken) bool { return cached.Token == token })
+	}
+	return cache.OAuthTokens.Expire(ctx, kind, token, expiresAt)
+}
+
+// RevokeAllForUser invalidates the cached tokens of the user along with revoking them.
+func (cache *accessTokenCache) RevokeAllForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	defer cache.invalidate(func(cached OAuthToken) bool { return cached.UserID == userID })
+	return cache.OAuthTokens.RevokeAllForUser(ctx, userID)
+}
+
+// RevokeIssuedSince invalidates the cached tokens the client was issued for the user along with revoking them.
+func (cache *accessTokenCache) RevokeIssuedSince(ctx context.Context, userID, clientID uuid.UUID, since time.Time) (int64, error) {
+	defer cache.invalidate(func(cached OAuthToken) bool {
+		return cached.UserID == userID && cached.ClientID == clientID && !cached.CreatedAt.Before(since)
+	})
+	return cache.OAuthTokens.RevokeIssuedSince(ctx, userID, clientID, since)
+}
+
+// DeleteAllForClient invalidates the cached tokens of the client along with deleting them.
+func (cache *accessTokenCache) DeleteAllForClient(ctx context.Context, clientID uuid.UUID) (int64, error) {
+	defer cache.invalidate(func(cached OAuthToken) bool { return cached.ClientID == clientID })
+	return cache.OAuthTokens.DeleteAllForClient(ctx, clientID)
+}
+
+// lookup returns the cached token unless its entry expired, along with the generation to add the token at otherwise.
+func (cache *accessTokenCache) lookup(token string, now time.Time) (_ OAuthToken, generation uint64, ok bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, ok := cache.entries[token]
+	if !ok {
+		return OAuthToken{}, cache.generation, false
+	}
+
+	entry := element.Value.(*accessTokenEntry)
+	if !now.Before(entry.expiresAt) {
+		cache.remove(element)
+		return OAuthToken{}, cache.generation, false
+	}
+
+	cache.recent.MoveToFront(element)
+	cached := entry.token
+	cached.Resources = append([]string(nil), cached.Resources...)
+	return cached, cache.generation, true
+}
+
+// add caches the token until the ttl passes or the token expires, whichever is first, unless the cache was invalidated
+// since the generation the token was looked up at.
+func (cache *accessTokenCache) add(token OAuthToken, generation uint64, now time.Time) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if generation != cache.generation {
+		return
+	}
+
+	if element, ok := cache.entries[token.Token]; ok {
+		cache.remove(element)
+	}
+
+	if len(cache.entries) >= cache.capacity {
+		cache.evictExpired(now)
+	}
+	for len(cache.entries) >= cache.capacity {
+		mon.Counter("oidc_access_cache_evicted").Inc(1)
+		cache.remove(cache.recent.Back())
+	}
+
+	expiresAt := now.Add(cache.ttl)
+	if token.ExpiresAt.Before(expiresAt) {
+		expiresAt = token.ExpiresAt
+	}
+	if cache.nextExpiry.IsZero() || expiresAt.Before(cache.nextExpiry) {
+		cache.nextExpiry = expiresAt
+	}
+
+	token.Resources = append([]string(nil), token.Resources...)
+	cache.entries[token.Token] = cache.recent.PushFront(&accessTokenEntry{token: token, expiresAt: expiresAt})
+	mon.IntVal("oidc_access_cache_size").Observe(int64(len(cache.entries)))
+}
+
+// evictExpired drops the expired entries, unless none expired yet.
+func (cache *accessTokenCache) evictExpired(now time.Time) {
+	if now.Before(cache.nextExpiry) {
+		return
+	}
+
+	cache.nextExpiry = time.Time{}
+	for key, element := range cache.entries {
+		entry := element.Value.(*accessTokenEntry)
+		if !now.Before(entry.expiresAt) {
+			cache.recent.Remove(element)
+			delete(cache.entries, key)
+			continue
+		}
+		if cache.nextExpiry.IsZero() || entry.expiresAt.Before(cache.nextExpiry) {
+			cache.nextExpiry = entry.expiresAt
+		}
+	}
+}
+
+// remove drops the entry.
+func (cache *accessTokenCache) remove(element *list.Element) {
+	cache.recent.Remove(element)
+	delete(cache.entries, element.Value.(*accessTokenEntry).token.Token)
+}
+
+// invalidate drops the cached tokens matching the predicate. It's called once the tokens were revoked, so that they
+// can't be cached again from before their revocation either.
+func (cache *accessTokenCache) invalidate(revoked func(OAuthToken) bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.generation++
+	for _, element := range cache.entries {
+		if revoked(element.Value.(*accessTokenEntry).token) {
+			cache.remove(element)
+		}
+	}
+	mon.IntVal("oidc_access_cache_size").Observe(int64(len(cache.entries)))
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// redactedParams are the request parameters carrying secrets or bearer credentials, whose values are never logged.
+var redactedParams = map[string]bool{
+	"client_secret":    true,
+	"client_assertion": true,
+	"code":             true,
+	"code_verifier":    true,
+	"refresh_token":    true,
+	"access_token":     true,
+	"id_token_hint":    true,
+}
+
+// redactedValue replaces the values of redacted parameters in the access log.
+const redactedValue = "[redacted]"
+
+// AccessLog wraps the oidc handlers, logging every request with its method, path, client, grant type, status and
+// latency when access logging is enabled. Parameters are logged with the values of the ones carrying secrets
+// redacted, while headers, which carry client credentials and bearer tokens, aren't logged at all.
+//
+// Body parameters are only known for the requests whose body the endpoint reads itself.
+func (e *Endpoint) AccessLog(next http.Handler) http.Handler {
+	if e.accessLog == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		entry := &accessLogEntry{}
+		recorder := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), accessLogKey{}, entry)))
+
+		params := url.Values{}
+		for _, values := range []url.Values{r.URL.Query(), entry.form} {
+			for key, value := range values {
+				params[key] = append(params[key], value...)
+			}
+		}
+
+		clientID := params.Get("client_id")
+		if username, _, ok := r.BasicAuth(); ok {
+			clientID = username
+		}
+		grantType := params.Get("grant_type")
+
+		for key, values := range params {
+			if !redactedParams[key] {
+				continue
+			}
+			redacted := make([]string, len(values))
+			for i := range redacted {
+				redacted[i] = redactedValue
+			}
+			params[key] = redacted
+		}
+
+		e.accessLog.Info("oidc request",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.String("client", clientID),
+			zap.String("grant_type", grantType),
+			zap.Int("status", recorder.status()),
+			zap.Duration("latency", time.Since(start)),
+			zap.Any("params", params),
+		)
+	})
+}
+
+// accessLogKey is the context key of the access log entry of a request.
+type accessLogKey struct{}
+
+// accessLogEntry collects what the handlers learn about a request while serving it.
+type accessLogEntry struct {
+	form url.Values
+}
+
+// recordBody records the parameters of form encoded request bodies, when access logging is enabled.
+func recordBody(r *http.Request, body []byte) {
+	entry, ok := r.Context().Value(accessLogKey{}).(*accessLogEntry)
+	if !ok {
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/x-www-form-urlencoded" {
+		return
+	}
+
+	// malformed bodies are rejected by the handler, the parameters parsed until then are still logged
+	entry.form, _ = url.ParseQuery(string(body))
+}
+
+// statusRecorder remembers the status code of the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *statusRecorder) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write implements http.ResponseWriter.
+func (w *statusRecorder) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// status returns the status code the response was sent with, which is 200 when the handler didn't write anything.
+func (w *statusRecorder) status() int {
+	if w.statusCode == 0 {
+		return http.StatusOK
+	}
+	return w.statusCode
+}
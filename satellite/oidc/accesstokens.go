@@ -0,0 +1,103 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/golang-jwt/jwt"
+
+	"storj.io/common/uuid"
+)
+
+// AccessTokenFormat is the format of the access tokens issued to a client.
+type AccessTokenFormat string
+
+const (
+	// AccessTokenMacaroon issues access tokens as macaroons, which are accepted as api keys by uplinks and the
+	// gateway.
+	AccessTokenMacaroon AccessTokenFormat = "macaroon"
+	// AccessTokenJWT issues access tokens as jwts signed with the client's secret, which relying parties can validate
+	// without asking the provider.
+	AccessTokenJWT AccessTokenFormat = "jwt"
+)
+
+// accessTokenJWTType is the type of jwt access tokens, which keeps them from being mistaken for id tokens.
+const accessTokenJWTType = "at+jwt"
+
+// AccessTokenFormats maps oauth client ids onto the format of the access tokens they are issued.
+type AccessTokenFormats map[uuid.UUID]AccessTokenFormat
+
+// Type implements pflag.Value.
+func (AccessTokenFormats) Type() string { return "oidc.AccessTokenFormats" }
+
+// String is required for pflag.Value.
+func (formats *AccessTokenFormats) String() string {
+	data, err := json.Marshal(*formats)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (formats *AccessTokenFormats) Set(s string) (err error) {
+	parsed := make(AccessTokenFormats)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	for clientID, format := range parsed {
+		if format != AccessTokenMacaroon && format != AccessTokenJWT {
+			return Error.New("client %s: unknown access token format %q", clientID, format)
+		}
+	}
+
+	*formats = parsed
+	return nil
+}
+
+// lookup returns the access token format of the client. Clients without an entry are issued macaroons.
+func (formats AccessTokenFormats) lookup(clientID uuid.UUID) AccessTokenFormat {
+	if format, ok := formats[clientID]; ok {
+		return format
+	}
+	return AccessTokenMacaroon
+}
+
+// jwtAccessToken issues the access token as a jwt signed with the client's secret. It carries the granted scope and
+// expires with the macaroon the grant is backed by, which the jwt doesn't reveal. The token is for the client itself,
+// unless it's issued for resources.
+func (a *MacaroonAccessGenerate) jwtAccessToken(data *oauth2.GenerateBasic, client OAuthClient, id uuid.UUID, createAt, expireAt time.Time, resources []string) (string, error) {
+	var audience interface{} = client.ID.String()
+	switch len(resources) {
+	case 0:
+	case 1:
+		audience = resources[0]
+	default:
+		audience = resources
+	}
+
+	claims := jwt.MapClaims{
+		"iss":       a.Issuer,
+		"sub":       data.UserID,
+		"aud":       audience,
+		"client_id": client.ID.String(),
+		"scope":     data.TokenInfo.GetScope(),
+		"jti":       id.String(),
+		"iat":       createAt.Unix(),
+		"nbf":       createAt.Add(-a.ClockSkew).Unix(),
+		"exp":       expireAt.Unix(),
+	}
+
+	return signJWT(claims, accessTokenJWTType, client)
+}
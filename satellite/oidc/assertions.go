@@ -0,0 +1,271 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/golang-jwt/jwt"
+)
+
+// clientAssertionType is the client_assertion_type of token requests authenticating the client with a jwt signed with
+// its secret (client_secret_jwt).
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// inboundJWT is what a jwt a client sent to the provider is verified against, after its signature was.
+type inboundJWT struct {
+	// issuer is the iss the token must carry.
+	issuer string
+	// subject is the sub the token must carry, any subject is accepted when it's empty.
+	subject string
+	// audiences are the values of which the aud of the token must contain at least one.
+	audiences []string
+	// requireExpiry rejects tokens without an exp, and tokens past it beyond the clock skew.
+	requireExpiry bool
+	// replays rejects tokens without a jti, or whose jti was sent before, when it's set.
+	replays *jtiReplays
+}
+
+// parseInbound verifies the jwt a client sent to the provider was signed with one of the keys returned for its
+// unverified claims, and returns its claims along with the secret of the key. Keys with another kid than the token are
+// skipped when it has one, otherwise every key is tried. The claims still have to be verified with verifyInbound.
+func parseInbound(raw string, keys func(claims jwt.MapClaims) ([]signingKey, error)) (_ jwt.MapClaims, secret []byte, err error) {
+	parser := jwt.Parser{
+		ValidMethods:         []string{userInfoSigningAlg},
+		SkipClaimsValidation: true,
+	}
+
+	unverified := jwt.MapClaims{}
+	token, _, err := parser.ParseUnverified(raw, unverified)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// logout and access tokens are signed the same way, but are never sent back to us
+	if typ, _ := token.Header["typ"].(string); typ == "logout+jwt" || typ == accessTokenJWTType {
+		return nil, nil, Error.New("unexpected token type %q", typ)
+	}
+
+	candidates, err := keys(unverified)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if kid, ok := token.Header["kid"].(string); ok {
+		var matching []signingKey
+		for _, key := range candidates {
+			// secrets stored before kids were can't be told apart by kid
+			if key.id == kid || key.id == "" {
+				matching = append(matching, key)
+			}
+		}
+		candidates = matching
+	}
+
+	err = Error.New("no key to verify the token with")
+	for _, key := range candidates {
+		claims := jwt.MapClaims{}
+		_, err = parser.ParseWithClaims(raw, claims, func(*jwt.Token) (interface{}, error) {
+			return key.secret, nil
+		})
+		if err == nil {
+			return claims, key.secret, nil
+		}
+	}
+	return nil, nil, err
+}
+
+// verifyInbound strictly checks the claims of a jwt a client sent to the provider against what's expected of it. A
+// jti is only remembered once every other check passed.
+func (e *Endpoint) verifyInbound(claims jwt.MapClaims, expected inboundJWT, now time.Time) error {
+	if iss, _ := claims["iss"].(string); iss != expected.issuer {
+		return Error.New("unexpected issuer %q", iss)
+	}
+	if sub, _ := claims["sub"].(string); expected.subject != "" && sub != expected.subject {
+		return Error.New("unexpected subject %q", sub)
+	}
+
+	addressed := false
+	for _, audience := range expected.audiences {
+		if claims.VerifyAudience(audience, true) {
+			addressed = true
+			break
+		}
+	}
+	if !addressed {
+		return Error.New("token not addressed to us")
+	}
+
+	if expected.requireExpiry && !claims.VerifyExpiresAt(now.Add(-e.clockSkew).Unix(), true) {
+		return Error.New("token expired")
+	}
+
+	err := e.verifyIssued(claims, now)
+	if err != nil {
+		return err
+	}
+
+	if expected.replays != nil {
+		jti, _ := claims["jti"].(string)
+		if jti == "" {
+			return Error.New("token has no jti")
+		}
+
+		// tokens without an expiry are remembered for as long as the process runs
+		var expiresAt time.Time
+		if exp, ok := claims["exp"].(float64); ok {
+			expiresAt = time.Unix(int64(exp), 0).Add(e.clockSkew)
+		}
+		claimed, err := expected.replays.claim(expected.issuer+" "+jti, expiresAt, now)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return Error.New("token replayed")
+		}
+	}
+	return nil
+}
+
+// defaultMaxReplays limits how many jti are remembered at once when no limit is configured.
+const defaultMaxReplays = 100000
+
+// errReplaysFull is returned for tokens that can't be checked for replays, because as many unexpired jti as can be are
+// remembered already. Forgetting one would let it be replayed, so the token is rejected until one expires.
+var errReplaysFull = errors.New("too many unexpired tokens to detect replays of")
+
+// jtiReplays remembers the jti of the tokens clients sent until the tokens expire, so that they can't be sent again.
+//
+// The jti are in-process, every satellite process only knows about the tokens it received itself.
+type jtiReplays struct {
+	capacity int
+
+	mu   sync.Mutex
+	seen map[string]time.Time // zero when the token doesn't expire
+	// nextExpiry is the earliest a jti expires at, so that the jti are only swept once one expired.
+	nextExpiry time.Time
+}
+
+func newJTIReplays(capacity int) *jtiReplays {
+	if capacity <= 0 {
+		capacity = defaultMaxReplays
+	}
+	return &jtiReplays{capacity: capacity, seen: map[string]time.Time{}}
+}
+
+// claim remembers the jti until expiresAt, and reports whether it wasn't remembered yet. It fails with errReplaysFull
+// when the jti can't be remembered.
+func (replays *jtiReplays) claim(jti string, expiresAt, now time.Time) (bool, error) {
+	replays.mu.Lock()
+	defer replays.mu.Unlock()
+
+	if !replays.nextExpiry.IsZero() && now.After(replays.nextExpiry) {
+		replays.nextExpiry = time.Time{}
+		for seen, seenExpiresAt := range replays.seen {
+			switch {
+			case seenExpiresAt.IsZero():
+			case now.After(seenExpiresAt):
+				delete(replays.seen, seen)
+			case replays.nextExpiry.IsZero() || seenExpiresAt.Before(replays.nextExpiry):
+				replays.nextExpiry = seenExpiresAt
+			}
+		}
+	}
+
+	if _, ok := replays.seen[jti]; ok {
+		return false, nil
+	}
+	if len(replays.seen) >= replays.capacity {
+		mon.Counter("oidc_jti_replays_full").Inc(1)
+		return false, errReplaysFull
+	}
+
+	replays.seen[jti] = expiresAt
+	if !expiresAt.IsZero() && (replays.nextExpiry.IsZero() || expiresAt.Before(replays.nextExpiry)) {
+		replays.nextExpiry = expiresAt
+	}
+	mon.IntVal("oidc_jti_replays_size").Observe(int64(len(replays.seen)))
+	return true, nil
+}
+
+// clientAssertions authenticates clients at the token endpoint with a client_assertion signed with their secret, as
+// client_secret_jwt of OpenID Connect Core 9.
+type clientAssertions struct {
+	endpoint  *Endpoint
+	audiences []string
+	replays   *jtiReplays
+}
+
+// clientInfo returns the client of the assertion along with the secret it was signed with, which the manager then
+// verifies as any other client secret. Requests without an assertion are authenticated with basic auth.
+func (assertions *clientAssertions) clientInfo(r *http.Request) (clientID, clientSecret string, err error) {
+	assertionType := r.FormValue("client_assertion_type")
+	assertion := r.FormValue("client_assertion")
+	if assertionType == "" && assertion == "" {
+		return server.ClientBasicHandler(r)
+	}
+	if assertionType != clientAssertionType || assertion == "" {
+		return "", "", oauth2errors.ErrInvalidClient
+	}
+
+	clientID, secret, err := assertions.verify(r.Context(), assertion)
+	if isTransient(err) {
+		return "", "", err
+	}
+	if err != nil {
+		mon.Counter("oidc_invalid_client_assertion").Inc(1)
+		return "", "", oauth2errors.ErrInvalidClient
+	}
+
+	if id := r.FormValue("client_id"); id != "" && id != clientID {
+		return "", "", oauth2errors.ErrInvalidClient
+	}
+	return clientID, string(secret), nil
+}
+
+// verify verifies the assertion was issued by the client it's about, for this provider, and returns the client along
+// with the secret it was signed with.
+func (assertions *clientAssertions) verify(ctx context.Context, assertion string) (clientID string, secret []byte, err error) {
+	claims, secret, err := parseInbound(assertion, func(claims jwt.MapClaims) ([]signingKey, error) {
+		clientID, _ = claims["iss"].(string)
+
+		info, err := assertions.endpoint.clientStore.GetByID(ctx, clientID)
+		if err != nil {
+			return nil, err
+		}
+
+		client, ok := info.(OAuthClient)
+		if !ok || len(client.Secret) == 0 {
+			return nil, Error.New("client has no secret to sign assertions with")
+		}
+
+		keys := []signingKey{{id: client.SecretKeyID, secret: client.Secret}}
+		// the previous secret still authenticates the client while the secret is rotated
+		if len(client.PreviousSecret) > 0 && client.PreviousSecretExpiresAt != nil && time.Now().Before(*client.PreviousSecretExpiresAt) {
+			keys = append(keys, signingKey{id: client.PreviousSecretKeyID, secret: client.PreviousSecret})
+		}
+		return keys, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = assertions.endpoint.verifyInbound(claims, inboundJWT{
+		issuer:        clientID,
+		subject:       clientID,
+		audiences:     assertions.audiences,
+		requireExpiry: true,
+		replays:       assertions.replays,
+	}, time.Now())
+	if err != nil {
+		return "", nil, err
+	}
+	return clientID, secret, nil
+}
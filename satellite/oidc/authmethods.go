@@ -0,0 +1,162 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/server"
+
+	"storj.io/common/uuid"
+)
+
+// TokenEndpointAuthMethod is how a client authenticates at the token endpoint.
+type TokenEndpointAuthMethod string
+
+const (
+	// ClientSecretBasic authenticates with the client secret in the basic auth header.
+	ClientSecretBasic TokenEndpointAuthMethod = "client_secret_basic"
+	// ClientSecretPost authenticates with the client secret in the request body. Only clients configured for it may
+	// use it.
+	ClientSecretPost TokenEndpointAuthMethod = "client_secret_post"
+	// ClientSecretJWT authenticates with a client assertion signed with the client secret.
+	ClientSecretJWT TokenEndpointAuthMethod = "client_secret_jwt"
+)
+
+// defaultAuthMethods are the methods clients without a configured method may authenticate with.
+var defaultAuthMethods = []TokenEndpointAuthMethod{ClientSecretBasic, ClientSecretJWT}
+
+// TokenEndpointAuthMethods maps oauth client ids onto the only method they may authenticate at the token endpoint
+// with.
+type TokenEndpointAuthMethods map[uuid.UUID]TokenEndpointAuthMethod
+
+// Type implements pflag.Value.
+func (TokenEndpointAuthMethods) Type() string { return "oidc.TokenEndpointAuthMethods" }
+
+// String is required for pflag.Value.
+func (methods *TokenEndpointAuthMethods) String() string {
+	data, err := json.Marshal(*methods)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (methods *TokenEndpointAuthMethods) Set(s string) (err error) {
+	parsed := make(TokenEndpointAuthMethods)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	for clientID, method := range parsed {
+		switch method {
+		case ClientSecretBasic, ClientSecretPost, ClientSecretJWT:
+		default:
+			return Error.New("client %s: unknown token endpoint auth method %q", clientID, method)
+		}
+	}
+
+	*methods = parsed
+	return nil
+}
+
+// allows reports whether the client may authenticate with the method.
+func (methods TokenEndpointAuthMethods) allows(clientID string, method TokenEndpointAuthMethod) bool {
+	if id, err := uuid.FromString(clientID); err == nil {
+		if configured, ok := methods[id]; ok {
+			return method == configured
+		}
+	}
+
+	for _, allowed := range defaultAuthMethods {
+		if method == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// advertised returns the methods any client may authenticate with, in the order they're preferred.
+func (methods TokenEndpointAuthMethods) advertised() []string {
+	names := make([]string, 0, len(defaultAuthMethods)+1)
+	for _, method := range defaultAuthMethods {
+		names = append(names, string(method))
+	}
+	for _, method := range methods {
+		if method == ClientSecretPost {
+			return append(names, string(ClientSecretPost))
+		}
+	}
+	return names
+}
+
+// requestAuthMethod returns the method the token request authenticates the client with. Requests using more than one
+// method are rejected, as RFC 6749 2.3 requires.
+func requestAuthMethod(r *http.Request) (TokenEndpointAuthMethod, error) {
+	var used []TokenEndpointAuthMethod
+	if _, _, ok := r.BasicAuth(); ok {
+		used = append(used, ClientSecretBasic)
+	}
+	if _, ok := r.PostForm["client_secret"]; ok {
+		used = append(used, ClientSecretPost)
+	}
+	if r.FormValue("client_assertion_type") != "" || r.FormValue("client_assertion") != "" {
+		used = append(used, ClientSecretJWT)
+	}
+
+	switch len(used) {
+	case 0:
+		// the basic auth handler rejects the request for lacking credentials
+		return ClientSecretBasic, nil
+	case 1:
+		return used[0], nil
+	default:
+		return "", oauth2errors.ErrInvalidClient
+	}
+}
+
+// clientAuthentication authenticates clients at the token endpoint with the method the request uses, and rejects
+// clients using a method they may not use.
+type clientAuthentication struct {
+	methods    TokenEndpointAuthMethods
+	assertions *clientAssertions
+}
+
+// clientInfo implements server.ClientInfoHandler.
+func (auth *clientAuthentication) clientInfo(r *http.Request) (clientID, clientSecret string, err error) {
+	method, err := requestAuthMethod(r)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch method {
+	case ClientSecretJWT:
+		clientID, clientSecret, err = auth.assertions.clientInfo(r)
+	case ClientSecretPost:
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		if clientID == "" {
+			err = oauth2errors.ErrInvalidClient
+		}
+	default:
+		clientID, clientSecret, err = server.ClientBasicHandler(r)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	if !auth.methods.allows(clientID, method) {
+		mon.Counter("oidc_client_auth_method_rejected").Inc(1)
+		return "", "", oauth2errors.ErrInvalidClient
+	}
+	return clientID, clientSecret, nil
+}
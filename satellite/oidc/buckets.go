@@ -0,0 +1,96 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// liveBucketsScope requests that user info lists the buckets currently in the granted project, rather than the
+// buckets named when the grant was issued.
+const liveBucketsScope = "storj:buckets"
+
+// defaultUserInfoBucketLimit caps the listed buckets when no limit is configured.
+const defaultUserInfoBucketLimit = 100
+
+// listLiveBuckets replaces the granted buckets of the user info with the buckets that currently exist in the project.
+// When the grant was restricted to specific buckets, only those that still exist are listed. The granted buckets are
+// kept when the lookup fails.
+func (e *Endpoint) listLiveBuckets(ctx context.Context, user *console.User, info *UserInfo, allowed macaroon.AllowedBuckets) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(info.Project)
+	if err != nil {
+		e.log.Warn("unable to list buckets without a project", zap.Stringer("user", user.ID), zap.Error(err))
+		return
+	}
+
+	limit := e.bucketLimit
+	if limit <= 0 {
+		limit = defaultUserInfoBucketLimit
+	}
+
+	buckets, more, err := e.service.ListBucketNames(console.WithUser(ctx, user), projectID, allowed, limit)
+	if err != nil {
+		e.log.Warn("failed to list buckets, using the granted buckets instead",
+			zap.Stringer("user", user.ID), zap.Stringer("project", projectID), zap.Error(err))
+		return
+	}
+
+	info.Buckets = buckets
+	info.BucketsTruncated = more
+}
+
+// grantedBuckets returns the buckets the caveats of an access token allow. Every caveat applies to the token, so a
+// bucket is only allowed when every caveat restricting the buckets allows it.
+func grantedBuckets(caveats []macaroon.Caveat) macaroon.AllowedBuckets {
+	allowed := macaroon.AllowedBuckets{All: true}
+	for _, caveat := range caveats {
+		if len(caveat.AllowedPaths) == 0 {
+			continue
+		}
+
+		buckets := make(map[string]struct{}, len(caveat.AllowedPaths))
+		for _, path := range caveat.AllowedPaths {
+			bucket := string(path.Bucket)
+			if _, ok := allowed.Buckets[bucket]; allowed.All || ok {
+				buckets[bucket] = struct{}{}
+			}
+		}
+		allowed = macaroon.AllowedBuckets{Buckets: buckets}
+	}
+	return allowed
+}
+
+// filterBuckets returns the buckets that are allowed, without repeating any.
+func filterBuckets(buckets []string, allowed macaroon.AllowedBuckets) []string {
+	seen := make(map[string]bool, len(buckets))
+	filtered := buckets[:0:0]
+	for _, bucket := range buckets {
+		if _, ok := allowed.Buckets[bucket]; seen[bucket] || !(allowed.All || ok) {
+			continue
+		}
+		seen[bucket] = true
+		filtered = append(filtered, bucket)
+	}
+	return filtered
+}
+
+// hasScope reports whether the scope was granted.
+func hasScope(granted, scope string) bool {
+	for _, s := range strings.Fields(granted) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// userClaims are the claims a template may draw from the user or the granted project.
+var userClaims = map[string]func(user *console.User, project *console.Project) interface{}{
+	"name":      func(user *console.User, _ *console.Project) interface{} { return user.FullName },
+	"nickname":  func(user *console.User, _ *console.Project) interface{} { return user.ShortName },
+	"company":   func(user *console.User, _ *console.Project) interface{} { return user.CompanyName },
+	"position":  func(user *console.User, _ *console.Project) interface{} { return user.Position },
+	"paid_tier": func(user *console.User, _ *console.Project) interface{} { return user.PaidTier },
+	"project_name": func(_ *console.User, project *console.Project) interface{} {
+		if project == nil {
+			return nil
+		}
+		return project.Name
+	},
+}
+
+// reservedClaims are always derived by the provider and can't be set by a template.
+var reservedClaims = map[string]bool{
+	"sub": true, "email": true, "email_verified": true,
+	"project": true, "buckets": true, "buckets_truncated": true, "cubbyhole": true,
+	"iss": true, "aud": true, "exp": true, "iat": true, "nonce": true,
+}
+
+// ClaimTemplate describes the additional claims a client receives about the user. It's registered with the client.
+type ClaimTemplate struct {
+	// Claims lists the user or project claims to include.
+	Claims []string `json:"claims,omitempty"`
+	// Static claims are included with the registered values.
+	Static map[string]interface{} `json:"static,omitempty"`
+}
+
+// IsEmpty reports whether the template adds no claims, which is how a registered template is removed.
+func (template ClaimTemplate) IsEmpty() bool {
+	return len(template.Claims) == 0 && len(template.Static) == 0
+}
+
+// Validate ensures the template only requests claims the provider is able to supply and doesn't shadow the claims it
+// derives itself.
+func (template ClaimTemplate) Validate() error {
+	for _, claim := range template.Claims {
+		if _, ok := userClaims[claim]; !ok {
+			return Error.New("unknown claim %q", claim)
+		}
+	}
+
+	names := make([]string, 0, len(template.Static))
+	for name := range template.Static {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == "" {
+			return Error.New("static claims must be named")
+		}
+		if _, ok := userClaims[name]; ok || reservedClaims[name] || strings.HasPrefix(name, customClaimPrefix) {
+			return Error.New("static claim %q is reserved", name)
+		}
+	}
+
+	return nil
+}
+
+// claims renders the template for the user. The project is only looked up when the template asks for it and the
+// client was granted one.
+func (template ClaimTemplate) claims(ctx context.Context, service *console.Service, user *console.User, projectID string) (map[string]interface{}, error) {
+	claims := make(map[string]interface{}, len(template.Claims)+len(template.Static))
+	for name, value := range template.Static {
+		claims[name] = value
+	}
+
+	var project *console.Project
+	for _, claim := range template.Claims {
+		if claim == "project_name" && project == nil && projectID != "" {
+			id, err := uuid.FromString(projectID)
+			if err != nil {
+				return nil, err
+			}
+
+			project, err = service.GetProject(console.WithUser(ctx, user), id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if value := userClaims[claim](user, project); value != nil {
+			claims[claim] = value
+		}
+	}
+
+	return claims, nil
+}
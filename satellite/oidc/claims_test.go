@@ -0,0 +1,60 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/oidc"
+)
+
+func TestClaimTemplateValidate(t *testing.T) {
+	require.True(t, oidc.ClaimTemplate{}.IsEmpty())
+
+	template := oidc.ClaimTemplate{
+		Claims: []string{"name", "project_name"},
+		Static: map[string]interface{}{"groups": []string{"admins"}, "tenant": "acme"},
+	}
+	require.NoError(t, template.Validate())
+	require.False(t, template.IsEmpty())
+
+	for name, invalid := range map[string]oidc.ClaimTemplate{
+		"unknown claim": {Claims: []string{"password_hash"}},
+		"reserved":      {Static: map[string]interface{}{"email": "spoofed@mail.test"}},
+		"shadowing":     {Static: map[string]interface{}{"name": "spoofed"}},
+		"unnamed":       {Static: map[string]interface{}{"": "unnamed"}},
+		"custom prefix": {Static: map[string]interface{}{"storj_project_id": "spoofed"}},
+	} {
+		require.Error(t, invalid.Validate(), name)
+	}
+}
+
+func TestUserInfoClaims(t *testing.T) {
+	info := oidc.UserInfo{
+		Subject: testrand.UUID(),
+		Email:   "u@mail.test",
+		Claims: map[string]interface{}{
+			"tenant": "acme",
+			"email":  "spoofed@mail.test",
+		},
+	}
+
+	data, err := json.Marshal(info)
+	require.NoError(t, err)
+
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &claims))
+	require.Equal(t, "acme", claims["tenant"])
+	require.Equal(t, "u@mail.test", claims["email"])
+	require.Equal(t, info.Subject.String(), claims["sub"])
+
+	var decoded oidc.UserInfo
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, info.Subject, decoded.Subject)
+	require.Nil(t, decoded.Claims)
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// setIssued stamps a token issued now. The token becomes valid clockSkew before it was issued, so that clients whose
+// clock is behind ours don't reject it.
+func (e *Endpoint) setIssued(claims jwt.MapClaims, now time.Time) {
+	claims["iat"] = now.Unix()
+	claims["nbf"] = now.Add(-e.clockSkew).Unix()
+}
+
+// verifyIssued checks that a token we received wasn't issued, and doesn't become valid, further in the future than the
+// clocks of clients may drift from ours.
+func (e *Endpoint) verifyIssued(claims jwt.MapClaims, now time.Time) error {
+	latest := now.Add(e.clockSkew).Unix()
+
+	if !claims.VerifyIssuedAt(latest, false) {
+		return Error.New("token issued in the future")
+	}
+	if !claims.VerifyNotBefore(latest, false) {
+		return Error.New("token not valid yet")
+	}
+	return nil
+}
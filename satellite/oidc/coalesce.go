@@ -0,0 +1,124 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+)
+
+// refreshCoalescing rotates a refresh token only once when it's refreshed many times at once, such as by a fleet of
+// servers sharing a session. Refreshes of the same token by the same client arriving while it's rotated, or within the
+// window after, receive the tokens of that rotation, rather than each rotating the token into a different replacement.
+//
+// Refreshes are only coalesced when they're identical, including the client secret, so that a refresh never hands out
+// tokens the client couldn't have been issued on its own. Failed rotations are only shared with the refreshes waiting
+// for them.
+type refreshCoalescing struct {
+	oauth2.Manager
+
+	window time.Duration
+
+	mu        sync.Mutex
+	refreshes map[string]*coalescedRefresh
+}
+
+func newRefreshCoalescing(manager oauth2.Manager, window time.Duration) *refreshCoalescing {
+	return &refreshCoalescing{
+		Manager:   manager,
+		window:    window,
+		refreshes: make(map[string]*coalescedRefresh),
+	}
+}
+
+// coalescedRefresh is a rotation of a refresh token, which is shared until its window passes once it's done.
+type coalescedRefresh struct {
+	done chan struct{}
+	info oauth2.TokenInfo
+	err  error
+
+	complete  bool
+	expiresAt time.Time
+}
+
+// GenerateAccessToken shares the rotation of refresh tokens that are being refreshed already, or were refreshed within
+// the window. Other grants are passed through.
+func (coalescing *refreshCoalescing) GenerateAccessToken(ctx context.Context, gt oauth2.GrantType, tgr *oauth2.TokenGenerateRequest) (oauth2.TokenInfo, error) {
+	if gt != oauth2.Refreshing {
+		return coalescing.Manager.GenerateAccessToken(ctx, gt, tgr)
+	}
+
+	key := refreshKey(ctx, tgr)
+
+	refresh, leader := coalescing.join(key, time.Now())
+	if !leader {
+		mon.Counter("oidc_refresh_coalesced").Inc(1)
+
+		select {
+		case <-refresh.done:
+			return refresh.info, refresh.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	refresh.info, refresh.err = coalescing.Manager.GenerateAccessToken(ctx, gt, tgr)
+	coalescing.finish(key, refresh, time.Now())
+	return refresh.info, refresh.err
+}
+
+// join returns the rotation of the refresh, and whether the caller is to rotate the token because there's none yet.
+func (coalescing *refreshCoalescing) join(key string, now time.Time) (_ *coalescedRefresh, leader bool) {
+	coalescing.mu.Lock()
+	defer coalescing.mu.Unlock()
+
+	for k, refresh := range coalescing.refreshes {
+		if refresh.complete && !now.Before(refresh.expiresAt) {
+			delete(coalescing.refreshes, k)
+		}
+	}
+
+	if refresh, ok := coalescing.refreshes[key]; ok {
+		return refresh, false
+	}
+
+	refresh := &coalescedRefresh{done: make(chan struct{})}
+	coalescing.refreshes[key] = refresh
+	return refresh, true
+}
+
+// finish shares the rotation for the window when it succeeded, and forgets it otherwise, before releasing the
+// refreshes waiting for it.
+func (coalescing *refreshCoalescing) finish(key string, refresh *coalescedRefresh, now time.Time) {
+	coalescing.mu.Lock()
+	if refresh.err != nil {
+		delete(coalescing.refreshes, key)
+	}
+	refresh.complete = true
+	refresh.expiresAt = now.Add(coalescing.window)
+	coalescing.mu.Unlock()
+
+	close(refresh.done)
+}
+
+// refreshKey identifies identical refreshes. It's hashed so that the tokens and secrets aren't kept around as map keys.
+func refreshKey(ctx context.Context, tgr *oauth2.TokenGenerateRequest) string {
+	var resources []string
+	if req := resourceRequestFrom(ctx); req != nil {
+		resources = req.requested
+	}
+
+	hash := sha256.New()
+	for _, part := range []string{tgr.ClientID, tgr.ClientSecret, tgr.Refresh, tgr.Scope, strings.Join(resources, " ")} {
+		_, _ = hash.Write([]byte(part))
+		_, _ = hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"storj.io/common/memory"
+)
+
+// Config defines configuration for the OIDC identity provider.
+type Config struct {
+	RoutePrefix  string       `help:"path prefix the oidc routes are mounted under, for when the console is served from a subpath" default:""`
+	ScopeCaveats ScopeCaveats `help:"json mapping of custom oauth scopes to the macaroon caveats they imply" default:"{}"`
+
+	CodeExpiry             time.Duration `help:"how long oauth authorization codes are issued for" default:"10m"`
+	AccessTokenExpiry      time.Duration `help:"how long oauth access tokens are issued for" default:"24h"`
+	IDTokenExpiry          time.Duration `help:"how long signed oidc user info, used by relying parties as id tokens, is valid for; zero matches the access token expiry" default:"0s"`
+	RefreshTokenExpiry     time.Duration `help:"how long oauth refresh tokens are issued for" default:"720h"`
+	RefreshTokenMaxAge     time.Duration `help:"how long after being granted oauth refresh tokens can still be refreshed; when set, refreshing extends them by their expiry up to this age rather than keeping the expiry of the grant" default:"0s"`
+	RefreshTokenReuseGrace time.Duration `help:"how long a rotated oauth refresh token is still accepted for, so that concurrent refreshes don't revoke the grant" default:"5s"`
+	ClockSkew              time.Duration `help:"how far the clocks of oauth clients may drift from ours when validating and issuing tokens" default:"2m"`
+
+	ConsentExpiry ConsentExpiry `help:"json mapping of oauth scopes to how long consent to them is remembered for authorize requests with prompt=none (e.g. 720h), consent to other scopes is remembered until given again" default:"{}"`
+
+	SignedUserInfoClients []string `help:"ids of oauth clients that registered to receive user info as a signed jwt" default:""`
+
+	ClientResponseTypes ClientResponseTypes `help:"json mapping of oauth client ids to the response types they are permitted to use, clients without an entry may only use the code response type" default:"{}"`
+	AccessTokenFormats  AccessTokenFormats  `help:"json mapping of oauth client ids to the format of the access tokens they are issued (macaroon or jwt), clients without an entry receive macaroons" default:"{}"`
+
+	ClientPKCEPolicies PKCEPolicies `help:"json mapping of oauth client ids to their pkce policy (required, optional or off), public clients default to required and confidential clients to optional" default:"{}"`
+	PKCEOffAllowed     bool         `help:"allow confidential clients to have pkce turned off, otherwise clients configured off fall back to optional" default:"true"`
+
+	PKCEPublicClientsRequired bool `help:"require pkce of public clients, which have no secret, even when they're configured optional" default:"true"`
+
+	FrontChannelRequiredScopes []string `help:"scopes authorize requests must include to receive tokens in the front channel with the token response type" default:"openid"`
+	MaxScopes                  int      `help:"maximum number of distinct scopes a single authorize request may include, zero is unlimited" default:"100"`
+
+	Resources []string `help:"absolute uris of the resources clients may request audience restricted tokens for with resource indicators" default:""`
+
+	UserInfoBucketLimit int `help:"maximum number of buckets listed in user info for tokens granted the storj:buckets scope" default:"100"`
+
+	UserInfoRetryBackendFailures bool `help:"respond to user info requests whose token or user can't be looked up for another reason than not existing with 503 and a retry-after, rather than with 500" default:"true"`
+
+	BackchannelLogoutURIs     LogoutURIs    `help:"json mapping of oauth client ids to the uri they receive back-channel logout tokens at" default:"{}"`
+	BackchannelLogoutAttempts int           `help:"how many times delivering a back-channel logout token is attempted" default:"5"`
+	BackchannelLogoutBackoff  time.Duration `help:"how long to wait before retrying a failed back-channel logout, doubled with every attempt" default:"1s"`
+	BackchannelLogoutTimeout  time.Duration `help:"how long a client may take to accept a back-channel logout token" default:"10s"`
+	BackchannelLogoutQueue    int           `help:"maximum number of back-channel logout tokens waiting to be delivered, tokens beyond it are dropped" default:"1000"`
+
+	LoginURL string `help:"url users without a session are sent to from the authorize flow to log in, with a return_to back to the authorization request" default:""`
+
+	RequireTLS     bool     `help:"redirect plaintext http authorize requests to https" default:"false"`
+	CookieSameSite SameSite `help:"SameSite mode of cookies set during the authorize flow (lax, strict or none)" default:"lax"`
+
+	DescribeExpiredCodes bool `help:"reject token requests exchanging expired authorization codes with an invalid_grant described as an expired code, rather than as any unknown code" default:"true"`
+
+	ErrorDocsURL string `help:"base url of the documentation oauth error responses link to in error_uri, with the error code as fragment" default:""`
+
+	ClientLockoutThreshold  int           `help:"number of failed client authentications from an address at the token endpoint after which the client is locked out from that address, zero disables the lockout. client ids are public, so anyone able to send requests from the address of a client, or through the same proxy when no client lockout header is configured, can lock the client out" default:"0"`
+	ClientLockoutDuration   time.Duration `help:"how long a client is locked out of the token endpoint from an address after too many failed authentications" default:"15m"`
+	ClientLockoutDecay      time.Duration `help:"how long it takes for one failed client authentication to be forgotten" default:"1m"`
+	ClientLockoutHeader     string        `help:"header the client lockout takes the address of token requests from, which must be set by a trusted proxy in front of the satellite (e.g. X-Real-IP or X-Forwarded-For, of which the last address is used). the remote address of the connection is used when empty or the request has no such header" default:""`
+	ClientLockoutMaxClients int           `help:"maximum number of clients and addresses whose failed authentications are tracked, the clients that failed longest ago are forgotten once none can be forgotten otherwise" default:"10000"`
+
+	AccessTokenCacheTTL      time.Duration `help:"how long access tokens looked up by user info requests are cached for, zero disables the cache. only revocations made through the same process invalidate cached tokens, tokens revoked by other processes, such as the admin deleting a client, are accepted for as long" default:"0s"`
+	AccessTokenCacheCapacity int           `help:"maximum number of access tokens cached at once, the least recently used tokens are evicted once no cached token expired" default:"10000"`
+
+	RefreshCoalescingWindow time.Duration `help:"how long the tokens issued by rotating a refresh token are handed out to identical concurrent refreshes of the same token, rather than rotating it again, zero disables coalescing" default:"2s"`
+
+	AccessLog bool `help:"log the method, path, client, grant type, status, latency and parameters of every oidc request, with the values of secret parameters redacted" default:"false"`
+
+	MaxRequestBodySize memory.Size   `help:"maximum size of the body of authorize, token and user info requests" default:"1MiB"`
+	RequestTimeout     time.Duration `help:"how long authorize, token and user info requests may take, including receiving their body" default:"30s"`
+
+	MaxConcurrentRequests int `help:"maximum number of token and user info requests served at once, further requests are rejected with 503 until one finishes, zero is unlimited" default:"0"`
+
+	ClientAuthMethods TokenEndpointAuthMethods `help:"json mapping of oauth client ids to the only method they may authenticate at the token endpoint with (client_secret_basic, client_secret_post or client_secret_jwt), clients without an entry may use client_secret_basic or client_secret_jwt" default:"{}"`
+
+	ClientAssertionAudiences []string `help:"audiences client assertions authenticating clients at the token endpoint must be addressed to, defaults to the token endpoint and the issuer" default:""`
+	MaxAssertionReplays      int      `help:"maximum number of client assertions remembered until they expire to reject their replays, token requests with further assertions are rejected with 503 until one expires" default:"100000"`
+
+	DiscoveryMetadata DiscoveryMetadata `help:"json mapping of additional provider metadata included in the openid configuration document (e.g. claims_supported or op_policy_uri)" default:"{}"`
+}
+
+// PathPrefix returns the normalized route prefix. It always starts and ends with a '/'.
+func (config Config) PathPrefix() string {
+	prefix := strings.Trim(config.RoutePrefix, "/")
+	if prefix == "" {
+		return "/"
+	}
+
+	return "/" + prefix + "/"
+}
+
+// SameSite is the SameSite mode applied to cookies set during the authorize flow.
+type SameSite http.SameSite
+
+// Type implements pflag.Value.
+func (SameSite) Type() string { return "oidc.SameSite" }
+
+// String is required for pflag.Value.
+func (mode *SameSite) String() string {
+	switch http.SameSite(*mode) {
+	case http.SameSiteStrictMode:
+		return "strict"
+	case http.SameSiteNoneMode:
+		return "none"
+	default:
+		return "lax"
+	}
+}
+
+// Set parses the SameSite mode.
+func (mode *SameSite) Set(s string) error {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "lax":
+		*mode = SameSite(http.SameSiteLaxMode)
+	case "strict":
+		*mode = SameSite(http.SameSiteStrictMode)
+	case "none":
+		*mode = SameSite(http.SameSiteNoneMode)
+	default:
+		return Error.New("invalid SameSite mode %q", s)
+	}
+	return nil
+}
+
+// ScopeCaveat describes the restrictions that a granted scope places on the derived access token.
+type ScopeCaveat struct {
+	DisallowLists   bool `json:"disallowLists,omitempty"`
+	DisallowReads   bool `json:"disallowReads,omitempty"`
+	DisallowWrites  bool `json:"disallowWrites,omitempty"`
+	DisallowDeletes bool `json:"disallowDeletes,omitempty"`
+
+	// Buckets restricts the token to the listed buckets.
+	Buckets []string `json:"buckets,omitempty"`
+	// BucketFromSuffix restricts the token to the bucket named by the part of the scope matched by a trailing '*'.
+	BucketFromSuffix bool `json:"bucketFromSuffix,omitempty"`
+}
+
+// ScopeCaveats maps custom scopes onto macaroon caveats. A scope ending with '*' matches any scope sharing its prefix,
+// for example "storj:bucket:*" matches "storj:bucket:photos". Exact matches take priority over prefix matches.
+type ScopeCaveats map[string]ScopeCaveat
+
+// Type implements pflag.Value.
+func (ScopeCaveats) Type() string { return "oidc.ScopeCaveats" }
+
+// String is required for pflag.Value.
+func (sc *ScopeCaveats) String() string {
+	mapping, err := json.Marshal(*sc)
+	if err != nil {
+		return ""
+	}
+
+	return string(mapping)
+}
+
+// Set does validation on the configured JSON.
+func (sc *ScopeCaveats) Set(s string) (err error) {
+	mapping := make(ScopeCaveats)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &mapping)
+		if err != nil {
+			return err
+		}
+	}
+
+	for scope, caveat := range mapping {
+		if caveat.BucketFromSuffix && !strings.HasSuffix(scope, "*") {
+			return Error.New("scope %q must end with '*' to derive a bucket from its suffix", scope)
+		}
+	}
+
+	*sc = mapping
+	return nil
+}
+
+// lookup returns the caveat associated with the provided scope along with the portion of the scope matched by a
+// wildcard. The longest matching prefix wins when multiple wildcards apply.
+func (sc ScopeCaveats) lookup(scope string) (caveat ScopeCaveat, suffix string, ok bool) {
+	if caveat, ok := sc[scope]; ok {
+		return caveat, "", true
+	}
+
+	prefixes := make([]string, 0, len(sc))
+	for pattern := range sc {
+		if strings.HasSuffix(pattern, "*") {
+			prefixes = append(prefixes, strings.TrimSuffix(pattern, "*"))
+		}
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(scope, prefix) {
+			return sc[prefix+"*"], strings.TrimPrefix(scope, prefix), true
+		}
+	}
+
+	return ScopeCaveat{}, "", false
+}
+
+// requiredDiscoveryMetadata are the fields of the openid configuration document OpenID Connect Discovery requires
+// providers to publish. Along with the fields the provider publishes itself, they can't be set as additional metadata.
+var requiredDiscoveryMetadata = map[string]bool{
+	"issuer": true, "authorization_endpoint": true, "token_endpoint": true, "jwks_uri": true,
+	"response_types_supported": true, "subject_types_supported": true, "id_token_signing_alg_values_supported": true,
+}
+
+// DiscoveryMetadata is the additional provider metadata included in the openid configuration document.
+type DiscoveryMetadata map[string]interface{}
+
+// Type implements pflag.Value.
+func (DiscoveryMetadata) Type() string { return "oidc.DiscoveryMetadata" }
+
+// String is required for pflag.Value.
+func (metadata *DiscoveryMetadata) String() string {
+	mapping, err := json.Marshal(*metadata)
+	if err != nil {
+		return ""
+	}
+
+	return string(mapping)
+}
+
+// Set does validation on the configured JSON.
+func (metadata *DiscoveryMetadata) Set(s string) (err error) {
+	mapping := make(DiscoveryMetadata)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &mapping)
+		if err != nil {
+			return err
+		}
+	}
+
+	// every field of the provider config is always published
+	published := map[string]interface{}{}
+	data, err := json.Marshal(ProviderConfig{})
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &published); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(mapping))
+	for name := range mapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == "" {
+			return Error.New("metadata must be named")
+		}
+		if _, ok := published[name]; ok || requiredDiscoveryMetadata[name] {
+			return Error.New("metadata %q is reserved", name)
+		}
+	}
+
+	*metadata = mapping
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+
+	"storj.io/common/uuid"
+)
+
+// errConsentRequired rejects authorize requests that asked not to prompt the user (prompt=none) while the user hasn't
+// consented to one of the requested scopes, or their consent to it expired.
+var errConsentRequired = errors.New("consent_required")
+
+// consentRequiredResponse describes errConsentRequired to clients.
+var consentRequiredResponse = oauth2errors.Response{
+	Error:       errConsentRequired,
+	Description: "The user has to consent to the requested scope",
+	StatusCode:  http.StatusBadRequest,
+}
+
+// ConsentExpiry maps scopes onto how long consent to them is remembered for, so that sensitive scopes have to be
+// consented to again periodically. A scope ending with '*' matches any scope sharing its prefix, as in ScopeCaveats.
+// Consent to scopes without an entry is remembered until the user consents again.
+type ConsentExpiry map[string]time.Duration
+
+// Type implements pflag.Value.
+func (ConsentExpiry) Type() string { return "oidc.ConsentExpiry" }
+
+// String is required for pflag.Value.
+func (expiry *ConsentExpiry) String() string {
+	durations := make(map[string]string, len(*expiry))
+	for scope, duration := range *expiry {
+		durations[scope] = duration.String()
+	}
+
+	data, err := json.Marshal(durations)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set parses the configured JSON, which maps scopes onto durations like "720h".
+func (expiry *ConsentExpiry) Set(s string) (err error) {
+	durations := make(map[string]string)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &durations)
+		if err != nil {
+			return err
+		}
+	}
+
+	parsed := make(ConsentExpiry, len(durations))
+	for scope, value := range durations {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return Error.New("scope %q: %v", scope, err)
+		}
+		if duration <= 0 {
+			return Error.New("scope %q: consent expiry must be positive", scope)
+		}
+		parsed[scope] = duration
+	}
+
+	*expiry = parsed
+	return nil
+}
+
+// lookup returns how long consent to the scope is remembered for. The longest matching prefix wins when multiple
+// wildcards apply.
+func (expiry ConsentExpiry) lookup(scope string) (duration time.Duration, ok bool) {
+	if duration, ok := expiry[scope]; ok {
+		return duration, true
+	}
+
+	prefixes := make([]string, 0, len(expiry))
+	for pattern := range expiry {
+		if strings.HasSuffix(pattern, "*") {
+			prefixes = append(prefixes, strings.TrimSuffix(pattern, "*"))
+		}
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(scope, prefix) {
+			return expiry[prefix+"*"], true
+		}
+	}
+
+	return 0, false
+}
+
+// consentFlow remembers the scopes users consent to, so that clients can authorize users again without prompting them
+// for as long as their consent lasts.
+type consentFlow struct {
+	consents OAuthConsents
+	expiry   ConsentExpiry
+}
+
+// authorize handles the consent of an authorize request of the user. Submitting the request is the user consenting to
+// the requested scopes, which is remembered from then on, unless the request asked not to prompt the user. Those
+// requests are only authorized when the user still consents to every requested scope.
+func (flow consentFlow) authorize(r *http.Request, userID uuid.UUID) error {
+	ctx := r.Context()
+
+	clientID, err := uuid.FromString(r.FormValue("client_id"))
+	if err != nil {
+		return oauth2errors.ErrInvalidClient
+	}
+
+	scopes := strings.Fields(r.FormValue("scope"))
+	now := time.Now()
+
+	if !promptsNone(r) {
+		return flow.consents.Grant(ctx, userID, clientID, scopes, now)
+	}
+
+	consented, err := flow.consents.List(ctx, userID, clientID)
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		grantedAt, ok := consented[scope]
+		if !ok {
+			return errConsentRequired
+		}
+
+		if expiry, ok := flow.expiry.lookup(scope); ok && !now.Before(grantedAt.Add(expiry)) {
+			return errConsentRequired
+		}
+	}
+
+	return nil
+}
+
+// promptsNone reports whether the authorize request asked not to prompt the user.
+func promptsNone(r *http.Request) bool {
+	for _, prompt := range strings.Fields(r.FormValue("prompt")) {
+		if prompt == "none" {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,8 @@ package oidc
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"time"
 
 	"storj.io/common/uuid"
@@ -21,6 +23,8 @@ type DB interface {
 	OAuthCodes() OAuthCodes
 	// OAuthTokens returns an API for the oauthtokens repository.
 	OAuthTokens() OAuthTokens
+	// OAuthConsents returns an API for the oauthconsents repository.
+	OAuthConsents() OAuthConsents
 }
 
 // OAuthClients defines an interface for creating, updating, and obtaining information about oauth clients known to our
@@ -37,6 +41,18 @@ type OAuthClients interface {
 
 	// Delete deletes the identified client from the database.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// RotateSecret replaces the secret of the client, keeping its current secret and its kid as the previous secret
+	// until previousExpiresAt. The new secret is assigned a new kid. A previous secret left from an earlier rotation is
+	// dropped.
+	RotateSecret(ctx context.Context, id uuid.UUID, secret []byte, previousExpiresAt time.Time) error
+
+	// DropPreviousSecret drops the previous secret of the client, finishing its rotation.
+	DropPreviousSecret(ctx context.Context, id uuid.UUID) error
+
+	// SetDenied denies the client since deniedAt, or allows it again when deniedAt is nil. It returns sql.ErrNoRows
+	// when the client doesn't exist.
+	SetDenied(ctx context.Context, id uuid.UUID, deniedAt *time.Time) error
 }
 
 // OAuthClient defines a concrete representation of an oauth client.
@@ -47,6 +63,21 @@ type OAuthClient struct {
 	RedirectURL string    `json:"redirectURL"`
 	AppName     string    `json:"appName"`
 	AppLogoURL  string    `json:"appLogoURL"`
+
+	// PreviousSecret is still accepted alongside the secret until PreviousSecretExpiresAt while the secret is rotated.
+	PreviousSecret          []byte     `json:"-"`
+	PreviousSecretExpiresAt *time.Time `json:"-"`
+
+	// SecretKeyID and PreviousSecretKeyID are the random kids of the secret and the previous secret. They're assigned
+	// whenever a secret is stored, and are empty for secrets stored before kids were.
+	SecretKeyID         string `json:"-"`
+	PreviousSecretKeyID string `json:"-"`
+
+	// DeniedAt is set while the client is denied, which rejects its authorize and token requests.
+	DeniedAt *time.Time `json:"deniedAt,omitempty"`
+
+	// ClaimTemplate describes the additional claims the client receives about the user, if any.
+	ClaimTemplate *ClaimTemplate `json:"claimTemplate,omitempty"`
 }
 
 // GetID returns the clients id.
@@ -59,6 +90,23 @@ func (o OAuthClient) GetSecret() string {
 	return string(o.Secret)
 }
 
+// VerifyPassword reports whether the secret a client authenticated with is the secret of the client, or its previous
+// secret while that is still accepted.
+func (o OAuthClient) VerifyPassword(secret string) bool {
+	// clients without a secret aren't required to authenticate
+	if len(o.Secret) == 0 {
+		return true
+	}
+	if subtle.ConstantTimeCompare(o.Secret, []byte(secret)) == 1 {
+		return true
+	}
+
+	if len(o.PreviousSecret) == 0 || o.PreviousSecretExpiresAt == nil || !time.Now().Before(*o.PreviousSecretExpiresAt) {
+		return false
+	}
+	return subtle.ConstantTimeCompare(o.PreviousSecret, []byte(secret)) == 1
+}
+
 // GetDomain returns the allowed redirect url associated with the client.
 func (o OAuthClient) GetDomain() string {
 	return o.RedirectURL
@@ -72,17 +120,24 @@ func (o OAuthClient) GetUserID() string {
 // OAuthCodes defines a set of operations allowed to be performed against oauth codes.
 type OAuthCodes interface {
 	// Get retrieves the OAuthCode for the specified code. Implementations should only return unexpired, unclaimed
-	// codes. Once a code has been claimed, it should be marked as such to prevent future calls from exchanging the
-	// value for an access tokens.
+	// codes, and return ErrCodeExpired for unclaimed codes that expired. Once a code has been claimed, it should be
+	// marked as such to prevent future calls from exchanging the value for an access tokens.
 	Get(ctx context.Context, code string) (OAuthCode, error)
 
 	// Create creates a new OAuthCode.
 	Create(ctx context.Context, code OAuthCode) error
 
-	// Claim marks that the provided code has been claimed and should not be issued to another caller.
+	// Claim marks that the provided code has been claimed and should not be issued to another caller. It returns
+	// sql.ErrNoRows when the code was claimed already, so that concurrent exchanges can't both claim it.
 	Claim(ctx context.Context, code string) error
+
+	// GetClaimed retrieves the OAuthCode for the specified code, given that it has been claimed already.
+	GetClaimed(ctx context.Context, code string) (OAuthCode, error)
 }
 
+// ErrCodeExpired is returned for authorization codes that expired before they were exchanged.
+var ErrCodeExpired = errors.New("authorization code expired")
+
 // OAuthTokens defines a set of operations that ca be performed against oauth tokens.
 type OAuthTokens interface {
 	// Get retrieves the OAuthToken for the specified kind and token value. This can be used to look up either refresh
@@ -94,6 +149,31 @@ type OAuthTokens interface {
 
 	// RevokeRESTTokenV0 revokes a v0 rest token by setting its expires_at time to zero.
 	RevokeRESTTokenV0(ctx context.Context, token string) error
+
+	// Expire shortens the lifetime of a token so that it expires at the provided time. Tokens that already expire
+	// earlier are left unchanged.
+	Expire(ctx context.Context, kind OAuthTokenKind, token string, expiresAt time.Time) error
+
+	// RevokeRefreshFamily revokes every refresh token rotated from the same grant as the provided refresh token, given
+	// that it has already expired. Tokens are revoked by setting their expires_at time to zero.
+	RevokeRefreshFamily(ctx context.Context, refresh string) error
+
+	// ListClients returns the clients holding unexpired access or refresh tokens of the user.
+	ListClients(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+
+	// ListGrants returns the grants of the user that still hold an unexpired refresh token.
+	ListGrants(ctx context.Context, userID uuid.UUID) ([]OAuthGrant, error)
+
+	// RevokeAllForUser revokes the unexpired access and refresh tokens of the user by setting their expires_at time to
+	// zero. It returns the clients the revoked tokens were issued to.
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+
+	// RevokeIssuedSince revokes the unexpired access and refresh tokens the client was issued for the user since the
+	// provided time by setting their expires_at time to zero, returning how many were revoked.
+	RevokeIssuedSince(ctx context.Context, userID, clientID uuid.UUID, since time.Time) (int64, error)
+
+	// DeleteAllForClient deletes every token issued to the client, returning how many were deleted.
+	DeleteAllForClient(ctx context.Context, clientID uuid.UUID) (int64, error)
 }
 
 // OAuthTokenKind defines an enumeration of different types of supported tokens.
@@ -122,6 +202,7 @@ type OAuthCode struct {
 	CreatedAt       time.Time
 	ExpiresAt       time.Time
 	ClaimedAt       *time.Time
+	Resources       []string // resource indicators the authorization was requested for
 }
 
 // OAuthToken represents a token stored within our database (either access / refresh).
@@ -133,6 +214,27 @@ type OAuthToken struct {
 	Token     string
 	CreatedAt time.Time
 	ExpiresAt time.Time
+	Resources []string // resource indicators the token was issued for
+}
+
+// OAuthConsents tracks the scopes users consented to grant to clients, so that consent can be remembered.
+type OAuthConsents interface {
+	// Grant records that the user consented to grant the scopes to the client at grantedAt. Earlier consent to the
+	// same scopes is replaced.
+	Grant(ctx context.Context, userID, clientID uuid.UUID, scopes []string, grantedAt time.Time) error
+
+	// List returns when the user last consented to each scope granted to the client.
+	List(ctx context.Context, userID, clientID uuid.UUID) (map[string]time.Time, error)
+}
+
+// OAuthGrant describes the refresh tokens rotated from the same consent of a user.
+type OAuthGrant struct {
+	ClientID  uuid.UUID
+	UserID    uuid.UUID
+	Scope     string // scope of the latest refresh token
+	GrantedAt time.Time
+	// RefreshExpiresAt is when the latest refresh token expires.
+	RefreshExpiresAt time.Time
 }
 
 // NewDB constructs a database using the provided dbx db.
@@ -147,13 +249,17 @@ func NewDB(dbxdb *dbx.DB) DB {
 		tokens: &tokensDBX{
 			db: dbxdb,
 		},
+		consents: &consentsDBX{
+			db: dbxdb,
+		},
 	}
 }
 
 type db struct {
-	clients OAuthClients
-	codes   OAuthCodes
-	tokens  OAuthTokens
+	clients  OAuthClients
+	codes    OAuthCodes
+	tokens   OAuthTokens
+	consents OAuthConsents
 }
 
 func (d *db) OAuthClients() OAuthClients {
@@ -168,4 +274,8 @@ func (d *db) OAuthTokens() OAuthTokens {
 	return d.tokens
 }
 
+func (d *db) OAuthConsents() OAuthConsents {
+	return d.consents
+}
+
 var _ DB = &db{}
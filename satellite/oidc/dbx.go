@@ -6,9 +6,14 @@ package oidc
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -35,26 +40,62 @@ func (clients *clientsDBX) Get(ctx context.Context, id uuid.UUID) (OAuthClient,
 		RedirectURL: oauthClient.RedirectUrl,
 		AppName:     oauthClient.AppName,
 		AppLogoURL:  oauthClient.AppLogoUrl,
+
+		PreviousSecret:          oauthClient.PreviousSecret,
+		PreviousSecretExpiresAt: oauthClient.PreviousSecretExpiresAt,
+
+		DeniedAt: oauthClient.DeniedAt,
+	}
+	if oauthClient.SecretKeyId != nil {
+		client.SecretKeyID = *oauthClient.SecretKeyId
+	}
+	if oauthClient.PreviousSecretKeyId != nil {
+		client.PreviousSecretKeyID = *oauthClient.PreviousSecretKeyId
+	}
+	if oauthClient.ClaimTemplate != nil {
+		client.ClaimTemplate = new(ClaimTemplate)
+		err = json.Unmarshal(oauthClient.ClaimTemplate, client.ClaimTemplate)
+		if err != nil {
+			return OAuthClient{}, err
+		}
 	}
 
 	return client, nil
 }
 
-// Create creates a new OAuthClient.
+// Create creates a new OAuthClient. Its secret is assigned a new kid unless it already has one.
 func (clients *clientsDBX) Create(ctx context.Context, client OAuthClient) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	keyID := client.SecretKeyID
+	if keyID == "" {
+		keyID, err = newSigningKeyID()
+		if err != nil {
+			return err
+		}
+	}
+
+	claimTemplate, err := marshalClaimTemplate(client.ClaimTemplate)
+	if err != nil {
+		return err
+	}
+
 	return clients.db.CreateNoReturn_OauthClient(ctx,
 		dbx.OauthClient_Id(client.ID.Bytes()), dbx.OauthClient_EncryptedSecret(client.Secret),
 		dbx.OauthClient_RedirectUrl(client.RedirectURL), dbx.OauthClient_UserId(client.UserID.Bytes()),
-		dbx.OauthClient_AppName(client.AppName), dbx.OauthClient_AppLogoUrl(client.AppLogoURL))
+		dbx.OauthClient_AppName(client.AppName), dbx.OauthClient_AppLogoUrl(client.AppLogoURL),
+		dbx.OauthClient_Create_Fields{
+			SecretKeyId:   dbx.OauthClient_SecretKeyId(keyID),
+			ClaimTemplate: dbx.OauthClient_ClaimTemplate_Raw(claimTemplate),
+		})
 }
 
-// Update modifies information for the provided OAuthClient.
+// Update modifies information for the provided OAuthClient. A new secret is assigned a new kid, and an empty claim
+// template removes the registered one.
 func (clients *clientsDBX) Update(ctx context.Context, client OAuthClient) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if client.RedirectURL == "" && client.Secret == nil {
+	if client.RedirectURL == "" && client.Secret == nil && client.ClaimTemplate == nil {
 		return nil
 	}
 
@@ -65,7 +106,21 @@ func (clients *clientsDBX) Update(ctx context.Context, client OAuthClient) (err
 	}
 
 	if client.Secret != nil {
+		keyID, err := newSigningKeyID()
+		if err != nil {
+			return err
+		}
+
 		update.EncryptedSecret = dbx.OauthClient_EncryptedSecret(client.Secret)
+		update.SecretKeyId = dbx.OauthClient_SecretKeyId(keyID)
+	}
+
+	if client.ClaimTemplate != nil {
+		claimTemplate, err := marshalClaimTemplate(client.ClaimTemplate)
+		if err != nil {
+			return err
+		}
+		update.ClaimTemplate = dbx.OauthClient_ClaimTemplate_Raw(claimTemplate)
 	}
 
 	return clients.db.UpdateNoReturn_OauthClient_By_Id(ctx, dbx.OauthClient_Id(client.ID.Bytes()), update)
@@ -77,6 +132,77 @@ func (clients *clientsDBX) Delete(ctx context.Context, id uuid.UUID) (err error)
 	return err
 }
 
+// marshalClaimTemplate encodes the claim template for storage, returning nil for templates that add no claims.
+func marshalClaimTemplate(template *ClaimTemplate) ([]byte, error) {
+	if template == nil || template.IsEmpty() {
+		return nil, nil
+	}
+	return json.Marshal(template)
+}
+
+// RotateSecret replaces the secret of the client, keeping its current secret and its kid as the previous secret until
+// previousExpiresAt. The new secret is assigned a new kid.
+func (clients *clientsDBX) RotateSecret(ctx context.Context, id uuid.UUID, secret []byte, previousExpiresAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyID, err := newSigningKeyID()
+	if err != nil {
+		return err
+	}
+
+	// the current secret is demoted in the same statement, so that concurrent rotations can't lose a secret
+	result, err := clients.db.ExecContext(ctx, clients.db.Rebind(`
+		UPDATE oauth_clients
+		SET previous_secret = encrypted_secret, previous_secret_key_id = secret_key_id, previous_secret_expires_at = ?,
+			encrypted_secret = ?, secret_key_id = ?
+		WHERE id = ?
+	`), previousExpiresAt, secret, keyID, id.Bytes())
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// DropPreviousSecret drops the previous secret of the client.
+func (clients *clientsDBX) DropPreviousSecret(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return clients.db.UpdateNoReturn_OauthClient_By_Id(ctx, dbx.OauthClient_Id(id.Bytes()), dbx.OauthClient_Update_Fields{
+		PreviousSecret:          dbx.OauthClient_PreviousSecret_Null(),
+		PreviousSecretExpiresAt: dbx.OauthClient_PreviousSecretExpiresAt_Null(),
+		PreviousSecretKeyId:     dbx.OauthClient_PreviousSecretKeyId_Null(),
+	})
+}
+
+// SetDenied denies the client since deniedAt, or allows it again when deniedAt is nil.
+func (clients *clientsDBX) SetDenied(ctx context.Context, id uuid.UUID, deniedAt *time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := clients.db.ExecContext(ctx, clients.db.Rebind(`
+		UPDATE oauth_clients SET denied_at = ? WHERE id = ?
+	`), deniedAt, id.Bytes())
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 type codesDBX struct {
 	db *dbx.DB
 }
@@ -100,7 +226,7 @@ func (o *codesDBX) Get(ctx context.Context, code string) (oauthCode OAuthCode, e
 	}
 
 	if time.Now().After(dbCode.ExpiresAt) {
-		return oauthCode, sql.ErrNoRows
+		return oauthCode, ErrCodeExpired
 	}
 
 	oauthCode.ClientID = clientID
@@ -113,6 +239,7 @@ func (o *codesDBX) Get(ctx context.Context, code string) (oauthCode OAuthCode, e
 	oauthCode.CreatedAt = dbCode.CreatedAt
 	oauthCode.ExpiresAt = dbCode.ExpiresAt
 	oauthCode.ClaimedAt = dbCode.ClaimedAt
+	oauthCode.Resources = splitResources(dbCode.Resources)
 
 	return oauthCode, nil
 }
@@ -124,15 +251,52 @@ func (o *codesDBX) Create(ctx context.Context, code OAuthCode) (err error) {
 		dbx.OauthCode_UserId(code.UserID.Bytes()), dbx.OauthCode_Scope(code.Scope),
 		dbx.OauthCode_RedirectUrl(code.RedirectURL), dbx.OauthCode_Challenge(code.Challenge),
 		dbx.OauthCode_ChallengeMethod(code.ChallengeMethod), dbx.OauthCode_Code(code.Code),
-		dbx.OauthCode_CreatedAt(code.CreatedAt), dbx.OauthCode_ExpiresAt(code.ExpiresAt), dbx.OauthCode_Create_Fields{})
+		dbx.OauthCode_CreatedAt(code.CreatedAt), dbx.OauthCode_ExpiresAt(code.ExpiresAt), dbx.OauthCode_Create_Fields{
+			Resources: dbx.OauthCode_Resources_Raw(joinResources(code.Resources)),
+		})
 }
 
 func (o *codesDBX) Claim(ctx context.Context, code string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return o.db.UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx, dbx.OauthCode_Code(code), dbx.OauthCode_Update_Fields{
-		ClaimedAt: dbx.OauthCode_ClaimedAt(time.Now()),
-	})
+	result, err := o.db.ExecContext(ctx, o.db.Rebind(`
+		UPDATE oauth_codes
+		SET claimed_at = ?
+		WHERE code = ? AND claimed_at IS NULL
+	`), time.Now(), code)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetClaimed retrieves the OAuthCode for the specified code, given that it has been claimed already. Claimed codes are
+// returned regardless of whether they expired since.
+func (o *codesDBX) GetClaimed(ctx context.Context, code string) (oauthCode OAuthCode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var claimedAt time.Time
+	err = o.db.QueryRowContext(ctx, o.db.Rebind(`
+		SELECT client_id, user_id, scope, created_at, expires_at, claimed_at
+		FROM oauth_codes
+		WHERE code = ? AND claimed_at IS NOT NULL
+	`), code).Scan(&oauthCode.ClientID, &oauthCode.UserID, &oauthCode.Scope,
+		&oauthCode.CreatedAt, &oauthCode.ExpiresAt, &claimedAt)
+	if err != nil {
+		return OAuthCode{}, err
+	}
+
+	oauthCode.Code = code
+	oauthCode.ClaimedAt = &claimedAt
+	return oauthCode, nil
 }
 
 type tokensDBX struct {
@@ -170,6 +334,7 @@ func (o *tokensDBX) Get(ctx context.Context, kind OAuthTokenKind, token string)
 	oauthToken.Token = token
 	oauthToken.CreatedAt = dbToken.CreatedAt
 	oauthToken.ExpiresAt = dbToken.ExpiresAt
+	oauthToken.Resources = splitResources(dbToken.Resources)
 
 	return oauthToken, nil
 }
@@ -180,7 +345,9 @@ func (o *tokensDBX) Create(ctx context.Context, token OAuthToken) (err error) {
 	err = o.db.CreateNoReturn_OauthToken(ctx, dbx.OauthToken_ClientId(token.ClientID.Bytes()),
 		dbx.OauthToken_UserId(token.UserID.Bytes()), dbx.OauthToken_Scope(token.Scope),
 		dbx.OauthToken_Kind(int(token.Kind)), dbx.OauthToken_Token([]byte(token.Token)),
-		dbx.OauthToken_CreatedAt(token.CreatedAt), dbx.OauthToken_ExpiresAt(token.ExpiresAt))
+		dbx.OauthToken_CreatedAt(token.CreatedAt), dbx.OauthToken_ExpiresAt(token.ExpiresAt), dbx.OauthToken_Create_Fields{
+			Resources: dbx.OauthToken_Resources_Raw(joinResources(token.Resources)),
+		})
 
 	// ignore duplicate key errors as they're somewhat expected
 	if err != nil && dbx.IsConstraintError(err) {
@@ -199,3 +366,199 @@ func (o *tokensDBX) RevokeRESTTokenV0(ctx context.Context, token string) (err er
 			ExpiresAt: dbx.OauthToken_ExpiresAt(time.Time{}),
 		})
 }
+
+// Expire shortens the lifetime of a token so that it expires at the provided time.
+func (o *tokensDBX) Expire(ctx context.Context, kind OAuthTokenKind, token string, expiresAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = o.db.ExecContext(ctx, o.db.Rebind(`
+		UPDATE oauth_tokens
+		SET expires_at = ?
+		WHERE kind = ? AND token = ? AND expires_at > ?
+	`), expiresAt, int(kind), []byte(token), expiresAt)
+	return err
+}
+
+// RevokeRefreshFamily revokes the refresh tokens rotated from the same grant as the provided, expired, refresh token.
+// Rotated refresh tokens share the client, user, and creation time of the token they were rotated from.
+func (o *tokensDBX) RevokeRefreshFamily(ctx context.Context, refresh string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	_, err = o.db.ExecContext(ctx, o.db.Rebind(`
+		UPDATE oauth_tokens
+		SET expires_at = ?
+		WHERE kind = ? AND expires_at > ?
+			AND (client_id, user_id, created_at) IN (
+				SELECT client_id, user_id, created_at
+				FROM oauth_tokens
+				WHERE kind = ? AND token = ? AND expires_at <= ?
+			)
+	`), time.Time{}, int(KindRefreshToken), now, int(KindRefreshToken), []byte(refresh), now)
+	return err
+}
+
+// ListClients returns the clients holding unexpired access or refresh tokens of the user.
+func (o *tokensDBX) ListClients(ctx context.Context, userID uuid.UUID) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := o.db.QueryContext(ctx, o.db.Rebind(`
+		SELECT DISTINCT client_id
+		FROM oauth_tokens
+		WHERE user_id = ? AND kind IN (?, ?) AND expires_at > ?
+	`), userID.Bytes(), int(KindAccessToken), int(KindRefreshToken), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return scanClientIDs(rows)
+}
+
+// ListGrants returns the grants of the user that still hold an unexpired refresh token. Rotated refresh tokens share
+// the creation time of the grant, and the latest of them is the one expiring last.
+func (o *tokensDBX) ListGrants(ctx context.Context, userID uuid.UUID) (_ []OAuthGrant, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := o.db.QueryContext(ctx, o.db.Rebind(`
+		SELECT DISTINCT ON (client_id, created_at) client_id, scope, created_at, expires_at
+		FROM oauth_tokens
+		WHERE user_id = ? AND kind = ? AND expires_at > ?
+		ORDER BY client_id, created_at, expires_at DESC
+	`), userID.Bytes(), int(KindRefreshToken), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var grants []OAuthGrant
+	for rows.Next() {
+		grant := OAuthGrant{UserID: userID}
+		err = rows.Scan(&grant.ClientID, &grant.Scope, &grant.GrantedAt, &grant.RefreshExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, rows.Err()
+}
+
+// RevokeAllForUser revokes the unexpired access and refresh tokens of the user, returning the clients they were issued
+// to.
+func (o *tokensDBX) RevokeAllForUser(ctx context.Context, userID uuid.UUID) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := o.db.QueryContext(ctx, o.db.Rebind(`
+		UPDATE oauth_tokens
+		SET expires_at = ?
+		WHERE user_id = ? AND kind IN (?, ?) AND expires_at > ?
+		RETURNING client_id
+	`), time.Time{}, userID.Bytes(), int(KindAccessToken), int(KindRefreshToken), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return scanClientIDs(rows)
+}
+
+// RevokeIssuedSince revokes the unexpired access and refresh tokens the client was issued for the user since the
+// provided time.
+func (o *tokensDBX) RevokeIssuedSince(ctx context.Context, userID, clientID uuid.UUID, since time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := o.db.ExecContext(ctx, o.db.Rebind(`
+		UPDATE oauth_tokens
+		SET expires_at = ?
+		WHERE user_id = ? AND client_id = ? AND kind IN (?, ?) AND created_at >= ? AND expires_at > ?
+	`), time.Time{}, userID.Bytes(), clientID.Bytes(), int(KindAccessToken), int(KindRefreshToken), since, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// DeleteAllForClient deletes every token issued to the client, returning how many were deleted.
+func (o *tokensDBX) DeleteAllForClient(ctx context.Context, clientID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := o.db.ExecContext(ctx, o.db.Rebind(`
+		DELETE FROM oauth_tokens
+		WHERE client_id = ?
+	`), clientID.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+type consentsDBX struct {
+	db *dbx.DB
+}
+
+// Grant records that the user consented to grant the scopes to the client at grantedAt.
+func (o *consentsDBX) Grant(ctx context.Context, userID, clientID uuid.UUID, scopes []string, grantedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	_, err = o.db.ExecContext(ctx, `
+		INSERT INTO oauth_consents (user_id, client_id, scope, granted_at)
+		SELECT $1, $2, unnest($3::text[]), $4
+		ON CONFLICT (user_id, client_id, scope) DO UPDATE SET granted_at = EXCLUDED.granted_at
+	`, userID.Bytes(), clientID.Bytes(), pgutil.TextArray(scopes), grantedAt)
+	return err
+}
+
+// List returns when the user last consented to each scope granted to the client.
+func (o *consentsDBX) List(ctx context.Context, userID, clientID uuid.UUID) (_ map[string]time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := o.db.QueryContext(ctx, o.db.Rebind(`
+		SELECT scope, granted_at
+		FROM oauth_consents
+		WHERE user_id = ? AND client_id = ?
+	`), userID.Bytes(), clientID.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	consents := make(map[string]time.Time)
+	for rows.Next() {
+		var scope string
+		var grantedAt time.Time
+		err = rows.Scan(&scope, &grantedAt)
+		if err != nil {
+			return nil, err
+		}
+		consents[scope] = grantedAt
+	}
+
+	return consents, rows.Err()
+}
+
+// scanClientIDs returns the distinct client ids of the rows.
+func scanClientIDs(rows tagsql.Rows) (_ []uuid.UUID, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	seen := make(map[uuid.UUID]bool)
+	var clientIDs []uuid.UUID
+	for rows.Next() {
+		var clientID uuid.UUID
+		if err := rows.Scan(&clientID); err != nil {
+			return nil, err
+		}
+
+		if !seen[clientID] {
+			seen[clientID] = true
+			clientIDs = append(clientIDs, clientID)
+		}
+	}
+
+	return clientIDs, rows.Err()
+}
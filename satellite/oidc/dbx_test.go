@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/oidc"
@@ -67,7 +68,7 @@ func TestOAuthCodes(t *testing.T) {
 			code string
 			err  error
 		}{
-			{"expired", sql.ErrNoRows},
+			{"expired", oidc.ErrCodeExpired},
 			{"valid", nil},
 			{"claimed", sql.ErrNoRows}, // this should return an error since it was claimed above
 		}
@@ -76,6 +77,52 @@ func TestOAuthCodes(t *testing.T) {
 			_, err := codes.Get(ctx, testCase.code)
 			require.Equal(t, testCase.err, err)
 		}
+
+		// codes can only be claimed once
+		require.ErrorIs(t, codes.Claim(ctx, "claimed"), sql.ErrNoRows)
+
+		claimed, err := codes.GetClaimed(ctx, "claimed")
+		require.NoError(t, err)
+		require.Equal(t, clientID, claimed.ClientID)
+		require.Equal(t, userID, claimed.UserID)
+		require.NotNil(t, claimed.ClaimedAt)
+
+		_, err = codes.GetClaimed(ctx, "valid")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestOAuthClientClaimTemplate(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		clients := db.OIDC().OAuthClients()
+
+		client := oidc.OAuthClient{
+			ID:          testrand.UUID(),
+			Secret:      []byte("client-secret"),
+			UserID:      testrand.UUID(),
+			RedirectURL: "https://app.test/callback",
+			ClaimTemplate: &oidc.ClaimTemplate{
+				Claims: []string{"name"},
+				Static: map[string]interface{}{"tenant": "acme"},
+			},
+		}
+		require.NoError(t, clients.Create(ctx, client))
+
+		stored, err := clients.Get(ctx, client.ID)
+		require.NoError(t, err)
+		require.Equal(t, client.ClaimTemplate, stored.ClaimTemplate)
+
+		// updates without a template keep the registered one
+		require.NoError(t, clients.Update(ctx, oidc.OAuthClient{ID: client.ID, RedirectURL: "https://app.test/other"}))
+		stored, err = clients.Get(ctx, client.ID)
+		require.NoError(t, err)
+		require.Equal(t, client.ClaimTemplate, stored.ClaimTemplate)
+
+		// an empty template removes it
+		require.NoError(t, clients.Update(ctx, oidc.OAuthClient{ID: client.ID, ClaimTemplate: &oidc.ClaimTemplate{}}))
+		stored, err = clients.Get(ctx, client.ID)
+		require.NoError(t, err)
+		require.Nil(t, stored.ClaimTemplate)
 	})
 }
 
@@ -149,5 +196,41 @@ func TestOAuthTokens(t *testing.T) {
 				require.True(t, token.ExpiresAt.IsZero())
 			}
 		}
+
+		// rotated refresh tokens share the creation time of the grant
+		family := oidc.OAuthToken{
+			ClientID:  clientID,
+			UserID:    userID,
+			Kind:      oidc.KindRefreshToken,
+			CreatedAt: start.Add(-time.Minute),
+			ExpiresAt: start.Add(time.Hour),
+		}
+		for _, token := range []string{"rotated", "current"} {
+			family.Token = token
+			require.NoError(t, tokens.Create(ctx, family))
+		}
+
+		// expire never extends a token's lifetime
+		require.NoError(t, tokens.Expire(ctx, oidc.KindRefreshToken, "rotated", start.Add(2*time.Hour)))
+		token, err := tokens.Get(ctx, oidc.KindRefreshToken, "rotated")
+		require.NoError(t, err)
+		require.WithinDuration(t, start.Add(time.Hour), token.ExpiresAt, time.Second)
+
+		// revoking with a token that is still valid is a no-op
+		require.NoError(t, tokens.RevokeRefreshFamily(ctx, "rotated"))
+		_, err = tokens.Get(ctx, oidc.KindRefreshToken, "current")
+		require.NoError(t, err)
+
+		require.NoError(t, tokens.Expire(ctx, oidc.KindRefreshToken, "rotated", start.Add(-time.Second)))
+		_, err = tokens.Get(ctx, oidc.KindRefreshToken, "rotated")
+		require.Equal(t, sql.ErrNoRows, err)
+
+		require.NoError(t, tokens.RevokeRefreshFamily(ctx, "rotated"))
+		_, err = tokens.Get(ctx, oidc.KindRefreshToken, "current")
+		require.Equal(t, sql.ErrNoRows, err)
+
+		// tokens of other grants are unaffected
+		_, err = tokens.Get(ctx, oidc.KindRefreshToken, "valid")
+		require.NoError(t, err)
 	})
 }
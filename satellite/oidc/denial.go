@@ -0,0 +1,66 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-oauth2/oauth2/v4"
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+
+	"storj.io/common/uuid"
+)
+
+// clientDenial rejects the requests of denied clients as unauthorized_client. Denials are looked up with every
+// request, so that denying a client takes effect right away in every satellite process.
+type clientDenial struct {
+	clients oauth2.ClientStore
+}
+
+// check returns unauthorized_client when the client is denied. Unknown and malformed clients pass, since the oauth2
+// server rejects them on its own.
+func (denial clientDenial) check(ctx context.Context, clientID string) error {
+	if _, err := uuid.FromString(clientID); err != nil {
+		return nil
+	}
+
+	info, err := denial.clients.GetByID(ctx, clientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if client, ok := info.(OAuthClient); ok && client.DeniedAt != nil {
+		mon.Counter("oidc_denied_client_request").Inc(1)
+		return oauth2errors.ErrUnauthorizedClient
+	}
+	return nil
+}
+
+// deniedClientManager rejects the token requests of denied clients before they're authenticated.
+type deniedClientManager struct {
+	oauth2.Manager
+
+	denial clientDenial
+}
+
+// GenerateAccessToken rejects denied clients exchanging codes or authenticating with their credentials.
+func (manager *deniedClientManager) GenerateAccessToken(ctx context.Context, gt oauth2.GrantType, tgr *oauth2.TokenGenerateRequest) (oauth2.TokenInfo, error) {
+	if err := manager.denial.check(ctx, tgr.ClientID); err != nil {
+		return nil, err
+	}
+	return manager.Manager.GenerateAccessToken(ctx, gt, tgr)
+}
+
+// RefreshAccessToken rejects denied clients refreshing their tokens.
+func (manager *deniedClientManager) RefreshAccessToken(ctx context.Context, tgr *oauth2.TokenGenerateRequest) (oauth2.TokenInfo, error) {
+	if err := manager.denial.check(ctx, tgr.ClientID); err != nil {
+		return nil, err
+	}
+	return manager.Manager.RefreshAccessToken(ctx, tgr)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/go-oauth2/oauth2/v4/server"
 	"github.com/gorilla/mux"
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
@@ -25,58 +27,266 @@ import (
 
 var (
 	mon = monkit.Package()
+
+	// Error is the default error class for the oidc package.
+	Error = errs.Class("oidc")
 )
 
 // NewEndpoint constructs an OpenID identity provider.
 func NewEndpoint(
 	nodeURL storj.NodeURL, externalAddress string, log *zap.Logger,
 	oidcService *Service, service *console.Service,
-	codeExpiry, accessTokenExpiry, refreshTokenExpiry time.Duration,
+	config Config,
 ) *Endpoint {
 	manager := manage.NewManager()
 
+	idTokenExpiry := config.IDTokenExpiry
+	if idTokenExpiry <= 0 {
+		idTokenExpiry = config.AccessTokenExpiry
+	}
+
 	clientStore := oidcService.ClientStore()
 	tokenStore := oidcService.TokenStore()
+	tokenStore.refreshReuseGrace = config.RefreshTokenReuseGrace
+	tokenStore.describeExpiredCodes = config.DescribeExpiredCodes
+	if config.AccessTokenCacheTTL > 0 {
+		tokenStore.tokens = newAccessTokenCache(tokenStore.tokens, config.AccessTokenCacheTTL, config.AccessTokenCacheCapacity)
+	}
 
 	manager.MapClientStorage(clientStore)
 	manager.MapTokenStorage(tokenStore)
 
 	manager.MapAuthorizeGenerate(&UUIDAuthorizeGenerate{})
-	manager.SetAuthorizeCodeExp(codeExpiry)
-
-	manager.MapAccessGenerate(&MacaroonAccessGenerate{Service: service})
+	manager.SetAuthorizeCodeExp(config.CodeExpiry)
+
+	// the issuer has no trailing slash, so that its discovery document is the issuer followed by
+	// /.well-known/openid-configuration no matter whether externalAddress ends with a '/'
+	issuer := strings.TrimRight(externalAddress, "/") + strings.TrimSuffix(config.PathPrefix(), "/")
+	authURL := issuer + "/oauth/v2/authorize"
+
+	manager.MapAccessGenerate(&MacaroonAccessGenerate{
+		Service:            service,
+		ScopeCaveats:       config.ScopeCaveats,
+		RefreshMaxAge:      config.RefreshTokenMaxAge,
+		AccessTokenFormats: config.AccessTokenFormats,
+		Issuer:             issuer,
+		ClockSkew:          config.ClockSkew,
+	})
 	manager.SetRefreshTokenCfg(&manage.RefreshingConfig{
-		AccessTokenExp:    accessTokenExpiry,
-		RefreshTokenExp:   refreshTokenExpiry,
-		IsGenerateRefresh: refreshTokenExpiry > 0,
+		AccessTokenExp:     config.AccessTokenExpiry,
+		RefreshTokenExp:    config.RefreshTokenExpiry,
+		IsGenerateRefresh:  config.RefreshTokenExpiry > 0,
+		IsRemoveRefreshing: true,
 	})
 
 	svr := server.NewDefaultServer(manager)
+	svr.Config.AllowedResponseTypes = supportedResponseTypes
+
+	var lockout *clientLockout
+	if config.ClientLockoutThreshold > 0 {
+		lockout = &clientLockout{
+			Manager:   manager,
+			log:       log,
+			threshold: config.ClientLockoutThreshold,
+			duration:  config.ClientLockoutDuration,
+			decay:     config.ClientLockoutDecay,
+
+			maxTracked: config.ClientLockoutMaxClients,
+			nowFn:      time.Now,
+			clients:    make(map[string]*clientFailures),
+		}
+		svr.Manager = lockout
+	}
+
+	denial := clientDenial{clients: clientStore}
+	svr.Manager = &deniedClientManager{Manager: svr.Manager, denial: denial}
+
+	pkce := pkceCheck{
+		clients:  clientStore,
+		policies: config.ClientPKCEPolicies,
+		allowOff: config.PKCEOffAllowed,
+
+		publicRequired: config.PKCEPublicClientsRequired,
+	}
+	svr.Manager = &pkceManager{Manager: svr.Manager, pkce: pkce}
+
+	if config.RefreshCoalescingWindow > 0 {
+		svr.Manager = newRefreshCoalescing(svr.Manager, config.RefreshCoalescingWindow)
+	}
+
+	// refreshes may narrow the granted scope, but never extend it
+	svr.SetRefreshingScopeHandler(func(tgr *oauth2.TokenGenerateRequest, oldScope string) (allowed bool, err error) {
+		return isSubScope(tgr.Scope, oldScope), nil
+	})
+
+	svr.SetExtensionFieldsHandler(tokenResponseFields)
+	svr.SetInternalErrorHandler(internalError)
+	svr.SetResponseErrorHandler(errorDocs(config.ErrorDocsURL))
+
+	var loginURL *url.URL
+	if config.LoginURL != "" {
+		var err error
+		loginURL, err = url.Parse(config.LoginURL)
+		if err != nil {
+			log.Warn("ignoring invalid login url", zap.String("url", config.LoginURL), zap.Error(err))
+		}
+	}
+
+	resources, invalidResources := newAllowedResources(config.Resources)
+	for _, resource := range invalidResources {
+		log.Warn("ignoring invalid resource", zap.String("resource", resource))
+	}
+
+	responseTypes := responseTypeCheck{
+		clients:        config.ClientResponseTypes,
+		requiredScopes: config.FrontChannelRequiredScopes,
+	}
+
+	consent := consentFlow{
+		consents: oidcService.store.OAuthConsents(),
+		expiry:   config.ConsentExpiry,
+	}
 
 	svr.SetUserAuthorizationHandler(func(w http.ResponseWriter, r *http.Request) (userID string, err error) {
+		// the request was validated by now, so the rejection is redirected back to the client
+		if err := denial.check(r.Context(), r.FormValue("client_id")); err != nil {
+			return "", err
+		}
+		if err := responseTypes.check(r); err != nil {
+			return "", err
+		}
+		if err := scopeLimit(config.MaxScopes).check(r); err != nil {
+			return "", err
+		}
+		if err := pkce.authorize(r); err != nil {
+			return "", err
+		}
+		if err := resources.check(r); err != nil {
+			return "", err
+		}
+
 		user, err := console.GetUser(r.Context())
 		if err != nil {
-			return "", console.ErrUnauthorized.Wrap(err)
+			if loginURL == nil {
+				return "", console.ErrUnauthorized.Wrap(err)
+			}
+
+			// without a user id the flow stops here, and resumes once the user logged in
+			http.Redirect(w, r, loginRedirect(loginURL, authURL, r.Form), http.StatusFound)
+			return "", nil
+		}
+
+		if err := consent.authorize(r, user.ID); err != nil {
+			return "", err
 		}
 
 		return user.ID.String(), nil
 	})
 
-	// externalAddress _should_ end with a '/' suffix based on the calling path
-	return &Endpoint{
+	signedUserInfo := make(map[uuid.UUID]bool, len(config.SignedUserInfoClients))
+	for _, client := range config.SignedUserInfoClients {
+		clientID, err := uuid.FromString(client)
+		if err != nil {
+			log.Warn("ignoring invalid signed user info client", zap.String("client", client), zap.Error(err))
+			continue
+		}
+		signedUserInfo[clientID] = true
+	}
+
+	maxBodySize := config.MaxRequestBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxRequestBodySize
+	}
+
+	requestTimeout := config.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	logouts := newLogoutDeliveries(log, config)
+
+	var accessLog *zap.Logger
+	if config.AccessLog {
+		accessLog = log.Named("access")
+	}
+
+	sameSite := http.SameSite(config.CookieSameSite)
+	if sameSite == http.SameSiteDefaultMode {
+		sameSite = http.SameSiteLaxMode
+	}
+
+	tokenURL := issuer + "/oauth/v2/tokens"
+
+	assertionAudiences := config.ClientAssertionAudiences
+	if len(assertionAudiences) == 0 {
+		// clients may address the issuer as it was advertised before it lost its trailing slash
+		assertionAudiences = []string{tokenURL, issuer, issuer + "/"}
+	}
+
+	endpoint := &Endpoint{
 		clientStore: clientStore,
 		tokenStore:  tokenStore,
+		tokens:      tokenStore.tokens,
 		service:     service,
 		server:      svr,
 		log:         log,
+		scopes:      config.ScopeCaveats,
+		requireTLS:  config.RequireTLS,
+		sameSite:    sameSite,
+		bucketLimit: config.UserInfoBucketLimit,
 		config: ProviderConfig{
-			NodeURL:     nodeURL.String(),
-			Issuer:      externalAddress,
-			AuthURL:     externalAddress + "oauth/v2/authorize",
-			TokenURL:    externalAddress + "oauth/v2/tokens",
-			UserInfoURL: externalAddress + "oauth/v2/userinfo",
+			NodeURL:       nodeURL.String(),
+			Issuer:        issuer,
+			AuthURL:       authURL,
+			TokenURL:      tokenURL,
+			UserInfoURL:   issuer + "/oauth/v2/userinfo",
+			EndSessionURL: issuer + "/oauth/v2/logout",
+
+			ResponseTypesSupported: responseTypeNames(config.ClientResponseTypes.advertised()),
+			UserInfoSigningAlgs:    []string{userInfoSigningAlg},
+
+			TokenEndpointAuthMethods:     config.ClientAuthMethods.advertised(),
+			TokenEndpointAuthSigningAlgs: []string{userInfoSigningAlg},
+
+			BackchannelLogoutSupported:        true,
+			BackchannelLogoutSessionSupported: true,
+
+			Metadata: config.DiscoveryMetadata,
 		},
+
+		signedUserInfo: signedUserInfo,
+
+		retryBackendFailures: config.UserInfoRetryBackendFailures,
+
+		pkce: pkce,
+
+		accessLog: accessLog,
+
+		lockout:       lockout,
+		lockoutHeader: config.ClientLockoutHeader,
+
+		maxBodySize:    maxBodySize.Int64(),
+		requestTimeout: requestTimeout,
+		concurrency:    newConcurrencyLimit(config.MaxConcurrentRequests),
+		clockSkew:      config.ClockSkew,
+		idTokenExpiry:  idTokenExpiry,
+
+		logoutRedirect: externalAddress,
+		logoutURIs:     config.BackchannelLogoutURIs,
+		logouts:        logouts,
 	}
+
+	authentication := &clientAuthentication{
+		methods: config.ClientAuthMethods,
+		assertions: &clientAssertions{
+			endpoint:  endpoint,
+			audiences: assertionAudiences,
+			replays:   newJTIReplays(config.MaxAssertionReplays),
+		},
+	}
+	svr.SetClientInfoHandler(authentication.clientInfo)
+
+	return endpoint
 }
 
 // Endpoint implements an OpenID Connect (OIDC) Identity Provider. It grants client applications access to resources
@@ -84,12 +294,80 @@ func NewEndpoint(
 //
 // architecture: Endpoint
 type Endpoint struct {
+	// Sessions ends the console session when a relying party logs the user out. Without it, logout requests only
+	// redirect.
+	Sessions ConsoleSessions
+
 	clientStore oauth2.ClientStore
 	tokenStore  oauth2.TokenStore
+	tokens      OAuthTokens
 	service     *console.Service
 	server      *server.Server
 	log         *zap.Logger
+	scopes      ScopeCaveats
+	requireTLS  bool
+	sameSite    http.SameSite
+	bucketLimit int
 	config      ProviderConfig
+
+	// retryBackendFailures responds with 503 rather than 500 to user info requests failing to look up their token or
+	// user.
+	retryBackendFailures bool
+
+	// signedUserInfo are the clients receiving user info as a signed jwt.
+	signedUserInfo map[uuid.UUID]bool
+
+	// pkce strips the code challenges of clients with PKCE turned off from their authorize requests.
+	pkce pkceCheck
+
+	// accessLog logs every request when access logging is enabled, and is nil otherwise.
+	accessLog *zap.Logger
+
+	// lockout locks out clients failing to authenticate when the lockout is enabled, and is nil otherwise.
+	lockout *clientLockout
+	// lockoutHeader is the header set by a trusted proxy the client lockout takes the address of requests from.
+	lockoutHeader string
+
+	// maxBodySize and requestTimeout bound the authorize, token and user info requests.
+	maxBodySize    int64
+	requestTimeout time.Duration
+	// concurrency caps the token and user info requests served at once.
+	concurrency *concurrencyLimit
+
+	// clockSkew is how far the clocks of clients may drift from ours when validating and issuing tokens.
+	clockSkew time.Duration
+	// idTokenExpiry is how long signed user info is valid for. Relying parties use it as their id token, which
+	// asserts the authentication rather than granting access, so it may be shorter lived than access tokens.
+	idTokenExpiry time.Duration
+
+	// logoutRedirect is where users are sent after logging out when the client didn't ask for a redirect.
+	logoutRedirect string
+
+	// logoutURIs are where clients receive back-channel logout tokens, which logouts delivers.
+	logoutURIs LogoutURIs
+	logouts    *logoutDeliveries
+}
+
+// tokenResponseFields ensures the token response always describes the granted scope, and how long the refresh token
+// remains valid for when one was issued.
+func tokenResponseFields(ti oauth2.TokenInfo) map[string]interface{} {
+	fields := map[string]interface{}{
+		"scope": ti.GetScope(),
+	}
+
+	if ti.GetRefresh() != "" {
+		expiresAt := ti.GetRefreshCreateAt().Add(ti.GetRefreshExpiresIn())
+		fields["refresh_expires_in"] = int64(time.Until(expiresAt) / time.Second)
+	}
+
+	return fields
+}
+
+// SetNow allows tests to have the client lockout act as if the current time is whatever they want.
+func (e *Endpoint) SetNow(nowFn func() time.Time) {
+	if e.lockout != nil {
+		e.lockout.nowFn = nowFn
+	}
 }
 
 // WellKnownConfiguration renders the identity provider configuration that points clients to various endpoints.
@@ -109,10 +387,23 @@ func (e *Endpoint) WellKnownConfiguration(w http.ResponseWriter, r *http.Request
 // AuthorizeUser is called from an authenticated context granting the requester access to the application. We redirect
 // back to the client application with the provided state and obtained code.
 func (e *Endpoint) AuthorizeUser(w http.ResponseWriter, r *http.Request) {
+	r, cancel := e.limitRequest(w, r)
+	defer cancel()
+	if r == nil {
+		return
+	}
+
 	ctx := r.Context()
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
+	// resource indicators may be repeated, which the oauth2 server can't represent, so they're passed on separately
+	if r.ParseForm() == nil {
+		r = r.WithContext(withResourceRequest(ctx, r))
+		r = withHybridResponseType(r)
+		e.pkce.strip(r)
+	}
+
 	err = e.server.HandleAuthorizeRequest(w, r)
 	if err != nil {
 		e.log.Error("failed to authorize user", zap.Error(err))
@@ -121,10 +412,28 @@ func (e *Endpoint) AuthorizeUser(w http.ResponseWriter, r *http.Request) {
 
 // Tokens exchanges unexpired refresh tokens or codes provided by AuthorizeUser for the associated set of tokens.
 func (e *Endpoint) Tokens(w http.ResponseWriter, r *http.Request) {
+	r, cancel := e.limitRequest(w, r)
+	defer cancel()
+	if r == nil {
+		return
+	}
+
+	release, ok := e.limitConcurrency(w)
+	if !ok {
+		return
+	}
+	defer release()
+
 	ctx := r.Context()
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
+	// resource indicators may be repeated, which the oauth2 server can't represent, so they're passed on separately
+	if r.ParseForm() == nil {
+		r = r.WithContext(withResourceRequest(ctx, r))
+	}
+	r = r.WithContext(withClientAddress(r.Context(), r, e.lockoutHeader))
+
 	err = e.server.HandleTokenRequest(w, r)
 	if err != nil {
 		e.log.Error("failed to exchange for token", zap.Error(err))
@@ -133,6 +442,18 @@ func (e *Endpoint) Tokens(w http.ResponseWriter, r *http.Request) {
 
 // UserInfo uses the provided access token to look up the associated user information.
 func (e *Endpoint) UserInfo(w http.ResponseWriter, r *http.Request) {
+	r, cancel := e.limitRequest(w, r)
+	defer cancel()
+	if r == nil {
+		return
+	}
+
+	release, ok := e.limitConcurrency(w)
+	if !ok {
+		return
+	}
+	defer release()
+
 	ctx := r.Context()
 	var err error
 	defer mon.Task()(&ctx)(&err)
@@ -146,17 +467,28 @@ func (e *Endpoint) UserInfo(w http.ResponseWriter, r *http.Request) {
 	accessToken = strings.TrimPrefix(accessToken, "Bearer ")
 
 	info, err := e.tokenStore.GetByAccess(ctx, accessToken)
-	if err != nil || info == nil {
+	if unavailable(w, err) {
+		return
+	}
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && info == nil) {
 		http.Error(w, "", http.StatusUnauthorized)
 		return
 	}
+	if err != nil {
+		e.backendFailure(w, "failed to look up access token", err)
+		return
+	}
 
-	userInfo, _, err := parseScope(info.GetScope())
+	userInfo, caveats, err := parseScope(info.GetScope(), e.scopes)
 	if err != nil {
 		http.Error(w, "", http.StatusUnauthorized)
 		return
 	}
 
+	// the scopes name buckets the access token may still not grant, when other scopes restrict it to other buckets
+	allowedBuckets := grantedBuckets(caveats)
+	userInfo.Buckets = filterBuckets(userInfo.Buckets, allowedBuckets)
+
 	userID, err := uuid.FromString(info.GetUserID())
 	if err != nil {
 		http.Error(w, "", http.StatusUnauthorized)
@@ -164,19 +496,118 @@ func (e *Endpoint) UserInfo(w http.ResponseWriter, r *http.Request) {
 	}
 
 	user, err := e.service.GetUser(ctx, userID)
-	if err != nil {
+	if unavailable(w, err) {
+		return
+	}
+	if errors.Is(err, sql.ErrNoRows) {
 		http.Error(w, "", http.StatusUnauthorized)
 		return
 	}
+	if err != nil {
+		e.backendFailure(w, "failed to look up user", err)
+		return
+	}
 
+	// the token is fine, but the account it was granted for may not be used anymore
 	if user.Status != console.Active {
-		http.Error(w, "", http.StatusUnauthorized)
+		http.Error(w, "", http.StatusForbidden)
 		return
 	}
 
 	userInfo.Subject = user.ID
 	userInfo.Email = user.Email
-	userInfo.EmailVerified = true
+	// accounts are activated through the link sent to their email, but emails changed since aren't verified
+	userInfo.EmailVerified = !user.EmailUnverified
+
+	if hasScope(info.GetScope(), liveBucketsScope) {
+		e.listLiveBuckets(ctx, user, &userInfo, allowedBuckets)
+	}
+
+	clientID, err := uuid.FromString(info.GetClientID())
+	if err != nil {
+		http.Error(w, "", http.StatusUnauthorized)
+		return
+	}
+
+	var template *ClaimTemplate
+	if !clientID.IsZero() {
+		client, err := e.clientStore.GetByID(ctx, clientID.String())
+		if unavailable(w, err) {
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			e.backendFailure(w, "failed to look up client", err)
+			return
+		}
+		if c, ok := client.(OAuthClient); ok {
+			template = c.ClaimTemplate
+		}
+	}
+
+	if template != nil {
+		userInfo.Claims, err = template.claims(ctx, e.service, user, userInfo.Project)
+		if unavailable(w, err) {
+			return
+		}
+		if err != nil {
+			e.log.Error("failed to render claims", zap.Stringer("client", clientID), zap.Error(err))
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if hasScope(info.GetScope(), projectMetaScope) {
+		meta, err := projectMeta(ctx, e.service, user, userInfo.Project)
+		if unavailable(w, err) {
+			return
+		}
+		if err != nil {
+			e.log.Error("failed to render project metadata claims", zap.Stringer("client", clientID), zap.Error(err))
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+
+		if len(meta) > 0 && userInfo.Claims == nil {
+			userInfo.Claims = make(map[string]interface{}, len(meta))
+		}
+		for name, value := range meta {
+			userInfo.Claims[name] = value
+		}
+	}
+
+	if e.wantsSignedUserInfo(r, clientID) {
+		// the response is signed for the client the token was issued to, so it can't be served without one
+		if clientID.IsZero() {
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+
+		signed, err := e.signUserInfo(ctx, clientID, userInfo)
+		if unavailable(w, err) {
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			e.log.Error("failed to sign user info", zap.Stringer("client", clientID), zap.Error(err))
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/jwt")
+
+		_, err = w.Write([]byte(signed))
+		if err != nil {
+			e.log.Error("failed to write user info", zap.Error(err))
+		}
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 
@@ -220,6 +651,43 @@ type ProviderConfig struct {
 	AuthURL     string `json:"authorization_endpoint"`
 	TokenURL    string `json:"token_endpoint"`
 	UserInfoURL string `json:"userinfo_endpoint"`
+
+	EndSessionURL string `json:"end_session_endpoint"`
+
+	ResponseTypesSupported []string `json:"response_types_supported"`
+	UserInfoSigningAlgs    []string `json:"userinfo_signing_alg_values_supported"`
+
+	TokenEndpointAuthMethods     []string `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgs []string `json:"token_endpoint_auth_signing_alg_values_supported"`
+
+	BackchannelLogoutSupported        bool `json:"backchannel_logout_supported"`
+	BackchannelLogoutSessionSupported bool `json:"backchannel_logout_session_supported"`
+
+	// Metadata is the additional metadata configured by the operator.
+	Metadata DiscoveryMetadata `json:"-"`
+}
+
+// MarshalJSON includes the additional metadata alongside the provider's own.
+func (config ProviderConfig) MarshalJSON() ([]byte, error) {
+	type providerConfig ProviderConfig
+
+	data, err := json.Marshal(providerConfig(config))
+	if err != nil || len(config.Metadata) == 0 {
+		return data, err
+	}
+
+	document := make(map[string]interface{}, len(config.Metadata))
+	for name, value := range config.Metadata {
+		document[name] = value
+	}
+
+	// the provider's own metadata always takes precedence
+	err = json.Unmarshal(data, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(document)
 }
 
 // UserInfo provides a semi-standard object for common user information. The "cubbyhole" value is used to share the
@@ -235,4 +703,33 @@ type UserInfo struct {
 	Project   string   `json:"project"`
 	Buckets   []string `json:"buckets"`
 	Cubbyhole string   `json:"cubbyhole"`
+
+	// BucketsTruncated is set when the storj:buckets scope was granted and the project has more buckets than listed.
+	BucketsTruncated bool `json:"buckets_truncated,omitempty"`
+
+	// Claims are the additional claims from the client's claim template.
+	Claims map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON includes the templated claims alongside the standard ones.
+func (info UserInfo) MarshalJSON() ([]byte, error) {
+	type userInfo UserInfo
+
+	data, err := json.Marshal(userInfo(info))
+	if err != nil || len(info.Claims) == 0 {
+		return data, err
+	}
+
+	claims := make(map[string]interface{}, len(info.Claims))
+	for name, value := range info.Claims {
+		claims[name] = value
+	}
+
+	// the standard claims always take precedence
+	err = json.Unmarshal(data, &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(claims)
 }
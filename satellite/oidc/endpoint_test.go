@@ -0,0 +1,1838 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/oidc"
+)
+
+type mockDB struct{}
+
+func (mockDB) OAuthClients() oidc.OAuthClients   { return noClients{} }
+func (mockDB) OAuthCodes() oidc.OAuthCodes       { return nil }
+func (mockDB) OAuthTokens() oidc.OAuthTokens     { return nil }
+func (mockDB) OAuthConsents() oidc.OAuthConsents { return nil }
+
+// noClients doesn't know any client.
+type noClients struct {
+	oidc.OAuthClients
+}
+
+func (noClients) Get(context.Context, uuid.UUID) (oidc.OAuthClient, error) {
+	return oidc.OAuthClient{}, sql.ErrNoRows
+}
+
+// failingTokens fails every token lookup with err.
+type failingTokens struct {
+	oidc.OAuthTokens
+	err error
+}
+
+func (tokens failingTokens) Get(context.Context, oidc.OAuthTokenKind, string) (oidc.OAuthToken, error) {
+	return oidc.OAuthToken{}, tokens.err
+}
+
+func (tokens failingTokens) RevokeRefreshFamily(context.Context, string) error { return nil }
+
+type failingTokensDB struct {
+	mockDB
+	tokens failingTokens
+}
+
+func (db failingTokensDB) OAuthTokens() oidc.OAuthTokens { return db.tokens }
+
+func newTestEndpoint(t *testing.T, externalAddress string, config oidc.Config) *oidc.Endpoint {
+	config.CodeExpiry = time.Minute
+	config.AccessTokenExpiry = time.Hour
+
+	return oidc.NewEndpoint(
+		storj.NodeURL{}, externalAddress, zaptest.NewLogger(t),
+		oidc.NewService(mockDB{}), nil,
+		config,
+	)
+}
+
+func TestPathPrefix(t *testing.T) {
+	for prefix, expected := range map[string]string{
+		"":      "/",
+		"/":     "/",
+		"app":   "/app/",
+		"/app":  "/app/",
+		"/app/": "/app/",
+		"a/b/":  "/a/b/",
+	} {
+		require.Equal(t, expected, oidc.Config{RoutePrefix: prefix}.PathPrefix(), prefix)
+	}
+}
+
+func TestWellKnownConfigurationPrefix(t *testing.T) {
+	endpoint := newTestEndpoint(t, "https://satellite.test/", oidc.Config{RoutePrefix: "/app/"})
+
+	recorder := httptest.NewRecorder()
+	endpoint.WellKnownConfiguration(recorder, httptest.NewRequest(http.MethodGet, "/app/.well-known/openid-configuration", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var cfg oidc.ProviderConfig
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &cfg))
+
+	require.Equal(t, "https://satellite.test/app", cfg.Issuer)
+	require.Equal(t, "https://satellite.test/app/oauth/v2/authorize", cfg.AuthURL)
+	require.Equal(t, "https://satellite.test/app/oauth/v2/tokens", cfg.TokenURL)
+	require.Equal(t, "https://satellite.test/app/oauth/v2/userinfo", cfg.UserInfoURL)
+	require.Equal(t, []string{"HS256"}, cfg.UserInfoSigningAlgs)
+	// without clients registered for the implicit flow, only the code response type is used
+	require.Equal(t, []string{"code"}, cfg.ResponseTypesSupported)
+	require.True(t, cfg.BackchannelLogoutSupported)
+	require.True(t, cfg.BackchannelLogoutSessionSupported)
+}
+
+func TestDiscoveryIssuer(t *testing.T) {
+	for _, tc := range []struct {
+		externalAddress, prefix string
+		discovery               string
+	}{
+		{"https://satellite.test/", "", "https://satellite.test/.well-known/openid-configuration"},
+		{"https://satellite.test", "", "https://satellite.test/.well-known/openid-configuration"},
+		{"https://satellite.test//", "/", "https://satellite.test/.well-known/openid-configuration"},
+		{"https://satellite.test/", "/app/", "https://satellite.test/app/.well-known/openid-configuration"},
+		{"https://satellite.test", "app", "https://satellite.test/app/.well-known/openid-configuration"},
+	} {
+		endpoint := newTestEndpoint(t, tc.externalAddress, oidc.Config{RoutePrefix: tc.prefix})
+
+		recorder := httptest.NewRecorder()
+		endpoint.WellKnownConfiguration(recorder, httptest.NewRequest(http.MethodGet, tc.discovery, nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var cfg oidc.ProviderConfig
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &cfg))
+
+		// the document is fetched from the issuer followed by the well-known path
+		require.Equal(t, tc.discovery, cfg.Issuer+"/.well-known/openid-configuration", tc)
+		require.False(t, strings.HasSuffix(cfg.Issuer, "/"), tc)
+
+		for _, endpointURL := range []string{cfg.AuthURL, cfg.TokenURL, cfg.UserInfoURL, cfg.EndSessionURL} {
+			require.True(t, strings.HasPrefix(endpointURL, cfg.Issuer+"/oauth/v2/"), endpointURL)
+			require.NotContains(t, strings.TrimPrefix(endpointURL, "https://"), "//", endpointURL)
+		}
+	}
+}
+
+func TestDiscoveryMetadata(t *testing.T) {
+	var metadata oidc.DiscoveryMetadata
+	require.NoError(t, metadata.Set(""))
+	require.Empty(t, metadata)
+
+	for _, invalid := range []string{
+		`{"issuer": "https://spoofed.test/"}`,
+		`{"token_endpoint": "https://spoofed.test/tokens"}`,
+		`{"backchannel_logout_supported": false}`,
+		`{"jwks_uri": "https://spoofed.test/jwks"}`,
+		`{"": "unnamed"}`,
+		`["claims_supported"]`,
+	} {
+		require.Error(t, metadata.Set(invalid), invalid)
+	}
+
+	require.NoError(t, metadata.Set(`{"claims_supported": ["sub", "email"], "op_policy_uri": "https://satellite.test/policy"}`))
+
+	endpoint := newTestEndpoint(t, "https://satellite.test/", oidc.Config{DiscoveryMetadata: metadata})
+
+	recorder := httptest.NewRecorder()
+	endpoint.WellKnownConfiguration(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+	require.Equal(t, []interface{}{"sub", "email"}, document["claims_supported"])
+	require.Equal(t, "https://satellite.test/policy", document["op_policy_uri"])
+	require.Equal(t, "https://satellite.test", document["issuer"])
+	require.Equal(t, "https://satellite.test/oauth/v2/tokens", document["token_endpoint"])
+}
+
+func TestLogoutURIs(t *testing.T) {
+	clientID := testrand.UUID()
+
+	var uris oidc.LogoutURIs
+	require.NoError(t, uris.Set(""))
+	require.Empty(t, uris)
+
+	require.NoError(t, uris.Set(`{"`+clientID.String()+`": "https://app.test/logout"}`))
+	require.Equal(t, oidc.LogoutURIs{clientID: "https://app.test/logout"}, uris)
+
+	for _, invalid := range []string{"/logout", "ftp://app.test/logout", "https://app.test/logout#fragment"} {
+		require.Error(t, uris.Set(`{"`+clientID.String()+`": "`+invalid+`"}`), invalid)
+	}
+}
+
+func TestSecureFlowCookies(t *testing.T) {
+	for mode, expected := range map[string]http.SameSite{
+		"":       http.SameSiteLaxMode,
+		"lax":    http.SameSiteLaxMode,
+		"strict": http.SameSiteStrictMode,
+		"none":   http.SameSiteNoneMode,
+	} {
+		config := oidc.Config{}
+		require.NoError(t, config.CookieSameSite.Set(mode))
+
+		endpoint := newTestEndpoint(t, "https://satellite.test/", config)
+		handler := endpoint.SecureFlow(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.SetCookie(w, &http.Cookie{Name: "csrf", Value: "token", Path: "/"})
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "id", Path: "/oauth"})
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "https://satellite.test/oauth/v2/authorize", nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		cookies := recorder.Result().Cookies()
+		require.Len(t, cookies, 2)
+		for _, cookie := range cookies {
+			require.True(t, cookie.Secure, mode)
+			require.True(t, cookie.HttpOnly, mode)
+			require.Equal(t, expected, cookie.SameSite, mode)
+		}
+		require.Equal(t, "csrf", cookies[0].Name)
+		require.Equal(t, "/oauth", cookies[1].Path)
+	}
+
+	var mode oidc.SameSite
+	require.Error(t, mode.Set("sometimes"))
+}
+
+func TestSecureFlowRequireTLS(t *testing.T) {
+	endpoint := newTestEndpoint(t, "https://satellite.test/", oidc.Config{RequireTLS: true})
+
+	handler := endpoint.SecureFlow(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://satellite.test/oauth/v2/authorize?state=1", nil))
+	require.Equal(t, http.StatusPermanentRedirect, recorder.Code)
+	require.Equal(t, "https://satellite.test/oauth/v2/authorize?state=1", recorder.Header().Get("Location"))
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "https://satellite.test/oauth/v2/authorize", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	proxied := httptest.NewRequest(http.MethodGet, "http://satellite.test/oauth/v2/authorize", nil)
+	proxied.Header.Set("X-Forwarded-Proto", "https")
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, proxied)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestRequestLimits(t *testing.T) {
+	config := oidc.Config{
+		MaxRequestBodySize: memory.KiB,
+		RequestTimeout:     50 * time.Millisecond,
+	}
+	endpoint := newTestEndpoint(t, "https://satellite.test/", config)
+
+	oversized := strings.NewReader("grant_type=refresh_token&refresh_token=" + strings.Repeat("a", memory.KiB.Int()))
+
+	recorder := httptest.NewRecorder()
+	endpoint.Tokens(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", oversized))
+	require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+
+	// a body that is never completed
+	slow, writer := io.Pipe()
+	defer func() { _ = writer.Close() }()
+
+	recorder = httptest.NewRecorder()
+	endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize", slow))
+	require.Equal(t, http.StatusRequestTimeout, recorder.Code)
+
+	// requests within the limits reach the handler
+	recorder = httptest.NewRecorder()
+	endpoint.UserInfo(recorder, httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil))
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestErrorDocs(t *testing.T) {
+	errorResponse := func(endpoint *oidc.Endpoint, r *http.Request) map[string]interface{} {
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, r)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return data
+	}
+
+	endpoint := newTestEndpoint(t, "https://satellite.test/", oidc.Config{})
+	data := errorResponse(endpoint, httptest.NewRequest(http.MethodGet, "/oauth/v2/tokens", nil))
+	require.Equal(t, "invalid_request", data["error"])
+	require.NotContains(t, data, "error_uri")
+
+	endpoint = newTestEndpoint(t, "https://satellite.test/", oidc.Config{ErrorDocsURL: "https://docs.test/oauth#errors"})
+	data = errorResponse(endpoint, httptest.NewRequest(http.MethodGet, "/oauth/v2/tokens", nil))
+	require.Equal(t, "invalid_request", data["error"])
+	require.Equal(t, "https://docs.test/oauth#invalid_request", data["error_uri"])
+
+	data = errorResponse(endpoint, httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", nil))
+	require.Equal(t, "unsupported_grant_type", data["error"])
+	require.Equal(t, "https://docs.test/oauth#unsupported_grant_type", data["error_uri"])
+}
+
+func TestClientResponseTypes(t *testing.T) {
+	codeOnly, implicit, unregistered := testrand.UUID(), testrand.UUID(), testrand.UUID()
+
+	config := oidc.Config{FrontChannelRequiredScopes: []string{"openid"}}
+	require.NoError(t, config.ClientResponseTypes.Set(`{"`+codeOnly.String()+`": ["code"]}`))
+	require.Error(t, config.ClientResponseTypes.Set(`{"`+codeOnly.String()+`": ["id_token"]}`))
+	require.Error(t, config.ClientResponseTypes.Set(`{"`+codeOnly.String()+`": ["code token"]}`))
+	require.NoError(t, config.ClientResponseTypes.Set(`{"`+codeOnly.String()+`": ["code"], "`+implicit.String()+`": ["code", "token"]}`))
+
+	endpoint := newTestEndpoint(t, "https://satellite.test/", config)
+
+	authorize := func(clientID uuid.UUID, responseType, scope string) url.Values {
+		query := url.Values{
+			"client_id":     {clientID.String()},
+			"response_type": {responseType},
+			"redirect_uri":  {"https://app.test/callback"},
+			"scope":         {scope},
+			"state":         {"state"},
+		}
+
+		recorder := httptest.NewRecorder()
+		endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize?"+query.Encode(), nil))
+		require.Equal(t, http.StatusFound, recorder.Code)
+
+		location, err := url.Parse(recorder.Header().Get("Location"))
+		require.NoError(t, err)
+
+		if responseType != "code" {
+			values, err := url.ParseQuery(location.Fragment)
+			require.NoError(t, err)
+			return values
+		}
+		return location.Query()
+	}
+
+	// neither the code-only client nor clients that didn't register response types can use the implicit flow
+	values := authorize(codeOnly, "token", "openid")
+	require.Equal(t, "unauthorized_client", values.Get("error"))
+	require.Equal(t, "state", values.Get("state"))
+	require.Equal(t, "unauthorized_client", authorize(unregistered, "token", "openid").Get("error"))
+
+	// tokens are only returned in the front channel for the required scopes
+	require.Equal(t, "invalid_scope", authorize(implicit, "token", "profile").Get("error"))
+
+	// hybrid requests are rejected for restricted clients, and unsupported for permitted ones
+	require.Equal(t, "unauthorized_client", authorize(codeOnly, "code token", "openid").Get("error"))
+	require.Equal(t, "unauthorized_client", authorize(unregistered, "code token", "openid").Get("error"))
+	require.Equal(t, "unsupported_response_type", authorize(implicit, "code token", "openid").Get("error"))
+	// no client receives id tokens
+	require.Equal(t, "unauthorized_client", authorize(implicit, "code id_token", "openid").Get("error"))
+
+	// the other requests pass the check and only fail for lack of an authenticated user
+	for _, request := range []struct {
+		clientID     uuid.UUID
+		responseType string
+		scope        string
+	}{
+		{codeOnly, "code", ""},
+		{unregistered, "code", ""},
+		{implicit, "code", "profile"},
+		{implicit, "token", "openid profile"},
+	} {
+		errorCode := authorize(request.clientID, request.responseType, request.scope).Get("error")
+		require.NotEqual(t, "unauthorized_client", errorCode)
+		require.NotEqual(t, "unsupported_response_type", errorCode)
+		require.NotEqual(t, "invalid_scope", errorCode)
+	}
+
+	// response types are advertised once a client may use them
+	recorder := httptest.NewRecorder()
+	endpoint.WellKnownConfiguration(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil))
+
+	var cfg oidc.ProviderConfig
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &cfg))
+	require.Equal(t, []string{"code", "token"}, cfg.ResponseTypesSupported)
+}
+
+func TestResourceIndicators(t *testing.T) {
+	config := oidc.Config{Resources: []string{"https://gateway.test", "not a uri"}}
+	endpoint := newTestEndpoint(t, "https://satellite.test/", config)
+
+	authorize := func(resources ...string) url.Values {
+		query := url.Values{
+			"client_id":     {testrand.UUID().String()},
+			"response_type": {"code"},
+			"redirect_uri":  {"https://app.test/callback"},
+			"state":         {"state"},
+			"resource":      resources,
+		}
+
+		recorder := httptest.NewRecorder()
+		endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize?"+query.Encode(), nil))
+		require.Equal(t, http.StatusFound, recorder.Code)
+
+		location, err := url.Parse(recorder.Header().Get("Location"))
+		require.NoError(t, err)
+		return location.Query()
+	}
+
+	// resources outside of the allowlist are rejected back to the client
+	values := authorize("https://gateway.test", "https://other.test")
+	require.Equal(t, "invalid_target", values.Get("error"))
+	require.Equal(t, "state", values.Get("state"))
+
+	// invalid resources are never allowed, even when configured
+	require.Equal(t, "invalid_target", authorize("not a uri").Get("error"))
+
+	// allowed resources pass the check and only fail for lack of an authenticated user
+	require.NotEqual(t, "invalid_target", authorize("https://gateway.test").Get("error"))
+	require.NotEqual(t, "invalid_target", authorize().Get("error"))
+}
+
+func TestMaxScopes(t *testing.T) {
+	endpoint := newTestEndpoint(t, "https://satellite.test/", oidc.Config{MaxScopes: 3})
+
+	authorize := func(scope string) url.Values {
+		query := url.Values{
+			"client_id":     {testrand.UUID().String()},
+			"response_type": {"code"},
+			"redirect_uri":  {"https://app.test/callback"},
+			"state":         {"state"},
+			"scope":         {scope},
+		}
+
+		recorder := httptest.NewRecorder()
+		endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize?"+query.Encode(), nil))
+		require.Equal(t, http.StatusFound, recorder.Code)
+
+		location, err := url.Parse(recorder.Header().Get("Location"))
+		require.NoError(t, err)
+		return location.Query()
+	}
+
+	values := authorize("openid bucket:a bucket:b bucket:c")
+	require.Equal(t, "invalid_scope", values.Get("error"))
+	require.Equal(t, "at most 3 scopes may be requested", values.Get("error_description"))
+	require.Equal(t, "state", values.Get("state"))
+
+	// repeated scopes only count once, and requests within the limit only fail for lack of an authenticated user
+	require.NotEqual(t, "invalid_scope", authorize("openid bucket:a bucket:b bucket:a").Get("error"))
+
+	// without a limit any number of scopes may be requested
+	endpoint = newTestEndpoint(t, "https://satellite.test/", oidc.Config{})
+	require.NotEqual(t, "invalid_scope", authorize("openid bucket:a bucket:b bucket:c").Get("error"))
+}
+
+func TestLoginRedirect(t *testing.T) {
+	endpoint := newTestEndpoint(t, "https://satellite.test/", oidc.Config{LoginURL: "https://satellite.test/login?source=oauth"})
+
+	params := url.Values{
+		"client_id":     {testrand.UUID().String()},
+		"response_type": {"code"},
+		"redirect_uri":  {"https://app.test/callback"},
+		"scope":         {"project:test bucket:a"},
+		"state":         {"state & more"},
+		"nonce":         {"nonce"},
+	}
+
+	recorder := httptest.NewRecorder()
+	endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize?"+params.Encode(), nil))
+	require.Equal(t, http.StatusFound, recorder.Code)
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "satellite.test", location.Host)
+	require.Equal(t, "/login", location.Path)
+	require.Equal(t, "oauth", location.Query().Get("source"))
+
+	returnTo, err := url.Parse(location.Query().Get("return_to"))
+	require.NoError(t, err)
+	require.Equal(t, "https://satellite.test/oauth/v2/authorize", returnTo.Scheme+"://"+returnTo.Host+returnTo.Path)
+	require.Equal(t, params, returnTo.Query())
+
+	// without a login url the missing session is an error
+	endpoint = newTestEndpoint(t, "https://satellite.test/", oidc.Config{})
+
+	recorder = httptest.NewRecorder()
+	endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize?"+params.Encode(), nil))
+
+	location, err = url.Parse(recorder.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "app.test", location.Host)
+	require.NotEmpty(t, location.Query().Get("error"))
+}
+
+func TestTransientErrors(t *testing.T) {
+	endpointFailingWith := func(err error) *oidc.Endpoint {
+		return oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(failingTokensDB{tokens: failingTokens{err: err}}), nil,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+			},
+		)
+	}
+
+	refresh := func(endpoint *oidc.Endpoint) *httptest.ResponseRecorder {
+		form := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"token"}}
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(testrand.UUID().String(), "secret")
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+		return recorder
+	}
+
+	userInfo := func(endpoint *oidc.Endpoint) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		recorder := httptest.NewRecorder()
+		endpoint.UserInfo(recorder, req)
+		return recorder
+	}
+
+	errorCode := func(recorder *httptest.ResponseRecorder) string {
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return fmt.Sprint(data["error"])
+	}
+
+	for _, err := range []error{driver.ErrBadConn, context.DeadlineExceeded, syscall.ECONNREFUSED} {
+		endpoint := endpointFailingWith(err)
+
+		recorder := refresh(endpoint)
+		require.Equal(t, http.StatusServiceUnavailable, recorder.Code, err)
+		require.Equal(t, "5", recorder.Header().Get("Retry-After"), err)
+		require.Equal(t, "temporarily_unavailable", errorCode(recorder), err)
+
+		recorder = userInfo(endpoint)
+		require.Equal(t, http.StatusServiceUnavailable, recorder.Code, err)
+		require.Equal(t, "5", recorder.Header().Get("Retry-After"), err)
+	}
+
+	// unknown tokens are the client's fault and aren't worth retrying
+	endpoint := endpointFailingWith(sql.ErrNoRows)
+
+	recorder := refresh(endpoint)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Retry-After"))
+	require.Equal(t, "invalid_grant", errorCode(recorder))
+
+	recorder = userInfo(endpoint)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Retry-After"))
+}
+
+func TestUserInfoBackendFailures(t *testing.T) {
+	userInfo := func(err error, config oidc.Config) *httptest.ResponseRecorder {
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		endpoint := oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(failingTokensDB{tokens: failingTokens{err: err}}), nil,
+			config,
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		recorder := httptest.NewRecorder()
+		endpoint.UserInfo(recorder, req)
+		return recorder
+	}
+
+	// failing to look up the token doesn't tell the client it's invalid
+	failure := errs.New("unexpected token row")
+
+	recorder := userInfo(failure, oidc.Config{UserInfoRetryBackendFailures: true})
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.Equal(t, "5", recorder.Header().Get("Retry-After"))
+
+	recorder = userInfo(failure, oidc.Config{})
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Retry-After"))
+
+	// while unknown tokens are invalid however it's configured
+	for _, config := range []oidc.Config{{}, {UserInfoRetryBackendFailures: true}} {
+		recorder = userInfo(sql.ErrNoRows, config)
+		require.Equal(t, http.StatusUnauthorized, recorder.Code)
+		require.Empty(t, recorder.Header().Get("Retry-After"))
+	}
+}
+
+// staticClients knows a single client.
+type staticClients struct {
+	oidc.OAuthClients
+	client oidc.OAuthClient
+}
+
+func (clients staticClients) Get(ctx context.Context, id uuid.UUID) (oidc.OAuthClient, error) {
+	if id != clients.client.ID {
+		return oidc.OAuthClient{}, sql.ErrNoRows
+	}
+	return clients.client, nil
+}
+
+type staticClientsDB struct {
+	mockDB
+	clients staticClients
+}
+
+func (db staticClientsDB) OAuthClients() oidc.OAuthClients { return db.clients }
+
+// testSessions holds a single console session.
+type testSessions struct {
+	userID, sessionID uuid.UUID
+	ended             bool
+}
+
+func (sessions *testSessions) Current(*http.Request) (userID, sessionID uuid.UUID, ok bool) {
+	return sessions.userID, sessions.sessionID, !sessions.ended
+}
+
+func (sessions *testSessions) End(ctx context.Context, w http.ResponseWriter, sessionID uuid.UUID) error {
+	sessions.ended = true
+	return nil
+}
+
+func TestLogout(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(staticClientsDB{clients: staticClients{client: client}}), nil,
+		oidc.Config{
+			CodeExpiry:        time.Minute,
+			AccessTokenExpiry: time.Hour,
+		},
+	)
+
+	hint := func(userID uuid.UUID, secret string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss": "https://satellite.test",
+			"aud": client.ID.String(),
+			"sub": userID.String(),
+		})
+		signed, err := token.SignedString([]byte(secret))
+		require.NoError(t, err)
+		return signed
+	}
+
+	logout := func(query url.Values) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		endpoint.Logout(recorder, httptest.NewRequest(http.MethodGet, "/oauth/v2/logout?"+query.Encode(), nil))
+		return recorder
+	}
+
+	requireInvalid := func(recorder *httptest.ResponseRecorder) {
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		require.Equal(t, "invalid_request", data["error"])
+	}
+
+	user, other := testrand.UUID(), testrand.UUID()
+	sessions := &testSessions{userID: user, sessionID: testrand.UUID()}
+	endpoint.Sessions = sessions
+
+	// malformed, forged and missing hints are rejected
+	requireInvalid(logout(url.Values{}))
+	requireInvalid(logout(url.Values{"id_token_hint": {"not a token"}}))
+	requireInvalid(logout(url.Values{"id_token_hint": {hint(user, "forged-secret")}}))
+
+	// the redirect must belong to the client the hint was issued to
+	requireInvalid(logout(url.Values{
+		"id_token_hint":            {hint(user, "client-secret")},
+		"post_logout_redirect_uri": {"https://attacker.test/"},
+	}))
+
+	// another user's hint doesn't end the session
+	requireInvalid(logout(url.Values{"id_token_hint": {hint(other, "client-secret")}}))
+	require.False(t, sessions.ended)
+
+	recorder := logout(url.Values{
+		"id_token_hint":            {hint(user, "client-secret")},
+		"post_logout_redirect_uri": {"https://app.test/logged-out"},
+		"state":                    {"state"},
+	})
+	require.Equal(t, http.StatusFound, recorder.Code)
+	require.Equal(t, "https://app.test/logged-out?state=state", recorder.Header().Get("Location"))
+	require.True(t, sessions.ended)
+
+	// without a session, a valid hint only redirects
+	recorder = logout(url.Values{"id_token_hint": {hint(other, "client-secret")}})
+	require.Equal(t, http.StatusFound, recorder.Code)
+	require.Equal(t, "https://satellite.test/", recorder.Header().Get("Location"))
+}
+
+// loggedInTokens reports the user as holding tokens of a single client.
+type loggedInTokens struct {
+	oidc.OAuthTokens
+	clientID uuid.UUID
+}
+
+func (tokens loggedInTokens) ListClients(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return []uuid.UUID{tokens.clientID}, nil
+}
+
+type loggedInDB struct {
+	staticClientsDB
+}
+
+func (db loggedInDB) OAuthTokens() oidc.OAuthTokens {
+	return loggedInTokens{clientID: db.clients.client.ID}
+}
+
+func TestBackchannelLogoutDelivery(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	attempts := make(chan string, 10)
+	relyingParty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts <- r.PostFormValue("logout_token")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer relyingParty.Close()
+
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(loggedInDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+		oidc.Config{
+			CodeExpiry:        time.Minute,
+			AccessTokenExpiry: time.Hour,
+
+			BackchannelLogoutURIs:     oidc.LogoutURIs{client.ID: relyingParty.URL},
+			BackchannelLogoutAttempts: 2,
+			BackchannelLogoutBackoff:  time.Hour,
+			BackchannelLogoutQueue:    1,
+		},
+	)
+
+	// tokens wait in the queue until the endpoint runs, and don't fit beyond its size
+	endpoint.EndSession(ctx, testrand.UUID(), testrand.UUID())
+	endpoint.EndSession(ctx, testrand.UUID(), testrand.UUID())
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() { done <- endpoint.Run(runCtx) }()
+
+	select {
+	case token := <-attempts:
+		require.NotEmpty(t, token)
+	case <-time.After(10 * time.Second):
+		t.Fatal("logout token was not delivered")
+	}
+
+	// stopping doesn't wait for the backoff of the failed delivery
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("run did not stop")
+	}
+	require.Empty(t, attempts)
+}
+
+func TestClockSkew(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	const skew = 2 * time.Minute
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(staticClientsDB{clients: staticClients{client: client}}), nil,
+		oidc.Config{
+			CodeExpiry:        time.Minute,
+			AccessTokenExpiry: time.Hour,
+			ClockSkew:         skew,
+		},
+	)
+
+	logout := func(claim string, at time.Time) int {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss": "https://satellite.test",
+			"aud": client.ID.String(),
+			"sub": testrand.UUID().String(),
+			claim: at.Unix(),
+		})
+		hint, err := token.SignedString(client.Secret)
+		require.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+		endpoint.Logout(recorder, httptest.NewRequest(http.MethodGet, "/oauth/v2/logout?id_token_hint="+hint, nil))
+		return recorder.Code
+	}
+
+	for _, claim := range []string{"iat", "nbf"} {
+		// tokens from clients whose clock is ahead are accepted up to the skew
+		require.Equal(t, http.StatusFound, logout(claim, time.Now().Add(skew-5*time.Second)), claim)
+		require.Equal(t, http.StatusBadRequest, logout(claim, time.Now().Add(skew+5*time.Second)), claim)
+
+		// expired hints are still fine
+		require.Equal(t, http.StatusFound, logout(claim, time.Now().Add(-time.Hour)), claim)
+	}
+}
+
+func TestConsentExpirySet(t *testing.T) {
+	var expiry oidc.ConsentExpiry
+
+	require.NoError(t, expiry.Set(""))
+	require.Empty(t, expiry)
+
+	require.NoError(t, expiry.Set(`{"object:write": "720h", "bucket:*": "24h"}`))
+	require.Equal(t, oidc.ConsentExpiry{"object:write": 720 * time.Hour, "bucket:*": 24 * time.Hour}, expiry)
+	require.Equal(t, `{"bucket:*":"24h0m0s","object:write":"720h0m0s"}`, expiry.String())
+
+	require.Error(t, expiry.Set(`{"object:write": "a month"}`))
+	require.Error(t, expiry.Set(`{"object:write": "-1h"}`))
+	require.Error(t, expiry.Set(`not json`))
+}
+
+// missingCodes knows no codes.
+type missingCodes struct {
+	oidc.OAuthCodes
+}
+
+func (missingCodes) Get(context.Context, string) (oidc.OAuthCode, error) {
+	return oidc.OAuthCode{}, sql.ErrNoRows
+}
+
+func (missingCodes) GetClaimed(context.Context, string) (oidc.OAuthCode, error) {
+	return oidc.OAuthCode{}, sql.ErrNoRows
+}
+
+type lockoutDB struct {
+	staticClientsDB
+}
+
+func (lockoutDB) OAuthCodes() oidc.OAuthCodes { return missingCodes{} }
+
+func TestClientLockout(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	now := time.Now()
+	newEndpoint := func(config oidc.Config) *oidc.Endpoint {
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		endpoint := oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(lockoutDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+			config,
+		)
+		endpoint.SetNow(func() time.Time { return now })
+		return endpoint
+	}
+
+	address, forwardedFor := "192.0.2.1:1234", ""
+	exchange := func(endpoint *oidc.Endpoint, secret string) (errorCode, retryAfter string) {
+		form := url.Values{"grant_type": {"authorization_code"}, "code": {"code"}, "redirect_uri": {client.RedirectURL}}
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(client.ID.String(), secret)
+		req.RemoteAddr = address
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return fmt.Sprint(data["error"]), recorder.Header().Get("Retry-After")
+	}
+
+	endpoint := newEndpoint(oidc.Config{
+		ClientLockoutThreshold: 3,
+		ClientLockoutDuration:  90 * time.Second,
+		ClientLockoutDecay:     time.Hour,
+	})
+
+	for i := 0; i < 2; i++ {
+		errorCode, retryAfter := exchange(endpoint, "wrong-secret")
+		require.Equal(t, "invalid_client", errorCode)
+		require.Empty(t, retryAfter)
+	}
+
+	// below the threshold the correct secret still authenticates the client, which fails on the unknown code instead
+	errorCode, _ := exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+
+	// locked out clients are rejected even with the correct secret
+	errorCode, retryAfter := exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_client", errorCode)
+	require.Equal(t, "90", retryAfter)
+
+	// the client is only locked out from the address the failed attempts came from, so that others can't lock it out
+	address = "198.51.100.1:1234"
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+	require.Empty(t, retryAfter)
+	address = "192.0.2.1:1234"
+
+	// forwarded addresses are ignored without a trusted proxy header, so they can't be rotated to evade the lockout
+	forwardedFor = "203.0.113.1"
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_client", errorCode)
+	require.Equal(t, "90", retryAfter)
+	forwardedFor = ""
+
+	now = now.Add(time.Minute)
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_client", errorCode)
+	require.Equal(t, "30", retryAfter)
+
+	now = now.Add(30 * time.Second)
+
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+	require.Empty(t, retryAfter)
+
+	// failed attempts are forgotten as they decay
+	endpoint = newEndpoint(oidc.Config{
+		ClientLockoutThreshold: 2,
+		ClientLockoutDuration:  time.Hour,
+		ClientLockoutDecay:     time.Minute,
+	})
+
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+
+	now = now.Add(time.Minute)
+
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+	require.Empty(t, retryAfter)
+
+	// behind a trusted proxy the last forwarded address is the one the proxy received the request from
+	endpoint = newEndpoint(oidc.Config{
+		ClientLockoutThreshold: 1,
+		ClientLockoutDuration:  time.Hour,
+		ClientLockoutHeader:    "X-Forwarded-For",
+	})
+
+	forwardedFor = "203.0.113.1, 198.51.100.1"
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+
+	forwardedFor = "203.0.113.2, 198.51.100.1"
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_client", errorCode)
+	require.NotEmpty(t, retryAfter)
+
+	forwardedFor = "198.51.100.2"
+	errorCode, _ = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+	forwardedFor = ""
+
+	// the clients that failed longest ago are forgotten once too many are tracked
+	endpoint = newEndpoint(oidc.Config{
+		ClientLockoutThreshold:  2,
+		ClientLockoutDuration:   time.Hour,
+		ClientLockoutDecay:      time.Hour,
+		ClientLockoutMaxClients: 1,
+	})
+
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+
+	address = "198.51.100.1:1234"
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+	address = "192.0.2.1:1234"
+
+	errorCode, _ = exchange(endpoint, "wrong-secret")
+	require.Equal(t, "invalid_client", errorCode)
+	errorCode, retryAfter = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+	require.Empty(t, retryAfter)
+
+	// the lockout is disabled without a threshold
+	endpoint = newEndpoint(oidc.Config{})
+	for i := 0; i < 20; i++ {
+		errorCode, _ = exchange(endpoint, "wrong-secret")
+		require.Equal(t, "invalid_client", errorCode)
+	}
+	errorCode, _ = exchange(endpoint, "client-secret")
+	require.Equal(t, "invalid_grant", errorCode)
+}
+
+// expiringCodes knows a single code, which expired.
+type expiringCodes struct {
+	missingCodes
+}
+
+func (codes expiringCodes) Get(ctx context.Context, code string) (oidc.OAuthCode, error) {
+	if code != "expired" {
+		return codes.missingCodes.Get(ctx, code)
+	}
+	return oidc.OAuthCode{}, oidc.ErrCodeExpired
+}
+
+type expiringCodesDB struct {
+	staticClientsDB
+}
+
+func (expiringCodesDB) OAuthCodes() oidc.OAuthCodes { return expiringCodes{} }
+
+func TestExpiredCode(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	newEndpoint := func(config oidc.Config) *oidc.Endpoint {
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		return oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(expiringCodesDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+			config,
+		)
+	}
+
+	exchange := func(endpoint *oidc.Endpoint, code string) (status int, data map[string]interface{}) {
+		form := url.Values{"grant_type": {"authorization_code"}, "code": {code}, "redirect_uri": {client.RedirectURL}}
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return recorder.Code, data
+	}
+
+	endpoint := newEndpoint(oidc.Config{DescribeExpiredCodes: true})
+
+	expiredStatus, expired := exchange(endpoint, "expired")
+	require.Equal(t, "invalid_grant", expired["error"])
+	require.Equal(t, "authorization code expired", expired["error_description"])
+
+	unknownStatus, unknown := exchange(endpoint, "unknown")
+	require.Equal(t, expiredStatus, unknownStatus)
+	require.Equal(t, "invalid_grant", unknown["error"])
+	require.NotEqual(t, expired["error_description"], unknown["error_description"])
+
+	// without describing them, expired codes are rejected as any unknown code
+	endpoint = newEndpoint(oidc.Config{})
+
+	hiddenStatus, hidden := exchange(endpoint, "expired")
+	require.Equal(t, unknownStatus, hiddenStatus)
+	require.Equal(t, unknown, hidden)
+}
+
+type accessLogDB struct {
+	lockoutDB
+}
+
+func (accessLogDB) OAuthTokens() oidc.OAuthTokens { return failingTokens{err: sql.ErrNoRows} }
+
+func TestAccessLog(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	newEndpoint := func(config oidc.Config) (*oidc.Endpoint, *bytes.Buffer) {
+		var output bytes.Buffer
+		log := zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&output), zap.InfoLevel))
+
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		return oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", log,
+			oidc.NewService(accessLogDB{lockoutDB{staticClientsDB{clients: staticClients{client: client}}}}), nil,
+			config,
+		), &output
+	}
+
+	serve := func(endpoint *oidc.Endpoint) {
+		form := url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"secret-code"},
+			"redirect_uri":  {client.RedirectURL},
+			"client_id":     {client.ID.String()},
+			"client_secret": {"client-secret"},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		endpoint.AccessLog(http.HandlerFunc(endpoint.Tokens)).ServeHTTP(httptest.NewRecorder(), req)
+
+		form = url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"secret-refresh-token"}}
+		req = httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(client.ID.String(), "client-secret")
+		endpoint.AccessLog(http.HandlerFunc(endpoint.Tokens)).ServeHTTP(httptest.NewRecorder(), req)
+
+		req = httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo?access_token=secret-access-token", nil)
+		req.Header.Set("Authorization", "Bearer secret-bearer-token")
+		endpoint.AccessLog(http.HandlerFunc(endpoint.UserInfo)).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	endpoint, output := newEndpoint(oidc.Config{AccessLog: true})
+	serve(endpoint)
+
+	for _, secret := range []string{"client-secret", "secret-code", "secret-refresh-token", "secret-access-token", "secret-bearer-token"} {
+		require.NotContains(t, output.String(), secret)
+	}
+
+	type entry struct {
+		Message   string              `json:"msg"`
+		Method    string              `json:"method"`
+		Path      string              `json:"path"`
+		Client    string              `json:"client"`
+		GrantType string              `json:"grant_type"`
+		Status    int                 `json:"status"`
+		Latency   float64             `json:"latency"`
+		Params    map[string][]string `json:"params"`
+	}
+
+	var entries []entry
+	decoder := json.NewDecoder(output)
+	for decoder.More() {
+		var logged entry
+		require.NoError(t, decoder.Decode(&logged))
+		if logged.Message == "oidc request" {
+			entries = append(entries, logged)
+		}
+	}
+	require.Len(t, entries, 3)
+
+	require.Equal(t, http.MethodPost, entries[0].Method)
+	require.Equal(t, "/oauth/v2/tokens", entries[0].Path)
+	require.Equal(t, client.ID.String(), entries[0].Client)
+	require.Equal(t, "authorization_code", entries[0].GrantType)
+	require.NotZero(t, entries[0].Status)
+	require.Equal(t, []string{"[redacted]"}, entries[0].Params["code"])
+	require.Equal(t, []string{"[redacted]"}, entries[0].Params["client_secret"])
+	require.Equal(t, []string{client.RedirectURL}, entries[0].Params["redirect_uri"])
+
+	// clients authenticating with basic auth are logged by their id
+	require.Equal(t, client.ID.String(), entries[1].Client)
+	require.Equal(t, "refresh_token", entries[1].GrantType)
+	require.Equal(t, []string{"[redacted]"}, entries[1].Params["refresh_token"])
+
+	require.Equal(t, http.MethodGet, entries[2].Method)
+	require.Equal(t, "/oauth/v2/userinfo", entries[2].Path)
+	require.Equal(t, http.StatusUnauthorized, entries[2].Status)
+	require.Equal(t, []string{"[redacted]"}, entries[2].Params["access_token"])
+
+	// nothing is logged when access logging is disabled
+	endpoint, output = newEndpoint(oidc.Config{})
+	serve(endpoint)
+	require.NotContains(t, output.String(), "oidc request")
+}
+
+func TestDeniedClient(t *testing.T) {
+	deniedAt := time.Now()
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+		DeniedAt:    &deniedAt,
+	}
+
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(staticClientsDB{clients: staticClients{client: client}}), nil,
+		oidc.Config{
+			CodeExpiry:         time.Minute,
+			AccessTokenExpiry:  time.Hour,
+			RefreshTokenExpiry: time.Hour,
+		},
+	)
+
+	query := url.Values{
+		"client_id":     {client.ID.String()},
+		"response_type": {"code"},
+		"redirect_uri":  {client.RedirectURL},
+		"scope":         {"openid"},
+		"state":         {"state"},
+	}
+
+	recorder := httptest.NewRecorder()
+	endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodGet, "/oauth/v2/authorize?"+query.Encode(), nil))
+	require.Equal(t, http.StatusFound, recorder.Code)
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "unauthorized_client", location.Query().Get("error"))
+	require.Equal(t, "state", location.Query().Get("state"))
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", "refresh-token")
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+	recorder = httptest.NewRecorder()
+	endpoint.Tokens(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+	require.Equal(t, "unauthorized_client", data["error"])
+}
+
+func TestPKCEPolicies(t *testing.T) {
+	public := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		RedirectURL: "https://app.test/callback",
+	}
+	confidential := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	newEndpoint := func(client oidc.OAuthClient, config oidc.Config) *oidc.Endpoint {
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		return oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(expiringCodesDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+			config,
+		)
+	}
+
+	// authorize requests that pass the pkce check only fail for lack of an authenticated user, while requests with
+	// malformed challenges aren't redirected back to the client at all
+	authorize := func(endpoint *oidc.Endpoint, client oidc.OAuthClient, challenge string) url.Values {
+		query := url.Values{
+			"client_id":     {client.ID.String()},
+			"response_type": {"code"},
+			"redirect_uri":  {client.RedirectURL},
+			"scope":         {"openid"},
+			"state":         {"state"},
+		}
+		if challenge != "" {
+			query.Set("code_challenge", challenge)
+			query.Set("code_challenge_method", "S256")
+		}
+
+		recorder := httptest.NewRecorder()
+		endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodGet, "/oauth/v2/authorize?"+query.Encode(), nil))
+		if recorder.Code != http.StatusFound {
+			return nil
+		}
+
+		location, err := url.Parse(recorder.Header().Get("Location"))
+		require.NoError(t, err)
+		return location.Query()
+	}
+
+	exchange := func(endpoint *oidc.Endpoint, client oidc.OAuthClient) string {
+		form := url.Values{}
+		form.Set("grant_type", "authorization_code")
+		form.Set("code", "code")
+		form.Set("redirect_uri", client.RedirectURL)
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return data["error"].(string)
+	}
+
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	// public clients require pkce by default
+	endpoint := newEndpoint(public, oidc.Config{PKCEOffAllowed: true})
+	values := authorize(endpoint, public, "")
+	require.Equal(t, "invalid_request", values.Get("error"))
+	require.Equal(t, "state", values.Get("state"))
+	require.NotEqual(t, "invalid_request", authorize(endpoint, public, challenge).Get("error"))
+	require.Equal(t, "invalid_request", exchange(endpoint, public))
+
+	// and can't have it turned off
+	endpoint = newEndpoint(public, oidc.Config{
+		ClientPKCEPolicies: oidc.PKCEPolicies{public.ID: oidc.PKCEOff},
+		PKCEOffAllowed:     true,
+	})
+	require.Equal(t, "invalid_request", authorize(endpoint, public, "").Get("error"))
+
+	// nor optional, when public clients are classified by their missing secret to require it
+	optional := oidc.PKCEPolicies{public.ID: oidc.PKCEOptional, confidential.ID: oidc.PKCEOptional}
+	endpoint = newEndpoint(public, oidc.Config{ClientPKCEPolicies: optional, PKCEPublicClientsRequired: true})
+	require.Equal(t, "invalid_request", authorize(endpoint, public, "").Get("error"))
+	require.Equal(t, "invalid_request", exchange(endpoint, public))
+
+	endpoint = newEndpoint(public, oidc.Config{ClientPKCEPolicies: optional})
+	require.NotEqual(t, "invalid_request", authorize(endpoint, public, "").Get("error"))
+
+	// while confidential clients keep their configured policy
+	endpoint = newEndpoint(confidential, oidc.Config{ClientPKCEPolicies: optional, PKCEPublicClientsRequired: true})
+	require.NotEqual(t, "invalid_request", authorize(endpoint, confidential, "").Get("error"))
+	require.Equal(t, "invalid_grant", exchange(endpoint, confidential))
+
+	// confidential clients use pkce optionally by default
+	endpoint = newEndpoint(confidential, oidc.Config{PKCEOffAllowed: true})
+	require.NotEqual(t, "invalid_request", authorize(endpoint, confidential, "").Get("error"))
+	require.Equal(t, "invalid_grant", exchange(endpoint, confidential))
+
+	// unless they're configured to require it
+	endpoint = newEndpoint(confidential, oidc.Config{
+		ClientPKCEPolicies: oidc.PKCEPolicies{confidential.ID: oidc.PKCERequired},
+	})
+	require.Equal(t, "invalid_request", authorize(endpoint, confidential, "").Get("error"))
+	require.Equal(t, "invalid_request", exchange(endpoint, confidential))
+
+	// or to turn it off, which ignores their code challenges
+	endpoint = newEndpoint(confidential, oidc.Config{
+		ClientPKCEPolicies: oidc.PKCEPolicies{confidential.ID: oidc.PKCEOff},
+		PKCEOffAllowed:     true,
+	})
+	values = authorize(endpoint, confidential, "not a valid challenge")
+	require.NotNil(t, values)
+	require.NotEqual(t, "invalid_request", values.Get("error"))
+
+	// without off allowed, the challenge is still validated
+	endpoint = newEndpoint(confidential, oidc.Config{
+		ClientPKCEPolicies: oidc.PKCEPolicies{confidential.ID: oidc.PKCEOff},
+	})
+	require.Nil(t, authorize(endpoint, confidential, "not a valid challenge"))
+}
+
+func TestPKCEPoliciesSet(t *testing.T) {
+	var policies oidc.PKCEPolicies
+
+	clientID := testrand.UUID()
+	require.NoError(t, policies.Set(`{"`+clientID.String()+`": "off"}`))
+	require.Equal(t, oidc.PKCEPolicies{clientID: oidc.PKCEOff}, policies)
+
+	require.Error(t, policies.Set(`{"`+clientID.String()+`": "sometimes"}`))
+	require.Error(t, policies.Set(`{"not a client": "required"}`))
+}
+
+func TestClientAssertions(t *testing.T) {
+	previousExpiresAt := time.Now().Add(time.Hour)
+	client := oidc.OAuthClient{
+		ID:                      testrand.UUID(),
+		Secret:                  []byte("client-secret"),
+		RedirectURL:             "https://app.test/callback",
+		PreviousSecret:          []byte("previous-secret"),
+		PreviousSecretExpiresAt: &previousExpiresAt,
+		SecretKeyID:             "client-secret-kid",
+		PreviousSecretKeyID:     "previous-secret-kid",
+	}
+
+	newEndpoint := func(config oidc.Config) *oidc.Endpoint {
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+		config.ClockSkew = time.Minute
+
+		return oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(lockoutDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+			config,
+		)
+	}
+
+	const tokenURL = "https://satellite.test/oauth/v2/tokens"
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": client.ID.String(),
+			"sub": client.ID.String(),
+			"aud": tokenURL,
+			"exp": time.Now().Add(5 * time.Minute).Unix(),
+			"iat": time.Now().Unix(),
+			"jti": testrand.UUID().String(),
+		}
+	}
+
+	sign := func(claims jwt.MapClaims, secret string) string {
+		assertion, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		require.NoError(t, err)
+		return assertion
+	}
+
+	// the clients are authenticated before the unknown code is rejected as invalid_grant
+	exchange := func(endpoint *oidc.Endpoint, assertion string) string {
+		form := url.Values{
+			"grant_type":            {"authorization_code"},
+			"code":                  {"code"},
+			"redirect_uri":          {client.RedirectURL},
+			"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			"client_assertion":      {assertion},
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return fmt.Sprint(data["error"])
+	}
+
+	endpoint := newEndpoint(oidc.Config{})
+
+	require.Equal(t, "invalid_grant", exchange(endpoint, sign(validClaims(), "client-secret")))
+	require.Equal(t, "invalid_grant", exchange(endpoint, sign(validClaims(), "previous-secret")))
+
+	issuerAudience := validClaims()
+	issuerAudience["aud"] = []string{"https://other.test/", "https://satellite.test/"}
+	require.Equal(t, "invalid_grant", exchange(endpoint, sign(issuerAudience, "client-secret")))
+
+	skewed := validClaims()
+	skewed["iat"] = time.Now().Add(30 * time.Second).Unix()
+	skewed["exp"] = time.Now().Add(-30 * time.Second).Unix()
+	require.Equal(t, "invalid_grant", exchange(endpoint, sign(skewed, "client-secret")))
+
+	reused := validClaims()
+	require.Equal(t, "invalid_grant", exchange(endpoint, sign(reused, "client-secret")))
+
+	rejections := map[string]func(claims jwt.MapClaims){
+		"missing aud": func(claims jwt.MapClaims) { delete(claims, "aud") },
+		"wrong aud":   func(claims jwt.MapClaims) { claims["aud"] = "https://other.test/oauth/v2/tokens" },
+		"missing iss": func(claims jwt.MapClaims) { delete(claims, "iss") },
+		"wrong iss":   func(claims jwt.MapClaims) { claims["iss"] = testrand.UUID().String() },
+		"wrong sub":   func(claims jwt.MapClaims) { claims["sub"] = testrand.UUID().String() },
+		"missing exp": func(claims jwt.MapClaims) { delete(claims, "exp") },
+		"expired":     func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-2 * time.Minute).Unix() },
+		"future iat":  func(claims jwt.MapClaims) { claims["iat"] = time.Now().Add(2 * time.Minute).Unix() },
+		"missing jti": func(claims jwt.MapClaims) { delete(claims, "jti") },
+		"reused jti":  func(claims jwt.MapClaims) { claims["jti"] = reused["jti"] },
+	}
+	for name, modify := range rejections {
+		claims := validClaims()
+		modify(claims)
+		require.Equal(t, "invalid_client", exchange(endpoint, sign(claims, "client-secret")), name)
+	}
+
+	require.Equal(t, "invalid_client", exchange(endpoint, sign(validClaims(), "wrong-secret")))
+	require.Equal(t, "invalid_client", exchange(endpoint, "not a jwt"))
+
+	none, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	require.Equal(t, "invalid_client", exchange(endpoint, none))
+
+	// a kid only lets the secret it was stored with verify the token
+	signWithKeyID := func(secret, keyID string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims())
+		token.Header["kid"] = keyID
+		assertion, err := token.SignedString([]byte(secret))
+		require.NoError(t, err)
+		return assertion
+	}
+	require.Equal(t, "invalid_grant", exchange(endpoint, signWithKeyID("previous-secret", client.PreviousSecretKeyID)))
+	require.Equal(t, "invalid_client", exchange(endpoint, signWithKeyID("previous-secret", client.SecretKeyID)))
+	require.Equal(t, "invalid_client", exchange(endpoint, signWithKeyID("client-secret", "unknown")))
+
+	// configured audiences replace the token endpoint and the issuer
+	endpoint = newEndpoint(oidc.Config{ClientAssertionAudiences: []string{"https://auth.test/"}})
+
+	configured := validClaims()
+	configured["aud"] = "https://auth.test/"
+	require.Equal(t, "invalid_grant", exchange(endpoint, sign(configured, "client-secret")))
+	require.Equal(t, "invalid_client", exchange(endpoint, sign(validClaims(), "client-secret")))
+}
+
+func TestTokenEndpointAuthMethods(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	newEndpoint := func(method oidc.TokenEndpointAuthMethod) *oidc.Endpoint {
+		config := oidc.Config{}
+		if method != "" {
+			config.ClientAuthMethods = oidc.TokenEndpointAuthMethods{client.ID: method}
+		}
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+		config.ClockSkew = time.Minute
+
+		return oidc.NewEndpoint(
+			storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(lockoutDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+			config,
+		)
+	}
+
+	assertion := func() string {
+		assertion, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss": client.ID.String(),
+			"sub": client.ID.String(),
+			"aud": "https://satellite.test/oauth/v2/tokens",
+			"exp": time.Now().Add(5 * time.Minute).Unix(),
+			"jti": testrand.UUID().String(),
+		}).SignedString(client.Secret)
+		require.NoError(t, err)
+		return assertion
+	}
+
+	// the clients are authenticated before the unknown code is rejected as invalid_grant
+	exchange := func(endpoint *oidc.Endpoint, basic bool, extra url.Values) string {
+		form := url.Values{
+			"grant_type":   {"authorization_code"},
+			"code":         {"code"},
+			"redirect_uri": {client.RedirectURL},
+		}
+		for name, values := range extra {
+			form[name] = values
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if basic {
+			req.SetBasicAuth(client.ID.String(), string(client.Secret))
+		}
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return fmt.Sprint(data["error"])
+	}
+
+	post := func() url.Values {
+		return url.Values{"client_id": {client.ID.String()}, "client_secret": {string(client.Secret)}}
+	}
+	jwtAuth := func() url.Values {
+		return url.Values{
+			"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			"client_assertion":      {assertion()},
+		}
+	}
+
+	// clients without a configured method may use basic auth or assertions, but not the body
+	endpoint := newEndpoint("")
+	require.Equal(t, "invalid_grant", exchange(endpoint, true, nil))
+	require.Equal(t, "invalid_grant", exchange(endpoint, false, jwtAuth()))
+	require.Equal(t, "invalid_client", exchange(endpoint, false, post()))
+
+	endpoint = newEndpoint(oidc.ClientSecretBasic)
+	require.Equal(t, "invalid_grant", exchange(endpoint, true, nil))
+	require.Equal(t, "invalid_client", exchange(endpoint, false, post()))
+	require.Equal(t, "invalid_client", exchange(endpoint, false, jwtAuth()))
+
+	endpoint = newEndpoint(oidc.ClientSecretPost)
+	require.Equal(t, "invalid_grant", exchange(endpoint, false, post()))
+	require.Equal(t, "invalid_client", exchange(endpoint, true, nil))
+	require.Equal(t, "invalid_client", exchange(endpoint, false, url.Values{"client_id": {client.ID.String()}, "client_secret": {"wrong-secret"}}))
+
+	endpoint = newEndpoint(oidc.ClientSecretJWT)
+	require.Equal(t, "invalid_grant", exchange(endpoint, false, jwtAuth()))
+	require.Equal(t, "invalid_client", exchange(endpoint, true, nil))
+
+	// requests may only use a single method
+	endpoint = newEndpoint(oidc.ClientSecretPost)
+	require.Equal(t, "invalid_client", exchange(endpoint, true, post()))
+
+	// the methods are advertised once any client may use them
+	for method, advertised := range map[oidc.TokenEndpointAuthMethod][]string{
+		"":                     {"client_secret_basic", "client_secret_jwt"},
+		oidc.ClientSecretBasic: {"client_secret_basic", "client_secret_jwt"},
+		oidc.ClientSecretPost:  {"client_secret_basic", "client_secret_jwt", "client_secret_post"},
+	} {
+		recorder := httptest.NewRecorder()
+		newEndpoint(method).WellKnownConfiguration(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil))
+
+		var cfg oidc.ProviderConfig
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &cfg))
+		require.Equal(t, advertised, cfg.TokenEndpointAuthMethods, method)
+	}
+}
+
+func TestTokenEndpointAuthMethodsSet(t *testing.T) {
+	var methods oidc.TokenEndpointAuthMethods
+
+	clientID := testrand.UUID()
+	require.NoError(t, methods.Set(`{"`+clientID.String()+`": "client_secret_post"}`))
+	require.Equal(t, oidc.TokenEndpointAuthMethods{clientID: oidc.ClientSecretPost}, methods)
+
+	require.Error(t, methods.Set(`{"`+clientID.String()+`": "private_key_jwt"}`))
+	require.Error(t, methods.Set(`{"not a client": "client_secret_basic"}`))
+}
+
+// blockingTokens blocks every token lookup until release is closed, signaling started once it does.
+type blockingTokens struct {
+	oidc.OAuthTokens
+	started chan struct{}
+	release chan struct{}
+}
+
+func (tokens blockingTokens) Get(ctx context.Context, kind oidc.OAuthTokenKind, token string) (oidc.OAuthToken, error) {
+	tokens.started <- struct{}{}
+	<-tokens.release
+	return oidc.OAuthToken{}, sql.ErrNoRows
+}
+
+type blockingTokensDB struct {
+	mockDB
+	tokens blockingTokens
+}
+
+func (db blockingTokensDB) OAuthTokens() oidc.OAuthTokens { return db.tokens }
+
+func TestConcurrencyLimit(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	tokens := blockingTokens{started: make(chan struct{}, 1), release: make(chan struct{})}
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(blockingTokensDB{tokens: tokens}), nil,
+		oidc.Config{
+			CodeExpiry:        time.Minute,
+			AccessTokenExpiry: time.Hour,
+
+			MaxConcurrentRequests: 1,
+		},
+	)
+
+	userInfo := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		recorder := httptest.NewRecorder()
+		endpoint.UserInfo(recorder, req)
+		return recorder
+	}
+
+	inFlight := make(chan *httptest.ResponseRecorder, 1)
+	ctx.Go(func() error {
+		inFlight <- userInfo()
+		return nil
+	})
+	<-tokens.started
+
+	// every further token and user info request is rejected while the first one is in flight
+	recorder := httptest.NewRecorder()
+	endpoint.Tokens(recorder, httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", nil))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.NotEmpty(t, recorder.Header().Get("Retry-After"))
+
+	recorder = userInfo()
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.NotEmpty(t, recorder.Header().Get("Retry-After"))
+
+	close(tokens.release)
+	require.Equal(t, http.StatusUnauthorized, (<-inFlight).Code)
+
+	// the slot is free again once the request finished
+	recorder = userInfo()
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Retry-After"))
+}
+
+// countingTokens returns an access token for every lookup, counting the lookups of every token.
+type countingTokens struct {
+	oidc.OAuthTokens
+
+	mu      sync.Mutex
+	lookups map[string]int
+}
+
+func (tokens *countingTokens) Get(ctx context.Context, kind oidc.OAuthTokenKind, token string) (oidc.OAuthToken, error) {
+	tokens.mu.Lock()
+	defer tokens.mu.Unlock()
+
+	tokens.lookups[token]++
+	// the scope can't be granted, so that user info is rejected without looking up the user
+	return oidc.OAuthToken{Kind: kind, Token: token, Scope: "unknown:scope", ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (tokens *countingTokens) count(token string) int {
+	tokens.mu.Lock()
+	defer tokens.mu.Unlock()
+	return tokens.lookups[token]
+}
+
+type countingTokensDB struct {
+	mockDB
+	tokens *countingTokens
+}
+
+func (db countingTokensDB) OAuthTokens() oidc.OAuthTokens { return db.tokens }
+
+func TestAccessTokenCacheCapacity(t *testing.T) {
+	tokens := &countingTokens{lookups: map[string]int{}}
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(countingTokensDB{tokens: tokens}), nil,
+		oidc.Config{
+			CodeExpiry:        time.Minute,
+			AccessTokenExpiry: time.Hour,
+
+			AccessTokenCacheTTL: time.Hour, AccessTokenCacheCapacity: 2,
+		},
+	)
+
+	userInfo := func(token string) {
+		req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		recorder := httptest.NewRecorder()
+		endpoint.UserInfo(recorder, req)
+		require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	}
+
+	userInfo("first")
+	userInfo("second")
+	userInfo("first")
+	require.Equal(t, 1, tokens.count("first"))
+
+	// the cache is full of unexpired tokens, so the least recently used one makes room
+	userInfo("third")
+	userInfo("first")
+	userInfo("third")
+	require.Equal(t, 1, tokens.count("first"))
+	require.Equal(t, 1, tokens.count("third"))
+
+	userInfo("second")
+	require.Equal(t, 2, tokens.count("second"))
+}
+
+func TestAssertionReplaysCapacity(t *testing.T) {
+	client := oidc.OAuthClient{
+		ID:          testrand.UUID(),
+		Secret:      []byte("client-secret"),
+		RedirectURL: "https://app.test/callback",
+	}
+
+	endpoint := oidc.NewEndpoint(
+		storj.NodeURL{}, "https://satellite.test/", zaptest.NewLogger(t),
+		oidc.NewService(lockoutDB{staticClientsDB{clients: staticClients{client: client}}}), nil,
+		oidc.Config{
+			CodeExpiry:         time.Minute,
+			AccessTokenExpiry:  time.Hour,
+			RefreshTokenExpiry: time.Hour,
+			ClockSkew:          time.Minute,
+
+			MaxAssertionReplays: 1,
+		},
+	)
+
+	sign := func(jti string) string {
+		assertion, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss": client.ID.String(),
+			"sub": client.ID.String(),
+			"aud": "https://satellite.test/oauth/v2/tokens",
+			"exp": time.Now().Add(5 * time.Minute).Unix(),
+			"jti": jti,
+		}).SignedString(client.Secret)
+		require.NoError(t, err)
+		return assertion
+	}
+
+	exchange := func(assertion string) *httptest.ResponseRecorder {
+		form := url.Values{
+			"grant_type":            {"authorization_code"},
+			"code":                  {"code"},
+			"redirect_uri":          {client.RedirectURL},
+			"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			"client_assertion":      {assertion},
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		endpoint.Tokens(recorder, req)
+		return recorder
+	}
+
+	errorOf := func(recorder *httptest.ResponseRecorder) string {
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &data))
+		return fmt.Sprint(data["error"])
+	}
+
+	// the client is authenticated before the unknown code is rejected
+	first := sign("first")
+	require.Equal(t, "invalid_grant", errorOf(exchange(first)))
+	require.Equal(t, "invalid_client", errorOf(exchange(first)))
+
+	// unexpired jti can't be forgotten without letting them be replayed, so further assertions are turned away
+	recorder := exchange(sign("second"))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.Equal(t, "temporarily_unavailable", errorOf(recorder))
+}
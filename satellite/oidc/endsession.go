@@ -0,0 +1,181 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/manage"
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// ConsoleSessions looks up and ends the console sessions that end session requests are made with.
+type ConsoleSessions interface {
+	// Current returns the user and the session the request is authenticated with. ok is false when the request
+	// doesn't carry a valid session.
+	Current(r *http.Request) (userID, sessionID uuid.UUID, ok bool)
+	// End deletes the session and removes its cookie with the response.
+	End(ctx context.Context, w http.ResponseWriter, sessionID uuid.UUID) error
+}
+
+// Logout implements the end session endpoint relying parties redirect the user to when the user logs out of them.
+// The id_token_hint identifies the user the relying party is logging out, and the console session is only ended when
+// it belongs to that same user, so that a shared logout link can't end someone else's session. The user is then
+// redirected to the post_logout_redirect_uri, which must belong to the client the hint was issued to.
+func (e *Endpoint) Logout(w http.ResponseWriter, r *http.Request) {
+	r, cancel := e.limitRequest(w, r)
+	defer cancel()
+	if r == nil {
+		return
+	}
+
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = r.ParseForm()
+	if err != nil {
+		e.invalidEndSession(w, "malformed request")
+		return
+	}
+
+	hint := r.Form.Get("id_token_hint")
+	if hint == "" {
+		e.invalidEndSession(w, "id_token_hint is required")
+		return
+	}
+
+	clientID, userID, err := e.parseIDTokenHint(ctx, hint)
+	if unavailable(w, err) {
+		return
+	}
+	if err != nil {
+		e.invalidEndSession(w, "invalid id_token_hint")
+		return
+	}
+
+	if id := r.Form.Get("client_id"); id != "" && id != clientID.String() {
+		e.invalidEndSession(w, "id_token_hint was not issued to client_id")
+		return
+	}
+
+	redirect := e.logoutRedirect
+	if uri := r.Form.Get("post_logout_redirect_uri"); uri != "" {
+		client, err := e.clientStore.GetByID(ctx, clientID.String())
+		if unavailable(w, err) {
+			return
+		}
+		if err != nil || manage.DefaultValidateURI(client.GetDomain(), uri) != nil {
+			e.invalidEndSession(w, "invalid post_logout_redirect_uri")
+			return
+		}
+
+		parsed, err := url.Parse(uri)
+		if err != nil {
+			e.invalidEndSession(w, "invalid post_logout_redirect_uri")
+			return
+		}
+
+		if state := r.Form.Get("state"); state != "" {
+			query := parsed.Query()
+			query.Set("state", state)
+			parsed.RawQuery = query.Encode()
+		}
+		redirect = parsed.String()
+	}
+
+	if e.Sessions != nil {
+		sessionUserID, sessionID, ok := e.Sessions.Current(r)
+		if ok {
+			if sessionUserID != userID {
+				e.invalidEndSession(w, "id_token_hint does not match the current session")
+				return
+			}
+
+			err = e.Sessions.End(ctx, w, sessionID)
+			if unavailable(w, err) {
+				return
+			}
+			if err != nil {
+				e.log.Error("failed to end session", zap.Stringer("user", userID), zap.Error(err))
+				http.Error(w, "", http.StatusInternalServerError)
+				return
+			}
+
+			e.EndSession(ctx, userID, sessionID)
+		}
+	}
+
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// parseIDTokenHint verifies the hint is a token this provider signed for one of its clients, and returns the client and
+// the user it was issued for. Expired hints are still accepted, since relying parties commonly log users out after
+// their tokens expired, but hints issued in the future beyond the allowed clock skew are not.
+func (e *Endpoint) parseIDTokenHint(ctx context.Context, hint string) (clientID, userID uuid.UUID, err error) {
+	claims, _, err := parseInbound(hint, func(claims jwt.MapClaims) ([]signingKey, error) {
+		aud, _ := claims["aud"].(string)
+
+		clientID, err = uuid.FromString(aud)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := e.clientStore.GetByID(ctx, clientID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		key := signingKey{secret: []byte(client.GetSecret())}
+		if c, ok := client.(OAuthClient); ok {
+			key.id = c.SecretKeyID
+		}
+		return []signingKey{key}, nil
+	})
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+
+	err = e.verifyInbound(claims, inboundJWT{
+		issuer:    e.config.Issuer,
+		audiences: []string{clientID.String()},
+	}, time.Now())
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+
+	sub, _ := claims["sub"].(string)
+	userID, err = uuid.FromString(sub)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+
+	return clientID, userID, nil
+}
+
+// invalidEndSession rejects the end session request as invalid. The request can't be redirected back to the client,
+// since the client is either unknown or can't be trusted with the redirect.
+func (e *Endpoint) invalidEndSession(w http.ResponseWriter, description string) {
+	data, statusCode, header := e.server.GetErrorData(oauth2errors.ErrInvalidRequest)
+	data["error_description"] = description
+
+	for key := range header {
+		w.Header().Set(key, header.Get(key))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(data)
+	if err != nil {
+		e.log.Error("failed to encode end session error", zap.Error(err))
+	}
+}
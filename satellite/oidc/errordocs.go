@@ -0,0 +1,27 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"strings"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+)
+
+// errorDocs returns a handler that links oauth error responses to the documentation of their error code. The code is
+// appended as the fragment of the base URL, e.g. https://docs.test/oauth#invalid_grant. Error responses are left
+// untouched when no base URL is configured.
+func errorDocs(baseURL string) func(re *oauth2errors.Response) {
+	if i := strings.IndexByte(baseURL, '#'); i >= 0 {
+		baseURL = baseURL[:i]
+	}
+
+	return func(re *oauth2errors.Response) {
+		if baseURL == "" || re.Error == nil {
+			return
+		}
+
+		re.URI = baseURL + "#" + re.Error.Error()
+	}
+}
@@ -6,18 +6,26 @@ package oidc_test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	oauth2v4 "github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
 	"golang.org/x/oauth2"
 	"golang.org/x/sync/errgroup"
 
@@ -25,6 +33,7 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
@@ -80,6 +89,15 @@ func send(t *testing.T, body io.Reader, response interface{}, status int, parts
 	}
 }
 
+// tokenResponse captures the fields of the token endpoint response that oauth2.Token doesn't expose.
+type tokenResponse struct {
+	oauth2.Token
+
+	Scope            string  `json:"scope"`
+	ExpiresIn        float64 `json:"expires_in"`
+	RefreshExpiresIn float64 `json:"refresh_expires_in"`
+}
+
 func TestOIDC(t *testing.T) {
 	id, err := uuid.New()
 	require.NoError(t, err)
@@ -100,7 +118,7 @@ func TestOIDC(t *testing.T) {
 		adminAddr := sat.Admin.Admin.Listener.Addr().String()
 		consoleAddr := sat.API.Console.Listener.Addr().String()
 
-		issuer := "http://" + consoleAddr + "/"
+		issuer := "http://" + consoleAddr
 		authEndpoint := "http://" + consoleAddr + "/oauth/v2/authorize"
 		tokenEndpoint := "http://" + consoleAddr + "/oauth/v2/tokens"
 		userinfoEndpoint := "http://" + consoleAddr + "/oauth/v2/userinfo"
@@ -258,7 +276,7 @@ func TestOIDC(t *testing.T) {
 		refresh.Set("grant_type", "refresh_token")
 		refresh.Set("refresh_token", token.RefreshToken)
 
-		refreshed := oauth2.Token{}
+		refreshed := tokenResponse{}
 		auth := base64.StdEncoding.EncodeToString([]byte(client.ID.String() + ":" + string(client.Secret)))
 
 		{
@@ -266,8 +284,34 @@ func TestOIDC(t *testing.T) {
 			send(t, body, &refreshed, http.StatusOK, tokenEndpoint, http.MethodPost, "Basic "+auth, "application/x-www-form-urlencoded")
 		}
 
-		require.Equal(t, token.RefreshToken, refreshed.RefreshToken)
+		require.NotEqual(t, token.RefreshToken, refreshed.RefreshToken)
 		require.NotEqual(t, token.AccessToken, refreshed.AccessToken)
+		require.Equal(t, "Bearer", refreshed.TokenType)
+		require.Equal(t, scope, refreshed.Scope)
+		require.InDelta(t, sat.Config.Console.OIDC.AccessTokenExpiry.Seconds(), refreshed.ExpiresIn, 5)
+		require.InDelta(t, sat.Config.Console.OIDC.RefreshTokenExpiry.Seconds(), refreshed.RefreshExpiresIn, 5)
+
+		// Refreshing may narrow the granted scope, but never extend it.
+
+		narrowScope := fmt.Sprintf("project:%s bucket:%s object:list object:read", project.ID.String(), bucket.Name)
+		refresh.Set("scope", narrowScope)
+
+		narrowed := tokenResponse{}
+		{
+			body := strings.NewReader(refresh.Encode())
+			send(t, body, &narrowed, http.StatusOK, tokenEndpoint, http.MethodPost, "Basic "+auth, "application/x-www-form-urlencoded")
+		}
+
+		require.Equal(t, narrowScope, narrowed.Scope)
+		require.Equal(t, "Bearer", narrowed.TokenType)
+		require.NotZero(t, narrowed.ExpiresIn)
+		require.NotZero(t, narrowed.RefreshExpiresIn)
+
+		refresh.Set("scope", scope+" object:admin")
+		{
+			body := strings.NewReader(refresh.Encode())
+			send(t, body, nil, http.StatusBadRequest, tokenEndpoint, http.MethodPost, "Basic "+auth, "application/x-www-form-urlencoded")
+		}
 
 		// Fetch UserInfo
 
@@ -276,6 +320,38 @@ func TestOIDC(t *testing.T) {
 
 		require.Equal(t, "cyphertext", info.Cubbyhole)
 
+		// Fetch UserInfo as a signed JWT, which is for the client the access token was issued to, including after the
+		// access token was refreshed
+
+		for _, accessToken := range []string{token.AccessToken, refreshed.AccessToken} {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, userinfoEndpoint, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+			req.Header.Set("Accept", "application/jwt")
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "application/jwt", resp.Header.Get("Content-Type"))
+
+			signed, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			claims := jwt.MapClaims{}
+			_, err = jwt.ParseWithClaims(string(signed), claims, func(token *jwt.Token) (interface{}, error) {
+				require.Equal(t, "HS256", token.Method.Alg())
+				return client.Secret, nil
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, issuer, claims["iss"])
+			require.Equal(t, client.ID.String(), claims["aud"])
+			require.Equal(t, user.ID.String(), claims["sub"])
+			require.Equal(t, "cyphertext", claims["cubbyhole"])
+		}
+
 		// Use token with uplink
 
 		apiKey, err := macaroon.ParseAPIKey(token.AccessToken)
@@ -327,3 +403,1537 @@ func TestOIDC(t *testing.T) {
 		require.Equal(t, "hello world!", string(content))
 	})
 }
+
+func TestOIDCScopeCaveats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		project := upl.Projects[0]
+
+		require.NoError(t, upl.Upload(ctx, sat, "photos", "cat.jpg", []byte("meow")))
+		require.NoError(t, upl.Upload(ctx, sat, "videos", "dog.mp4", []byte("woof")))
+
+		clientID, err := uuid.New()
+		require.NoError(t, err)
+
+		var scopeCaveats oidc.ScopeCaveats
+		require.NoError(t, scopeCaveats.Set(`{
+			"storj:readonly": {"disallowWrites": true, "disallowDeletes": true},
+			"storj:bucket:*": {"bucketFromSuffix": true}
+		}`))
+
+		generate := &oidc.MacaroonAccessGenerate{
+			Service:      sat.API.Console.Service,
+			ScopeCaveats: scopeCaveats,
+		}
+
+		access, _, err := generate.Token(ctx, &oauth2v4.GenerateBasic{
+			Client: oidc.OAuthClient{ID: clientID, AppName: "scoped"},
+			UserID: project.Owner.ID.String(),
+			TokenInfo: &models.Token{
+				Scope: "project:" + project.ID.String() + " object:list object:read object:write object:delete " +
+					"storj:readonly storj:bucket:photos",
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			},
+		}, false)
+		require.NoError(t, err)
+
+		apiKey, err := macaroon.ParseAPIKey(access)
+		require.NoError(t, err)
+
+		serialized, err := upl.Access[sat.ID()].Serialize()
+		require.NoError(t, err)
+
+		scoped, err := grant.ParseAccess(serialized)
+		require.NoError(t, err)
+
+		scoped.APIKey = apiKey
+
+		serialized, err = scoped.Serialize()
+		require.NoError(t, err)
+
+		uplinkAccess, err := uplink.ParseAccess(serialized)
+		require.NoError(t, err)
+
+		proj, err := uplink.OpenProject(ctx, uplinkAccess)
+		require.NoError(t, err)
+		defer ctx.Check(proj.Close)
+
+		download, err := proj.DownloadObject(ctx, "photos", "cat.jpg", nil)
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(download)
+		require.NoError(t, err)
+		require.NoError(t, download.Close())
+		require.Equal(t, "meow", string(content))
+
+		_, err = proj.DownloadObject(ctx, "videos", "dog.mp4", nil)
+		require.ErrorIs(t, err, uplink.ErrPermissionDenied)
+
+		upload, err := proj.UploadObject(ctx, "photos", "new.jpg", nil)
+		if err == nil {
+			_, err = upload.Write([]byte("purr"))
+			if err == nil {
+				err = upload.Commit()
+			}
+		}
+		require.ErrorIs(t, err, uplink.ErrPermissionDenied)
+
+		_, err = proj.DeleteObject(ctx, "photos", "cat.jpg")
+		require.ErrorIs(t, err, uplink.ErrPermissionDenied)
+	})
+}
+
+func TestOIDCRefreshReuse(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		clientID, err := uuid.New()
+		require.NoError(t, err)
+
+		client := oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("badadmin"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://127.0.0.1/callback",
+			AppName:     "refresher",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		generate := &oidc.MacaroonAccessGenerate{Service: sat.API.Console.Service}
+
+		// issue grants a new set of tokens as if the user had just consented.
+		issue := func() string {
+			info := &models.Token{
+				ClientID:         client.ID.String(),
+				UserID:           project.Owner.ID.String(),
+				Scope:            "project:" + project.ID.String() + " object:list object:read",
+				AccessCreateAt:   time.Now(),
+				AccessExpiresIn:  time.Hour,
+				RefreshCreateAt:  time.Now(),
+				RefreshExpiresIn: time.Hour,
+			}
+
+			info.Access, info.Refresh, err = generate.Token(ctx, &oauth2v4.GenerateBasic{
+				Client:    client,
+				UserID:    info.UserID,
+				TokenInfo: info,
+			}, true)
+			require.NoError(t, err)
+			require.NoError(t, service.TokenStore().Create(ctx, info))
+
+			return info.Refresh
+		}
+
+		refresh := func(endpoint *oidc.Endpoint, refreshToken string) (int, tokenResponse) {
+			form := url.Values{}
+			form.Set("grant_type", "refresh_token")
+			form.Set("refresh_token", refreshToken)
+
+			req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+			recorder := httptest.NewRecorder()
+			endpoint.Tokens(recorder, req)
+
+			var response tokenResponse
+			if recorder.Code == http.StatusOK {
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			}
+			return recorder.Code, response
+		}
+
+		newEndpoint := func(grace time.Duration) *oidc.Endpoint {
+			return oidc.NewEndpoint(
+				sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+				service, sat.API.Console.Service,
+				oidc.Config{
+					CodeExpiry:             time.Minute,
+					AccessTokenExpiry:      time.Hour,
+					RefreshTokenExpiry:     time.Hour,
+					RefreshTokenReuseGrace: grace,
+				},
+			)
+		}
+
+		t.Run("concurrent refreshes within grace", func(t *testing.T) {
+			endpoint := newEndpoint(time.Minute)
+			original := issue()
+
+			const concurrency = 5
+			rotated := make([]string, concurrency)
+
+			var group errgroup.Group
+			for i := 0; i < concurrency; i++ {
+				i := i
+				group.Go(func() error {
+					code, response := refresh(endpoint, original)
+					if code != http.StatusOK {
+						return fmt.Errorf("unexpected status %d", code)
+					}
+					rotated[i] = response.RefreshToken
+					return nil
+				})
+			}
+			require.NoError(t, group.Wait())
+
+			for _, token := range rotated {
+				require.NotEqual(t, original, token)
+				require.Equal(t, rotated[0], token)
+			}
+
+			code, _ := refresh(endpoint, rotated[0])
+			require.Equal(t, http.StatusOK, code)
+		})
+
+		t.Run("concurrent refreshes are coalesced", func(t *testing.T) {
+			// without a grace period, every refresh but the one rotating the token would revoke the family
+			endpoint := oidc.NewEndpoint(
+				sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+				service, sat.API.Console.Service,
+				oidc.Config{
+					CodeExpiry:         time.Minute,
+					AccessTokenExpiry:  time.Hour,
+					RefreshTokenExpiry: time.Hour,
+
+					RefreshCoalescingWindow: time.Minute,
+				},
+			)
+			original := issue()
+
+			const concurrency = 5
+			responses := make([]tokenResponse, concurrency)
+
+			var group errgroup.Group
+			for i := 0; i < concurrency; i++ {
+				i := i
+				group.Go(func() error {
+					code, response := refresh(endpoint, original)
+					if code != http.StatusOK {
+						return fmt.Errorf("unexpected status %d", code)
+					}
+					responses[i] = response
+					return nil
+				})
+			}
+			require.NoError(t, group.Wait())
+
+			for _, response := range responses {
+				require.NotEqual(t, original, response.RefreshToken)
+				require.Equal(t, responses[0].AccessToken, response.AccessToken)
+				require.Equal(t, responses[0].RefreshToken, response.RefreshToken)
+			}
+
+			// refreshes within the window receive the same tokens as well
+			code, response := refresh(endpoint, original)
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, responses[0].AccessToken, response.AccessToken)
+
+			code, response = refresh(endpoint, responses[0].RefreshToken)
+			require.Equal(t, http.StatusOK, code)
+			require.NotEqual(t, responses[0].AccessToken, response.AccessToken)
+		})
+
+		t.Run("reuse after grace revokes the family", func(t *testing.T) {
+			endpoint := newEndpoint(0)
+			original := issue()
+
+			code, response := refresh(endpoint, original)
+			require.Equal(t, http.StatusOK, code)
+			rotated := response.RefreshToken
+
+			code, _ = refresh(endpoint, original)
+			require.NotEqual(t, http.StatusOK, code)
+
+			code, _ = refresh(endpoint, rotated)
+			require.NotEqual(t, http.StatusOK, code)
+
+			// other grants of the same client are unaffected
+			code, _ = refresh(endpoint, issue())
+			require.Equal(t, http.StatusOK, code)
+		})
+
+		t.Run("refreshes end at the absolute lifetime", func(t *testing.T) {
+			const expiry, maxAge = 2 * time.Second, 3 * time.Second
+
+			endpoint := oidc.NewEndpoint(
+				sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+				service, sat.API.Console.Service,
+				oidc.Config{
+					CodeExpiry:         time.Minute,
+					AccessTokenExpiry:  time.Hour,
+					RefreshTokenExpiry: expiry,
+					RefreshTokenMaxAge: maxAge,
+				},
+			)
+
+			start := time.Now()
+			token := issue()
+
+			// refreshing continuously keeps the token from expiring, until the absolute lifetime is reached
+			refreshes := 0
+			for {
+				code, response := refresh(endpoint, token)
+				if code != http.StatusOK {
+					break
+				}
+				require.LessOrEqual(t, response.RefreshExpiresIn, expiry.Seconds())
+
+				token = response.RefreshToken
+				refreshes++
+				require.Less(t, time.Since(start), maxAge+expiry, "refreshed beyond the absolute lifetime")
+
+				time.Sleep(expiry / 4)
+			}
+
+			require.Greater(t, refreshes, 2)
+			require.GreaterOrEqual(t, time.Since(start), maxAge-time.Second)
+		})
+	})
+}
+
+func TestOIDCClientSecretRotation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		clientID, err := uuid.New()
+		require.NoError(t, err)
+
+		client := oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("original-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://127.0.0.1/callback",
+			AppName:     "rotator",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+			},
+		)
+
+		// exchange authenticates with secret to exchange a freshly granted code for tokens.
+		exchange := func(secret string) int {
+			code, err := uuid.New()
+			require.NoError(t, err)
+
+			require.NoError(t, sat.DB.OIDC().OAuthCodes().Create(ctx, oidc.OAuthCode{
+				ClientID:    client.ID,
+				UserID:      project.Owner.ID,
+				Scope:       "project:" + project.ID.String() + " object:list",
+				RedirectURL: client.RedirectURL,
+				Code:        code.String(),
+				CreatedAt:   time.Now(),
+				ExpiresAt:   time.Now().Add(time.Minute),
+			}))
+
+			form := url.Values{}
+			form.Set("grant_type", "authorization_code")
+			form.Set("code", code.String())
+			form.Set("redirect_uri", client.RedirectURL)
+
+			req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(client.ID.String(), secret)
+
+			recorder := httptest.NewRecorder()
+			endpoint.Tokens(recorder, req)
+			return recorder.Code
+		}
+
+		require.Equal(t, http.StatusOK, exchange("original-secret"))
+
+		clients := sat.DB.OIDC().OAuthClients()
+		created, err := clients.Get(ctx, client.ID)
+		require.NoError(t, err)
+		require.NotEmpty(t, created.SecretKeyID)
+
+		secret, err := service.RotateClientSecret(ctx, client.ID, time.Hour)
+		require.NoError(t, err)
+		require.NotEqual(t, client.Secret, secret)
+
+		// the new secret gets a new kid, and the current one moves along with its secret
+		rotating, err := clients.Get(ctx, client.ID)
+		require.NoError(t, err)
+		require.Equal(t, created.SecretKeyID, rotating.PreviousSecretKeyID)
+		require.NotEmpty(t, rotating.SecretKeyID)
+		require.NotEqual(t, created.SecretKeyID, rotating.SecretKeyID)
+
+		// mid-rotation both secrets are accepted
+		require.Equal(t, http.StatusOK, exchange("original-secret"))
+		require.Equal(t, http.StatusOK, exchange(string(secret)))
+		require.NotEqual(t, http.StatusOK, exchange("wrong-secret"))
+
+		require.NoError(t, service.FinalizeClientSecretRotation(ctx, client.ID))
+		require.NotEqual(t, http.StatusOK, exchange("original-secret"))
+		require.Equal(t, http.StatusOK, exchange(string(secret)))
+
+		// the previous secret isn't accepted once the overlap ended
+		rotated, err := service.RotateClientSecret(ctx, client.ID, 0)
+		require.NoError(t, err)
+		require.NotEqual(t, http.StatusOK, exchange(string(secret)))
+		require.Equal(t, http.StatusOK, exchange(string(rotated)))
+
+		unknownID, err := uuid.New()
+		require.NoError(t, err)
+		_, err = service.RotateClientSecret(ctx, unknownID, time.Hour)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestOIDCDeleteClient(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		createClient := func() oidc.OAuthClient {
+			client := oidc.OAuthClient{
+				ID:          testrand.UUID(),
+				Secret:      []byte("client-secret"),
+				UserID:      project.Owner.ID,
+				RedirectURL: "http://app.test/callback",
+			}
+			require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+			return client
+		}
+		offboarded, kept := createClient(), createClient()
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+			},
+		)
+
+		// issue creates an access and refresh token for the client, returning the access token.
+		issue := func(client oidc.OAuthClient) string {
+			access, refresh := testrand.UUID().String(), testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:         client.ID.String(),
+				UserID:           project.Owner.ID.String(),
+				Scope:            "project:" + project.ID.String(),
+				Access:           access,
+				AccessCreateAt:   time.Now(),
+				AccessExpiresIn:  time.Hour,
+				Refresh:          refresh,
+				RefreshCreateAt:  time.Now(),
+				RefreshExpiresIn: time.Hour,
+			}))
+			return access
+		}
+
+		userInfo := func(access string) int {
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			return recorder.Code
+		}
+
+		revokedAccess := []string{issue(offboarded), issue(offboarded)}
+		keptAccess := issue(kept)
+
+		for _, access := range revokedAccess {
+			require.Equal(t, http.StatusOK, userInfo(access))
+		}
+
+		revoked, err := service.DeleteClient(ctx, offboarded.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 4, revoked)
+
+		_, err = sat.DB.OIDC().OAuthClients().Get(ctx, offboarded.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		for _, access := range revokedAccess {
+			require.Equal(t, http.StatusUnauthorized, userInfo(access))
+
+			_, err = sat.DB.OIDC().OAuthTokens().Get(ctx, oidc.KindAccessToken, access)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+		}
+
+		clients, err := sat.DB.OIDC().OAuthTokens().ListClients(ctx, project.Owner.ID)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{kept.ID}, clients)
+
+		// tokens of other clients are left alone
+		require.Equal(t, http.StatusOK, userInfo(keptAccess))
+
+		revoked, err = service.DeleteClient(ctx, offboarded.ID)
+		require.NoError(t, err)
+		require.Zero(t, revoked)
+	})
+}
+
+func TestOIDCDenyClient(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		client := oidc.OAuthClient{
+			ID:          testrand.UUID(),
+			Secret:      []byte("client-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://app.test/callback",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+			},
+		)
+		generate := &oidc.MacaroonAccessGenerate{Service: sat.API.Console.Service}
+
+		// issue grants a new set of tokens as if the user had just consented, returning the refresh token.
+		issue := func() string {
+			info := &models.Token{
+				ClientID:         client.ID.String(),
+				UserID:           project.Owner.ID.String(),
+				Scope:            "project:" + project.ID.String() + " object:list object:read",
+				AccessCreateAt:   time.Now(),
+				AccessExpiresIn:  time.Hour,
+				RefreshCreateAt:  time.Now(),
+				RefreshExpiresIn: time.Hour,
+			}
+
+			var err error
+			info.Access, info.Refresh, err = generate.Token(ctx, &oauth2v4.GenerateBasic{
+				Client:    client,
+				UserID:    info.UserID,
+				TokenInfo: info,
+			}, true)
+			require.NoError(t, err)
+			require.NoError(t, service.TokenStore().Create(ctx, info))
+
+			return info.Refresh
+		}
+
+		refresh := func(refreshToken string) (int, string) {
+			form := url.Values{}
+			form.Set("grant_type", "refresh_token")
+			form.Set("refresh_token", refreshToken)
+
+			req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+			recorder := httptest.NewRecorder()
+			endpoint.Tokens(recorder, req)
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			if recorder.Code != http.StatusOK {
+				return recorder.Code, response["error"].(string)
+			}
+			return recorder.Code, response["refresh_token"].(string)
+		}
+
+		authorize := func() string {
+			query := url.Values{
+				"client_id":     {client.ID.String()},
+				"response_type": {"code"},
+				"redirect_uri":  {client.RedirectURL},
+				"scope":         {"project:" + project.ID.String()},
+				"state":         {"state"},
+			}
+
+			recorder := httptest.NewRecorder()
+			endpoint.AuthorizeUser(recorder, httptest.NewRequest(http.MethodGet, "/oauth/v2/authorize?"+query.Encode(), nil))
+			require.Equal(t, http.StatusFound, recorder.Code)
+
+			location, err := url.Parse(recorder.Header().Get("Location"))
+			require.NoError(t, err)
+			return location.Query().Get("error")
+		}
+
+		refreshToken := issue()
+
+		// denied clients can neither authorize nor refresh, while their tokens stay put
+		revoked, err := service.DenyClient(ctx, client.ID, false)
+		require.NoError(t, err)
+		require.Zero(t, revoked)
+
+		require.Equal(t, "unauthorized_client", authorize())
+		code, denied := refresh(refreshToken)
+		require.Equal(t, http.StatusUnauthorized, code)
+		require.Equal(t, "unauthorized_client", denied)
+
+		// once allowed again, the tokens can be refreshed
+		require.NoError(t, service.AllowClient(ctx, client.ID))
+		require.NotEqual(t, "unauthorized_client", authorize())
+		code, refreshToken = refresh(refreshToken)
+		require.Equal(t, http.StatusOK, code)
+
+		// denying with revocation revokes the outstanding tokens, which stay revoked once allowed again
+		revoked, err = service.DenyClient(ctx, client.ID, true)
+		require.NoError(t, err)
+		require.Positive(t, revoked)
+
+		require.NoError(t, service.AllowClient(ctx, client.ID))
+		code, _ = refresh(refreshToken)
+		require.Equal(t, http.StatusBadRequest, code)
+
+		_, err = service.DenyClient(ctx, testrand.UUID(), false)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestOIDCCodeReplay(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		client := oidc.OAuthClient{
+			ID:          testrand.UUID(),
+			Secret:      []byte("client-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://127.0.0.1/callback",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(sat.DB.OIDC()), sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+			},
+		)
+
+		code := testrand.UUID().String()
+		require.NoError(t, sat.DB.OIDC().OAuthCodes().Create(ctx, oidc.OAuthCode{
+			ClientID:    client.ID,
+			UserID:      project.Owner.ID,
+			Scope:       "project:" + project.ID.String() + " object:list",
+			RedirectURL: client.RedirectURL,
+			Code:        code,
+			CreatedAt:   time.Now(),
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}))
+
+		tokens := func(form url.Values) (status int, token map[string]interface{}) {
+			req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+			recorder := httptest.NewRecorder()
+			endpoint.Tokens(recorder, req)
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&token))
+			return recorder.Code, token
+		}
+
+		exchange := func() (int, map[string]interface{}) {
+			return tokens(url.Values{
+				"grant_type":   {"authorization_code"},
+				"code":         {code},
+				"redirect_uri": {client.RedirectURL},
+			})
+		}
+
+		userInfo := func(access string) int {
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			return recorder.Code
+		}
+
+		status, issued := exchange()
+		require.Equal(t, http.StatusOK, status)
+		access, _ := issued["access_token"].(string)
+		refresh, _ := issued["refresh_token"].(string)
+		require.NotEmpty(t, access)
+		require.NotEmpty(t, refresh)
+		require.Equal(t, http.StatusOK, userInfo(access))
+
+		// the code was claimed by the first exchange, and exchanging it again revokes what it was exchanged for
+		status, replayed := exchange()
+		require.Equal(t, http.StatusUnauthorized, status)
+		require.Equal(t, "invalid_grant", replayed["error"])
+		require.Nil(t, replayed["access_token"])
+
+		require.Equal(t, http.StatusUnauthorized, userInfo(access))
+
+		_, err := sat.DB.OIDC().OAuthTokens().Get(ctx, oidc.KindAccessToken, access)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = sat.DB.OIDC().OAuthTokens().Get(ctx, oidc.KindRefreshToken, refresh)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		status, refreshed := tokens(url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refresh},
+		})
+		require.NotEqual(t, http.StatusOK, status)
+		require.Equal(t, "invalid_grant", refreshed["error"])
+
+		// a claimed code can't be claimed again by a concurrent exchange
+		require.ErrorIs(t, sat.DB.OIDC().OAuthCodes().Claim(ctx, code), sql.ErrNoRows)
+	})
+}
+
+func TestOIDCResourceIndicators(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		const gateway, linksharing = "https://gateway.example.test", "https://linksharing.example.test"
+
+		client := oidc.OAuthClient{
+			ID:          testrand.UUID(),
+			Secret:      []byte("resource-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://127.0.0.1/callback",
+			AppName:     "resources",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		config := oidc.Config{Resources: []string{gateway, linksharing}}
+		require.NoError(t, config.AccessTokenFormats.Set(`{"`+client.ID.String()+`": "jwt"}`))
+
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(sat.DB.OIDC()), sat.API.Console.Service,
+			config,
+		)
+
+		newCode := func(resources ...string) string {
+			code := testrand.UUID().String()
+			require.NoError(t, sat.DB.OIDC().OAuthCodes().Create(ctx, oidc.OAuthCode{
+				ClientID:    client.ID,
+				UserID:      project.Owner.ID,
+				Scope:       "project:" + project.ID.String() + " object:list",
+				RedirectURL: client.RedirectURL,
+				Code:        code,
+				CreatedAt:   time.Now(),
+				ExpiresAt:   time.Now().Add(time.Minute),
+				Resources:   resources,
+			}))
+			return code
+		}
+
+		tokens := func(form url.Values, resources ...string) (status int, token map[string]interface{}) {
+			form["resource"] = resources
+
+			req := httptest.NewRequest(http.MethodPost, "/oauth/v2/tokens", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(client.ID.String(), string(client.Secret))
+
+			recorder := httptest.NewRecorder()
+			endpoint.Tokens(recorder, req)
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&token))
+			return recorder.Code, token
+		}
+
+		exchange := func(code string, resources ...string) (int, map[string]interface{}) {
+			return tokens(url.Values{
+				"grant_type":   {"authorization_code"},
+				"code":         {code},
+				"redirect_uri": {client.RedirectURL},
+			}, resources...)
+		}
+
+		refresh := func(refreshToken string, resources ...string) (int, map[string]interface{}) {
+			return tokens(url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {refreshToken},
+			}, resources...)
+		}
+
+		// issued returns the audience of the issued jwt access token, and the resources it was stored for.
+		issued := func(token map[string]interface{}) (audience interface{}, resources []string) {
+			access, _ := token["access_token"].(string)
+
+			claims := jwt.MapClaims{}
+			_, err := jwt.ParseWithClaims(access, claims, func(*jwt.Token) (interface{}, error) {
+				return client.Secret, nil
+			})
+			require.NoError(t, err)
+
+			stored, err := sat.DB.OIDC().OAuthTokens().Get(ctx, oidc.KindAccessToken, access)
+			require.NoError(t, err)
+
+			return claims["aud"], stored.Resources
+		}
+
+		// the exchange picks one of the authorized resources
+		status, token := exchange(newCode(gateway, linksharing), gateway)
+		require.Equal(t, http.StatusOK, status)
+		audience, resources := issued(token)
+		require.Equal(t, gateway, audience)
+		require.Equal(t, []string{gateway}, resources)
+
+		// the refresh token still covers both, so a refresh may pick the other one
+		status, token = refresh(token["refresh_token"].(string), linksharing)
+		require.Equal(t, http.StatusOK, status)
+		audience, resources = issued(token)
+		require.Equal(t, linksharing, audience)
+		require.Equal(t, []string{linksharing}, resources)
+
+		// without picking one, access tokens are issued for all of them
+		status, token = refresh(token["refresh_token"].(string))
+		require.Equal(t, http.StatusOK, status)
+		audience, resources = issued(token)
+		require.Equal(t, []interface{}{gateway, linksharing}, audience)
+		require.Equal(t, []string{gateway, linksharing}, resources)
+
+		// resources that weren't authorized can't be picked
+		status, rejected := refresh(token["refresh_token"].(string), "https://other.example.test")
+		require.Equal(t, http.StatusBadRequest, status)
+		require.Equal(t, "invalid_target", rejected["error"])
+
+		status, rejected = exchange(newCode(), gateway)
+		require.Equal(t, http.StatusBadRequest, status)
+		require.Equal(t, "invalid_target", rejected["error"])
+
+		// authorizations without resources are issued tokens for the client
+		status, token = exchange(newCode())
+		require.Equal(t, http.StatusOK, status)
+		audience, resources = issued(token)
+		require.Equal(t, client.ID.String(), audience)
+		require.Empty(t, resources)
+	})
+}
+
+func TestOIDCConsentExpiry(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		user, err := sat.DB.Console().Users().Get(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		client := oidc.OAuthClient{
+			ID:          testrand.UUID(),
+			Secret:      []byte("consent-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://127.0.0.1/callback",
+			AppName:     "consent",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		config := oidc.Config{}
+		require.NoError(t, config.ConsentExpiry.Set(`{"object:write": "1h"}`))
+
+		config.CodeExpiry = time.Minute
+		config.AccessTokenExpiry = time.Hour
+		config.RefreshTokenExpiry = time.Hour
+
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			oidc.NewService(sat.DB.OIDC()), sat.API.Console.Service,
+			config,
+		)
+
+		readScope := "project:" + project.ID.String() + " object:list object:read"
+		writeScope := readScope + " object:write"
+
+		// authorize returns the parameters the user is sent back to the client with.
+		authorize := func(scope, prompt string) url.Values {
+			form := url.Values{
+				"client_id":     {client.ID.String()},
+				"response_type": {"code"},
+				"redirect_uri":  {client.RedirectURL},
+				"state":         {"state"},
+				"scope":         {scope},
+			}
+			if prompt != "" {
+				form.Set("prompt", prompt)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/oauth/v2/authorize", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req = req.WithContext(console.WithUser(ctx, user))
+
+			recorder := httptest.NewRecorder()
+			endpoint.AuthorizeUser(recorder, req)
+			require.Equal(t, http.StatusFound, recorder.Code)
+
+			location, err := url.Parse(recorder.Header().Get("Location"))
+			require.NoError(t, err)
+			return location.Query()
+		}
+
+		// without having consented, the user has to be prompted
+		require.Equal(t, "consent_required", authorize(writeScope, "none").Get("error"))
+
+		// consenting is remembered
+		require.NotEmpty(t, authorize(writeScope, "").Get("code"))
+		require.NotEmpty(t, authorize(writeScope, "none").Get("code"))
+
+		consents, err := sat.DB.OIDC().OAuthConsents().List(ctx, user.ID, client.ID)
+		require.NoError(t, err)
+		require.Len(t, consents, 4)
+
+		// consent to the sensitive scope expires, while consent to the others persists
+		granted := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, sat.DB.OIDC().OAuthConsents().Grant(ctx, user.ID, client.ID, strings.Fields(writeScope), granted))
+
+		values := authorize(writeScope, "none")
+		require.Equal(t, "consent_required", values.Get("error"))
+		require.Equal(t, "state", values.Get("state"))
+		require.NotEmpty(t, authorize(readScope, "none").Get("code"))
+
+		// consenting again renews it
+		require.NotEmpty(t, authorize(writeScope, "").Get("code"))
+		require.NotEmpty(t, authorize(writeScope, "none").Get("code"))
+	})
+}
+
+func TestOIDCUserInfoLiveBuckets(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		project := upl.Projects[0]
+
+		for _, bucket := range []string{"alpha", "beta", "gamma"} {
+			require.NoError(t, upl.CreateBucket(ctx, sat, bucket))
+		}
+
+		clientID, err := uuid.New()
+		require.NoError(t, err)
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+
+				UserInfoBucketLimit: 2,
+			},
+		)
+
+		userInfo := func(scope string) oidc.UserInfo {
+			access := testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        clientID.String(),
+				UserID:          project.Owner.ID.String(),
+				Scope:           "project:" + project.ID.String() + " " + scope,
+				Access:          access,
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var info oidc.UserInfo
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &info))
+			return info
+		}
+
+		// without the scope the granted buckets are returned as is
+		info := userInfo("bucket:alpha bucket:deleted")
+		require.Equal(t, []string{"alpha", "deleted"}, info.Buckets)
+
+		info = userInfo("bucket:alpha bucket:deleted storj:buckets")
+		require.Equal(t, []string{"alpha"}, info.Buckets)
+		require.False(t, info.BucketsTruncated)
+
+		info = userInfo("storj:buckets")
+		require.Equal(t, []string{"alpha", "beta"}, info.Buckets)
+		require.True(t, info.BucketsTruncated)
+	})
+}
+
+func TestOIDCUserInfoBucketScopedToken(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		project := upl.Projects[0]
+
+		for _, bucket := range []string{"alpha", "beta", "gamma"} {
+			require.NoError(t, upl.CreateBucket(ctx, sat, bucket))
+		}
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+
+				ScopeCaveats: oidc.ScopeCaveats{"storj:bucket:*": {BucketFromSuffix: true}},
+			},
+		)
+
+		userInfo := func(scope string) oidc.UserInfo {
+			access := testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        testrand.UUID().String(),
+				UserID:          project.Owner.ID.String(),
+				Scope:           "project:" + project.ID.String() + " " + scope,
+				Access:          access,
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var info oidc.UserInfo
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &info))
+			return info
+		}
+
+		// a token scoped to a single bucket only lists that bucket
+		require.Equal(t, []string{"alpha"}, userInfo("storj:bucket:alpha storj:buckets").Buckets)
+		require.Equal(t, []string{"alpha"}, userInfo("storj:bucket:alpha").Buckets)
+
+		// buckets named by scopes the other scopes exclude aren't granted by the token
+		require.Equal(t, []string{"alpha"}, userInfo("bucket:alpha bucket:beta storj:bucket:alpha storj:buckets").Buckets)
+		require.Equal(t, []string{"alpha"}, userInfo("bucket:alpha bucket:beta storj:bucket:alpha").Buckets)
+
+		// and a token granting none of the buckets lists none
+		require.Empty(t, userInfo("bucket:beta storj:bucket:alpha storj:buckets").Buckets)
+		require.Empty(t, userInfo("bucket:beta storj:bucket:alpha").Buckets)
+	})
+}
+
+func TestOIDCUserInfoProjectMeta(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		owner := planet.Uplinks[0].Projects[0].Owner
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{
+			Name:      "attributed",
+			OwnerID:   owner.ID,
+			UserAgent: []byte("Partner"),
+		})
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, owner.ID, project.ID)
+		require.NoError(t, err)
+
+		clientID := testrand.UUID()
+		client := oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("client-secret"),
+			UserID:      owner.ID,
+			RedirectURL: "http://app.test/callback",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+			},
+		)
+
+		userInfo := func(scope, accept string) *httptest.ResponseRecorder {
+			access := testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        clientID.String(),
+				UserID:          owner.ID.String(),
+				Scope:           scope,
+				Access:          access,
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+			if accept != "" {
+				req.Header.Set("Accept", accept)
+			}
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			return recorder
+		}
+
+		claims := func(scope string) map[string]interface{} {
+			var claims map[string]interface{}
+			require.NoError(t, json.Unmarshal(userInfo(scope, "").Body.Bytes(), &claims))
+			return claims
+		}
+
+		projectScope := "project:" + project.ID.String()
+
+		// the claims are only included when the scope is granted
+		info := claims(projectScope)
+		require.NotContains(t, info, "storj_project_id")
+		require.NotContains(t, info, "storj_partner")
+
+		info = claims(projectScope + " storj:project_meta")
+		require.Equal(t, project.ID.String(), info["storj_project_id"])
+		require.Equal(t, "Partner", info["storj_partner"])
+
+		// grants without a project have no project to describe
+		info = claims("storj:project_meta")
+		require.NotContains(t, info, "storj_project_id")
+		require.NotContains(t, info, "storj_partner")
+
+		// the claims are part of the id token too
+		signed := jwt.MapClaims{}
+		_, err = jwt.ParseWithClaims(userInfo(projectScope+" storj:project_meta", "application/jwt").Body.String(), signed, func(*jwt.Token) (interface{}, error) {
+			return client.Secret, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, project.ID.String(), signed["storj_project_id"])
+		require.Equal(t, "Partner", signed["storj_partner"])
+
+		// projects without partner attribution leave the partner out
+		info = claims("project:" + planet.Uplinks[0].Projects[0].ID.String() + " storj:project_meta")
+		require.Equal(t, planet.Uplinks[0].Projects[0].ID.String(), info["storj_project_id"])
+		require.NotContains(t, info, "storj_partner")
+	})
+}
+
+func TestOIDCUserInfoEmailVerified(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		clientID := testrand.UUID()
+		client := oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("client-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://app.test/callback",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+
+				SignedUserInfoClients: []string{clientID.String()},
+			},
+		)
+
+		emailVerified := func() bool {
+			access := testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        clientID.String(),
+				UserID:          project.Owner.ID.String(),
+				Scope:           "project:" + project.ID.String(),
+				Access:          access,
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			claims := jwt.MapClaims{}
+			_, err := jwt.ParseWithClaims(recorder.Body.String(), claims, func(*jwt.Token) (interface{}, error) {
+				return client.Secret, nil
+			})
+			require.NoError(t, err)
+			return claims["email_verified"] == true
+		}
+
+		require.True(t, emailVerified())
+
+		// the email an active account changed to wasn't verified
+		user, err := sat.API.Console.Service.GetUser(ctx, project.Owner.ID)
+		require.NoError(t, err)
+		require.NoError(t, sat.API.Console.Service.ChangeEmail(console.WithUser(ctx, user), "changed@app.test"))
+		require.False(t, emailVerified())
+	})
+}
+
+func TestOIDCUserInfoAccountStatus(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		clientID := testrand.UUID()
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("client-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://app.test/callback",
+		}))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+
+				UserInfoRetryBackendFailures: true,
+			},
+		)
+
+		userInfo := func(userID uuid.UUID) int {
+			access := testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        clientID.String(),
+				UserID:          userID.String(),
+				Scope:           "project:" + project.ID.String(),
+				Access:          access,
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			return recorder.Code
+		}
+
+		require.Equal(t, http.StatusOK, userInfo(project.Owner.ID))
+
+		// tokens of users that don't exist are invalid, while accounts that aren't active are forbidden
+		require.Equal(t, http.StatusUnauthorized, userInfo(testrand.UUID()))
+
+		inactive := console.Inactive
+		require.NoError(t, sat.DB.Console().Users().Update(ctx, project.Owner.ID, console.UpdateUserRequest{Status: &inactive}))
+		require.Equal(t, http.StatusForbidden, userInfo(project.Owner.ID))
+
+		deleted := console.Deleted
+		require.NoError(t, sat.DB.Console().Users().Update(ctx, project.Owner.ID, console.UpdateUserRequest{Status: &deleted}))
+		require.Equal(t, http.StatusForbidden, userInfo(project.Owner.ID))
+	})
+}
+
+func TestOIDCIDTokenExpiry(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		clientID := testrand.UUID()
+		client := oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("client-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://app.test/callback",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+
+		// expiries returns when an access token issued by the endpoint and the id token issued for it expire.
+		expiries := func(idTokenExpiry time.Duration) (accessToken, idToken int64) {
+			endpoint := oidc.NewEndpoint(
+				sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+				service, sat.API.Console.Service,
+				oidc.Config{
+					CodeExpiry:         time.Minute,
+					AccessTokenExpiry:  time.Hour,
+					IDTokenExpiry:      idTokenExpiry,
+					RefreshTokenExpiry: time.Hour,
+
+					SignedUserInfoClients: []string{clientID.String()},
+				},
+			)
+
+			access := testrand.UUID().String()
+			createdAt := time.Now()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        clientID.String(),
+				UserID:          project.Owner.ID.String(),
+				Scope:           "project:" + project.ID.String(),
+				Access:          access,
+				AccessCreateAt:  createdAt,
+				AccessExpiresIn: time.Hour,
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			claims := jwt.StandardClaims{}
+			_, err := jwt.ParseWithClaims(recorder.Body.String(), &claims, func(*jwt.Token) (interface{}, error) {
+				return client.Secret, nil
+			})
+			require.NoError(t, err)
+			return createdAt.Add(time.Hour).Unix(), claims.ExpiresAt
+		}
+
+		// by default, id tokens expire along with the access tokens
+		accessToken, idToken := expiries(0)
+		require.InDelta(t, accessToken, idToken, 5)
+
+		accessToken, idToken = expiries(5 * time.Minute)
+		require.NotEqual(t, accessToken, idToken)
+		require.InDelta(t, time.Now().Add(5*time.Minute).Unix(), idToken, 5)
+		require.InDelta(t, accessToken-idToken, (55 * time.Minute).Seconds(), 5)
+	})
+}
+
+func TestOIDCAccessTokenCache(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		clientID := testrand.UUID()
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, oidc.OAuthClient{
+			ID:          clientID,
+			Secret:      []byte("client-secret"),
+			UserID:      project.Owner.ID,
+			RedirectURL: "http://app.test/callback",
+		}))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpointCaching := func(ttl time.Duration) *oidc.Endpoint {
+			return oidc.NewEndpoint(
+				sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+				service, sat.API.Console.Service,
+				oidc.Config{
+					CodeExpiry:         time.Minute,
+					AccessTokenExpiry:  time.Hour,
+					RefreshTokenExpiry: time.Hour,
+
+					AccessTokenCacheTTL: ttl,
+				},
+			)
+		}
+
+		createAccess := func() string {
+			access := testrand.UUID().String()
+			require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+				ClientID:        clientID.String(),
+				UserID:          project.Owner.ID.String(),
+				Scope:           "project:" + project.ID.String(),
+				Access:          access,
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			}))
+			return access
+		}
+
+		userInfo := func(endpoint *oidc.Endpoint, access string) int {
+			req := httptest.NewRequest(http.MethodGet, "/oauth/v2/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+
+			recorder := httptest.NewRecorder()
+			endpoint.UserInfo(recorder, req)
+			return recorder.Code
+		}
+
+		cached, uncached := endpointCaching(time.Hour), endpointCaching(0)
+
+		access := createAccess()
+		require.Equal(t, http.StatusOK, userInfo(cached, access))
+		require.Equal(t, http.StatusOK, userInfo(uncached, access))
+
+		// revocations that bypass the endpoint are only picked up once the cached token expires
+		_, err := sat.DB.OIDC().OAuthTokens().RevokeAllForUser(ctx, project.Owner.ID)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, userInfo(cached, access))
+		require.Equal(t, http.StatusUnauthorized, userInfo(uncached, access))
+
+		// revoked tokens aren't cached
+		access = createAccess()
+		_, err = sat.DB.OIDC().OAuthTokens().RevokeAllForUser(ctx, project.Owner.ID)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, userInfo(cached, access))
+	})
+}
+
+func TestOIDCBackchannelLogout(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		user := planet.Uplinks[0].Projects[0].Owner
+
+		tokens := make(chan string, 10)
+		var deliveries int32
+		relyingParty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the first delivery fails to exercise the retries
+			if atomic.AddInt32(&deliveries, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			tokens <- r.PostFormValue("logout_token")
+		}))
+		defer relyingParty.Close()
+
+		client := oidc.OAuthClient{
+			ID:          testrand.UUID(),
+			Secret:      []byte("badadmin"),
+			UserID:      user.ID,
+			RedirectURL: "http://127.0.0.1/callback",
+			AppName:     "relying party",
+		}
+		require.NoError(t, sat.DB.OIDC().OAuthClients().Create(ctx, client))
+
+		service := oidc.NewService(sat.DB.OIDC())
+		endpoint := oidc.NewEndpoint(
+			sat.NodeURL(), "http://satellite.test/", zaptest.NewLogger(t),
+			service, sat.API.Console.Service,
+			oidc.Config{
+				CodeExpiry:         time.Minute,
+				AccessTokenExpiry:  time.Hour,
+				RefreshTokenExpiry: time.Hour,
+
+				BackchannelLogoutURIs:    oidc.LogoutURIs{client.ID: relyingParty.URL},
+				BackchannelLogoutBackoff: time.Millisecond,
+			},
+		)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error {
+			return endpoint.Run(runCtx)
+		})
+
+		access := testrand.UUID().String()
+		require.NoError(t, service.TokenStore().Create(ctx, &models.Token{
+			ClientID:        client.ID.String(),
+			UserID:          user.ID.String(),
+			Scope:           "object:list",
+			Access:          access,
+			AccessCreateAt:  time.Now(),
+			AccessExpiresIn: time.Hour,
+		}))
+
+		logoutClaims := func() jwt.MapClaims {
+			select {
+			case token := <-tokens:
+				claims := jwt.MapClaims{}
+				_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+					return client.Secret, nil
+				})
+				require.NoError(t, err)
+				return claims
+			case <-time.After(10 * time.Second):
+				t.Fatal("logout token was not delivered")
+				return nil
+			}
+		}
+
+		sessionID := testrand.UUID()
+		endpoint.EndSession(ctx, user.ID, sessionID)
+
+		claims := logoutClaims()
+		require.Equal(t, client.ID.String(), claims["aud"])
+		require.Equal(t, user.ID.String(), claims["sub"])
+		require.Equal(t, sessionID.String(), claims["sid"])
+		require.Contains(t, claims["events"], "http://schemas.openid.net/event/backchannel-logout")
+
+		// ending a session leaves the tokens valid
+		_, err := service.TokenStore().GetByAccess(ctx, access)
+		require.NoError(t, err)
+
+		require.NoError(t, service.RevokeUserTokens(ctx, user.ID))
+
+		_, err = service.TokenStore().GetByAccess(ctx, access)
+		require.Error(t, err)
+
+		// without live tokens there is nobody to notify
+		endpoint.EndSession(ctx, user.ID, sessionID)
+		require.Empty(t, tokens)
+	})
+}
+
+func TestOIDCRoutePrefix(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Console.OIDC.RoutePrefix = "/app/"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		consoleAddr := sat.API.Console.Listener.Addr().String()
+
+		cfg := oidc.ProviderConfig{}
+		send(t, nil, &cfg, http.StatusOK, "http://"+consoleAddr+"/app/.well-known/openid-configuration")
+
+		require.Equal(t, "http://"+consoleAddr+"/app", cfg.Issuer)
+		require.Equal(t, "http://"+consoleAddr+"/app/oauth/v2/authorize", cfg.AuthURL)
+		require.Equal(t, "http://"+consoleAddr+"/app/oauth/v2/tokens", cfg.TokenURL)
+		require.Equal(t, "http://"+consoleAddr+"/app/oauth/v2/userinfo", cfg.UserInfoURL)
+
+		// the userinfo handler is mounted under the prefix and rejects unauthenticated requests
+		send(t, nil, nil, http.StatusUnauthorized, cfg.UserInfoURL)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/golang-jwt/jwt"
+)
+
+// signingKeyIDSize is the number of random bytes the kids of client secrets are generated from.
+const signingKeyIDSize = 16
+
+// newSigningKeyID generates a kid for a new client secret. It's random rather than derived from the secret, so that
+// it reveals nothing about the secret, and it's stored alongside the secret so that clients can pick the secret a token
+// was signed with while they're rotated.
+func newSigningKeyID() (string, error) {
+	random := make([]byte, signingKeyIDSize)
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+// signingKey is a secret a client may have signed an inbound jwt with, along with its kid. The kid is empty for
+// secrets stored before kids were.
+type signingKey struct {
+	id     string
+	secret []byte
+}
+
+// signJWT signs the claims with the client secret, with the stored kid of the secret in the header when the client has
+// one, and the typ when it's not empty.
+func signJWT(claims jwt.MapClaims, typ string, client oauth2.ClientInfo) (string, error) {
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(userInfoSigningAlg), claims)
+	if c, ok := client.(OAuthClient); ok && c.SecretKeyID != "" {
+		token.Header["kid"] = c.SecretKeyID
+	}
+	if typ != "" {
+		token.Header["typ"] = typ
+	}
+	return token.SignedString([]byte(client.GetSecret()))
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+
+	"storj.io/common/memory"
+)
+
+const (
+	// defaultMaxRequestBodySize is used when no body size limit is configured.
+	defaultMaxRequestBodySize = memory.MiB
+	// defaultRequestTimeout is used when no request timeout is configured.
+	defaultRequestTimeout = 30 * time.Second
+)
+
+// limitRequest bounds the request by the configured timeout and reads its body up front, so that handlers never block
+// on slow or oversized bodies. It responds with 413 when the body is too large and 408 when it isn't received in time,
+// in which case the returned request is nil. The cancel func must always be called.
+func (e *Endpoint) limitRequest(w http.ResponseWriter, r *http.Request) (_ *http.Request, cancel func()) {
+	ctx, cancel := context.WithTimeout(r.Context(), e.requestTimeout)
+
+	type result struct {
+		body []byte
+		err  error
+	}
+
+	read := make(chan result, 1)
+	go func() {
+		body, err := io.ReadAll(io.LimitReader(r.Body, e.maxBodySize+1))
+		read <- result{body: body, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		http.Error(w, "", http.StatusRequestTimeout)
+		return nil, cancel
+	case result := <-read:
+		switch {
+		case result.err != nil:
+			http.Error(w, "", http.StatusBadRequest)
+			return nil, cancel
+		case int64(len(result.body)) > e.maxBodySize:
+			http.Error(w, "", http.StatusRequestEntityTooLarge)
+			return nil, cancel
+		}
+		recordBody(r, result.body)
+
+		r = r.WithContext(ctx)
+		r.Body = io.NopCloser(bytes.NewReader(result.body))
+		return r, cancel
+	}
+}
+
+// concurrencyLimit caps how many token and user info requests, which are the ones hitting the database, are served at
+// once.
+type concurrencyLimit struct {
+	slots    chan struct{} // nil when unlimited
+	inFlight int64
+}
+
+func newConcurrencyLimit(max int) *concurrencyLimit {
+	limit := &concurrencyLimit{}
+	if max > 0 {
+		limit.slots = make(chan struct{}, max)
+	}
+	return limit
+}
+
+// acquire takes a slot for a request, reporting false when every slot is taken. Requests are counted as in flight even
+// without a limit. Release must be called once a request that got a slot was served.
+func (limit *concurrencyLimit) acquire() bool {
+	if limit.slots != nil {
+		select {
+		case limit.slots <- struct{}{}:
+		default:
+			mon.Counter("oidc_requests_over_concurrency_limit").Inc(1)
+			return false
+		}
+	}
+
+	mon.IntVal("oidc_requests_in_flight").Observe(atomic.AddInt64(&limit.inFlight, 1))
+	return true
+}
+
+// release frees the slot of a served request.
+func (limit *concurrencyLimit) release() {
+	mon.IntVal("oidc_requests_in_flight").Observe(atomic.AddInt64(&limit.inFlight, -1))
+	if limit.slots != nil {
+		<-limit.slots
+	}
+}
+
+// limitConcurrency takes a slot for the request, responding with 503 and asking the client to retry later when the
+// configured concurrency is reached, in which case it reports false. Release must be called otherwise.
+func (e *Endpoint) limitConcurrency(w http.ResponseWriter) (release func(), ok bool) {
+	if !e.concurrency.acquire() {
+		w.Header().Set("Retry-After", strconv.Itoa(int(transientRetryAfter/time.Second)))
+		http.Error(w, "", http.StatusServiceUnavailable)
+		return nil, false
+	}
+	return e.concurrency.release, true
+}
+
+// scopeLimit caps how many scopes a single authorize request may include, so that clients can't ask for tokens with
+// an absurd number of bucket scopes. Zero is unlimited.
+type scopeLimit int
+
+// tooManyScopesError rejects authorize requests including more scopes than the limit.
+type tooManyScopesError struct {
+	max int
+}
+
+func (err *tooManyScopesError) Error() string { return "too many scopes" }
+
+// response describes the limit to the client as an invalid scope.
+func (err *tooManyScopesError) response() *oauth2errors.Response {
+	return &oauth2errors.Response{
+		Error:       oauth2errors.ErrInvalidScope,
+		Description: fmt.Sprintf("at most %d scopes may be requested", err.max),
+		StatusCode:  oauth2errors.StatusCodes[oauth2errors.ErrInvalidScope],
+	}
+}
+
+// check rejects the authorize request when it includes more distinct scopes than the limit.
+func (limit scopeLimit) check(r *http.Request) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	scopes := map[string]bool{}
+	for _, scope := range strings.Fields(r.FormValue("scope")) {
+		scopes[scope] = true
+	}
+	if len(scopes) > int(limit) {
+		mon.Counter("oidc_too_many_scopes").Inc(1)
+		return &tooManyScopesError{max: int(limit)}
+	}
+	return nil
+}
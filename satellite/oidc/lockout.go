@@ -0,0 +1,208 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+	"go.uber.org/zap"
+)
+
+// clientLockedError rejects token requests of a client that failed to authenticate too often, whether or not the
+// request authenticates the client correctly.
+type clientLockedError struct {
+	retryAfter time.Duration
+}
+
+func (err *clientLockedError) Error() string { return "client locked out" }
+
+// response describes the lockout to the client as an invalid client, asking it to retry once the lockout expired.
+func (err *clientLockedError) response() *oauth2errors.Response {
+	header := http.Header{}
+	header.Set("Retry-After", strconv.Itoa(int(math.Ceil(err.retryAfter.Seconds()))))
+
+	return &oauth2errors.Response{
+		Error:       oauth2errors.ErrInvalidClient,
+		Description: oauth2errors.Descriptions[oauth2errors.ErrInvalidClient],
+		StatusCode:  oauth2errors.StatusCodes[oauth2errors.ErrInvalidClient],
+		Header:      header,
+	}
+}
+
+// clientLockout locks clients out of exchanging tokens for a while once they failed to authenticate with their secret
+// threshold times. Failed attempts are forgotten one per decay, so that occasional mistakes never add up to a lockout.
+//
+// The lockout is checked before the client is authenticated, and client ids are public, so failures are counted per
+// address the requests come from. Otherwise anyone could lock a client out by sending it bad secrets. Only known
+// clients are tracked, since unknown clients fail before their secret is verified. The address is the remote address
+// of the connection, unless a header set by a trusted proxy is configured, since clients can send any header.
+type clientLockout struct {
+	oauth2.Manager
+
+	log       *zap.Logger
+	threshold int
+	duration  time.Duration
+	decay     time.Duration
+	// maxTracked bounds the number of clients tracked, so that requests from many addresses can't exhaust memory.
+	maxTracked int
+	nowFn      func() time.Time
+
+	mu sync.Mutex
+	// clients are keyed by client id and address.
+	clients map[string]*clientFailures
+}
+
+// clientFailures tracks the failed authentication attempts of a client.
+type clientFailures struct {
+	attempts    int
+	decayedAt   time.Time // when an attempt was last forgotten
+	lockedUntil time.Time
+	failedAt    time.Time // when the client last failed to authenticate
+}
+
+type clientAddressKey struct{}
+
+// withClientAddress returns a context carrying the address the request came from. The address is taken from the
+// header when one is configured and the request has it, and from the remote address of the connection otherwise.
+// Proxies append the address they received the request from to lists like X-Forwarded-For, so the last address of a
+// list is used, which clients can't forge.
+func withClientAddress(ctx context.Context, r *http.Request, header string) context.Context {
+	var address string
+	if header != "" {
+		if values := r.Header.Values(header); len(values) > 0 {
+			list := strings.Split(values[len(values)-1], ",")
+			address = strings.TrimSpace(list[len(list)-1])
+		}
+	}
+	if address == "" {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		address = host
+	}
+	return context.WithValue(ctx, clientAddressKey{}, address)
+}
+
+// lockoutKey identifies the client authenticating from the address carried by the context.
+func lockoutKey(ctx context.Context, clientID string) string {
+	address, _ := ctx.Value(clientAddressKey{}).(string)
+	return clientID + " " + address
+}
+
+// GenerateAccessToken rejects clients locked out from the address of the request before they're authenticated, and
+// otherwise records whether the client authenticated successfully.
+func (lockout *clientLockout) GenerateAccessToken(ctx context.Context, gt oauth2.GrantType, tgr *oauth2.TokenGenerateRequest) (oauth2.TokenInfo, error) {
+	key := lockoutKey(ctx, tgr.ClientID)
+
+	if retryAfter := lockout.lockedFor(key, lockout.nowFn()); retryAfter > 0 {
+		return nil, &clientLockedError{retryAfter: retryAfter}
+	}
+
+	info, err := lockout.Manager.GenerateAccessToken(ctx, gt, tgr)
+	if errors.Is(err, oauth2errors.ErrInvalidClient) {
+		lockout.fail(key, tgr.ClientID, lockout.nowFn())
+	} else if err == nil {
+		lockout.succeed(key)
+	}
+
+	return info, err
+}
+
+// lockedFor returns how long the client remains locked out from the address the key is for, or zero when it isn't.
+func (lockout *clientLockout) lockedFor(key string, now time.Time) time.Duration {
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	failures, ok := lockout.clients[key]
+	if !ok {
+		return 0
+	}
+	return failures.lockedUntil.Sub(now)
+}
+
+// fail records a failed authentication of the client from the address the key is for, locking it out from the address
+// when its attempts reach the threshold.
+func (lockout *clientLockout) fail(key, clientID string, now time.Time) {
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	failures, ok := lockout.clients[key]
+	if !ok {
+		if lockout.maxTracked > 0 && len(lockout.clients) >= lockout.maxTracked {
+			lockout.evict(now)
+		}
+		failures = &clientFailures{decayedAt: now}
+		lockout.clients[key] = failures
+	}
+
+	failures.decayTo(lockout.decay, now)
+	failures.attempts++
+	failures.failedAt = now
+
+	if failures.attempts >= lockout.threshold {
+		// the lockout starts over without any attempts left once it expires
+		failures.attempts = 0
+		failures.lockedUntil = now.Add(lockout.duration)
+
+		mon.Counter("oidc_client_lockout").Inc(1)
+		lockout.log.Warn("locked out client after failed authentications",
+			zap.String("client", clientID), zap.Duration("duration", lockout.duration))
+	}
+}
+
+// succeed forgets the failed authentications of the client from the address the key is for.
+func (lockout *clientLockout) succeed(key string) {
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	delete(lockout.clients, key)
+}
+
+// evict forgets the clients that are neither locked out nor have failed attempts left, and the client that failed
+// longest ago when there are none, to make room for another client.
+func (lockout *clientLockout) evict(now time.Time) {
+	var oldestKey string
+	var oldest *clientFailures
+	for key, failures := range lockout.clients {
+		if !failures.lockedUntil.After(now) && failures.decayTo(lockout.decay, now) == 0 {
+			delete(lockout.clients, key)
+			continue
+		}
+		if oldest == nil || failures.failedAt.Before(oldest.failedAt) {
+			oldestKey, oldest = key, failures
+		}
+	}
+
+	if len(lockout.clients) >= lockout.maxTracked && oldest != nil {
+		delete(lockout.clients, oldestKey)
+	}
+}
+
+// decayTo forgets the attempts that decayed by now and returns the attempts left.
+func (failures *clientFailures) decayTo(decay time.Duration, now time.Time) int {
+	if decay <= 0 {
+		return failures.attempts
+	}
+
+	decayed := int(now.Sub(failures.decayedAt) / decay)
+	if decayed >= failures.attempts {
+		failures.attempts = 0
+		failures.decayedAt = now
+	} else {
+		failures.attempts -= decayed
+		failures.decayedAt = failures.decayedAt.Add(time.Duration(decayed) * decay)
+	}
+	return failures.attempts
+}
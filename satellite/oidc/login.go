@@ -0,0 +1,24 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"net/url"
+)
+
+// loginRedirect returns where a user without a session is sent to log in. The return_to parameter leads back to the
+// authorization request with all of its original parameters, so that the flow continues once the user logged in.
+func loginRedirect(loginURL *url.URL, authURL string, params url.Values) string {
+	returnTo := authURL
+	if len(params) > 0 {
+		returnTo += "?" + params.Encode()
+	}
+
+	redirect := *loginURL
+	query := redirect.Query()
+	query.Set("return_to", returnTo)
+	redirect.RawQuery = query.Encode()
+
+	return redirect.String()
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/uuid"
+)
+
+const (
+	// backchannelLogoutEvent is the event claim identifying a logout token.
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+	defaultLogoutAttempts = 5
+	defaultLogoutBackoff  = time.Second
+	defaultLogoutTimeout  = 10 * time.Second
+	defaultLogoutQueue    = 1000
+
+	// logoutWorkers is how many logout tokens are delivered at once.
+	logoutWorkers = 4
+)
+
+// LogoutURIs maps oauth client ids onto the uri they receive back-channel logout tokens at.
+type LogoutURIs map[uuid.UUID]string
+
+// Type implements pflag.Value.
+func (LogoutURIs) Type() string { return "oidc.LogoutURIs" }
+
+// String is required for pflag.Value.
+func (uris *LogoutURIs) String() string {
+	data, err := json.Marshal(*uris)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (uris *LogoutURIs) Set(s string) (err error) {
+	parsed := make(LogoutURIs)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	// logout uris must be absolute and can't contain a fragment
+	for clientID, uri := range parsed {
+		u, err := url.Parse(uri)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Fragment != "" {
+			return Error.New("client %s: invalid back-channel logout uri %q", clientID, uri)
+		}
+	}
+
+	*uris = parsed
+	return nil
+}
+
+// EndSession notifies the clients holding tokens of the user that the user ended the identified session.
+func (e *Endpoint) EndSession(ctx context.Context, userID, sessionID uuid.UUID) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if len(e.logoutURIs) == 0 {
+		return
+	}
+
+	clientIDs, err := e.tokens.ListClients(ctx, userID)
+	if err != nil {
+		e.log.Error("failed to list clients for back-channel logout", zap.Stringer("user", userID), zap.Error(err))
+		return
+	}
+
+	e.notifyLogout(ctx, clientIDs, userID, &sessionID)
+}
+
+// Run delivers the queued back-channel logout tokens until ctx is canceled.
+func (e *Endpoint) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return e.logouts.run(ctx)
+}
+
+// notifyLogout queues a logout token for every client that registered a back-channel logout uri. Tokens are delivered
+// in the background by Run, so that slow clients don't hold up the user.
+func (e *Endpoint) notifyLogout(ctx context.Context, clientIDs []uuid.UUID, userID uuid.UUID, sessionID *uuid.UUID) {
+	for _, clientID := range clientIDs {
+		uri, ok := e.logoutURIs[clientID]
+		if !ok {
+			continue
+		}
+
+		token, err := e.logoutToken(ctx, clientID, userID, sessionID)
+		if err != nil {
+			e.log.Error("failed to create logout token", zap.Stringer("client", clientID), zap.Error(err))
+			continue
+		}
+
+		if !e.logouts.enqueue(logoutDelivery{clientID: clientID, uri: uri, token: token}) {
+			e.log.Warn("dropped logout token, delivery queue is full", zap.Stringer("client", clientID))
+		}
+	}
+}
+
+// logoutToken renders a logout token for the client, signed with the client's secret like signed user info.
+func (e *Endpoint) logoutToken(ctx context.Context, clientID, userID uuid.UUID, sessionID *uuid.UUID) (string, error) {
+	client, err := e.clientStore.GetByID(ctx, clientID.String())
+	if err != nil {
+		return "", err
+	}
+
+	jti, err := uuid.New()
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{
+		"iss": e.config.Issuer,
+		"aud": clientID.String(),
+		"jti": jti.String(),
+		"sub": userID.String(),
+		"events": map[string]interface{}{
+			backchannelLogoutEvent: map[string]interface{}{},
+		},
+	}
+	if sessionID != nil {
+		claims["sid"] = sessionID.String()
+	}
+	e.setIssued(claims, time.Now())
+
+	return signJWT(claims, "logout+jwt", client)
+}
+
+// logoutDelivery is a logout token waiting to be posted to a client.
+type logoutDelivery struct {
+	clientID uuid.UUID
+	uri      string
+	token    string
+}
+
+// logoutDeliveries posts queued logout tokens to clients with a fixed number of workers, so that neither slow clients
+// nor bursts of logouts pile up requests.
+type logoutDeliveries struct {
+	log      *zap.Logger
+	client   *http.Client
+	attempts int
+	backoff  time.Duration
+	queue    chan logoutDelivery
+}
+
+func newLogoutDeliveries(log *zap.Logger, config Config) *logoutDeliveries {
+	attempts := config.BackchannelLogoutAttempts
+	if attempts <= 0 {
+		attempts = defaultLogoutAttempts
+	}
+
+	backoff := config.BackchannelLogoutBackoff
+	if backoff <= 0 {
+		backoff = defaultLogoutBackoff
+	}
+
+	timeout := config.BackchannelLogoutTimeout
+	if timeout <= 0 {
+		timeout = defaultLogoutTimeout
+	}
+
+	queue := config.BackchannelLogoutQueue
+	if queue <= 0 {
+		queue = defaultLogoutQueue
+	}
+
+	return &logoutDeliveries{
+		log:      log,
+		client:   &http.Client{Timeout: timeout},
+		attempts: attempts,
+		backoff:  backoff,
+		queue:    make(chan logoutDelivery, queue),
+	}
+}
+
+// enqueue queues the delivery without waiting, and reports whether the queue had room for it.
+func (deliveries *logoutDeliveries) enqueue(delivery logoutDelivery) bool {
+	select {
+	case deliveries.queue <- delivery:
+		return true
+	default:
+		mon.Counter("oidc_logout_dropped").Inc(1)
+		return false
+	}
+}
+
+// run delivers queued tokens until ctx is canceled. Tokens still queued then are dropped.
+func (deliveries *logoutDeliveries) run(ctx context.Context) error {
+	var group errgroup.Group
+	for i := 0; i < logoutWorkers; i++ {
+		group.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case delivery := <-deliveries.queue:
+					deliveries.deliver(ctx, delivery)
+				}
+			}
+		})
+	}
+	return group.Wait()
+}
+
+// deliver posts the logout token to the client, retrying with exponential backoff until the client accepts it, the
+// attempts run out, or ctx is canceled.
+func (deliveries *logoutDeliveries) deliver(ctx context.Context, delivery logoutDelivery) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	backoff := deliveries.backoff
+	for attempt := 1; ; attempt++ {
+		err = deliveries.post(ctx, delivery.uri, delivery.token)
+		if err == nil {
+			return
+		}
+
+		if attempt >= deliveries.attempts {
+			deliveries.log.Warn("failed to deliver logout token", zap.Stringer("client", delivery.clientID), zap.Int("attempts", attempt), zap.Error(err))
+			return
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (deliveries *logoutDeliveries) post(ctx context.Context, uri, token string) error {
+	body := url.Values{"logout_token": {token}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, bytes.NewBufferString(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := deliveries.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
@@ -4,11 +4,14 @@
 package oidc
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-oauth2/oauth2/v4"
 
@@ -36,6 +39,20 @@ func (a *UUIDAuthorizeGenerate) Token(ctx context.Context, data *oauth2.Generate
 // MacaroonAccessGenerate provides an access_token and refresh_token generator using Storj's Macaroons.
 type MacaroonAccessGenerate struct {
 	Service GenerateService
+
+	// ScopeCaveats maps additional scopes onto the caveats they imply.
+	ScopeCaveats ScopeCaveats
+
+	// RefreshMaxAge limits how long after the grant its refresh tokens can be refreshed. Without it, refresh tokens
+	// expire their lifetime after the grant. With it, every refresh extends the lifetime from the time of the refresh,
+	// but never beyond RefreshMaxAge after the grant.
+	RefreshMaxAge time.Duration
+
+	// AccessTokenFormats lists the clients that are issued access tokens in a format other than macaroons. Jwt access
+	// tokens are issued by Issuer, and are valid from ClockSkew before they're issued.
+	AccessTokenFormats AccessTokenFormats
+	Issuer             string
+	ClockSkew          time.Duration
 }
 
 // GenerateService defines the minimal interface needed to generate macaroon based api keys.
@@ -94,62 +111,63 @@ func (a *MacaroonAccessGenerate) apiKeyForProject(ctx context.Context, data *oau
 //	object:read          - optional, allows reading object data
 //	object:write         - optional, allows writing object data
 //	object:delete        - optional, allows deleting object data
+//	storj:buckets        - optional, lists the project's current buckets in user info instead of the granted ones
+//
+// Scopes configured in ScopeCaveats further restrict the token with the caveats they map to. Any other scope, aside
+// from the standard OpenID Connect ones, is rejected rather than ignored.
 //
 // In OAuth2.0, access_tokens are short-lived tokens that authorize operations to be performed on behalf of an end user.
-// refresh_tokens are longer lived tokens that allow you to obtain new authorization tokens.
+// refresh_tokens are longer lived tokens that allow you to obtain new authorization tokens. Each refresh rotates the
+// refresh_token, keeping its original caveats and, unless RefreshMaxAge is set, its expiration. Both kinds of token
+// are restricted from the project's api key with exactly the caveats of their scope and expiration, so the caveats
+// of a token don't depend on how often it was refreshed.
+//
+// Clients registered in AccessTokenFormats for jwt access tokens receive a jwt carrying the granted scope instead of
+// the access macaroon, with the requested resources as its audience. Their refresh tokens remain macaroons.
 func (a *MacaroonAccessGenerate) Token(ctx context.Context, data *oauth2.GenerateBasic, isGenRefresh bool) (access, refresh string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var apiKey *macaroon.APIKey
-
-	if priorRefresh := data.TokenInfo.GetRefresh(); isGenRefresh && priorRefresh != "" {
-		apiKey, err = macaroon.ParseAPIKey(priorRefresh)
-		if err != nil {
-			return access, refresh, err
-		}
-
-		refresh = priorRefresh
-	} else {
-		info, perms, err := parseScope(data.TokenInfo.GetScope())
-		if err != nil {
-			return access, refresh, err
-		}
+	// the refresh may have requested a narrower scope than the one originally granted
+	info, perms, err := parseScope(data.TokenInfo.GetScope(), a.ScopeCaveats)
+	if err != nil {
+		return access, refresh, err
+	}
 
-		if info.Project == "" {
-			return access, refresh, fmt.Errorf("missing project")
-		}
+	if info.Project == "" {
+		return access, refresh, fmt.Errorf("missing project")
+	}
 
-		apiKey, err = a.apiKeyForProject(ctx, data, info.Project)
-		if err != nil {
-			return access, refresh, err
-		}
+	root, err := a.apiKeyForProject(ctx, data, info.Project)
+	if err != nil {
+		return access, refresh, err
+	}
 
-		apiKey, err = apiKey.Restrict(perms)
-		if err != nil {
-			return access, refresh, err
-		}
+	if isGenRefresh {
+		createAt, expireAt := a.refreshExpiration(data)
 
-		if isGenRefresh {
+		var refreshKey *macaroon.APIKey
+		if priorRefresh := data.TokenInfo.GetRefresh(); priorRefresh != "" {
+			refreshKey, err = rotateRefresh(root, priorRefresh, createAt, expireAt)
+			if err != nil {
+				return access, refresh, err
+			}
+		} else {
 			nonce, err := uuid.New()
 			if err != nil {
 				return "", "", err
 			}
 
-			createAt := data.TokenInfo.GetRefreshCreateAt()
-			expireAt := createAt.Add(data.TokenInfo.GetRefreshExpiresIn())
-
-			apiKey, err = apiKey.Restrict(macaroon.Caveat{
+			refreshKey, err = restrictKey(root, append(perms[:len(perms):len(perms)], macaroon.Caveat{
 				NotBefore: &(createAt),
 				NotAfter:  &(expireAt),
 				Nonce:     nonce.Bytes(),
-			})
-
+			}))
 			if err != nil {
 				return access, refresh, err
 			}
-
-			refresh = apiKey.Serialize()
 		}
+
+		refresh = refreshKey.Serialize()
 	}
 
 	nonce, err := uuid.New()
@@ -160,21 +178,115 @@ func (a *MacaroonAccessGenerate) Token(ctx context.Context, data *oauth2.Generat
 	createAt := data.TokenInfo.GetAccessCreateAt()
 	expireAt := createAt.Add(data.TokenInfo.GetAccessExpiresIn())
 
-	apiKey, err = apiKey.Restrict(macaroon.Caveat{
+	// access tokens are restricted from the root rather than from the refresh token, so that they carry no caveats
+	// of the tokens they were refreshed from, and refreshing never adds to them
+	apiKey, err := restrictKey(root, append(perms, macaroon.Caveat{
 		NotBefore: &(createAt),
 		NotAfter:  &(expireAt),
 		Nonce:     nonce.Bytes(),
-	})
-
+	}))
 	if err != nil {
 		return "", "", err
 	}
 
 	access = apiKey.Serialize()
+
+	client := data.Client.(OAuthClient)
+	if a.AccessTokenFormats.lookup(client.ID) == AccessTokenJWT {
+		access, err = a.jwtAccessToken(data, client, nonce, createAt, expireAt, resourceRequestFrom(ctx).audience())
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	return access, refresh, nil
 }
 
-func parseScope(scope string) (UserInfo, macaroon.Caveat, error) {
+// refreshExpiration returns when the grant of the refresh token being issued was created, and when the macaroon backing
+// the token expires. With RefreshMaxAge, the token's lifetime is extended from the time it's issued, capped at
+// RefreshMaxAge after the grant. The macaroon then expires with the cap instead, so that rotating the same token still
+// always results in the same replacement, and the token store enforces the extended lifetime.
+func (a *MacaroonAccessGenerate) refreshExpiration(data *oauth2.GenerateBasic) (createAt, expireAt time.Time) {
+	createAt = data.TokenInfo.GetRefreshCreateAt()
+	expiresIn := data.TokenInfo.GetRefreshExpiresIn()
+	if a.RefreshMaxAge <= 0 {
+		return createAt, createAt.Add(expiresIn)
+	}
+
+	maxExpireAt := createAt.Add(a.RefreshMaxAge)
+
+	// access tokens are issued alongside, at the time of the grant or of the refresh
+	extendedAt := data.TokenInfo.GetAccessCreateAt().Add(expiresIn)
+	if extendedAt.After(maxExpireAt) {
+		extendedAt = maxExpireAt
+	}
+	data.TokenInfo.SetRefreshExpiresIn(extendedAt.Sub(createAt))
+
+	return createAt, maxExpireAt
+}
+
+// rotateRefresh derives the refresh token that replaces prior. The replacement carries the caveats of prior, except
+// for its expiration, which is reissued with a nonce derived from prior. Rotating the same token therefore always
+// results in the same replacement, which lets concurrent refreshes agree on the tokens they return.
+func rotateRefresh(root *macaroon.APIKey, prior string, createAt, expireAt time.Time) (*macaroon.APIKey, error) {
+	priorKey, err := macaroon.ParseAPIKey(prior)
+	if err != nil {
+		return nil, err
+	}
+
+	priorMac, err := macaroon.ParseMacaroon(priorKey.SerializeRaw())
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(priorMac.Head(), root.Head()) {
+		return nil, fmt.Errorf("refresh token was issued for another api key")
+	}
+
+	caveats := priorMac.Caveats()
+	if len(caveats) == 0 {
+		return nil, fmt.Errorf("refresh token is missing its expiration")
+	}
+
+	mac, err := macaroon.ParseMacaroon(root.SerializeRaw())
+	if err != nil {
+		return nil, err
+	}
+
+	// the last caveat is the expiration added when the refresh token was issued
+	for _, caveat := range caveats[:len(caveats)-1] {
+		mac, err = mac.AddFirstPartyCaveat(caveat)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	apiKey, err := macaroon.ParseRawAPIKey(mac.Serialize())
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := sha256.Sum256([]byte(prior))
+
+	return apiKey.Restrict(macaroon.Caveat{
+		NotBefore: &(createAt),
+		NotAfter:  &(expireAt),
+		Nonce:     nonce[:16],
+	})
+}
+
+// restrictKey restricts the api key with each of the caveats in order.
+func restrictKey(key *macaroon.APIKey, caveats []macaroon.Caveat) (_ *macaroon.APIKey, err error) {
+	for _, caveat := range caveats {
+		key, err = key.Restrict(caveat)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func parseScope(scope string, mapping ScopeCaveats) (UserInfo, []macaroon.Caveat, error) {
 	scopes := strings.Split(scope, " ")
 
 	info := UserInfo{}
@@ -186,13 +298,18 @@ func parseScope(scope string) (UserInfo, macaroon.Caveat, error) {
 		AllowedPaths:    make([]*macaroon.Caveat_Path, 0, len(scopes)),
 	}
 
+	mapped := macaroon.Caveat{}
+	hasMapped := false
+
 	for i := 0; i < len(scopes); i++ {
 		scopes[i] = strings.TrimSpace(scopes[i])
 
 		switch {
+		case scopes[i] == "":
+			continue
 		case strings.HasPrefix(scopes[i], "project:"):
 			if info.Project != "" {
-				return info, perms, fmt.Errorf("multiple project scopes provided")
+				return info, nil, fmt.Errorf("multiple project scopes provided")
 			}
 
 			info.Project = strings.TrimPrefix(scopes[i], "project:")
@@ -213,8 +330,72 @@ func parseScope(scope string) (UserInfo, macaroon.Caveat, error) {
 			perms.DisallowWrites = false
 		case scopes[i] == "object:delete":
 			perms.DisallowDeletes = false
+		case scopes[i] == liveBucketsScope:
+			// the buckets are looked up when the user info is requested
+		case scopes[i] == projectMetaScope:
+			// the project metadata is looked up when the user info is requested
+		case standardScopes[scopes[i]]:
+			// standard OpenID Connect scopes don't affect the issued macaroon
+		default:
+			caveat, suffix, ok := mapping.lookup(scopes[i])
+			if !ok {
+				return info, nil, fmt.Errorf("unknown scope %q", scopes[i])
+			}
+
+			buckets := caveat.Buckets
+			if caveat.BucketFromSuffix {
+				if suffix == "" {
+					return info, nil, fmt.Errorf("scope %q is missing a bucket", scopes[i])
+				}
+
+				buckets = append(buckets[:len(buckets):len(buckets)], suffix)
+			}
+
+			hasMapped = true
+			mapped.DisallowLists = mapped.DisallowLists || caveat.DisallowLists
+			mapped.DisallowReads = mapped.DisallowReads || caveat.DisallowReads
+			mapped.DisallowWrites = mapped.DisallowWrites || caveat.DisallowWrites
+			mapped.DisallowDeletes = mapped.DisallowDeletes || caveat.DisallowDeletes
+
+			for _, bucket := range buckets {
+				info.Buckets = append(info.Buckets, bucket)
+
+				mapped.AllowedPaths = append(mapped.AllowedPaths, &macaroon.Caveat_Path{
+					Bucket: []byte(bucket),
+				})
+			}
+		}
+	}
+
+	caveats := []macaroon.Caveat{perms}
+	if hasMapped {
+		// mapped scopes are applied as a separate caveat so they can only ever narrow the access granted above
+		caveats = append(caveats, mapped)
+	}
+
+	return info, caveats, nil
+}
+
+// isSubScope reports whether every scope in requested was also granted.
+func isSubScope(requested, granted string) bool {
+	grantedScopes := make(map[string]bool)
+	for _, scope := range strings.Fields(granted) {
+		grantedScopes[scope] = true
+	}
+
+	for _, scope := range strings.Fields(requested) {
+		if !grantedScopes[scope] {
+			return false
 		}
 	}
 
-	return info, perms, nil
+	return true
+}
+
+// standardScopes are the scopes defined by OpenID Connect that are accepted alongside our own.
+var standardScopes = map[string]bool{
+	"openid":         true,
+	"profile":        true,
+	"email":          true,
+	"offline_access": true,
 }
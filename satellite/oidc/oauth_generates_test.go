@@ -11,9 +11,12 @@ import (
 
 	"github.com/go-oauth2/oauth2/v4"
 	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 
 	"storj.io/common/macaroon"
+	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/oidc"
@@ -172,9 +175,357 @@ func TestMacaroonGenerate(t *testing.T) {
 		refreshed, refresh, err := generate.Token(ctx, request, testCase.refresh)
 
 		require.NoError(t, err)
-		require.Equal(t, token.Refresh, refresh)
+		require.NotEqual(t, token.Refresh, refresh)
 
 		// ensure the refreshed token isn't the same as the original
 		require.NotEqual(t, access, refreshed)
+
+		// rotating the same refresh token again results in the same replacement
+		_, rotated, err := generate.Token(ctx, request, testCase.refresh)
+		require.NoError(t, err)
+		require.Equal(t, refresh, rotated)
+	}
+}
+
+func TestMacaroonGenerateRefreshCaveats(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	apiKey, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	user, project := testrand.UUID(), testrand.UUID()
+
+	generate := &oidc.MacaroonAccessGenerate{
+		Service: &mockGenerateService{
+			GetAPIKeyInfoFunc: func(ctx context.Context, id uuid.UUID, name string) (*console.APIKeyInfo, error) {
+				return &console.APIKeyInfo{ID: id, ProjectID: id, Name: name, Head: apiKey.Head(), Secret: secret}, nil
+			},
+			GetUserFunc: func(ctx context.Context, id uuid.UUID) (*console.User, error) {
+				return &console.User{ID: user}, nil
+			},
+		},
+		RefreshMaxAge: time.Hour,
+	}
+
+	caveats := func(token string) int {
+		key, err := macaroon.ParseAPIKey(token)
+		require.NoError(t, err)
+
+		mac, err := macaroon.ParseMacaroon(key.SerializeRaw())
+		require.NoError(t, err)
+
+		return len(mac.Caveats())
+	}
+
+	now := time.Now()
+	token := &models.Token{
+		Scope:            "project:" + project.String() + " bucket:test object:list object:read",
+		AccessCreateAt:   now,
+		AccessExpiresIn:  time.Minute,
+		RefreshCreateAt:  now,
+		RefreshExpiresIn: time.Minute,
+	}
+	request := &oauth2.GenerateBasic{
+		Client:    oidc.OAuthClient{},
+		UserID:    user.String(),
+		TokenInfo: token,
+	}
+
+	access, refresh, err := generate.Token(context.Background(), request, true)
+	require.NoError(t, err)
+
+	accessCaveats, refreshCaveats := caveats(access), caveats(refresh)
+	// the scope and expiration caveats
+	require.Equal(t, 2, accessCaveats)
+	require.Equal(t, 2, refreshCaveats)
+
+	for i := 1; i <= 5; i++ {
+		token.Refresh = refresh
+		token.AccessCreateAt = now.Add(time.Duration(i) * time.Minute)
+
+		access, refresh, err = generate.Token(context.Background(), request, true)
+		require.NoError(t, err)
+
+		require.Equal(t, accessCaveats, caveats(access), "refresh %d", i)
+		require.Equal(t, refreshCaveats, caveats(refresh), "refresh %d", i)
+	}
+
+	// narrowing the scope on refresh restricts the access token instead of adding to it
+	token.Refresh = refresh
+	token.Scope = "project:" + project.String() + " bucket:test object:list"
+
+	access, _, err = generate.Token(context.Background(), request, true)
+	require.NoError(t, err)
+	require.Equal(t, accessCaveats, caveats(access))
+}
+
+func TestMacaroonGenerateScopeCaveats(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	apiKey, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	user, err := uuid.New()
+	require.NoError(t, err)
+
+	project, err := uuid.New()
+	require.NoError(t, err)
+
+	var scopeCaveats oidc.ScopeCaveats
+	require.NoError(t, scopeCaveats.Set(`{
+		"storj:readonly": {"disallowWrites": true, "disallowDeletes": true},
+		"storj:bucket:*": {"bucketFromSuffix": true}
+	}`))
+
+	ctx := context.Background()
+	generate := &oidc.MacaroonAccessGenerate{
+		Service: &mockGenerateService{
+			GetUserFunc: func(ctx context.Context, id uuid.UUID) (*console.User, error) {
+				return &console.User{ID: id}, nil
+			},
+			GetAPIKeyInfoFunc: func(ctx context.Context, id uuid.UUID, name string) (*console.APIKeyInfo, error) {
+				return &console.APIKeyInfo{Head: apiKey.Head(), Secret: secret}, nil
+			},
+		},
+		ScopeCaveats: scopeCaveats,
+	}
+
+	token := &models.Token{
+		AccessCreateAt:  time.Now(),
+		AccessExpiresIn: time.Minute,
+	}
+
+	request := &oauth2.GenerateBasic{
+		Client:    oidc.OAuthClient{},
+		UserID:    user.String(),
+		TokenInfo: token,
+	}
+
+	allScope := "project:" + project.String() + " object:list object:read object:write object:delete "
+
+	check := func(access string, op macaroon.ActionType, bucket string) error {
+		key, err := macaroon.ParseAPIKey(access)
+		require.NoError(t, err)
+
+		return key.Check(ctx, secret, macaroon.Action{
+			Op:            op,
+			Bucket:        []byte(bucket),
+			EncryptedPath: []byte("path"),
+			Time:          time.Now(),
+		}, nil)
+	}
+
+	{ // mapped scopes restrict operations and buckets
+		token.Scope = allScope + "storj:readonly storj:bucket:photos"
+
+		access, _, err := generate.Token(ctx, request, false)
+		require.NoError(t, err)
+
+		require.NoError(t, check(access, macaroon.ActionRead, "photos"))
+		require.NoError(t, check(access, macaroon.ActionList, "photos"))
+		require.Error(t, check(access, macaroon.ActionWrite, "photos"))
+		require.Error(t, check(access, macaroon.ActionDelete, "photos"))
+		require.Error(t, check(access, macaroon.ActionRead, "videos"))
+	}
+
+	{ // multiple bucket scopes allow access to each of the buckets
+		token.Scope = allScope + "storj:bucket:photos storj:bucket:videos"
+
+		access, _, err := generate.Token(ctx, request, false)
+		require.NoError(t, err)
+
+		require.NoError(t, check(access, macaroon.ActionWrite, "photos"))
+		require.NoError(t, check(access, macaroon.ActionWrite, "videos"))
+		require.Error(t, check(access, macaroon.ActionWrite, "music"))
+	}
+
+	{ // unknown scopes are rejected
+		token.Scope = allScope + "storj:admin"
+
+		_, _, err := generate.Token(ctx, request, false)
+		require.Error(t, err)
+		require.Equal(t, `unknown scope "storj:admin"`, err.Error())
+	}
+
+	{ // wildcard scopes require a bucket
+		token.Scope = allScope + "storj:bucket:"
+
+		_, _, err := generate.Token(ctx, request, false)
+		require.Error(t, err)
+	}
+}
+
+func TestScopeCaveatsSet(t *testing.T) {
+	var scopeCaveats oidc.ScopeCaveats
+
+	require.NoError(t, scopeCaveats.Set(""))
+	require.Empty(t, scopeCaveats)
+
+	require.NoError(t, scopeCaveats.Set(`{"storj:readonly": {"disallowWrites": true}}`))
+	require.Equal(t, oidc.ScopeCaveats{"storj:readonly": {DisallowWrites: true}}, scopeCaveats)
+
+	require.Error(t, scopeCaveats.Set(`{"storj:bucket": {"bucketFromSuffix": true}}`))
+	require.Error(t, scopeCaveats.Set(`not json`))
+}
+
+func TestMacaroonGenerateAccessTokenFormats(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	apiKey, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	user, project, jwtClient := testrand.UUID(), testrand.UUID(), testrand.UUID()
+
+	var formats oidc.AccessTokenFormats
+	require.NoError(t, formats.Set(`{"`+jwtClient.String()+`": "jwt"}`))
+
+	ctx := context.Background()
+	generate := &oidc.MacaroonAccessGenerate{
+		Service: &mockGenerateService{
+			GetUserFunc: func(ctx context.Context, id uuid.UUID) (*console.User, error) {
+				return &console.User{ID: id}, nil
+			},
+			GetAPIKeyInfoFunc: func(ctx context.Context, id uuid.UUID, name string) (*console.APIKeyInfo, error) {
+				return &console.APIKeyInfo{Head: apiKey.Head(), Secret: secret}, nil
+			},
+		},
+		AccessTokenFormats: formats,
+		Issuer:             "https://satellite.example/",
+		ClockSkew:          time.Minute,
 	}
+
+	createAt := time.Now().Truncate(time.Second)
+	scope := "project:" + project.String() + " object:read"
+
+	newRequest := func(client oidc.OAuthClient) *oauth2.GenerateBasic {
+		return &oauth2.GenerateBasic{
+			Client: client,
+			UserID: user.String(),
+			TokenInfo: &models.Token{
+				Scope:           scope,
+				AccessCreateAt:  createAt,
+				AccessExpiresIn: time.Hour,
+			},
+		}
+	}
+
+	{ // clients without an entry are issued macaroons
+		access, _, err := generate.Token(ctx, newRequest(oidc.OAuthClient{ID: testrand.UUID(), Secret: []byte("secret")}), false)
+		require.NoError(t, err)
+
+		_, err = macaroon.ParseAPIKey(access)
+		require.NoError(t, err)
+	}
+
+	{ // registered clients are issued jwts signed with their secret, with macaroon refresh tokens
+		access, refresh, err := generate.Token(ctx, newRequest(oidc.OAuthClient{ID: jwtClient, Secret: []byte("secret")}), true)
+		require.NoError(t, err)
+
+		_, err = macaroon.ParseAPIKey(refresh)
+		require.NoError(t, err)
+
+		claims := jwt.MapClaims{}
+		token, err := jwt.ParseWithClaims(access, claims, func(token *jwt.Token) (interface{}, error) {
+			return []byte("secret"), nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "at+jwt", token.Header["typ"])
+
+		require.Equal(t, "https://satellite.example/", claims["iss"])
+		require.Equal(t, user.String(), claims["sub"])
+		require.Equal(t, jwtClient.String(), claims["aud"])
+		require.Equal(t, jwtClient.String(), claims["client_id"])
+		require.Equal(t, scope, claims["scope"])
+		require.NotEmpty(t, claims["jti"])
+		require.EqualValues(t, createAt.Unix(), claims["iat"])
+		require.EqualValues(t, createAt.Add(-time.Minute).Unix(), claims["nbf"])
+		require.EqualValues(t, createAt.Add(time.Hour).Unix(), claims["exp"])
+	}
+}
+
+func TestMacaroonGenerateKeyID(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	apiKey, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	clientID := testrand.UUID()
+
+	var formats oidc.AccessTokenFormats
+	require.NoError(t, formats.Set(`{"`+clientID.String()+`": "jwt"}`))
+
+	ctx := context.Background()
+	generate := &oidc.MacaroonAccessGenerate{
+		Service: &mockGenerateService{
+			GetUserFunc: func(ctx context.Context, id uuid.UUID) (*console.User, error) {
+				return &console.User{ID: id}, nil
+			},
+			GetAPIKeyInfoFunc: func(ctx context.Context, id uuid.UUID, name string) (*console.APIKeyInfo, error) {
+				return &console.APIKeyInfo{Head: apiKey.Head(), Secret: secret}, nil
+			},
+		},
+		AccessTokenFormats: formats,
+		Issuer:             "https://satellite.example/",
+	}
+
+	issue := func(clientSecret, keyID string) string {
+		access, _, err := generate.Token(ctx, &oauth2.GenerateBasic{
+			Client: oidc.OAuthClient{ID: clientID, Secret: []byte(clientSecret), SecretKeyID: keyID},
+			UserID: testrand.UUID().String(),
+			TokenInfo: &models.Token{
+				Scope:           "project:" + testrand.UUID().String() + " object:read",
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			},
+		}, false)
+		require.NoError(t, err)
+		return access
+	}
+
+	// the relying party keeps the secrets it's rotating between keyed by the kids stored with them
+	keyIDs := map[string]string{"previous-secret": "previous-kid", "current-secret": "current-kid"}
+	keys := map[string][]byte{}
+	for clientSecret, keyID := range keyIDs {
+		keys[keyID] = []byte(clientSecret)
+	}
+
+	kids := map[string]bool{}
+	for _, clientSecret := range []string{"previous-secret", "current-secret", "current-secret"} {
+		token, err := jwt.Parse(issue(clientSecret, keyIDs[clientSecret]), func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+			key, ok := keys[kid]
+			if !ok {
+				return nil, errs.New("unknown kid %q", kid)
+			}
+			return key, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte(clientSecret), keys[token.Header["kid"].(string)])
+		kids[token.Header["kid"].(string)] = true
+	}
+	require.Len(t, kids, 2)
+
+	// secrets stored before kids were are signed without one
+	token, _, err := new(jwt.Parser).ParseUnverified(issue("legacy-secret", ""), jwt.MapClaims{})
+	require.NoError(t, err)
+	require.NotContains(t, token.Header, "kid")
+}
+
+func TestAccessTokenFormatsSet(t *testing.T) {
+	var formats oidc.AccessTokenFormats
+
+	require.NoError(t, formats.Set(""))
+	require.Empty(t, formats)
+
+	client := testrand.UUID()
+	require.NoError(t, formats.Set(`{"`+client.String()+`": "macaroon"}`))
+	require.Equal(t, oidc.AccessTokenFormats{client: oidc.AccessTokenMacaroon}, formats)
+
+	require.Error(t, formats.Set(`{"`+client.String()+`": "opaque"}`))
+	require.Error(t, formats.Set(`{"not-a-uuid": "jwt"}`))
+	require.Error(t, formats.Set(`not json`))
 }
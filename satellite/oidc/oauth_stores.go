@@ -5,9 +5,12 @@ package oidc
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/go-oauth2/oauth2/v4"
+	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
 )
@@ -35,6 +38,12 @@ func (c *ClientStore) GetByID(ctx context.Context, id string) (_ oauth2.ClientIn
 type TokenStore struct {
 	codes  OAuthCodes
 	tokens OAuthTokens
+
+	// refreshReuseGrace is how long a rotated refresh token is still accepted for.
+	refreshReuseGrace time.Duration
+	// describeExpiredCodes tells clients exchanging expired codes that they expired, rather than rejecting them as
+	// any unknown code.
+	describeExpiredCodes bool
 }
 
 var _ oauth2.TokenStore = (*TokenStore)(nil)
@@ -46,10 +55,15 @@ func (t *TokenStore) Create(ctx context.Context, info oauth2.TokenInfo) (err err
 	var code OAuthCode
 	var access, refresh OAuthToken
 
+	resources := resourceRequestFrom(ctx)
+
 	if r, ok := info.(*record); ok {
 		code = r.code
 		access = r.access
 		refresh = r.refresh
+
+		// refreshed access tokens are issued for the resources the refresh picked
+		access.Resources = resources.audience()
 	} else {
 		clientID, err := uuid.FromString(info.GetClientID())
 		if err != nil {
@@ -71,6 +85,7 @@ func (t *TokenStore) Create(ctx context.Context, info oauth2.TokenInfo) (err err
 			code.Code = c
 			code.CreatedAt = info.GetCodeCreateAt()
 			code.ExpiresAt = code.CreatedAt.Add(info.GetCodeExpiresIn())
+			code.Resources = resources.grant()
 		}
 
 		if a := info.GetAccess(); a != "" {
@@ -81,6 +96,7 @@ func (t *TokenStore) Create(ctx context.Context, info oauth2.TokenInfo) (err err
 			access.Token = a
 			access.CreatedAt = info.GetAccessCreateAt()
 			access.ExpiresAt = access.CreatedAt.Add(info.GetAccessExpiresIn())
+			access.Resources = resources.audience()
 		}
 
 		if r := info.GetRefresh(); r != "" {
@@ -91,6 +107,7 @@ func (t *TokenStore) Create(ctx context.Context, info oauth2.TokenInfo) (err err
 			refresh.Token = r
 			refresh.CreatedAt = info.GetRefreshCreateAt()
 			refresh.ExpiresAt = refresh.CreatedAt.Add(info.GetRefreshExpiresIn())
+			refresh.Resources = resources.grant()
 		}
 	}
 
@@ -132,18 +149,35 @@ func (t *TokenStore) RemoveByAccess(ctx context.Context, access string) (err err
 	return nil // unsupported by current configuration
 }
 
-// RemoveByRefresh deletes token by refresh token.
+// RemoveByRefresh expires a refresh token once it has been rotated. The token is still accepted during the reuse grace
+// period, so that concurrent refreshes rotate it into the same replacement rather than being treated as reuse.
 func (t *TokenStore) RemoveByRefresh(ctx context.Context, refresh string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return nil // unsupported by current configuration
+	return t.tokens.Expire(ctx, KindRefreshToken, refresh, time.Now().Add(t.refreshReuseGrace))
 }
 
-// GetByCode uses authorization code to find token information.
+// GetByCode uses authorization code to find token information. Exchanging a code that was exchanged already means it
+// was compromised, so the tokens issued from it are revoked.
 func (t *TokenStore) GetByCode(ctx context.Context, code string) (_ oauth2.TokenInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	oauthCode, err := t.codes.Get(ctx, code)
+	if errors.Is(err, ErrCodeExpired) {
+		mon.Counter("oidc_expired_code_exchange").Inc(1)
+		if !t.describeExpiredCodes {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.Combine(err, t.revokeReplayed(ctx, code))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = resourceRequestFrom(ctx).exchange(oauthCode.Resources)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +185,26 @@ func (t *TokenStore) GetByCode(ctx context.Context, code string) (_ oauth2.Token
 	return &record{code: oauthCode}, nil
 }
 
+// revokeReplayed revokes the tokens issued from the code when it was claimed already. The tokens aren't linked to the
+// code they were issued from, so every token the client was issued for the user since the code was created is revoked.
+func (t *TokenStore) revokeReplayed(ctx context.Context, code string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	claimed, err := t.codes.GetClaimed(ctx, code)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// the code is unknown or expired without being exchanged
+			return nil
+		}
+		return err
+	}
+
+	mon.Counter("oidc_code_replay").Inc(1)
+
+	_, err = t.tokens.RevokeIssuedSince(ctx, claimed.UserID, claimed.ClientID, claimed.CreatedAt)
+	return err
+}
+
 // GetByAccess uses access token to find token information.
 func (t *TokenStore) GetByAccess(ctx context.Context, access string) (_ oauth2.TokenInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -168,6 +222,15 @@ func (t *TokenStore) GetByRefresh(ctx context.Context, refresh string) (_ oauth2
 	defer mon.Task()(&ctx)(&err)
 
 	oauthToken, err := t.tokens.Get(ctx, KindRefreshToken, refresh)
+	if errors.Is(err, sql.ErrNoRows) {
+		// a rotated refresh token being used after the grace period indicates that it leaked, so everything rotated
+		// from it is revoked as well
+		return nil, errs.Combine(err, t.tokens.RevokeRefreshFamily(ctx, refresh))
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = resourceRequestFrom(ctx).exchange(oauthToken.Resources)
 	if err != nil {
 		return nil, err
 	}
@@ -228,9 +291,9 @@ func (r *record) SetUserID(s string) {
 		return
 	}
 
-	r.code.ClientID = userID
-	r.access.ClientID = userID
-	r.refresh.ClientID = userID
+	r.code.UserID = userID
+	r.access.UserID = userID
+	r.refresh.UserID = userID
 }
 
 func (r *record) GetScope() string {
@@ -0,0 +1,184 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-oauth2/oauth2/v4"
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+
+	"storj.io/common/uuid"
+)
+
+// PKCEPolicy is whether a client has to use PKCE (RFC 7636) for the authorization codes it's issued.
+type PKCEPolicy string
+
+const (
+	// PKCERequired rejects authorize requests without a code challenge, and code exchanges without a verifier.
+	PKCERequired PKCEPolicy = "required"
+	// PKCEOptional verifies the code challenge when the authorize request included one.
+	PKCEOptional PKCEPolicy = "optional"
+	// PKCEOff ignores the code challenges and verifiers of clients that can't use PKCE. Public clients never have PKCE
+	// turned off.
+	PKCEOff PKCEPolicy = "off"
+)
+
+// PKCEPolicies maps oauth client ids onto their PKCE policy.
+type PKCEPolicies map[uuid.UUID]PKCEPolicy
+
+// Type implements pflag.Value.
+func (PKCEPolicies) Type() string { return "oidc.PKCEPolicies" }
+
+// String is required for pflag.Value.
+func (policies *PKCEPolicies) String() string {
+	data, err := json.Marshal(*policies)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (policies *PKCEPolicies) Set(s string) (err error) {
+	parsed := make(PKCEPolicies)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	for clientID, policy := range parsed {
+		switch policy {
+		case PKCERequired, PKCEOptional, PKCEOff:
+		default:
+			return Error.New("client %s: unknown pkce policy %q", clientID, policy)
+		}
+	}
+
+	*policies = parsed
+	return nil
+}
+
+// pkceCheck applies the PKCE policy of clients to their authorize and token requests. Clients without a policy of
+// their own require PKCE when they're public, and use it optionally when they're confidential.
+type pkceCheck struct {
+	clients  oauth2.ClientStore
+	policies PKCEPolicies
+	// allowOff lets confidential clients have PKCE turned off, otherwise they fall back to optional PKCE.
+	allowOff bool
+	// publicRequired requires PKCE of public clients configured optional as well.
+	publicRequired bool
+}
+
+// policy returns the policy the client's requests are held to. Clients are classified as public when they have no
+// secret, and are only looked up when their policy depends on it. Unknown and malformed clients pass as optional, since
+// the oauth2 server rejects them on its own.
+func (check pkceCheck) policy(ctx context.Context, clientID string) (PKCEPolicy, error) {
+	id, err := uuid.FromString(clientID)
+	if err != nil {
+		return PKCEOptional, nil
+	}
+
+	policy, registered := check.policies[id]
+	if registered && (policy == PKCERequired || (policy == PKCEOptional && !check.publicRequired)) {
+		return policy, nil
+	}
+
+	info, err := check.clients.GetByID(ctx, clientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return PKCEOptional, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case info.GetSecret() == "":
+		return PKCERequired, nil
+	case registered && policy == PKCEOff && check.allowOff:
+		return PKCEOff, nil
+	default:
+		return PKCEOptional, nil
+	}
+}
+
+// strip removes the code challenge from the parsed authorize request of clients with PKCE turned off, so that the code
+// they're issued can be exchanged without a verifier. Requests of clients that can't be looked up are left as they are.
+func (check pkceCheck) strip(r *http.Request) {
+	clientID := r.Form.Get("client_id")
+	if id, err := uuid.FromString(clientID); err != nil || check.policies[id] != PKCEOff {
+		return
+	}
+
+	policy, err := check.policy(r.Context(), clientID)
+	if err != nil || policy != PKCEOff {
+		return
+	}
+
+	r.Form.Del("code_challenge")
+	r.Form.Del("code_challenge_method")
+}
+
+// authorize rejects authorize requests of clients requiring PKCE that ask for a code without a code challenge.
+func (check pkceCheck) authorize(r *http.Request) error {
+	if !containsResponseType(responseTypeParts(requestedResponseType(r)), oauth2.Code) {
+		return nil
+	}
+
+	policy, err := check.policy(r.Context(), r.FormValue("client_id"))
+	if err != nil {
+		return err
+	}
+
+	if policy == PKCERequired && r.FormValue("code_challenge") == "" {
+		mon.Counter("oidc_pkce_challenge_missing").Inc(1)
+		return oauth2errors.ErrCodeChallengeRquired
+	}
+	return nil
+}
+
+// responseTypeParts splits a response type into the response types it combines.
+func responseTypeParts(responseType oauth2.ResponseType) []oauth2.ResponseType {
+	var parts []oauth2.ResponseType
+	for _, part := range strings.Fields(responseType.String()) {
+		parts = append(parts, oauth2.ResponseType(part))
+	}
+	return parts
+}
+
+// pkceManager applies the PKCE policy of clients to their code exchanges.
+type pkceManager struct {
+	oauth2.Manager
+
+	pkce pkceCheck
+}
+
+// GenerateAccessToken rejects code exchanges without a verifier of clients requiring PKCE, and drops the verifier of
+// clients with PKCE turned off.
+func (manager *pkceManager) GenerateAccessToken(ctx context.Context, gt oauth2.GrantType, tgr *oauth2.TokenGenerateRequest) (oauth2.TokenInfo, error) {
+	if gt == oauth2.AuthorizationCode {
+		policy, err := manager.pkce.policy(ctx, tgr.ClientID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case policy == PKCERequired && tgr.CodeVerifier == "":
+			mon.Counter("oidc_pkce_verifier_missing").Inc(1)
+			return nil, oauth2errors.ErrInvalidRequest
+		case policy == PKCEOff:
+			tgr.CodeVerifier = ""
+		}
+	}
+	return manager.Manager.GenerateAccessToken(ctx, gt, tgr)
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// projectMetaScope requests that user info includes the project metadata claims of the granted project.
+const projectMetaScope = "storj:project_meta"
+
+// customClaimPrefix namespaces the claims the provider adds on its own, so that they can't collide with standard claims
+// or with the claims of a template.
+const customClaimPrefix = "storj_"
+
+// projectMetaClaims are the claims drawn from the granted project when the project metadata scope is granted. Claims
+// without a value are left out.
+var projectMetaClaims = map[string]func(project *console.Project) interface{}{
+	customClaimPrefix + "project_id": func(project *console.Project) interface{} { return project.ID.String() },
+	customClaimPrefix + "partner": func(project *console.Project) interface{} {
+		if len(project.UserAgent) == 0 {
+			return nil
+		}
+		return string(project.UserAgent)
+	},
+}
+
+// projectMeta renders the project metadata claims of the granted project. Grants without a project have no metadata
+// to include.
+func projectMeta(ctx context.Context, service *console.Service, user *console.User, projectID string) (map[string]interface{}, error) {
+	if projectID == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.FromString(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := service.GetProject(console.WithUser(ctx, user), id)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make(map[string]interface{}, len(projectMetaClaims))
+	for name, claim := range projectMetaClaims {
+		if value := claim(project); value != nil {
+			claims[name] = value
+		}
+	}
+	return claims, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+)
+
+// errInvalidTarget rejects resource indicators that aren't allowed, or that weren't granted by the authorization being
+// exchanged (RFC 8707).
+var errInvalidTarget = errors.New("invalid_target")
+
+// invalidTargetResponse describes errInvalidTarget to clients.
+var invalidTargetResponse = oauth2errors.Response{
+	Error:       errInvalidTarget,
+	Description: "The requested resource is invalid, unknown, or not granted",
+	StatusCode:  http.StatusBadRequest,
+}
+
+// resourceRequest carries the resource indicators of an authorize or token request through the oauth2 server, which
+// doesn't know about them, to the token store and the access token generator.
+type resourceRequest struct {
+	// requested are the resources the request indicated.
+	requested []string
+	// granted are the resources of the authorization code or refresh token the request exchanges, if it exchanges one.
+	granted   []string
+	exchanges bool
+}
+
+type resourceRequestKey struct{}
+
+// withResourceRequest returns a context carrying the resources requested by the request.
+func withResourceRequest(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, resourceRequestKey{}, &resourceRequest{
+		requested: dedupeResources(r.Form["resource"]),
+	})
+}
+
+// resourceRequestFrom returns the resource request carried by the context, or nil when there is none.
+func resourceRequestFrom(ctx context.Context) *resourceRequest {
+	req, _ := ctx.Value(resourceRequestKey{}).(*resourceRequest)
+	return req
+}
+
+// exchange records the resources granted by the code or token being exchanged. The request may only narrow them.
+func (req *resourceRequest) exchange(granted []string) error {
+	if req == nil {
+		return nil
+	}
+
+	req.granted = granted
+	req.exchanges = true
+
+	grantedSet := make(map[string]bool, len(granted))
+	for _, resource := range granted {
+		grantedSet[resource] = true
+	}
+	for _, resource := range req.requested {
+		if !grantedSet[resource] {
+			return errInvalidTarget
+		}
+	}
+	return nil
+}
+
+// grant returns the resources the authorization covers. Refresh tokens keep covering all of them, so that they can be
+// exchanged for access tokens of any one of them later on.
+func (req *resourceRequest) grant() []string {
+	if req == nil {
+		return nil
+	}
+	if req.exchanges {
+		return req.granted
+	}
+	return req.requested
+}
+
+// audience returns the resources access tokens are issued for, which are all of the granted ones unless the request
+// picked some of them.
+func (req *resourceRequest) audience() []string {
+	if req == nil {
+		return nil
+	}
+	if len(req.requested) > 0 {
+		return req.requested
+	}
+	return req.grant()
+}
+
+// allowedResources lists the resources clients may request tokens for with resource indicators.
+type allowedResources map[string]bool
+
+// newAllowedResources returns the allowlist of the configured resources, skipping and reporting invalid ones.
+func newAllowedResources(resources []string) (allowed allowedResources, invalid []string) {
+	allowed = make(allowedResources, len(resources))
+	for _, resource := range resources {
+		if !isResourceIndicator(resource) {
+			invalid = append(invalid, resource)
+			continue
+		}
+		allowed[resource] = true
+	}
+	return allowed, invalid
+}
+
+// check rejects authorize requests indicating resources that aren't allowed.
+func (allowed allowedResources) check(r *http.Request) error {
+	for _, resource := range r.Form["resource"] {
+		if !allowed[resource] {
+			return errInvalidTarget
+		}
+	}
+	return nil
+}
+
+// isResourceIndicator reports whether the resource is an absolute uri without a fragment, as RFC 8707 requires.
+func isResourceIndicator(resource string) bool {
+	u, err := url.Parse(resource)
+	return err == nil && u.IsAbs() && !strings.ContainsAny(resource, "# \t\n")
+}
+
+func dedupeResources(resources []string) []string {
+	seen := make(map[string]bool, len(resources))
+	deduped := resources[:0:0]
+	for _, resource := range resources {
+		if !seen[resource] {
+			seen[resource] = true
+			deduped = append(deduped, resource)
+		}
+	}
+	return deduped
+}
+
+// joinResources encodes resources to be stored, as the space separated list scopes are stored as too.
+func joinResources(resources []string) *string {
+	if len(resources) == 0 {
+		return nil
+	}
+	joined := strings.Join(resources, " ")
+	return &joined
+}
+
+// splitResources decodes resources stored by joinResources.
+func splitResources(joined *string) []string {
+	if joined == nil {
+		return nil
+	}
+	return strings.Fields(*joined)
+}
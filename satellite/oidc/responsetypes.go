@@ -0,0 +1,170 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-oauth2/oauth2/v4"
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+
+	"storj.io/common/uuid"
+)
+
+// supportedResponseTypes are the response types the provider supports. Clients that didn't register response types
+// may only use the code response type.
+var supportedResponseTypes = []oauth2.ResponseType{oauth2.Code, oauth2.Token}
+
+// defaultResponseTypes are the response types of clients that didn't register any, which never receive tokens in the
+// front channel.
+var defaultResponseTypes = []oauth2.ResponseType{oauth2.Code}
+
+// ClientResponseTypes maps oauth client ids onto the response types they are permitted to use.
+type ClientResponseTypes map[uuid.UUID][]oauth2.ResponseType
+
+// Type implements pflag.Value.
+func (ClientResponseTypes) Type() string { return "oidc.ClientResponseTypes" }
+
+// String is required for pflag.Value.
+func (crt *ClientResponseTypes) String() string {
+	data, err := json.Marshal(*crt)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (crt *ClientResponseTypes) Set(s string) (err error) {
+	parsed := make(ClientResponseTypes)
+
+	if strings.TrimSpace(s) != "" {
+		err = json.Unmarshal([]byte(s), &parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	for clientID, responseTypes := range parsed {
+		if len(responseTypes) == 0 {
+			return Error.New("client %s: no response types", clientID)
+		}
+		for _, responseType := range responseTypes {
+			if !isSupportedResponseType(responseType) {
+				return Error.New("client %s: unsupported response type %q", clientID, responseType)
+			}
+		}
+	}
+
+	*crt = parsed
+	return nil
+}
+
+// permits reports whether the client may use every part of the response type.
+func (crt ClientResponseTypes) permits(clientID string, responseType oauth2.ResponseType) bool {
+	responseTypes := defaultResponseTypes
+	if id, err := uuid.FromString(clientID); err == nil {
+		if registered, ok := crt[id]; ok {
+			responseTypes = registered
+		}
+	}
+
+	for _, part := range strings.Fields(responseType.String()) {
+		if !containsResponseType(responseTypes, oauth2.ResponseType(part)) {
+			return false
+		}
+	}
+	return true
+}
+
+// advertised returns the supported response types that any client may use.
+func (crt ClientResponseTypes) advertised() []oauth2.ResponseType {
+	var advertised []oauth2.ResponseType
+	for _, supported := range supportedResponseTypes {
+		used := containsResponseType(defaultResponseTypes, supported)
+		for _, responseTypes := range crt {
+			used = used || containsResponseType(responseTypes, supported)
+		}
+		if used {
+			advertised = append(advertised, supported)
+		}
+	}
+	return advertised
+}
+
+// responseTypeCheck rejects authorize requests using response types the client didn't register for, or that return
+// tokens in the front channel without the required scopes.
+type responseTypeCheck struct {
+	clients        ClientResponseTypes
+	requiredScopes []string
+}
+
+// check rejects the authorize request with unauthorized_client when the client may not use the response type, and
+// with unsupported_response_type when it may, but the provider doesn't implement the combination.
+func (c responseTypeCheck) check(r *http.Request) error {
+	responseType := requestedResponseType(r)
+	if !c.clients.permits(r.FormValue("client_id"), responseType) {
+		return oauth2errors.ErrUnauthorizedClient
+	}
+	if !isSupportedResponseType(responseType) {
+		return oauth2errors.ErrUnsupportedResponseType
+	}
+
+	// the token response type is the only supported one returning tokens in the front channel
+	if responseType == oauth2.Token && !isSubScope(strings.Join(c.requiredScopes, " "), r.FormValue("scope")) {
+		return oauth2errors.ErrInvalidScope
+	}
+	return nil
+}
+
+// responseTypeKey is the context key of the response type hybrid authorize requests were made with.
+type responseTypeKey struct{}
+
+// withHybridResponseType lets hybrid authorize requests, which the oauth2 server doesn't implement, pass its validation
+// as token requests, whose errors are returned in the fragment like those of hybrid requests. The requested response
+// type is kept for the response type check, which always rejects them.
+func withHybridResponseType(r *http.Request) *http.Request {
+	responseType := r.Form.Get("response_type")
+	if parts := strings.Fields(responseType); len(parts) < 2 && responseType != "id_token" {
+		return r
+	}
+
+	r = r.WithContext(context.WithValue(r.Context(), responseTypeKey{}, oauth2.ResponseType(responseType)))
+	r.Form.Set("response_type", oauth2.Token.String())
+	return r
+}
+
+// requestedResponseType returns the response type the authorize request was made with.
+func requestedResponseType(r *http.Request) oauth2.ResponseType {
+	if responseType, ok := r.Context().Value(responseTypeKey{}).(oauth2.ResponseType); ok {
+		return responseType
+	}
+	return oauth2.ResponseType(r.FormValue("response_type"))
+}
+
+func isSupportedResponseType(responseType oauth2.ResponseType) bool {
+	return containsResponseType(supportedResponseTypes, responseType)
+}
+
+func containsResponseType(responseTypes []oauth2.ResponseType, responseType oauth2.ResponseType) bool {
+	for _, candidate := range responseTypes {
+		if candidate == responseType {
+			return true
+		}
+	}
+	return false
+}
+
+// responseTypeNames returns the names of the response types.
+func responseTypeNames(responseTypes []oauth2.ResponseType) []string {
+	names := make([]string, 0, len(responseTypes))
+	for _, responseType := range responseTypes {
+		names = append(names, responseType.String())
+	}
+	return names
+}
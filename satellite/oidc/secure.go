@@ -0,0 +1,67 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"net/http"
+	"strings"
+)
+
+// SecureFlow wraps handlers of the authorize flow. When TLS is required, plaintext requests are redirected to https.
+// Any cookies the wrapped handler sets are marked Secure and HttpOnly and get the configured SameSite mode.
+func (e *Endpoint) SecureFlow(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e.requireTLS && !isTLS(r) {
+			http.Redirect(w, r, "https://"+r.Host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+			return
+		}
+
+		next.ServeHTTP(&secureCookieWriter{ResponseWriter: w, sameSite: e.sameSite}, r)
+	})
+}
+
+// isTLS reports whether the request reached us, or the proxy in front of us, over TLS.
+func isTLS(r *http.Request) bool {
+	return r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https")
+}
+
+// secureCookieWriter rewrites the cookies set on the response before its headers are written.
+type secureCookieWriter struct {
+	http.ResponseWriter
+	sameSite http.SameSite
+	wrote    bool
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *secureCookieWriter) WriteHeader(statusCode int) {
+	w.secureCookies()
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write implements http.ResponseWriter.
+func (w *secureCookieWriter) Write(data []byte) (int, error) {
+	w.secureCookies()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *secureCookieWriter) secureCookies() {
+	if w.wrote {
+		return
+	}
+	w.wrote = true
+
+	header := w.Header()
+	cookies := (&http.Response{Header: header}).Cookies()
+	if len(cookies) == 0 {
+		return
+	}
+
+	header.Del("Set-Cookie")
+	for _, cookie := range cookies {
+		cookie.Secure = true
+		cookie.HttpOnly = true
+		cookie.SameSite = w.sameSite
+		http.SetCookie(w.ResponseWriter, cookie)
+	}
+}
@@ -3,6 +3,15 @@
 
 package oidc
 
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
 // NewService constructs a service for handling various OAuth and OIDC operations.
 func NewService(db DB) *Service {
 	return &Service{
@@ -31,3 +40,84 @@ func (s *Service) TokenStore() *TokenStore {
 		tokens: s.store.OAuthTokens(),
 	}
 }
+
+// clientSecretSize is the number of random bytes rotated client secrets are generated from.
+const clientSecretSize = 32
+
+// RotateClientSecret generates a new secret for the client. The current secret is still accepted for overlap, so that
+// the client can switch to the new secret without failing requests in the meantime.
+func (s *Service) RotateClientSecret(ctx context.Context, clientID uuid.UUID, overlap time.Duration) (secret []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	random := make([]byte, clientSecretSize)
+	_, err = rand.Read(random)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	secret = []byte(base64.RawURLEncoding.EncodeToString(random))
+
+	err = s.store.OAuthClients().RotateSecret(ctx, clientID, secret, time.Now().Add(overlap))
+	if err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// FinalizeClientSecretRotation stops accepting the previous secret of the client before its overlap ends, once the
+// client switched to the new secret.
+func (s *Service) FinalizeClientSecretRotation(ctx context.Context, clientID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.OAuthClients().DropPreviousSecret(ctx, clientID)
+}
+
+// DeleteClient deletes the client along with every token issued to it, returning how many tokens were revoked. Tokens
+// are deleted first, so that a failure can't leave tokens behind for a client that no longer exists.
+func (s *Service) DeleteClient(ctx context.Context, clientID uuid.UUID) (revoked int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	revoked, err = s.store.OAuthTokens().DeleteAllForClient(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.store.OAuthClients().Delete(ctx, clientID)
+	if err != nil {
+		return revoked, err
+	}
+	return revoked, nil
+}
+
+// RevokeUserTokens revokes every unexpired access and refresh token of the user, e.g. once the account was deleted.
+func (s *Service) RevokeUserTokens(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.store.OAuthTokens().RevokeAllForUser(ctx, userID)
+	return err
+}
+
+// DenyClient denies the client, so that its authorize and token requests are rejected as unauthorized_client until
+// it's allowed again, without deleting it. When revokeTokens is set, every token issued to the client is revoked as
+// well, returning how many were, so that tokens it was already issued can't be used any longer either. Otherwise they
+// stay valid, even though the client can't refresh them.
+func (s *Service) DenyClient(ctx context.Context, clientID uuid.UUID, revokeTokens bool) (revoked int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deniedAt := time.Now()
+	err = s.store.OAuthClients().SetDenied(ctx, clientID, &deniedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	if !revokeTokens {
+		return 0, nil
+	}
+	return s.store.OAuthTokens().DeleteAllForClient(ctx, clientID)
+}
+
+// AllowClient allows the denied client again. Tokens revoked when it was denied aren't restored.
+func (s *Service) AllowClient(ctx context.Context, clientID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.OAuthClients().SetDenied(ctx, clientID, nil)
+}
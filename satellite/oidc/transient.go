@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+	"go.uber.org/zap"
+
+	"storj.io/private/dbutil/cockroachutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/satellite/console"
+)
+
+// transientRetryAfter is how long clients are asked to wait before retrying a request that failed for a transient
+// reason.
+const transientRetryAfter = 5 * time.Second
+
+// isTransient reports whether the error is caused by the token store or console being temporarily unavailable, or by the
+// provider remembering too many tokens, so that retrying the same request later may succeed.
+func isTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, errReplaysFull) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, driver.ErrBadConn) || cockroachutil.NeedsRetry(err) {
+		return true
+	}
+
+	// connection exceptions, insufficient resources and operator intervention
+	code := pgerrcode.FromError(err)
+	return strings.HasPrefix(code, "08") || strings.HasPrefix(code, "53") || strings.HasPrefix(code, "57P")
+}
+
+// internalError classifies the errors of the token store and console that the oauth library doesn't know about.
+// Transient failures ask the client to retry later, while expired codes, missing tokens or revoked access are reported
+// as an invalid grant, as are code exchanges failing PKCE. Authorize requests with too many scopes are reported as an
+// invalid scope. Everything else remains an internal server error.
+func internalError(err error) *oauth2errors.Response {
+	var locked *clientLockedError
+	var tooManyScopes *tooManyScopesError
+
+	switch {
+	case isTransient(err):
+		return transientResponse()
+	case errors.Is(err, ErrCodeExpired):
+		response := expiredCodeResponse
+		return &response
+	case errors.Is(err, sql.ErrNoRows), console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err),
+		errors.Is(err, oauth2errors.ErrMissingCodeVerifier), errors.Is(err, oauth2errors.ErrInvalidCodeChallenge):
+		return &oauth2errors.Response{
+			Error:       oauth2errors.ErrInvalidGrant,
+			Description: oauth2errors.Descriptions[oauth2errors.ErrInvalidGrant],
+			StatusCode:  oauth2errors.StatusCodes[oauth2errors.ErrInvalidGrant],
+		}
+	case errors.Is(err, errInvalidTarget):
+		response := invalidTargetResponse
+		return &response
+	case errors.Is(err, errConsentRequired):
+		response := consentRequiredResponse
+		return &response
+	case errors.As(err, &locked):
+		return locked.response()
+	case errors.As(err, &tooManyScopes):
+		return tooManyScopes.response()
+	}
+	return nil
+}
+
+// expiredCodeResponse tells clients exchanging an expired authorization code apart from clients exchanging an unknown
+// one.
+var expiredCodeResponse = oauth2errors.Response{
+	Error:       oauth2errors.ErrInvalidGrant,
+	Description: "authorization code expired",
+	StatusCode:  oauth2errors.StatusCodes[oauth2errors.ErrInvalidGrant],
+}
+
+func transientResponse() *oauth2errors.Response {
+	header := http.Header{}
+	header.Set("Retry-After", strconv.Itoa(int(transientRetryAfter/time.Second)))
+
+	return &oauth2errors.Response{
+		Error:       oauth2errors.ErrTemporarilyUnavailable,
+		Description: oauth2errors.Descriptions[oauth2errors.ErrTemporarilyUnavailable],
+		StatusCode:  oauth2errors.StatusCodes[oauth2errors.ErrTemporarilyUnavailable],
+		Header:      header,
+	}
+}
+
+// backendFailure responds to a user info request whose token or user couldn't be looked up for another reason than
+// not existing. It's never a 401, which has relying parties end the session of a user whose access is fine.
+func (e *Endpoint) backendFailure(w http.ResponseWriter, msg string, err error) {
+	e.log.Error(msg, zap.Error(err))
+
+	if e.retryBackendFailures {
+		w.Header().Set("Retry-After", strconv.Itoa(int(transientRetryAfter/time.Second)))
+		http.Error(w, "", http.StatusServiceUnavailable)
+		return
+	}
+	http.Error(w, "", http.StatusInternalServerError)
+}
+
+// unavailable responds with 503 and asks the client to retry later when the error is transient. It reports whether a
+// response was written.
+func unavailable(w http.ResponseWriter, err error) bool {
+	if !isTransient(err) {
+		return false
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(transientRetryAfter/time.Second)))
+	http.Error(w, "", http.StatusServiceUnavailable)
+	return true
+}
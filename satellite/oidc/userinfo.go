@@ -0,0 +1,65 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"storj.io/common/uuid"
+)
+
+// userInfoSigningAlg is the algorithm signed user info responses use. The client secret is the signing key, which
+// lets clients verify the response without the provider publishing keys of its own.
+const userInfoSigningAlg = "HS256"
+
+// wantsSignedUserInfo reports whether the client registered for, or the request accepts, a signed user info response.
+func (e *Endpoint) wantsSignedUserInfo(r *http.Request, clientID uuid.UUID) bool {
+	if e.signedUserInfo[clientID] {
+		return true
+	}
+
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == "application/jwt" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signUserInfo renders the user info as a jwt signed with the client's secret, which expires after the id token expiry.
+func (e *Endpoint) signUserInfo(ctx context.Context, clientID uuid.UUID, userInfo UserInfo) (string, error) {
+	client, err := e.clientStore.GetByID(ctx, clientID.String())
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(userInfo)
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{}
+	err = json.Unmarshal(data, &claims)
+	if err != nil {
+		return "", err
+	}
+
+	claims["iss"] = e.config.Issuer
+	claims["aud"] = clientID.String()
+
+	now := time.Now()
+	e.setIssued(claims, now)
+	claims["exp"] = now.Add(e.idTokenExpiry).Unix()
+
+	return signJWT(claims, "", client)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// ClockSkew is how far the clock of a node was off from the clock of the satellite, as measured from a timestamp the
+// node signed.
+type ClockSkew struct {
+	NodeID storj.NodeID
+	// Skew is positive when the clock of the node is ahead, and negative when it's behind.
+	Skew       time.Duration
+	MeasuredAt time.Time
+}
+
+// clockSkews remembers the clock skew last measured for every node.
+//
+// The skews are in-process, every satellite process only knows about the skews it measured itself since it started.
+type clockSkews struct {
+	mu    sync.Mutex
+	nodes map[storj.NodeID]ClockSkew
+}
+
+func newClockSkews() *clockSkews {
+	return &clockSkews{nodes: map[storj.NodeID]ClockSkew{}}
+}
+
+// SignedTimestamp is a timestamp a node signed at some point after Earliest, in satellite time.
+type SignedTimestamp struct {
+	NodeID   storj.NodeID
+	SignedAt time.Time
+	Earliest time.Time
+}
+
+// record measures the skew of timestamps the nodes signed at some point between their earliest time and latest, in
+// satellite time. Timestamps within that window can't be told apart from an accurate clock, and count as no skew, so
+// the measured skew is the least the clock of the node is off by.
+func (skews *clockSkews) record(timestamps []SignedTimestamp, latest time.Time) {
+	if len(timestamps) == 0 {
+		return
+	}
+
+	skews.mu.Lock()
+	defer skews.mu.Unlock()
+
+	for _, timestamp := range timestamps {
+		var skew time.Duration
+		switch {
+		case timestamp.SignedAt.Before(timestamp.Earliest):
+			skew = timestamp.SignedAt.Sub(timestamp.Earliest)
+		case timestamp.SignedAt.After(latest):
+			skew = timestamp.SignedAt.Sub(latest)
+		}
+
+		skews.nodes[timestamp.NodeID] = ClockSkew{NodeID: timestamp.NodeID, Skew: skew, MeasuredAt: latest}
+	}
+}
+
+// atLeast returns the skews off by at least the threshold in either direction, largest first.
+func (skews *clockSkews) atLeast(threshold time.Duration) []ClockSkew {
+	skews.mu.Lock()
+	defer skews.mu.Unlock()
+
+	var skewed []ClockSkew
+	for _, skew := range skews.nodes {
+		if absDuration(skew.Skew) >= threshold {
+			skewed = append(skewed, skew)
+		}
+	}
+
+	sort.Slice(skewed, func(i, k int) bool {
+		if a, b := absDuration(skewed[i].Skew), absDuration(skewed[k].Skew); a != b {
+			return a > b
+		}
+		return skewed[i].NodeID.Less(skewed[k].NodeID)
+	})
+	return skewed
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+)
+
+// CountryCapacity is the capacity of the qualified online nodes located in a country.
+type CountryCapacity struct {
+	// CountryCode is location.None for the nodes whose country is unknown.
+	CountryCode location.CountryCode
+	Nodes       int64
+	VettedNodes int64
+	FreeDisk    int64
+	// UploadExcluded is set when uploads exclude the country, and its capacity is only used for repairs.
+	UploadExcluded bool
+}
+
+// CapacityByCountry sums the free disk of the qualified online nodes per country. Nodes qualify when they're online,
+// neither disqualified, suspended nor exiting, and meet the minimum version and free disk of uploads. Unvetted nodes
+// qualify as well. The countries are keyed by country code, with the nodes whose country is unknown under
+// location.None.
+func (service *Service) CapacityByCountry(ctx context.Context) (_ map[location.CountryCode]*CountryCapacity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	countries := make(map[location.CountryCode]*CountryCapacity)
+	err = service.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *NodeDossier) error {
+		reason, err := service.nodeEligibility(node, storj.EveryCountry)
+		if err != nil {
+			return err
+		}
+		if reason != NodeSelectable && reason != NodeNotSelectedUnvetted && reason != NodeNotSelectedPlacement {
+			return nil
+		}
+
+		country, ok := countries[node.CountryCode]
+		if !ok {
+			country = &CountryCapacity{
+				CountryCode:    node.CountryCode,
+				UploadExcluded: isExcludedCountry(node.CountryCode, service.config.Node.UploadExcludedCountryCodes),
+			}
+			countries[node.CountryCode] = country
+		}
+
+		country.Nodes++
+		if node.Reputation.Status.VettedAt != nil {
+			country.VettedNodes++
+		}
+		country.FreeDisk += node.Capacity.FreeDisk
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return countries, nil
+}
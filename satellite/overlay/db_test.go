@@ -5,6 +5,8 @@ package overlay_test
 
 import (
 	"fmt"
+	"net"
+	"sort"
 	"testing"
 	"time"
 
@@ -238,3 +240,72 @@ func TestDBDisqualifyNode(t *testing.T) {
 		}
 	})
 }
+
+func TestDBNodesByIP(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		overlayDB := db.OverlayCache()
+		now := time.Now()
+
+		checkIn := func(lastIPPort string) storj.NodeID {
+			nodeID := testrand.NodeID()
+			require.NoError(t, overlayDB.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:     nodeID,
+				Address:    &pb.NodeAddress{Address: lastIPPort},
+				LastIPPort: lastIPPort,
+				IsUp:       true,
+				Version:    &pb.NodeVersion{Version: "v0.0.0", Timestamp: now},
+			}, now, overlay.NodeSelectionConfig{}))
+			return nodeID
+		}
+
+		shared := storj.NodeIDList{checkIn("10.0.0.1:28967"), checkIn("10.0.0.1:28968")}
+		sort.Sort(shared)
+		other := checkIn("10.1.0.1:28967")
+		ipv6 := checkIn("[2001:db8::1]:28967")
+		// nodes whose last ip and port isn't an ip are never matched
+		checkIn("example.test:28967")
+		checkIn("")
+
+		nodeIDs := func(nodes []*overlay.NodeDossier) (ids storj.NodeIDList) {
+			for _, node := range nodes {
+				ids = append(ids, node.Id)
+			}
+			return ids
+		}
+
+		nodes, more, err := overlayDB.GetNodesByIP(ctx, &net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(32, 32)}, storj.NodeID{}, 10)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Equal(t, shared, nodeIDs(nodes))
+
+		nodes, more, err = overlayDB.GetNodesByIP(ctx, &net.IPNet{IP: net.IPv4(10, 0, 0, 0), Mask: net.CIDRMask(8, 32)}, shared[0], 1)
+		require.NoError(t, err)
+		require.True(t, more)
+		require.Equal(t, shared[1:], nodeIDs(nodes))
+
+		_, subnet, err := net.ParseCIDR("2001:db8::/32")
+		require.NoError(t, err)
+		nodes, _, err = overlayDB.GetNodesByIP(ctx, subnet, storj.NodeID{}, 10)
+		require.NoError(t, err)
+		require.Equal(t, storj.NodeIDList{ipv6}, nodeIDs(nodes))
+
+		ips, more, err := overlayDB.GetIPsWithManyNodes(ctx, 1, nil, 10)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Len(t, ips, 1)
+		require.Equal(t, "10.0.0.1", ips[0].IP)
+		require.Equal(t, shared, nodeIDs(ips[0].Nodes))
+
+		ips, more, err = overlayDB.GetIPsWithManyNodes(ctx, 0, nil, 2)
+		require.NoError(t, err)
+		require.True(t, more)
+		require.Equal(t, []string{"10.0.0.1", "10.1.0.1"}, []string{ips[0].IP, ips[1].IP})
+		require.Equal(t, storj.NodeIDList{other}, nodeIDs(ips[1].Nodes))
+
+		ips, more, err = overlayDB.GetIPsWithManyNodes(ctx, 0, net.ParseIP(ips[1].IP), 2)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Len(t, ips, 1)
+		require.Equal(t, "2001:db8::1", ips[0].IP)
+	})
+}
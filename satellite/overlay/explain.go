@@ -0,0 +1,179 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/private/version"
+)
+
+// NodeSelectionReason explains why a node would not be selected for uploads.
+type NodeSelectionReason int
+
+const (
+	// NodeSelectable denotes that no selection criteria exclude the node.
+	NodeSelectable NodeSelectionReason = iota
+	// NodeNotSelectedOffline denotes that the node hasn't been contacted within the online window.
+	NodeNotSelectedOffline
+	// NodeNotSelectedDisqualified denotes that the node is disqualified.
+	NodeNotSelectedDisqualified
+	// NodeNotSelectedSuspended denotes that the node is suspended for unknown audits or being offline.
+	NodeNotSelectedSuspended
+	// NodeNotSelectedExiting denotes that the node has initiated or finished graceful exit.
+	NodeNotSelectedExiting
+	// NodeNotSelectedInsufficientFreeSpace denotes that the node has less free disk than the configured minimum.
+	NodeNotSelectedInsufficientFreeSpace
+	// NodeNotSelectedOutdatedVersion denotes that the node is below the minimum version or is not a release build.
+	NodeNotSelectedOutdatedVersion
+	// NodeNotSelectedPlacement denotes that the node's country is excluded by the placement or the upload configuration.
+	NodeNotSelectedPlacement
+	// NodeNotSelectedUnvetted denotes that the node is only eligible for the new node fraction of an upload.
+	NodeNotSelectedUnvetted
+	// NodeNotSelectedSubnetConflict denotes that the node shares its subnet with other eligible nodes while distinct
+	// ips are required, so at most one of them is selected per upload.
+	NodeNotSelectedSubnetConflict
+)
+
+// ExplainNodeSelection evaluates the upload selection criteria against a single node and returns the first one that
+// applies. Being unvetted or sharing a subnet doesn't exclude a node outright, so those are only reported when nothing
+// else applies.
+func (service *Service) ExplainNodeSelection(ctx context.Context, nodeID storj.NodeID, placement storj.PlacementConstraint) (_ *NodeDossier, _ NodeSelectionReason, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.Get(ctx, nodeID)
+	if err != nil {
+		return nil, NodeSelectable, err
+	}
+
+	reason, err := service.nodeEligibility(node, placement)
+	if err != nil || reason != NodeSelectable {
+		return node, reason, err
+	}
+
+	if service.config.Node.DistinctIP {
+		shared, err := service.sharesSubnet(ctx, node, placement)
+		if err != nil {
+			return node, NodeSelectable, Error.Wrap(err)
+		}
+		if shared {
+			return node, NodeNotSelectedSubnetConflict, nil
+		}
+	}
+
+	return node, NodeSelectable, nil
+}
+
+// nodeEligibility evaluates the selection criteria that depend only on the node itself.
+func (service *Service) nodeEligibility(node *NodeDossier, placement storj.PlacementConstraint) (NodeSelectionReason, error) {
+	criteria := service.config.Node
+
+	switch {
+	case node.Disqualified != nil:
+		return NodeNotSelectedDisqualified, nil
+	case node.ExitStatus.ExitInitiatedAt != nil:
+		return NodeNotSelectedExiting, nil
+	case node.UnknownAuditSuspended != nil || node.OfflineSuspended != nil:
+		return NodeNotSelectedSuspended, nil
+	case !service.IsOnline(node):
+		return NodeNotSelectedOffline, nil
+	case node.Capacity.FreeDisk < criteria.MinimumDiskSpace.Int64():
+		return NodeNotSelectedInsufficientFreeSpace, nil
+	}
+
+	if criteria.MinimumVersion != "" {
+		outdated, err := isOutdated(node, criteria.MinimumVersion)
+		if err != nil {
+			return NodeSelectable, Error.Wrap(err)
+		}
+		if outdated {
+			return NodeNotSelectedOutdatedVersion, nil
+		}
+	}
+
+	if !placement.AllowedCountry(node.CountryCode) || isExcludedCountry(node.CountryCode, criteria.UploadExcludedCountryCodes) {
+		return NodeNotSelectedPlacement, nil
+	}
+
+	if node.Reputation.Status.VettedAt == nil {
+		return NodeNotSelectedUnvetted, nil
+	}
+
+	return NodeSelectable, nil
+}
+
+// sharesSubnet checks whether any other node on the same subnet would be eligible for the upload.
+func (service *Service) sharesSubnet(ctx context.Context, node *NodeDossier, placement storj.PlacementConstraint) (shared bool, err error) {
+	err = service.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, other *NodeDossier) error {
+		if shared || other.Id == node.Id || other.LastNet != node.LastNet {
+			return nil
+		}
+
+		reason, err := service.nodeEligibility(other, placement)
+		if err != nil {
+			return err
+		}
+
+		shared = reason == NodeSelectable || reason == NodeNotSelectedUnvetted
+		return nil
+	})
+	return shared, err
+}
+
+// NodesBelowMinimumVersion returns the nodes excluded from uploads for being below the minimum version or not being
+// release builds. Disqualified nodes and nodes that finished graceful exit are left out, as they aren't selected
+// regardless of their version.
+func (service *Service) NodesBelowMinimumVersion(ctx context.Context) (nodes []*NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	minimumVersion := service.config.Node.MinimumVersion
+	if minimumVersion == "" {
+		return nil, nil
+	}
+
+	err = service.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *NodeDossier) error {
+		if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+			return nil
+		}
+
+		outdated, err := isOutdated(node, minimumVersion)
+		if err != nil {
+			return err
+		}
+		if outdated {
+			nodes = append(nodes, node)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return nodes, nil
+}
+
+func isOutdated(node *NodeDossier, minimumVersion string) (bool, error) {
+	minimum, err := version.NewSemVer(minimumVersion)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := version.NewSemVer(node.Version.GetVersion())
+	if err != nil {
+		return true, nil
+	}
+
+	return !node.Version.GetRelease() || current.Compare(minimum) < 0, nil
+}
+
+func isExcludedCountry(countryCode location.CountryCode, excluded []string) bool {
+	for _, code := range excluded {
+		if code != "" && location.ToCountryCode(code) == countryCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+)
+
+// PlacementDefinition describes the countries a placement constraint allows nodes from.
+type PlacementDefinition struct {
+	Placement    storj.PlacementConstraint
+	Name         string
+	AllCountries bool
+	Countries    []string // sorted, empty when all countries are allowed
+}
+
+// SelectionConfig describes the configuration node selection runs with. Lists are sorted and country codes are
+// normalized, so that the configuration of different satellites can be compared.
+type SelectionConfig struct {
+	Placements              []PlacementDefinition
+	UploadExcludedCountries []string
+	RepairExcludedCountries []string
+	DistinctIP              bool
+	MinimumVersion          string
+	NewNodeFraction         float64
+	MinimumDiskSpace        memory.Size
+	OnlineWindow            time.Duration
+}
+
+// placementNames are the names of the placement constraints known to the satellite, in order.
+var placementNames = []struct {
+	placement storj.PlacementConstraint
+	name      string
+}{
+	{storj.EveryCountry, "every country"},
+	{storj.EU, "EU"},
+	{storj.EEA, "EEA"},
+	{storj.US, "US"},
+	{storj.DE, "DE"},
+}
+
+// SelectionConfig returns the configuration node selection runs with.
+func (service *Service) SelectionConfig() SelectionConfig {
+	criteria := service.config.Node
+
+	config := SelectionConfig{
+		UploadExcludedCountries: normalizeCountryCodes(criteria.UploadExcludedCountryCodes),
+		RepairExcludedCountries: normalizeCountryCodes(service.config.RepairExcludedCountryCodes),
+		DistinctIP:              criteria.DistinctIP,
+		MinimumVersion:          criteria.MinimumVersion,
+		NewNodeFraction:         criteria.NewNodeFraction,
+		MinimumDiskSpace:        criteria.MinimumDiskSpace,
+		OnlineWindow:            criteria.OnlineWindow,
+	}
+
+	// placements only ever allow countries from the regions they're defined by
+	var candidates []location.CountryCode
+	candidates = append(candidates, location.EuCountries...)
+	candidates = append(candidates, location.EeaNonEuCountries...)
+	candidates = append(candidates, location.UnitedStates, location.Germany)
+
+	for _, known := range placementNames {
+		definition := PlacementDefinition{
+			Placement:    known.placement,
+			Name:         known.name,
+			AllCountries: known.placement == storj.EveryCountry,
+		}
+
+		if !definition.AllCountries {
+			seen := make(map[location.CountryCode]bool)
+			for _, country := range candidates {
+				if !seen[country] && known.placement.AllowedCountry(country) {
+					seen[country] = true
+					definition.Countries = append(definition.Countries, country.String())
+				}
+			}
+			sort.Strings(definition.Countries)
+		}
+
+		config.Placements = append(config.Placements, definition)
+	}
+
+	return config
+}
+
+// normalizeCountryCodes returns the distinct, non-empty country codes in upper case and sorted. Codes that aren't
+// valid country codes are kept as configured, so that misconfigurations stay visible.
+func normalizeCountryCodes(codes []string) []string {
+	seen := make(map[string]bool)
+	normalized := []string{}
+	for _, code := range codes {
+		if code == "" {
+			continue
+		}
+
+		country := location.ToCountryCode(code).String()
+		if country == "" {
+			country = strings.ToUpper(code)
+		}
+		if !seen[country] {
+			seen[country] = true
+			normalized = append(normalized, country)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
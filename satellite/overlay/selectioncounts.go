@@ -0,0 +1,126 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/storj"
+)
+
+// selectionCountsInterval is the granularity the selections are counted at, windows are rounded out to it.
+const selectionCountsInterval = time.Minute
+
+// PlacementSelections are the upload node selections made for a placement.
+type PlacementSelections struct {
+	Placement storj.PlacementConstraint
+	// Selections is the number of upload selections made for the placement.
+	Selections int64
+	// SelectedNodes is the number of nodes the selections returned in total.
+	SelectedNodes int64
+	// Shortfalls is the number of selections returning fewer nodes than requested.
+	Shortfalls int64
+}
+
+// selectionCounts counts the upload node selections per placement, keeping the counts of the last retention period
+// in intervals of selectionCountsInterval.
+//
+// The counts are in-process, every satellite process only knows about the selections it made itself since it started.
+type selectionCounts struct {
+	retention time.Duration
+
+	mu        sync.Mutex
+	intervals []selectionCountsAt // oldest first
+}
+
+// selectionCountsAt are the selections made in the interval starting at start.
+type selectionCountsAt struct {
+	start  time.Time
+	counts map[storj.PlacementConstraint]*PlacementSelections
+}
+
+func newSelectionCounts(retention time.Duration) *selectionCounts {
+	return &selectionCounts{retention: retention}
+}
+
+// record counts a selection made for the placement, which returned selected of the requested nodes.
+func (counts *selectionCounts) record(placement storj.PlacementConstraint, requested, selected int, now time.Time) {
+	tag := monkit.NewSeriesTag("placement", strconv.Itoa(int(placement)))
+	mon.Counter("node_selection_placement_selections", tag).Inc(1)
+	mon.Counter("node_selection_placement_nodes", tag).Inc(int64(selected))
+
+	counts.mu.Lock()
+	defer counts.mu.Unlock()
+
+	start := now.Truncate(selectionCountsInterval)
+
+	expired := 0
+	for ; expired < len(counts.intervals); expired++ {
+		if !counts.intervals[expired].start.Before(start.Add(-counts.retention)) {
+			break
+		}
+	}
+	counts.intervals = counts.intervals[expired:]
+
+	if len(counts.intervals) == 0 || counts.intervals[len(counts.intervals)-1].start.Before(start) {
+		counts.intervals = append(counts.intervals, selectionCountsAt{
+			start:  start,
+			counts: map[storj.PlacementConstraint]*PlacementSelections{},
+		})
+	}
+	// clocks going backwards are counted toward the latest interval
+	interval := counts.intervals[len(counts.intervals)-1]
+
+	count, ok := interval.counts[placement]
+	if !ok {
+		count = &PlacementSelections{Placement: placement}
+		interval.counts[placement] = count
+	}
+	count.Selections++
+	count.SelectedNodes += int64(selected)
+	if selected < requested {
+		count.Shortfalls++
+	}
+}
+
+// since sums up the selections made in the intervals overlapping the time since the given one, ordered by placement,
+// along with the start of the earliest interval counted. The start is zero when nothing was counted.
+func (counts *selectionCounts) since(since time.Time) (_ []PlacementSelections, countedSince time.Time) {
+	counts.mu.Lock()
+	defer counts.mu.Unlock()
+
+	sums := map[storj.PlacementConstraint]*PlacementSelections{}
+	for _, interval := range counts.intervals {
+		if interval.start.Add(selectionCountsInterval).Before(since) {
+			continue
+		}
+		if countedSince.IsZero() {
+			countedSince = interval.start
+		}
+		for placement, count := range interval.counts {
+			sum, ok := sums[placement]
+			if !ok {
+				sum = &PlacementSelections{Placement: placement}
+				sums[placement] = sum
+			}
+			sum.Selections += count.Selections
+			sum.SelectedNodes += count.SelectedNodes
+			sum.Shortfalls += count.Shortfalls
+		}
+	}
+
+	selections := make([]PlacementSelections, 0, len(sums))
+	for _, sum := range sums {
+		selections = append(selections, *sum)
+	}
+	sort.Slice(selections, func(i, j int) bool {
+		return selections[i].Placement < selections[j].Placement
+	})
+	return selections, countedSince
+}
@@ -71,8 +71,34 @@ type DB interface {
 	UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *InfoResponse) (stats *NodeDossier, err error)
-	// UpdateCheckIn updates a single storagenode's check-in stats.
+	// UpdateCheckIn updates a single storagenode's check-in stats, and records the change when its wallet changed.
 	UpdateCheckIn(ctx context.Context, node NodeCheckInInfo, timestamp time.Time, config NodeSelectionConfig) (err error)
+	// GetWalletChanges returns the wallet changes made since the given time, most recent first.
+	GetWalletChanges(ctx context.Context, since time.Time, offset, limit int) (changes []WalletChange, more bool, err error)
+
+	// GetNodesByIP returns the nodes after startAfter whose last known ip is within the subnet, ordered by id.
+	GetNodesByIP(ctx context.Context, subnet *net.IPNet, startAfter storj.NodeID, limit int) (nodes []*NodeDossier, more bool, err error)
+	// GetIPsWithManyNodes returns the ips after startAfter that more than minNodes nodes last resolved to, ordered by ip,
+	// along with those nodes ordered by id. A nil startAfter starts from the first ip.
+	GetIPsWithManyNodes(ctx context.Context, minNodes int, startAfter net.IP, limit int) (ips []IPNodes, more bool, err error)
+	// GetNodesByStoredBytes returns the nodes with an accounting rollup by the bytes they stored at rest during their
+	// latest one, most first. Nodes that haven't been contacted within the online window are left out when onlineOnly
+	// is set.
+	GetNodesByStoredBytes(ctx context.Context, onlineWindow time.Duration, onlineOnly bool, offset, limit int) (nodes []NodeStorage, more bool, err error)
+	// GetSpaceDiscrepancies returns the nodes with at least minFreeBytes free whose free space exceeds maxFreeRatio times
+	// the bytes they stored at rest during their latest accounting rollup, by how much it exceeds it, most first.
+	GetSpaceDiscrepancies(ctx context.Context, maxFreeRatio float64, minFreeBytes int64, offset, limit int) (nodes []SpaceDiscrepancy, more bool, err error)
+	// CountNodesByLatency counts the online nodes that weren't disqualified and didn't exit by how many of the ascending
+	// bounds their latency reaches, along with the nodes without a measured latency.
+	CountNodesByLatency(ctx context.Context, onlineWindow time.Duration, bounds []int64) (counts []int64, unmeasured int64, err error)
+	// GetNodesByLatency returns the online nodes that weren't disqualified and didn't exit with a measured latency from
+	// lower up to upper, ordered by latency. A zero upper has no bound.
+	GetNodesByLatency(ctx context.Context, onlineWindow time.Duration, lower, upper int64, offset, limit int) (nodes []NodeLatency, more bool, err error)
+	// CountNodesContactedSince counts the nodes that didn't exit and those of them contacted since the given time.
+	CountNodesContactedSince(ctx context.Context, since time.Time) (stats NodeContactStats, err error)
+	// GetNodesContactedSince returns the nodes that didn't exit and were contacted since the given time after the cursor,
+	// most recent first.
+	GetNodesContactedSince(ctx context.Context, since time.Time, cursor NodeContactCursor, limit int) (nodes []*NodeDossier, more bool, err error)
 
 	// AllPieceCounts returns a map of node IDs to piece counts from the db.
 	AllPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error)
@@ -143,10 +169,62 @@ type NodeCheckInInfo struct {
 	LastNet     string
 	LastIPPort  string
 	IsUp        bool
+	Latency     time.Duration // round trip time of the ping back, zero when it wasn't measured
 	Operator    *pb.NodeOperator
 	Capacity    *pb.NodeCapacity
 	Version     *pb.NodeVersion
 	CountryCode location.CountryCode
+	GeoRefresh  bool // whether CountryCode was just resolved from LastIPPort
+}
+
+// WalletChange is a change of the wallet of a storagenode's operator.
+type WalletChange struct {
+	NodeID    storj.NodeID
+	OldWallet string
+	NewWallet string
+	ChangedAt time.Time
+}
+
+// IPNodes are the nodes that last resolved to the same ip.
+type IPNodes struct {
+	IP    string
+	Nodes []*NodeDossier
+}
+
+// NodeStorage is the bytes a node stored at rest according to its latest accounting rollup.
+type NodeStorage struct {
+	NodeID      storj.NodeID
+	StoredBytes int64
+	PieceCount  int64
+	Online      bool
+}
+
+// SpaceDiscrepancy is the free space a node reports beyond what the bytes it stores at rest account for.
+type SpaceDiscrepancy struct {
+	NodeID      storj.NodeID
+	StoredBytes int64
+	FreeBytes   int64
+	ExcessBytes int64
+}
+
+// NodeLatency is the round trip time the satellite measured for a node, in milliseconds.
+type NodeLatency struct {
+	NodeID    storj.NodeID
+	LatencyMs int64
+}
+
+// NodeContactStats counts the nodes that were contacted recently.
+type NodeContactStats struct {
+	Nodes         int64
+	Contacted     int64
+	LatestContact time.Time
+}
+
+// NodeContactCursor is the node listings of recently contacted nodes continue after. The zero cursor starts from the
+// most recently contacted node.
+type NodeContactCursor struct {
+	LastContact time.Time
+	NodeID      storj.NodeID
 }
 
 // InfoResponse contains node dossier info requested from the storage node.
@@ -234,11 +312,12 @@ type NodeDossier struct {
 	LastNet                string
 	LastIPPort             string
 	CountryCode            location.CountryCode
+	GeoRefreshedAt         *time.Time // when CountryCode was last resolved, nil when it never was
 }
 
 // NodeStats contains statistics about a node.
 type NodeStats struct {
-	Latency90          int64
+	Latency90          int64 // ping round trip time in milliseconds, tracking the slower pings of the node
 	LastContactSuccess time.Time
 	LastContactFailure time.Time
 	OfflineUnderReview *time.Time
@@ -295,6 +374,9 @@ type Service struct {
 	GeoIP                  geoip.IPToCountry
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
+
+	selectionCounts *selectionCounts
+	clockSkews      *clockSkews
 }
 
 // NewService returns a new Service.
@@ -336,6 +418,9 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 
 		UploadSelectionCache:   uploadSelectionCache,
 		DownloadSelectionCache: downloadSelectionCache,
+
+		selectionCounts: newSelectionCounts(config.SelectionCountsRetention),
+		clockSkews:      newClockSkews(),
 	}, nil
 }
 
@@ -352,6 +437,13 @@ func (service *Service) Close() error {
 	return service.GeoIP.Close()
 }
 
+// GetExitingNodes returns the nodes that initiated a graceful exit, but haven't finished it.
+func (service *Service) GetExitingNodes(ctx context.Context) (_ []*ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetExitingNodes(ctx)
+}
+
 // Get looks up the provided nodeID from the overlay.
 func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (_ *NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -402,18 +494,15 @@ func (service *Service) FindStorageNodesForGracefulExit(ctx context.Context, req
 //
 // When enabled it uses the cache to select nodes.
 // When the node selection from the cache fails, it falls back to the old implementation.
-func (service *Service) FindStorageNodesForUpload(ctx context.Context, req FindStorageNodesRequest) (_ []*SelectedNode, err error) {
+//
+// The selections are counted per placement, see PlacementSelectionCounts.
+func (service *Service) FindStorageNodesForUpload(ctx context.Context, req FindStorageNodesRequest) (selectedNodes []*SelectedNode, err error) {
 	defer mon.Task()(&ctx)(&err)
-	if service.config.Node.AsOfSystemTime.Enabled && service.config.Node.AsOfSystemTime.DefaultInterval < 0 {
-		req.AsOfSystemInterval = service.config.Node.AsOfSystemTime.DefaultInterval
-	}
+	defer func() {
+		service.selectionCounts.record(req.Placement, req.RequestedCount, len(selectedNodes), time.Now())
+	}()
 
-	// TODO excluding country codes on upload if cache is disabled is not implemented
-	if service.config.NodeSelectionCache.Disabled {
-		return service.FindStorageNodesWithPreferences(ctx, req, &service.config.Node)
-	}
-
-	selectedNodes, err := service.UploadSelectionCache.GetNodes(ctx, req)
+	selectedNodes, err = service.selectStorageNodesForUpload(ctx, req)
 	if err != nil {
 		return selectedNodes, err
 	}
@@ -435,6 +524,49 @@ func (service *Service) FindStorageNodesForUpload(ctx context.Context, req FindS
 	return selectedNodes, err
 }
 
+// SimulateStorageNodesForUpload selects nodes like FindStorageNodesForUpload does, but the selection isn't counted
+// or logged, so it can be repeated to simulate uploads. When fewer nodes than requested can be selected, the nodes
+// that could be selected are returned together with an ErrNotEnoughNodes error.
+func (service *Service) SimulateStorageNodesForUpload(ctx context.Context, req FindStorageNodesRequest) (_ []*SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.selectStorageNodesForUpload(ctx, req)
+}
+
+func (service *Service) selectStorageNodesForUpload(ctx context.Context, req FindStorageNodesRequest) (_ []*SelectedNode, err error) {
+	if service.config.Node.AsOfSystemTime.Enabled && service.config.Node.AsOfSystemTime.DefaultInterval < 0 {
+		req.AsOfSystemInterval = service.config.Node.AsOfSystemTime.DefaultInterval
+	}
+
+	// TODO excluding country codes on upload if cache is disabled is not implemented
+	if service.config.NodeSelectionCache.Disabled {
+		return service.FindStorageNodesWithPreferences(ctx, req, &service.config.Node)
+	}
+
+	return service.UploadSelectionCache.GetNodes(ctx, req)
+}
+
+// PlacementSelectionCounts returns the upload node selections this process made per placement since the given time,
+// ordered by placement, along with the time the counts actually start at. The selections are counted in intervals of
+// a minute, for as long as the configured retention, so the counts start at most a minute before the given time and
+// later than it when the counts don't go back that far. The start is zero when no selections were counted.
+func (service *Service) PlacementSelectionCounts(since time.Time) (_ []PlacementSelections, countedSince time.Time) {
+	return service.selectionCounts.since(since)
+}
+
+// RecordClockSkews records the clock skews of nodes measured from timestamps they signed at some point between their
+// earliest time and latest, in satellite time. Timestamps within that window count as no skew, so only clocks off by
+// more than the window can be told apart from accurate ones. The skews replace the ones measured for the nodes before.
+func (service *Service) RecordClockSkews(timestamps []SignedTimestamp, latest time.Time) {
+	service.clockSkews.record(timestamps, latest)
+}
+
+// ClockSkews returns the skews this process last measured for the nodes whose clock is off by at least the threshold,
+// largest first.
+func (service *Service) ClockSkews(threshold time.Duration) []ClockSkew {
+	return service.clockSkews.atLeast(threshold)
+}
+
 // FindStorageNodesWithPreferences searches the overlay network for nodes that meet the provided criteria.
 //
 // This does not use a cache.
@@ -574,6 +706,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 				zap.Stringer("Node ID", node.NodeID),
 				zap.Error(err))
 		}
+		node.GeoRefresh = err == nil
 
 		return service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 	}
@@ -608,6 +741,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 				zap.Stringer("Node ID", node.NodeID),
 				zap.Error(err))
 		}
+		node.GeoRefresh = err == nil
 	} else {
 		node.CountryCode = oldInfo.CountryCode
 	}
@@ -626,6 +760,96 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 	return nil
 }
 
+// GetWalletChanges returns the wallet changes made since the given time, most recent first.
+func (service *Service) GetWalletChanges(ctx context.Context, since time.Time, offset, limit int) (changes []WalletChange, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetWalletChanges(ctx, since, offset, limit)
+}
+
+// GetNodesByIP returns the nodes after startAfter whose last known ip is within the subnet, ordered by id.
+func (service *Service) GetNodesByIP(ctx context.Context, subnet *net.IPNet, startAfter storj.NodeID, limit int) (nodes []*NodeDossier, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetNodesByIP(ctx, subnet, startAfter, limit)
+}
+
+// GetIPsWithManyNodes returns the ips after startAfter that more than minNodes nodes last resolved to, ordered by ip,
+// along with those nodes ordered by id.
+func (service *Service) GetIPsWithManyNodes(ctx context.Context, minNodes int, startAfter net.IP, limit int) (ips []IPNodes, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetIPsWithManyNodes(ctx, minNodes, startAfter, limit)
+}
+
+// GetNodesByStoredBytes returns the nodes with an accounting rollup by the bytes they stored at rest during their latest
+// one, most first, optionally only the online ones.
+func (service *Service) GetNodesByStoredBytes(ctx context.Context, onlineOnly bool, offset, limit int) (nodes []NodeStorage, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetNodesByStoredBytes(ctx, service.config.Node.OnlineWindow, onlineOnly, offset, limit)
+}
+
+// GetSpaceDiscrepancies returns the nodes with at least minFreeBytes free whose free space exceeds maxFreeRatio times the
+// bytes they stored at rest during their latest accounting rollup, by how much it exceeds it, most first.
+func (service *Service) GetSpaceDiscrepancies(ctx context.Context, maxFreeRatio float64, minFreeBytes int64, offset, limit int) (nodes []SpaceDiscrepancy, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetSpaceDiscrepancies(ctx, maxFreeRatio, minFreeBytes, offset, limit)
+}
+
+// CountNodesByLatency counts the online nodes that weren't disqualified and didn't exit by how many of the ascending
+// bounds their latency reaches, along with the nodes without a measured latency.
+func (service *Service) CountNodesByLatency(ctx context.Context, bounds []int64) (counts []int64, unmeasured int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.CountNodesByLatency(ctx, service.config.Node.OnlineWindow, bounds)
+}
+
+// GetNodesByLatency returns the online nodes that weren't disqualified and didn't exit with a measured latency from lower
+// up to upper, ordered by latency. A zero upper has no bound.
+func (service *Service) GetNodesByLatency(ctx context.Context, lower, upper int64, offset, limit int) (nodes []NodeLatency, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetNodesByLatency(ctx, service.config.Node.OnlineWindow, lower, upper, offset, limit)
+}
+
+// CountNodesContactedSince counts the nodes that didn't exit and those of them contacted since the given time.
+func (service *Service) CountNodesContactedSince(ctx context.Context, since time.Time) (stats NodeContactStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.CountNodesContactedSince(ctx, since)
+}
+
+// GetNodesContactedSince returns the nodes that didn't exit and were contacted since the given time after the cursor,
+// most recent first.
+func (service *Service) GetNodesContactedSince(ctx context.Context, since time.Time, cursor NodeContactCursor, limit int) (nodes []*NodeDossier, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetNodesContactedSince(ctx, since, cursor, limit)
+}
+
+// StaleGeoNodes returns the nodes whose country code wasn't resolved since refreshedBefore, including the nodes it was
+// never resolved for. Disqualified and exited nodes are skipped, since they're never selected.
+func (service *Service) StaleGeoNodes(ctx context.Context, refreshedBefore time.Time) (nodes []*NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *NodeDossier) error {
+		if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+			return nil
+		}
+		if node.GeoRefreshedAt == nil || node.GeoRefreshedAt.Before(refreshedBefore) {
+			nodes = append(nodes, node)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return nodes, nil
+}
+
 // GetMissingPieces returns the list of offline nodes and the corresponding pieces.
 func (service *Service) GetMissingPieces(ctx context.Context, pieces metabase.Pieces) (missingPieces []uint16, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -682,6 +906,12 @@ func (service *Service) SelectAllStorageNodesDownload(ctx context.Context, onlin
 	return service.db.SelectAllStorageNodesDownload(ctx, onlineWindow, asOf)
 }
 
+// IterateAllNodeDossiers will call cb on all known nodes.
+func (service *Service) IterateAllNodeDossiers(ctx context.Context, cb func(context.Context, *NodeDossier) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.db.IterateAllNodeDossiers(ctx, cb)
+}
+
 // ResolveIPAndNetwork resolves the target address and determines its IP and /24 subnet IPv4 or /64 subnet IPv6.
 func ResolveIPAndNetwork(ctx context.Context, target string) (ip net.IP, port, network string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,89 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+)
+
+// SubnetSaturation describes how the nodes eligible for uploads of a placement are spread over subnets. Selections
+// with distinct IPs pick at most one node per subnet, so every eligible node beyond the first of its subnet can't be
+// selected alongside it.
+type SubnetSaturation struct {
+	Eligible         int64
+	DistinctSubnets  int64
+	SaturatedSubnets int64 // subnets with more than one eligible node
+	LargestSubnet    int64
+	Excluded         int64 // eligible nodes beyond the first of their subnet
+	ExcludedFreeDisk int64 // free disk of the excluded nodes, taking the roomiest node of every subnet as its first
+	DistinctIP       bool  // whether selection enforces the subnet rule at all
+}
+
+// Selectable returns how many of the required nodes a single selection can find, with or without the subnet rule.
+func (saturation SubnetSaturation) Selectable(requiredCount int, distinctSubnets bool) int64 {
+	available := saturation.Eligible
+	if distinctSubnets {
+		available = saturation.DistinctSubnets
+	}
+	if required := int64(requiredCount); available > required {
+		return required
+	}
+	return available
+}
+
+// SubnetSaturation counts the nodes eligible for uploads of the placement that the subnet rule of selection excludes.
+// Vetted and unvetted nodes are counted together, even though selection draws new nodes separately.
+func (service *Service) SubnetSaturation(ctx context.Context, placement storj.PlacementConstraint) (saturation SubnetSaturation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type subnet struct {
+		nodes       int64
+		freeDisk    int64
+		largestFree int64
+	}
+	subnets := make(map[string]*subnet)
+
+	err = service.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *NodeDossier) error {
+		reason, err := service.nodeEligibility(node, placement)
+		if err != nil {
+			return err
+		}
+		if reason != NodeSelectable && reason != NodeNotSelectedUnvetted {
+			return nil
+		}
+		saturation.Eligible++
+
+		net, ok := subnets[node.LastNet]
+		if !ok {
+			net = &subnet{}
+			subnets[node.LastNet] = net
+		}
+		net.nodes++
+		net.freeDisk += node.Capacity.FreeDisk
+		if node.Capacity.FreeDisk > net.largestFree {
+			net.largestFree = node.Capacity.FreeDisk
+		}
+		return nil
+	})
+	if err != nil {
+		return SubnetSaturation{}, Error.Wrap(err)
+	}
+
+	saturation.DistinctSubnets = int64(len(subnets))
+	saturation.Excluded = saturation.Eligible - saturation.DistinctSubnets
+	saturation.DistinctIP = service.config.Node.DistinctIP
+	for _, net := range subnets {
+		if net.nodes > 1 {
+			saturation.SaturatedSubnets++
+		}
+		if net.nodes > saturation.LargestSubnet {
+			saturation.LargestSubnet = net.nodes
+		}
+		saturation.ExcludedFreeDisk += net.freeDisk - net.largestFree
+	}
+
+	return saturation, nil
+}
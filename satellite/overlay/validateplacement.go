@@ -0,0 +1,118 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+)
+
+// PlacementRequirements describes a placement to validate against the selectable nodes.
+type PlacementRequirements struct {
+	Countries       []location.CountryCode // every country is allowed when empty
+	MinimumFreeDisk int64                  // the upload minimum applies when it's higher
+	VettedOnly      bool
+	RequiredCount   int
+	DistinctSubnets bool
+}
+
+// PlacementLimit identifies the requirement of a placement that limits the nodes it can select.
+type PlacementLimit int
+
+const (
+	// PlacementLimitNone denotes that enough nodes satisfy every requirement.
+	PlacementLimitNone PlacementLimit = iota
+	// PlacementLimitEligibility denotes that not enough nodes are selectable for uploads at all.
+	PlacementLimitEligibility
+	// PlacementLimitCountries denotes that not enough selectable nodes are in the allowed countries.
+	PlacementLimitCountries
+	// PlacementLimitFreeDisk denotes that not enough of the remaining nodes have the required free disk.
+	PlacementLimitFreeDisk
+	// PlacementLimitVetting denotes that not enough of the remaining nodes are vetted.
+	PlacementLimitVetting
+	// PlacementLimitSubnets denotes that the remaining nodes are on too few distinct subnets.
+	PlacementLimitSubnets
+)
+
+// PlacementValidation reports how many nodes are left after applying each requirement of a placement on top of the
+// previous ones, and the first requirement leaving fewer nodes than required.
+type PlacementValidation struct {
+	Eligible        int64
+	InCountries     int64
+	WithFreeDisk    int64
+	Vetted          int64
+	DistinctSubnets int64
+
+	Binding PlacementLimit
+}
+
+// Satisfiable reports whether the placement can select the required number of nodes.
+func (validation PlacementValidation) Satisfiable() bool {
+	return validation.Binding == PlacementLimitNone
+}
+
+// ValidatePlacement checks the requirements against the selection criteria of the current node set, without the
+// placement having to be configured.
+func (service *Service) ValidatePlacement(ctx context.Context, requirements PlacementRequirements) (validation PlacementValidation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	countries := make(map[location.CountryCode]bool, len(requirements.Countries))
+	for _, country := range requirements.Countries {
+		countries[country] = true
+	}
+
+	subnets := make(map[string]bool)
+	err = service.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *NodeDossier) error {
+		reason, err := service.nodeEligibility(node, storj.EveryCountry)
+		if err != nil {
+			return err
+		}
+		if reason != NodeSelectable && reason != NodeNotSelectedUnvetted {
+			return nil
+		}
+		validation.Eligible++
+
+		if len(countries) > 0 && !countries[node.CountryCode] {
+			return nil
+		}
+		validation.InCountries++
+
+		if node.Capacity.FreeDisk < requirements.MinimumFreeDisk {
+			return nil
+		}
+		validation.WithFreeDisk++
+
+		if requirements.VettedOnly && reason == NodeNotSelectedUnvetted {
+			return nil
+		}
+		validation.Vetted++
+
+		if !subnets[node.LastNet] {
+			subnets[node.LastNet] = true
+			validation.DistinctSubnets++
+		}
+		return nil
+	})
+	if err != nil {
+		return PlacementValidation{}, Error.Wrap(err)
+	}
+
+	required := int64(requirements.RequiredCount)
+	switch {
+	case validation.Eligible < required:
+		validation.Binding = PlacementLimitEligibility
+	case validation.InCountries < required:
+		validation.Binding = PlacementLimitCountries
+	case validation.WithFreeDisk < required:
+		validation.Binding = PlacementLimitFreeDisk
+	case validation.Vetted < required:
+		validation.Binding = PlacementLimitVetting
+	case requirements.DistinctSubnets && validation.DistinctSubnets < required:
+		validation.Binding = PlacementLimitSubnets
+	}
+
+	return validation, nil
+}
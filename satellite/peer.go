@@ -40,6 +40,7 @@ import (
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
@@ -55,6 +56,7 @@ import (
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
@@ -105,6 +107,12 @@ type DB interface {
 	Orders() orders.DB
 	// Containment returns database for containment
 	Containment() audit.Containment
+	// AuditFailures returns database for the audits nodes failed since they last passed one
+	AuditFailures() audit.Failures
+	// RepairNodeStats returns database for the pieces repair moved off and onto nodes
+	RepairNodeStats() repair.NodeStats
+	// RetainFilters returns database for the latest retain filters sent to nodes
+	RetainFilters() sender.SentFilters
 	// Buckets returns the database to interact with buckets
 	Buckets() buckets.DB
 	// GracefulExit returns database for graceful exit
@@ -140,6 +148,8 @@ type Config struct {
 	Overlay    overlay.Config
 	StrayNodes straynodes.Config
 
+	Inspector inspector.Config
+
 	Metainfo metainfo.Config
 	Orders   orders.Config
 
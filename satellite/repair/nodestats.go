@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repair
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// NodeStatsError is the repair node stats errs class.
+var NodeStatsError = errs.Class("repair node stats")
+
+// NodeRepairs are the pieces repair moved off and onto a node, and the pieces it downloaded from the node.
+type NodeRepairs struct {
+	NodeID storj.NodeID
+	// Removed are the pieces removed from the node because it failed to keep them, which repair replaced elsewhere.
+	Removed int64
+	// Added are the pieces repair uploaded to the node.
+	Added int64
+	// DownloadsSucceeded are the pieces repair downloaded from the node.
+	DownloadsSucceeded int64
+	// DownloadsFailed are the pieces repair asked the node for, which it didn't have, sent corrupted or timed out
+	// sending.
+	DownloadsFailed int64
+}
+
+// NodeStats counts the pieces repair removed from, added to and downloaded from nodes, per hour.
+//
+// architecture: Database
+type NodeStats interface {
+	// Record counts the pieces a repair removed from and added to nodes, in the hour it was repaired at. A node listed
+	// more than once has every piece counted.
+	Record(ctx context.Context, repairedAt time.Time, removed, added storj.NodeIDList) error
+	// RecordDownloads counts the pieces repair downloaded from nodes and failed to download from them, in the hour
+	// they were downloaded at. A node listed more than once has every piece counted.
+	RecordDownloads(ctx context.Context, downloadedAt time.Time, succeeded, failed storj.NodeIDList) error
+	// Get sums the pieces repair removed from and added to the node in the hours from the one since is in, up to
+	// before.
+	Get(ctx context.Context, nodeID storj.NodeID, since, before time.Time) (NodeRepairs, error)
+	// ListDownloads sums the pieces of every node repair downloaded from or failed to download from in the hours from
+	// the one since is in, up to before.
+	ListDownloads(ctx context.Context, since, before time.Time) ([]NodeRepairs, error)
+}
@@ -23,6 +23,39 @@ type InjuredSegment struct {
 	InsertedAt    time.Time
 }
 
+// Stats describes the segments in the repair queue.
+type Stats struct {
+	Count            int64
+	OldestInsertedAt *time.Time // nil when the queue is empty
+	MinHealth        float64
+	MaxHealth        float64
+	// HealthBuckets split the range between the minimum and maximum segment health into buckets of equal width.
+	HealthBuckets []HealthBucket
+}
+
+// HealthBucket counts the queued segments whose health falls within [Lower, Upper). The last bucket includes its
+// upper bound.
+type HealthBucket struct {
+	Lower float64
+	Upper float64
+	Count int64
+}
+
+// AgeHistogram describes how long the segments in the repair queue have been waiting for.
+type AgeHistogram struct {
+	Count            int64
+	OldestInsertedAt *time.Time // nil when the queue is empty
+	Ranges           []AgeRange
+}
+
+// AgeRange counts the queued segments that were inserted at least Lower and less than Upper before the histogram was
+// taken. The last range has no upper bound, and its Upper is zero.
+type AgeRange struct {
+	Lower time.Duration
+	Upper time.Duration
+	Count int64
+}
+
 // RepairQueue implements queueing for segments that need repairing.
 // Implementation can be found at satellite/satellitedb/repairqueue.go.
 //
@@ -34,6 +67,8 @@ type RepairQueue interface {
 	InsertBatch(ctx context.Context, segments []*InjuredSegment) (newlyInsertedSegments []*InjuredSegment, err error)
 	// Select gets an injured segment.
 	Select(ctx context.Context) (*InjuredSegment, error)
+	// Get returns the queued segment at the position of the stream, without marking it as attempted.
+	Get(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (*InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *InjuredSegment) error
 	// Clean removes all segments last updated before a certain time
@@ -42,6 +77,12 @@ type RepairQueue interface {
 	SelectN(ctx context.Context, limit int) ([]InjuredSegment, error)
 	// Count counts the number of segments in the repair queue.
 	Count(ctx context.Context) (count int, err error)
+	// Stats returns the size of the queue, its oldest segment and the distribution of segment health across at most the
+	// requested number of buckets.
+	Stats(ctx context.Context, buckets int) (Stats, error)
+	// AgeHistogram returns the size of the queue, its oldest segment and the number of segments inserted within each
+	// of the ranges the ascending bounds split the time before now into.
+	AgeHistogram(ctx context.Context, now time.Time, bounds []time.Duration) (AgeHistogram, error)
 
 	// TestingSetAttemptedTime sets attempted time for a segment.
 	TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error)
@@ -4,6 +4,7 @@
 package queue_test
 
 import (
+	"database/sql"
 	"sort"
 	"testing"
 	"time"
@@ -42,6 +43,32 @@ func TestInsertSelect(t *testing.T) {
 	})
 }
 
+func TestGet(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		seg := createInjuredSegment()
+		_, err := q.Insert(ctx, seg)
+		require.NoError(t, err)
+
+		s, err := q.Get(ctx, seg.StreamID, seg.Position)
+		require.NoError(t, err)
+		require.Equal(t, seg.StreamID, s.StreamID)
+		require.Equal(t, seg.Position, s.Position)
+		require.Equal(t, seg.SegmentHealth, s.SegmentHealth)
+		require.Nil(t, s.AttemptedAt)
+		require.WithinDuration(t, time.Now(), s.InsertedAt, 5*time.Second)
+
+		// getting a segment doesn't select it for repair
+		s, err = q.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, seg.StreamID, s.StreamID)
+
+		_, err = q.Get(ctx, testrand.UUID(), seg.Position)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
 func TestInsertDuplicate(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		q := db.RepairQueue()
@@ -314,3 +341,86 @@ func TestClean(t *testing.T) {
 		require.Equal(t, 0, count)
 	})
 }
+
+func TestStats(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		stats, err := q.Stats(ctx, 3)
+		require.NoError(t, err)
+		require.Zero(t, stats.Count)
+		require.Nil(t, stats.OldestInsertedAt)
+		require.Empty(t, stats.HealthBuckets)
+
+		for _, health := range []float64{1, 2, 3, 10} {
+			seg := createInjuredSegment()
+			seg.SegmentHealth = health
+
+			_, err := q.Insert(ctx, seg)
+			require.NoError(t, err)
+		}
+
+		stats, err = q.Stats(ctx, 3)
+		require.NoError(t, err)
+		require.EqualValues(t, 4, stats.Count)
+		require.NotNil(t, stats.OldestInsertedAt)
+		require.WithinDuration(t, time.Now(), *stats.OldestInsertedAt, 5*time.Second)
+		require.Equal(t, 1.0, stats.MinHealth)
+		require.Equal(t, 10.0, stats.MaxHealth)
+		require.Equal(t, []queue.HealthBucket{
+			{Lower: 1, Upper: 4, Count: 3},
+			{Lower: 4, Upper: 7, Count: 0},
+			{Lower: 7, Upper: 10, Count: 1},
+		}, stats.HealthBuckets)
+
+		stats, err = q.Stats(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, []queue.HealthBucket{{Lower: 1, Upper: 10, Count: 4}}, stats.HealthBuckets)
+	})
+}
+
+func TestAgeHistogram(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+		bounds := []time.Duration{time.Hour, 6 * time.Hour}
+
+		histogram, err := q.AgeHistogram(ctx, time.Now(), bounds)
+		require.NoError(t, err)
+		require.Zero(t, histogram.Count)
+		require.Nil(t, histogram.OldestInsertedAt)
+		require.Equal(t, []queue.AgeRange{
+			{Lower: 0, Upper: time.Hour},
+			{Lower: time.Hour, Upper: 6 * time.Hour},
+			{Lower: 6 * time.Hour},
+		}, histogram.Ranges)
+
+		for i := 0; i < 2; i++ {
+			_, err := q.Insert(ctx, createInjuredSegment())
+			require.NoError(t, err)
+		}
+
+		histogram, err = q.AgeHistogram(ctx, time.Now(), bounds)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, histogram.Count)
+		require.NotNil(t, histogram.OldestInsertedAt)
+		require.WithinDuration(t, time.Now(), *histogram.OldestInsertedAt, 5*time.Second)
+		require.EqualValues(t, []int64{2, 0, 0}, ageRangeCounts(histogram))
+
+		// the segments were inserted about two hours before then
+		histogram, err = q.AgeHistogram(ctx, time.Now().Add(2*time.Hour), bounds)
+		require.NoError(t, err)
+		require.EqualValues(t, []int64{0, 2, 0}, ageRangeCounts(histogram))
+
+		histogram, err = q.AgeHistogram(ctx, time.Now().Add(24*time.Hour), bounds)
+		require.NoError(t, err)
+		require.EqualValues(t, []int64{0, 0, 2}, ageRangeCounts(histogram))
+	})
+}
+
+func ageRangeCounts(histogram queue.AgeHistogram) []int64 {
+	counts := make([]int64, 0, len(histogram.Ranges))
+	for _, ageRange := range histogram.Ranges {
+		counts = append(counts, ageRange.Count)
+	}
+	return counts
+}
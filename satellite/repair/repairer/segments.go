@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/uplink/private/eestream"
@@ -83,6 +84,7 @@ type SegmentRepairer struct {
 	ec             *ECRepairer
 	timeout        time.Duration
 	reporter       audit.Reporter
+	nodeStats      repair.NodeStats
 
 	// multiplierOptimalThreshold is the value that multiplied by the optimal
 	// threshold results in the maximum limit of number of nodes to upload
@@ -108,6 +110,7 @@ func NewSegmentRepairer(
 	orders *orders.Service,
 	overlay *overlay.Service,
 	reporter audit.Reporter,
+	nodeStats repair.NodeStats,
 	ecRepairer *ECRepairer,
 	repairOverrides checker.RepairOverrides,
 	timeout time.Duration, excessOptimalThreshold float64,
@@ -128,6 +131,7 @@ func NewSegmentRepairer(
 		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
 		repairOverrides:            repairOverrides.GetMap(),
 		reporter:                   reporter,
+		nodeStats:                  nodeStats,
 
 		nowFn: time.Now,
 	}
@@ -434,6 +438,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 
 	report := audit.Report{
 		NodesReputation: cachedNodesReputation,
+		FailedSegments:  make(map[storj.NodeID]audit.Segment),
 	}
 
 	for _, outcome := range piecesReport.Successful {
@@ -441,6 +446,10 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	}
 	for _, outcome := range piecesReport.Failed {
 		report.Fails = append(report.Fails, outcome.Piece.StorageNode)
+		report.FailedSegments[outcome.Piece.StorageNode] = audit.Segment{
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+		}
 	}
 	for _, outcome := range piecesReport.Offline {
 		report.Offlines = append(report.Offlines, outcome.Piece.StorageNode)
@@ -454,6 +463,22 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		repairer.log.Debug("failed to record audit", zap.Error(reportErr))
 	}
 
+	downloadedNodes := make(storj.NodeIDList, 0, len(piecesReport.Successful))
+	for _, outcome := range piecesReport.Successful {
+		downloadedNodes = append(downloadedNodes, outcome.Piece.StorageNode)
+	}
+	notDownloadedNodes := make(storj.NodeIDList, 0, len(piecesReport.Failed)+len(piecesReport.Contained))
+	for _, outcome := range piecesReport.Failed {
+		notDownloadedNodes = append(notDownloadedNodes, outcome.Piece.StorageNode)
+	}
+	for _, outcome := range piecesReport.Contained {
+		notDownloadedNodes = append(notDownloadedNodes, outcome.Piece.StorageNode)
+	}
+	statsErr := repairer.nodeStats.RecordDownloads(ctx, time.Now(), downloadedNodes, notDownloadedNodes)
+	if statsErr != nil {
+		repairer.log.Debug("failed to record downloaded pieces", zap.Error(statsErr))
+	}
+
 	// Upload the repaired pieces
 	successfulNodes, _, err := repairer.ec.Repair(ctx, putLimits, putPrivateKey, redundancy, segmentReader, repairer.timeout, minSuccessfulNeeded)
 	if err != nil {
@@ -546,6 +571,20 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		return false, metainfoPutError.Wrap(err)
 	}
 
+	removedNodes := make(storj.NodeIDList, 0, len(toRemove))
+	for _, piece := range toRemove {
+		removedNodes = append(removedNodes, piece.StorageNode)
+	}
+	addedNodes := make(storj.NodeIDList, 0, len(repairedPieces))
+	for _, piece := range repairedPieces {
+		addedNodes = append(addedNodes, piece.StorageNode)
+	}
+	statsErr = repairer.nodeStats.Record(ctx, time.Now(), removedNodes, addedNodes)
+	if statsErr != nil {
+		// like failed audit updates, failing to count the pieces should not affect repair
+		repairer.log.Debug("failed to record repaired pieces", zap.Error(statsErr))
+	}
+
 	repairedAt := time.Time{}
 	if segment.RepairedAt != nil {
 		repairedAt = *segment.RepairedAt
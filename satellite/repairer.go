@@ -29,6 +29,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
@@ -82,6 +83,8 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	overlayCache overlay.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	auditFailuresDB audit.Failures,
+	repairNodeStatsDB repair.NodeStats,
 	rollupsWriteCache *orders.RollupsWriteCache,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Repairer, error) {
@@ -202,6 +205,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			log.Named("reporter"),
 			peer.Reputation,
 			containmentDB,
+			auditFailuresDB,
 			config.Audit.MaxRetriesStatDB,
 			int32(config.Audit.MaxReverifyCount))
 	}
@@ -220,6 +224,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			peer.Orders.Service,
 			peer.Overlay,
 			peer.Audit.Reporter,
+			repairNodeStatsDB,
 			peer.EcRepairer,
 			config.Checker.RepairOverrides,
 			config.Repairer.Timeout,
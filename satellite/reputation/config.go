@@ -19,6 +19,10 @@ var (
 	Error = errs.Class("reputation")
 	// ErrNodeNotFound is returned if a node does not exist in database.
 	ErrNodeNotFound = errs.Class("node not found")
+	// ErrNodeDisqualified is returned when reinstating a disqualified node.
+	ErrNodeDisqualified = errs.Class("node disqualified")
+	// ErrNodeNotSuspended is returned when reinstating a node that doesn't have the suspensions to lift.
+	ErrNodeNotSuspended = errs.Class("node not suspended")
 )
 
 // Config contains all config values for the reputation service.
@@ -59,6 +63,7 @@ type AuditHistoryConfig struct {
 	OfflineThreshold         float64       `help:"The point below which a node is punished for offline audits. Determined by calculating the ratio of online/total audits within each window and finding the average across windows within the tracking period." default:"0.6"`
 	OfflineDQEnabled         bool          `help:"whether nodes will be disqualified if they have low online score after a review period" releaseDefault:"false" devDefault:"true"`
 	OfflineSuspensionEnabled bool          `help:"whether nodes will be suspended if they have low online score" releaseDefault:"true" devDefault:"true"`
+	OfflineWarningThreshold  float64       `help:"The online score below which nodes are reported as approaching offline disqualification." default:"0.8"`
 }
 
 // AuditType is an enum representing the outcome of a particular audit.
@@ -0,0 +1,121 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+)
+
+// NodeOnlineScore contains the online scoring data of a node.
+type NodeOnlineScore struct {
+	NodeID           storj.NodeID
+	OnlineScore      float64
+	AuditHistory     *pb.AuditHistory
+	OfflineSuspended *time.Time
+	UnderReview      *time.Time
+}
+
+// OfflineDQRisk describes a node that is at risk of being disqualified for being offline.
+type OfflineDQRisk struct {
+	NodeOnlineScore
+
+	// ProjectedDQ is when the node would be disqualified if its online score keeps following its current trajectory.
+	// It is nil when the score isn't trending towards the offline threshold.
+	ProjectedDQ *time.Time
+}
+
+// NodesNearOfflineDQ returns the nodes that aren't disqualified and whose online score is below the threshold, most at
+// risk first. Nodes are ordered by their projected disqualification and then by their online score. A threshold of
+// zero uses the configured warning threshold.
+func (service *Service) NodesNearOfflineDQ(ctx context.Context, threshold float64, now time.Time) (_ []OfflineDQRisk, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if threshold <= 0 {
+		threshold = service.config.AuditHistory.OfflineWarningThreshold
+	}
+
+	scores, err := service.db.GetNodesBelowOnlineScore(ctx, threshold)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	risks := make([]OfflineDQRisk, 0, len(scores))
+	for _, score := range scores {
+		risks = append(risks, OfflineDQRisk{
+			NodeOnlineScore: score,
+			ProjectedDQ:     projectOfflineDQ(score, service.config.AuditHistory, now),
+		})
+	}
+
+	sort.Slice(risks, func(i, k int) bool {
+		a, b := risks[i].ProjectedDQ, risks[k].ProjectedDQ
+		switch {
+		case a != nil && b != nil && !a.Equal(*b):
+			return a.Before(*b)
+		case (a == nil) != (b == nil):
+			return a != nil
+		case risks[i].OnlineScore != risks[k].OnlineScore:
+			return risks[i].OnlineScore < risks[k].OnlineScore
+		}
+		return risks[i].NodeID.Less(risks[k].NodeID)
+	})
+
+	return risks, nil
+}
+
+// OfflineDQEnabled reports whether nodes are disqualified when their online score stays low after a review period.
+func (service *Service) OfflineDQEnabled() bool {
+	return service.config.AuditHistory.OfflineDQEnabled
+}
+
+// projectOfflineDQ estimates when the node would be disqualified. Nodes below the offline threshold are disqualified
+// at the end of their review, which starts now if it hasn't started already. For nodes above the offline threshold
+// the score is extrapolated by how much the latest complete window changed it.
+func projectOfflineDQ(score NodeOnlineScore, config AuditHistoryConfig, now time.Time) *time.Time {
+	review := config.GracePeriod + config.TrackingPeriod
+
+	if score.OnlineScore < config.OfflineThreshold {
+		dq := now.Add(review)
+		if score.UnderReview != nil {
+			dq = score.UnderReview.Add(review)
+		}
+		return &dq
+	}
+
+	trend := scoreTrend(score.AuditHistory)
+	if trend >= 0 {
+		return nil
+	}
+
+	windows := (score.OnlineScore - config.OfflineThreshold) / -trend
+	crossing := now.Add(time.Duration(windows * float64(config.WindowSize)))
+
+	dq := crossing.Add(review)
+	if score.UnderReview != nil {
+		if reviewEnd := score.UnderReview.Add(review); !crossing.After(reviewEnd) {
+			dq = reviewEnd
+		}
+	}
+	return &dq
+}
+
+// scoreTrend returns how much the score changed with the latest complete window.
+func scoreTrend(history *pb.AuditHistory) float64 {
+	windows := history.GetWindows()
+	if len(windows) < 3 {
+		return 0
+	}
+
+	current := &pb.AuditHistory{Windows: windows}
+	previous := &pb.AuditHistory{Windows: windows[:len(windows)-1]}
+	RecalculateScore(current)
+	RecalculateScore(previous)
+
+	return current.Score - previous.Score
+}
@@ -24,10 +24,20 @@ type DB interface {
 
 	// UnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
 	UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error)
+	// ReinstateNode lifts the suspensions of a node that aren't disqualified, resetting the reputation they were
+	// imposed for.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID, reinstatement Reinstatement) (err error)
 	// DisqualifyNode disqualifies a storage node.
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, reason overlay.DisqualificationReason) (err error)
 	// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
 	SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
+	// UpdateAuditHistory replaces a node's audit history and sets its online score to the score of the history.
+	UpdateAuditHistory(ctx context.Context, nodeID storj.NodeID, history *pb.AuditHistory) (err error)
+	// GetNodesBelowOnlineScore returns the online scoring data of nodes that aren't disqualified and whose online
+	// score is below the threshold.
+	GetNodesBelowOnlineScore(ctx context.Context, threshold float64) (_ []NodeOnlineScore, err error)
+	// GetAuditScores returns the audit scores of the nodes that have a reputation entry.
+	GetAuditScores(ctx context.Context, nodeIDs []storj.NodeID) (_ map[storj.NodeID]float64, err error)
 }
 
 // Info contains all reputation data to be stored in DB.
@@ -69,6 +79,14 @@ func (i *Info) Copy() *Info {
 	return &i2
 }
 
+// Reinstatement describes the suspensions lifted from a node.
+type Reinstatement struct {
+	// UnknownAudit lifts the unknown audit suspension, resetting the unknown audit reputation to that of a new node.
+	UnknownAudit bool
+	// Offline lifts the offline suspension and review, forgetting the audit history the online score is derived from.
+	Offline bool
+}
+
 // Mutations represents changes which should be made to a particular node's
 // reputation, in terms of counts and/or timestamps of events which have
 // occurred. A Mutations record can be applied to a reputations row without
@@ -137,6 +155,11 @@ func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, rep
 	return err
 }
 
+// Config returns the configuration reputations are evaluated with.
+func (service *Service) Config() Config {
+	return service.config
+}
+
 // Get returns a node's reputation info from DB.
 // If a node is not found in the DB, default reputation information is returned.
 func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (info *Info, err error) {
@@ -163,6 +186,126 @@ func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (info *Inf
 	return info, nil
 }
 
+// AuditScores returns the audit score of every node in the list. Nodes without a reputation entry get the score of
+// the initial audit reputation, like Get returns for them.
+func (service *Service) AuditScores(ctx context.Context, nodeIDs []storj.NodeID) (_ map[storj.NodeID]float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scores, err := service.db.GetAuditScores(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	initial := service.config.InitialAlpha / (service.config.InitialAlpha + service.config.InitialBeta)
+	for _, nodeID := range nodeIDs {
+		if _, ok := scores[nodeID]; !ok {
+			scores[nodeID] = initial
+		}
+	}
+	return scores, nil
+}
+
+// RecalculateReputation re-derives a node's online score from its stored audit history, dropping windows that fell
+// outside of the tracking period. The audit scores are always derived from the stored alpha and beta values, so only
+// the online score can become stale. The stored reputation is only updated when the recalculated values differ, which
+// makes repeated calls harmless.
+func (service *Service) RecalculateReputation(ctx context.Context, nodeID storj.NodeID) (before, after *Info, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// include audits that are still cached
+	if err := service.FlushNodeInfo(ctx, nodeID); err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	before, err = service.db.Get(ctx, nodeID)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	after = before.Copy()
+	if after.AuditHistory == nil {
+		after.AuditHistory = &pb.AuditHistory{}
+	}
+	MergeAuditHistories(after.AuditHistory, nil, service.config.AuditHistory)
+	after.OnlineScore = after.AuditHistory.Score
+
+	if after.OnlineScore == before.OnlineScore &&
+		after.AuditHistory.Score == before.AuditHistory.GetScore() &&
+		len(after.AuditHistory.Windows) == len(before.AuditHistory.GetWindows()) {
+		return before, after, nil
+	}
+
+	err = service.db.UpdateAuditHistory(ctx, nodeID, after.AuditHistory)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	service.log.Info("recalculated reputation",
+		zap.Stringer("Node ID", nodeID),
+		zap.Float64("online score before", before.OnlineScore),
+		zap.Float64("online score after", after.OnlineScore),
+		zap.Int("windows before", len(before.AuditHistory.GetWindows())),
+		zap.Int("windows after", len(after.AuditHistory.Windows)))
+
+	return before, after, nil
+}
+
+// ReinstateNode lifts a node's suspensions that were imposed in error, resetting the reputation they were imposed
+// for so that the node isn't suspended again right away. Only the suspensions the node has are lifted, and disqualified
+// nodes are never reinstated. The operator and the reason given are logged along with the lifted suspensions.
+func (service *Service) ReinstateNode(ctx context.Context, nodeID storj.NodeID, reinstatement Reinstatement, operator, reason string) (before, after *Info, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// include audits that are still cached
+	if err := service.FlushNodeInfo(ctx, nodeID); err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	before, err = service.db.Get(ctx, nodeID)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	if before.Disqualified != nil {
+		return nil, nil, ErrNodeDisqualified.New("%s", nodeID)
+	}
+
+	reinstatement.UnknownAudit = reinstatement.UnknownAudit && before.UnknownAuditSuspended != nil
+	reinstatement.Offline = reinstatement.Offline && (before.OfflineSuspended != nil || before.UnderReview != nil)
+	if !reinstatement.UnknownAudit && !reinstatement.Offline {
+		return nil, nil, ErrNodeNotSuspended.New("%s", nodeID)
+	}
+
+	err = service.db.ReinstateNode(ctx, nodeID, reinstatement)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	after, err = service.db.Get(ctx, nodeID)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	err = service.overlay.UpdateReputation(ctx, nodeID, overlay.ReputationUpdate{
+		Disqualified:           after.Disqualified,
+		DisqualificationReason: after.DisqualificationReason,
+		UnknownAuditSuspended:  after.UnknownAuditSuspended,
+		OfflineSuspended:       after.OfflineSuspended,
+		VettedAt:               after.VettedAt,
+	})
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	service.log.Info("reinstated node",
+		zap.Stringer("Node ID", nodeID),
+		zap.String("operator", operator),
+		zap.String("reason", reason),
+		zap.Bool("unknown audit suspension lifted", reinstatement.UnknownAudit),
+		zap.Bool("offline suspension lifted", reinstatement.Offline))
+
+	return before, after, nil
+}
+
 // TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
 func (service *Service) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	err = service.db.SuspendNodeUnknownAudit(ctx, nodeID, suspendedAt)
@@ -5,6 +5,7 @@ package reputation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -149,6 +151,53 @@ func TestGet(t *testing.T) {
 	})
 }
 
+func TestRecalculateReputation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		nodeID := planet.StorageNodes[0].ID()
+		service := planet.Satellites[0].Reputation.Service
+		config := planet.Satellites[0].Config.Reputation.AuditHistory
+
+		require.NoError(t, service.ApplyAudit(ctx, nodeID, overlay.ReputationStatus{}, reputation.AuditSuccess))
+		require.NoError(t, service.TestFlushAllNodeInfo(ctx))
+
+		// store a history whose score doesn't match its windows
+		start := time.Now().Truncate(config.WindowSize)
+		stale := &pb.AuditHistory{
+			Score: 0.1,
+			Windows: []*pb.AuditWindow{
+				{WindowStart: start.Add(-config.TrackingPeriod - 2*config.WindowSize), TotalCount: 1, OnlineCount: 0},
+				{WindowStart: start.Add(-config.WindowSize), TotalCount: 4, OnlineCount: 3},
+				{WindowStart: start, TotalCount: 1, OnlineCount: 1},
+			},
+		}
+		require.NoError(t, planet.Satellites[0].DB.Reputation().UpdateAuditHistory(ctx, nodeID, stale))
+
+		before, after, err := service.RecalculateReputation(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 0.1, before.OnlineScore)
+		require.Len(t, before.AuditHistory.Windows, 3)
+
+		// the window outside of the tracking period is dropped and the last window isn't scored
+		require.InDelta(t, 0.75, after.OnlineScore, 1e-8)
+		require.Len(t, after.AuditHistory.Windows, 2)
+		require.Equal(t, before.AuditReputationAlpha, after.AuditReputationAlpha)
+
+		node, err := service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.InDelta(t, 0.75, node.OnlineScore, 1e-8)
+
+		// recalculating again doesn't change anything
+		before, after, err = service.RecalculateReputation(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, before, after)
+
+		_, _, err = service.RecalculateReputation(ctx, testrand.NodeID())
+		require.True(t, reputation.ErrNodeNotFound.Has(err))
+	})
+}
+
 func TestDisqualificationAuditFailure(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
@@ -0,0 +1,60 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// NodeUptime counts the audits a node was online for within a period.
+type NodeUptime struct {
+	NodeID storj.NodeID
+	Online int64
+	Total  int64
+}
+
+// Percentage returns the percentage of audits the node was online for. It's zero when the node had no audits.
+func (uptime NodeUptime) Percentage() float64 {
+	if uptime.Total == 0 {
+		return 0
+	}
+	return 100 * float64(uptime.Online) / float64(uptime.Total)
+}
+
+// NodeUptimes returns the uptime of every node that isn't disqualified, counted over the audit windows that started
+// within the period before now. Audit windows are only kept for the tracking period, which is also used when the
+// period is zero.
+func (service *Service) NodeUptimes(ctx context.Context, period time.Duration, now time.Time) (_ []NodeUptime, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if period <= 0 {
+		period = service.config.AuditHistory.TrackingPeriod
+	}
+	since := now.Add(-period)
+
+	// every online score is below the maximum, so this returns all nodes that aren't disqualified
+	scores, err := service.db.GetNodesBelowOnlineScore(ctx, math.MaxFloat64)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	uptimes := make([]NodeUptime, 0, len(scores))
+	for _, score := range scores {
+		uptime := NodeUptime{NodeID: score.NodeID}
+		for _, window := range score.AuditHistory.GetWindows() {
+			if window.WindowStart.Before(since) {
+				continue
+			}
+			uptime.Online += int64(window.OnlineCount)
+			uptime.Total += int64(window.TotalCount)
+		}
+		uptimes = append(uptimes, uptime)
+	}
+
+	return uptimes, nil
+}
@@ -355,6 +355,18 @@ func (cdb *CachingDB) UnsuspendNodeUnknownAudit(ctx context.Context, nodeID stor
 	return cdb.RequestSync(ctx, nodeID)
 }
 
+// ReinstateNode lifts the suspensions of a node that isn't disqualified.
+func (cdb *CachingDB) ReinstateNode(ctx context.Context, nodeID storj.NodeID, reinstatement Reinstatement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cdb.backingStore.ReinstateNode(ctx, nodeID, reinstatement)
+	if err != nil {
+		return err
+	}
+	// sync with database (this will get it marked as reinstated in the cache)
+	return cdb.RequestSync(ctx, nodeID)
+}
+
 // DisqualifyNode disqualifies a storage node.
 func (cdb *CachingDB) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, reason overlay.DisqualificationReason) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -379,6 +391,34 @@ func (cdb *CachingDB) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.
 	return cdb.RequestSync(ctx, nodeID)
 }
 
+// UpdateAuditHistory replaces a node's audit history and sets its online score to the score of the history.
+func (cdb *CachingDB) UpdateAuditHistory(ctx context.Context, nodeID storj.NodeID, history *pb.AuditHistory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cdb.backingStore.UpdateAuditHistory(ctx, nodeID, history)
+	if err != nil {
+		return err
+	}
+	// sync with database (this will get the new history into the cache)
+	return cdb.RequestSync(ctx, nodeID)
+}
+
+// GetNodesBelowOnlineScore returns the online scoring data of nodes that aren't disqualified and whose online score is
+// below the threshold. Cached audit results are not included.
+func (cdb *CachingDB) GetNodesBelowOnlineScore(ctx context.Context, threshold float64) (_ []NodeOnlineScore, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cdb.backingStore.GetNodesBelowOnlineScore(ctx, threshold)
+}
+
+// GetAuditScores returns the audit scores of the nodes that have a reputation entry. Cached audit results are not
+// included.
+func (cdb *CachingDB) GetAuditScores(ctx context.Context, nodeIDs []storj.NodeID) (_ map[storj.NodeID]float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cdb.backingStore.GetAuditScores(ctx, nodeIDs)
+}
+
 // RequestSync requests the managing goroutine to perform a sync of cached info
 // about the specified node to the backing store. This involves applying the
 // cached mutations and resetting the info attribute to match a snapshot of what
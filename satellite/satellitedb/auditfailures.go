@@ -0,0 +1,157 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+type auditFailures struct {
+	db *satelliteDB
+}
+
+// Record records the failed audits, and forgets the failures of the nodes that passed an audit.
+func (failures *auditFailures) Record(ctx context.Context, failed []audit.FailedAudit, passed storj.NodeIDList) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(passed) > 0 {
+		_, err = failures.db.ExecContext(ctx, `
+			DELETE FROM audit_failures
+			WHERE node_id = ANY($1::BYTEA[])
+		`, pgutil.NodeIDArray(passed))
+		if err != nil {
+			return audit.FailuresError.Wrap(err)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	// a statement can't upsert the same row twice
+	type failureKey struct {
+		nodeID   storj.NodeID
+		streamID uuid.UUID
+		position uint64
+	}
+	seen := make(map[failureKey]bool, len(failed))
+
+	var nodeIDs []storj.NodeID
+	var streamIDs []uuid.UUID
+	var positions []int64
+	var failedAts []time.Time
+	for _, failure := range failed {
+		key := failureKey{failure.NodeID, failure.StreamID, failure.Position.Encode()}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		nodeIDs = append(nodeIDs, failure.NodeID)
+		streamIDs = append(streamIDs, failure.StreamID)
+		positions = append(positions, int64(failure.Position.Encode()))
+		failedAts = append(failedAts, failure.FailedAt)
+	}
+
+	_, err = failures.db.ExecContext(ctx, `
+		INSERT INTO audit_failures (node_id, stream_id, position, failed_at)
+		SELECT unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::INT8[]), unnest($4::TIMESTAMPTZ[])
+		ON CONFLICT (node_id, stream_id, position) DO UPDATE SET failed_at = EXCLUDED.failed_at
+	`, pgutil.NodeIDArray(nodeIDs), pgutil.UUIDArray(streamIDs), pgutil.Int8Array(positions), pgutil.TimestampTZArray(failedAts))
+	return audit.FailuresError.Wrap(err)
+}
+
+// ListStreaks returns up to limit of the nodes that failed at least minFailures audits in a row, after the cursor,
+// along with up to recent of their latest failures.
+func (failures *auditFailures) ListStreaks(ctx context.Context, minFailures, recent int, cursor audit.FailureStreakCursor, limit int) (_ []audit.FailureStreak, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := failures.db.QueryContext(ctx, `
+		SELECT node_id, failures
+		FROM (
+			SELECT node_id, count(*) AS failures
+			FROM audit_failures
+			GROUP BY node_id
+			HAVING count(*) >= $1
+		) AS streaks
+		WHERE $2 = 0 OR failures < $2 OR (failures = $2 AND node_id > $3)
+		ORDER BY failures DESC, node_id
+		LIMIT $4
+	`, minFailures, cursor.Failures, cursor.NodeID.Bytes(), limit)
+	if err != nil {
+		return nil, audit.FailuresError.Wrap(err)
+	}
+
+	var streaks []audit.FailureStreak
+	byNode := make(map[storj.NodeID]int)
+	err = func() (err error) {
+		defer func() { err = errs.Combine(err, rows.Close()) }()
+
+		for rows.Next() {
+			var streak audit.FailureStreak
+			err = rows.Scan(&streak.NodeID, &streak.Failures)
+			if err != nil {
+				return err
+			}
+			byNode[streak.NodeID] = len(streaks)
+			streaks = append(streaks, streak)
+		}
+		return rows.Err()
+	}()
+	if err != nil {
+		return nil, audit.FailuresError.Wrap(err)
+	}
+
+	if len(streaks) == 0 || recent <= 0 {
+		return streaks, nil
+	}
+
+	nodeIDs := make([]storj.NodeID, 0, len(streaks))
+	for _, streak := range streaks {
+		nodeIDs = append(nodeIDs, streak.NodeID)
+	}
+
+	rows, err = failures.db.QueryContext(ctx, `
+		SELECT node_id, stream_id, position, failed_at
+		FROM (
+			SELECT node_id, stream_id, position, failed_at,
+				row_number() OVER (PARTITION BY node_id ORDER BY failed_at DESC, stream_id, position) AS n
+			FROM audit_failures
+			WHERE node_id = ANY($1::BYTEA[])
+		) AS failures
+		WHERE n <= $2
+		ORDER BY node_id, failed_at DESC, stream_id, position
+	`, pgutil.NodeIDArray(nodeIDs), recent)
+	if err != nil {
+		return nil, audit.FailuresError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var failure audit.FailedAudit
+		var position uint64
+		err = rows.Scan(&failure.NodeID, &failure.StreamID, &position, &failure.FailedAt)
+		if err != nil {
+			return nil, audit.FailuresError.Wrap(err)
+		}
+		failure.Position = metabase.SegmentPositionFromEncoded(position)
+
+		index, ok := byNode[failure.NodeID]
+		if !ok {
+			continue
+		}
+		streaks[index].Recent = append(streaks[index].Recent, failure)
+	}
+
+	return streaks, audit.FailuresError.Wrap(rows.Err())
+}
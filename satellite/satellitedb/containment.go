@@ -9,6 +9,7 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/pb"
@@ -101,6 +102,41 @@ func (containment *containment) Delete(ctx context.Context, id pb.NodeID) (isDel
 	return isDeleted, audit.ContainError.Wrap(err)
 }
 
+// List returns up to limit pending audits of the nodes after the cursor, ordered by node id.
+func (containment *containment) List(ctx context.Context, cursor storj.NodeID, limit int) (_ []*audit.PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := containment.db.QueryContext(ctx, containment.db.Rebind(`
+		SELECT node_id, stream_id, position, piece_id, stripe_index, share_size, expected_share_hash, reverify_count
+		FROM segment_pending_audits
+		WHERE node_id > ?
+		ORDER BY node_id
+		LIMIT ?
+	`), cursor.Bytes(), limit)
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var pendingAudits []*audit.PendingAudit
+	for rows.Next() {
+		var info dbx.SegmentPendingAudits
+		err = rows.Scan(&info.NodeId, &info.StreamId, &info.Position, &info.PieceId,
+			&info.StripeIndex, &info.ShareSize, &info.ExpectedShareHash, &info.ReverifyCount)
+		if err != nil {
+			return nil, audit.ContainError.Wrap(err)
+		}
+
+		pending, err := convertDBPending(ctx, &info)
+		if err != nil {
+			return nil, err
+		}
+		pendingAudits = append(pendingAudits, pending)
+	}
+
+	return pendingAudits, audit.ContainError.Wrap(rows.Err())
+}
+
 func convertDBPending(ctx context.Context, info *dbx.SegmentPendingAudits) (_ *audit.PendingAudit, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if info == nil {
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/oidc"
@@ -30,6 +31,7 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
@@ -262,6 +264,21 @@ func (dbc *satelliteDBCollection) Containment() audit.Containment {
 	return &containment{db: dbc.getByName("containment")}
 }
 
+// AuditFailures returns database for the audits nodes failed since they last passed one.
+func (dbc *satelliteDBCollection) AuditFailures() audit.Failures {
+	return &auditFailures{db: dbc.getByName("containment")}
+}
+
+// RepairNodeStats returns database for the pieces repair moved off and onto nodes.
+func (dbc *satelliteDBCollection) RepairNodeStats() repair.NodeStats {
+	return &repairNodeStats{db: dbc.getByName("repairqueue")}
+}
+
+// RetainFilters returns database for the latest retain filters sent to nodes.
+func (dbc *satelliteDBCollection) RetainFilters() sender.SentFilters {
+	return &sentFilters{db: dbc.getByName("overlaycache")}
+}
+
 // GracefulExit returns database for graceful exit.
 func (dbc *satelliteDBCollection) GracefulExit() gracefulexit.DB {
 	return &gracefulexitDB{db: dbc.getByName("gracefulexit")}
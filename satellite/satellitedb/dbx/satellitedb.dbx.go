@@ -337,6 +337,13 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_failures (
+	node_id bytea NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	failed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, stream_id, position )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -466,6 +473,7 @@ CREATE TABLE nodes (
 	last_net text NOT NULL,
 	last_ip_port text,
 	country_code text,
+	geo_refreshed_at timestamp with time zone,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -503,6 +511,29 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_repair_rollups (
+	node_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	pieces_removed bigint NOT NULL,
+	pieces_added bigint NOT NULL,
+	downloads_succeeded bigint NOT NULL DEFAULT 0,
+	downloads_failed bigint NOT NULL DEFAULT 0,
+	PRIMARY KEY ( node_id, interval_start )
+);
+CREATE TABLE node_retain_filters (
+	node_id bytea NOT NULL,
+	sent_at timestamp with time zone NOT NULL,
+	creation_date timestamp with time zone NOT NULL,
+	piece_count bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_wallet_changes (
+	node_id bytea NOT NULL,
+	old_wallet text NOT NULL,
+	new_wallet text NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, changed_at )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -510,6 +541,12 @@ CREATE TABLE oauth_clients (
 	user_id bytea NOT NULL,
 	app_name text NOT NULL,
 	app_logo_url text NOT NULL,
+	previous_secret bytea,
+	previous_secret_expires_at timestamp with time zone,
+	denied_at timestamp with time zone,
+	secret_key_id text,
+	previous_secret_key_id text,
+	claim_template jsonb,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE oauth_codes (
@@ -523,8 +560,16 @@ CREATE TABLE oauth_codes (
 	created_at timestamp with time zone NOT NULL,
 	expires_at timestamp with time zone NOT NULL,
 	claimed_at timestamp with time zone,
+	resources text,
 	PRIMARY KEY ( code )
 );
+CREATE TABLE oauth_consents (
+	user_id bytea NOT NULL,
+	client_id bytea NOT NULL,
+	scope text NOT NULL,
+	granted_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, client_id, scope )
+);
 CREATE TABLE oauth_tokens (
 	client_id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -533,6 +578,7 @@ CREATE TABLE oauth_tokens (
 	token bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	expires_at timestamp with time zone NOT NULL,
+	resources text,
 	PRIMARY KEY ( token )
 );
 CREATE TABLE offers (
@@ -798,6 +844,7 @@ CREATE TABLE users (
 	failed_login_count integer,
 	login_lockout_expiration timestamp with time zone,
 	signup_captcha double precision,
+	email_unverified boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -888,6 +935,7 @@ CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_wallet_changes_changed_at_index ON node_wallet_changes ( changed_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -1021,6 +1069,13 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_failures (
+	node_id bytea NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	failed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, stream_id, position )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -1150,6 +1205,7 @@ CREATE TABLE nodes (
 	last_net text NOT NULL,
 	last_ip_port text,
 	country_code text,
+	geo_refreshed_at timestamp with time zone,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -1187,6 +1243,29 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_repair_rollups (
+	node_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	pieces_removed bigint NOT NULL,
+	pieces_added bigint NOT NULL,
+	downloads_succeeded bigint NOT NULL DEFAULT 0,
+	downloads_failed bigint NOT NULL DEFAULT 0,
+	PRIMARY KEY ( node_id, interval_start )
+);
+CREATE TABLE node_retain_filters (
+	node_id bytea NOT NULL,
+	sent_at timestamp with time zone NOT NULL,
+	creation_date timestamp with time zone NOT NULL,
+	piece_count bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_wallet_changes (
+	node_id bytea NOT NULL,
+	old_wallet text NOT NULL,
+	new_wallet text NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, changed_at )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -1194,6 +1273,12 @@ CREATE TABLE oauth_clients (
 	user_id bytea NOT NULL,
 	app_name text NOT NULL,
 	app_logo_url text NOT NULL,
+	previous_secret bytea,
+	previous_secret_expires_at timestamp with time zone,
+	denied_at timestamp with time zone,
+	secret_key_id text,
+	previous_secret_key_id text,
+	claim_template jsonb,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE oauth_codes (
@@ -1207,8 +1292,16 @@ CREATE TABLE oauth_codes (
 	created_at timestamp with time zone NOT NULL,
 	expires_at timestamp with time zone NOT NULL,
 	claimed_at timestamp with time zone,
+	resources text,
 	PRIMARY KEY ( code )
 );
+CREATE TABLE oauth_consents (
+	user_id bytea NOT NULL,
+	client_id bytea NOT NULL,
+	scope text NOT NULL,
+	granted_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, client_id, scope )
+);
 CREATE TABLE oauth_tokens (
 	client_id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -1217,6 +1310,7 @@ CREATE TABLE oauth_tokens (
 	token bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	expires_at timestamp with time zone NOT NULL,
+	resources text,
 	PRIMARY KEY ( token )
 );
 CREATE TABLE offers (
@@ -1482,6 +1576,7 @@ CREATE TABLE users (
 	failed_login_count integer,
 	login_lockout_expiration timestamp with time zone,
 	signup_captcha double precision,
+	email_unverified boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -1572,6 +1667,7 @@ CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_wallet_changes_changed_at_index ON node_wallet_changes ( changed_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -1909,6 +2005,94 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
+type AuditFailure struct {
+	NodeId   []byte
+	StreamId []byte
+	Position uint64
+	FailedAt time.Time
+}
+
+func (AuditFailure) _Table() string { return "audit_failures" }
+
+type AuditFailure_Update_Fields struct {
+}
+
+type AuditFailure_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditFailure_NodeId(v []byte) AuditFailure_NodeId_Field {
+	return AuditFailure_NodeId_Field{_set: true, _value: v}
+}
+
+func (f AuditFailure_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditFailure_NodeId_Field) _Column() string { return "node_id" }
+
+type AuditFailure_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditFailure_StreamId(v []byte) AuditFailure_StreamId_Field {
+	return AuditFailure_StreamId_Field{_set: true, _value: v}
+}
+
+func (f AuditFailure_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditFailure_StreamId_Field) _Column() string { return "stream_id" }
+
+type AuditFailure_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func AuditFailure_Position(v uint64) AuditFailure_Position_Field {
+	return AuditFailure_Position_Field{_set: true, _value: v}
+}
+
+func (f AuditFailure_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditFailure_Position_Field) _Column() string { return "position" }
+
+type AuditFailure_FailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AuditFailure_FailedAt(v time.Time) AuditFailure_FailedAt_Field {
+	return AuditFailure_FailedAt_Field{_set: true, _value: v}
+}
+
+func (f AuditFailure_FailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditFailure_FailedAt_Field) _Column() string { return "failed_at" }
+
 type BillingBalance struct {
 	UserId      []byte
 	Balance     int64
@@ -3961,6 +4145,7 @@ type Node struct {
 	LastNet                string
 	LastIpPort             *string
 	CountryCode            *string
+	GeoRefreshedAt         *time.Time
 	Protocol               int
 	Type                   int
 	Email                  string
@@ -3997,6 +4182,7 @@ type Node_Create_Fields struct {
 	Address                Node_Address_Field
 	LastIpPort             Node_LastIpPort_Field
 	CountryCode            Node_CountryCode_Field
+	GeoRefreshedAt         Node_GeoRefreshedAt_Field
 	Protocol               Node_Protocol_Field
 	Type                   Node_Type_Field
 	WalletFeatures         Node_WalletFeatures_Field
@@ -4027,6 +4213,7 @@ type Node_Update_Fields struct {
 	LastNet                Node_LastNet_Field
 	LastIpPort             Node_LastIpPort_Field
 	CountryCode            Node_CountryCode_Field
+	GeoRefreshedAt         Node_GeoRefreshedAt_Field
 	Protocol               Node_Protocol_Field
 	Type                   Node_Type_Field
 	Email                  Node_Email_Field
@@ -4176,6 +4363,38 @@ func (f Node_CountryCode_Field) value() interface{} {
 
 func (Node_CountryCode_Field) _Column() string { return "country_code" }
 
+type Node_GeoRefreshedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_GeoRefreshedAt(v time.Time) Node_GeoRefreshedAt_Field {
+	return Node_GeoRefreshedAt_Field{_set: true, _value: &v}
+}
+
+func Node_GeoRefreshedAt_Raw(v *time.Time) Node_GeoRefreshedAt_Field {
+	if v == nil {
+		return Node_GeoRefreshedAt_Null()
+	}
+	return Node_GeoRefreshedAt(*v)
+}
+
+func Node_GeoRefreshedAt_Null() Node_GeoRefreshedAt_Field {
+	return Node_GeoRefreshedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_GeoRefreshedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_GeoRefreshedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_GeoRefreshedAt_Field) _Column() string { return "geo_refreshed_at" }
+
 type Node_Protocol_Field struct {
 	_set   bool
 	_null  bool
@@ -4916,363 +5135,1009 @@ func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
 
 func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OauthClient struct {
-	Id              []byte
-	EncryptedSecret []byte
-	RedirectUrl     string
-	UserId          []byte
-	AppName         string
-	AppLogoUrl      string
+type NodeRepairRollup struct {
+	NodeId             []byte
+	IntervalStart      time.Time
+	PiecesRemoved      int64
+	PiecesAdded        int64
+	DownloadsSucceeded int64
+	DownloadsFailed    int64
 }
 
-func (OauthClient) _Table() string { return "oauth_clients" }
+func (NodeRepairRollup) _Table() string { return "node_repair_rollups" }
 
-type OauthClient_Update_Fields struct {
-	EncryptedSecret OauthClient_EncryptedSecret_Field
-	RedirectUrl     OauthClient_RedirectUrl_Field
-	AppName         OauthClient_AppName_Field
-	AppLogoUrl      OauthClient_AppLogoUrl_Field
+type NodeRepairRollup_Update_Fields struct {
 }
 
-type OauthClient_Id_Field struct {
+type NodeRepairRollup_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthClient_Id(v []byte) OauthClient_Id_Field {
-	return OauthClient_Id_Field{_set: true, _value: v}
+func NodeRepairRollup_NodeId(v []byte) NodeRepairRollup_NodeId_Field {
+	return NodeRepairRollup_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_Id_Field) value() interface{} {
+func (f NodeRepairRollup_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_Id_Field) _Column() string { return "id" }
+func (NodeRepairRollup_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthClient_EncryptedSecret_Field struct {
+type NodeRepairRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
-	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+func NodeRepairRollup_IntervalStart(v time.Time) NodeRepairRollup_IntervalStart_Field {
+	return NodeRepairRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+func (f NodeRepairRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+func (NodeRepairRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type OauthClient_RedirectUrl_Field struct {
+type NodeRepairRollup_PiecesRemoved_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
-	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+func NodeRepairRollup_PiecesRemoved(v int64) NodeRepairRollup_PiecesRemoved_Field {
+	return NodeRepairRollup_PiecesRemoved_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_RedirectUrl_Field) value() interface{} {
+func (f NodeRepairRollup_PiecesRemoved_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (NodeRepairRollup_PiecesRemoved_Field) _Column() string { return "pieces_removed" }
 
-type OauthClient_UserId_Field struct {
+type NodeRepairRollup_PiecesAdded_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeRepairRollup_PiecesAdded(v int64) NodeRepairRollup_PiecesAdded_Field {
+	return NodeRepairRollup_PiecesAdded_Field{_set: true, _value: v}
+}
+
+func (f NodeRepairRollup_PiecesAdded_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeRepairRollup_PiecesAdded_Field) _Column() string { return "pieces_added" }
+
+type NodeRepairRollup_DownloadsSucceeded_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeRepairRollup_DownloadsSucceeded(v int64) NodeRepairRollup_DownloadsSucceeded_Field {
+	return NodeRepairRollup_DownloadsSucceeded_Field{_set: true, _value: v}
+}
+
+func (f NodeRepairRollup_DownloadsSucceeded_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeRepairRollup_DownloadsSucceeded_Field) _Column() string { return "downloads_succeeded" }
+
+type NodeRepairRollup_DownloadsFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeRepairRollup_DownloadsFailed(v int64) NodeRepairRollup_DownloadsFailed_Field {
+	return NodeRepairRollup_DownloadsFailed_Field{_set: true, _value: v}
+}
+
+func (f NodeRepairRollup_DownloadsFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeRepairRollup_DownloadsFailed_Field) _Column() string { return "downloads_failed" }
+
+type NodeRetainFilter struct {
+	NodeId       []byte
+	SentAt       time.Time
+	CreationDate time.Time
+	PieceCount   int64
+}
+
+func (NodeRetainFilter) _Table() string { return "node_retain_filters" }
+
+type NodeRetainFilter_Update_Fields struct {
+}
+
+type NodeRetainFilter_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
-	return OauthClient_UserId_Field{_set: true, _value: v}
+func NodeRetainFilter_NodeId(v []byte) NodeRetainFilter_NodeId_Field {
+	return NodeRetainFilter_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_UserId_Field) value() interface{} {
+func (f NodeRetainFilter_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+func (NodeRetainFilter_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthClient_AppName_Field struct {
+type NodeRetainFilter_SentAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OauthClient_AppName(v string) OauthClient_AppName_Field {
-	return OauthClient_AppName_Field{_set: true, _value: v}
+func NodeRetainFilter_SentAt(v time.Time) NodeRetainFilter_SentAt_Field {
+	return NodeRetainFilter_SentAt_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppName_Field) value() interface{} {
+func (f NodeRetainFilter_SentAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+func (NodeRetainFilter_SentAt_Field) _Column() string { return "sent_at" }
 
-type OauthClient_AppLogoUrl_Field struct {
+type NodeRetainFilter_CreationDate_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
-	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+func NodeRetainFilter_CreationDate(v time.Time) NodeRetainFilter_CreationDate_Field {
+	return NodeRetainFilter_CreationDate_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+func (f NodeRetainFilter_CreationDate_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+func (NodeRetainFilter_CreationDate_Field) _Column() string { return "creation_date" }
 
-type OauthCode struct {
-	ClientId        []byte
-	UserId          []byte
-	Scope           string
-	RedirectUrl     string
-	Challenge       string
-	ChallengeMethod string
-	Code            string
-	CreatedAt       time.Time
-	ExpiresAt       time.Time
-	ClaimedAt       *time.Time
+type NodeRetainFilter_PieceCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (OauthCode) _Table() string { return "oauth_codes" }
+func NodeRetainFilter_PieceCount(v int64) NodeRetainFilter_PieceCount_Field {
+	return NodeRetainFilter_PieceCount_Field{_set: true, _value: v}
+}
 
-type OauthCode_Create_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+func (f NodeRetainFilter_PieceCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type OauthCode_Update_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+func (NodeRetainFilter_PieceCount_Field) _Column() string { return "piece_count" }
+
+type NodeWalletChange struct {
+	NodeId    []byte
+	OldWallet string
+	NewWallet string
+	ChangedAt time.Time
 }
 
-type OauthCode_ClientId_Field struct {
+func (NodeWalletChange) _Table() string { return "node_wallet_changes" }
+
+type NodeWalletChange_Update_Fields struct {
+}
+
+type NodeWalletChange_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
-	return OauthCode_ClientId_Field{_set: true, _value: v}
+func NodeWalletChange_NodeId(v []byte) NodeWalletChange_NodeId_Field {
+	return NodeWalletChange_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ClientId_Field) value() interface{} {
+func (f NodeWalletChange_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+func (NodeWalletChange_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthCode_UserId_Field struct {
+type NodeWalletChange_OldWallet_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
-	return OauthCode_UserId_Field{_set: true, _value: v}
+func NodeWalletChange_OldWallet(v string) NodeWalletChange_OldWallet_Field {
+	return NodeWalletChange_OldWallet_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_UserId_Field) value() interface{} {
+func (f NodeWalletChange_OldWallet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_UserId_Field) _Column() string { return "user_id" }
+func (NodeWalletChange_OldWallet_Field) _Column() string { return "old_wallet" }
 
-type OauthCode_Scope_Field struct {
+type NodeWalletChange_NewWallet_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthCode_Scope(v string) OauthCode_Scope_Field {
-	return OauthCode_Scope_Field{_set: true, _value: v}
+func NodeWalletChange_NewWallet(v string) NodeWalletChange_NewWallet_Field {
+	return NodeWalletChange_NewWallet_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Scope_Field) value() interface{} {
+func (f NodeWalletChange_NewWallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeWalletChange_NewWallet_Field) _Column() string { return "new_wallet" }
+
+type NodeWalletChange_ChangedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeWalletChange_ChangedAt(v time.Time) NodeWalletChange_ChangedAt_Field {
+	return NodeWalletChange_ChangedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeWalletChange_ChangedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeWalletChange_ChangedAt_Field) _Column() string { return "changed_at" }
+
+type OauthClient struct {
+	Id                      []byte
+	EncryptedSecret         []byte
+	RedirectUrl             string
+	UserId                  []byte
+	AppName                 string
+	AppLogoUrl              string
+	PreviousSecret          []byte
+	PreviousSecretExpiresAt *time.Time
+	DeniedAt                *time.Time
+	SecretKeyId             *string
+	PreviousSecretKeyId     *string
+	ClaimTemplate           []byte
+}
+
+func (OauthClient) _Table() string { return "oauth_clients" }
+
+type OauthClient_Create_Fields struct {
+	PreviousSecret          OauthClient_PreviousSecret_Field
+	PreviousSecretExpiresAt OauthClient_PreviousSecretExpiresAt_Field
+	DeniedAt                OauthClient_DeniedAt_Field
+	SecretKeyId             OauthClient_SecretKeyId_Field
+	PreviousSecretKeyId     OauthClient_PreviousSecretKeyId_Field
+	ClaimTemplate           OauthClient_ClaimTemplate_Field
+}
+
+type OauthClient_Update_Fields struct {
+	EncryptedSecret         OauthClient_EncryptedSecret_Field
+	RedirectUrl             OauthClient_RedirectUrl_Field
+	AppName                 OauthClient_AppName_Field
+	AppLogoUrl              OauthClient_AppLogoUrl_Field
+	PreviousSecret          OauthClient_PreviousSecret_Field
+	PreviousSecretExpiresAt OauthClient_PreviousSecretExpiresAt_Field
+	DeniedAt                OauthClient_DeniedAt_Field
+	SecretKeyId             OauthClient_SecretKeyId_Field
+	PreviousSecretKeyId     OauthClient_PreviousSecretKeyId_Field
+	ClaimTemplate           OauthClient_ClaimTemplate_Field
+}
+
+type OauthClient_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_Id(v []byte) OauthClient_Id_Field {
+	return OauthClient_Id_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_Id_Field) _Column() string { return "id" }
+
+type OauthClient_EncryptedSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
+	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+
+type OauthClient_RedirectUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
+	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_RedirectUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
+
+type OauthClient_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
+	return OauthClient_UserId_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+
+type OauthClient_AppName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthClient_AppName(v string) OauthClient_AppName_Field {
+	return OauthClient_AppName_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_AppName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+
+type OauthClient_AppLogoUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
+	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+
+type OauthClient_PreviousSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_PreviousSecret(v []byte) OauthClient_PreviousSecret_Field {
+	return OauthClient_PreviousSecret_Field{_set: true, _value: v}
+}
+
+func OauthClient_PreviousSecret_Raw(v []byte) OauthClient_PreviousSecret_Field {
+	if v == nil {
+		return OauthClient_PreviousSecret_Null()
+	}
+	return OauthClient_PreviousSecret(v)
+}
+
+func OauthClient_PreviousSecret_Null() OauthClient_PreviousSecret_Field {
+	return OauthClient_PreviousSecret_Field{_set: true, _null: true}
+}
+
+func (f OauthClient_PreviousSecret_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthClient_PreviousSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_PreviousSecret_Field) _Column() string { return "previous_secret" }
+
+type OauthClient_PreviousSecretExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func OauthClient_PreviousSecretExpiresAt(v time.Time) OauthClient_PreviousSecretExpiresAt_Field {
+	return OauthClient_PreviousSecretExpiresAt_Field{_set: true, _value: &v}
+}
+
+func OauthClient_PreviousSecretExpiresAt_Raw(v *time.Time) OauthClient_PreviousSecretExpiresAt_Field {
+	if v == nil {
+		return OauthClient_PreviousSecretExpiresAt_Null()
+	}
+	return OauthClient_PreviousSecretExpiresAt(*v)
+}
+
+func OauthClient_PreviousSecretExpiresAt_Null() OauthClient_PreviousSecretExpiresAt_Field {
+	return OauthClient_PreviousSecretExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f OauthClient_PreviousSecretExpiresAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f OauthClient_PreviousSecretExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_PreviousSecretExpiresAt_Field) _Column() string {
+	return "previous_secret_expires_at"
+}
+
+type OauthClient_DeniedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func OauthClient_DeniedAt(v time.Time) OauthClient_DeniedAt_Field {
+	return OauthClient_DeniedAt_Field{_set: true, _value: &v}
+}
+
+func OauthClient_DeniedAt_Raw(v *time.Time) OauthClient_DeniedAt_Field {
+	if v == nil {
+		return OauthClient_DeniedAt_Null()
+	}
+	return OauthClient_DeniedAt(*v)
+}
+
+func OauthClient_DeniedAt_Null() OauthClient_DeniedAt_Field {
+	return OauthClient_DeniedAt_Field{_set: true, _null: true}
+}
+
+func (f OauthClient_DeniedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthClient_DeniedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_DeniedAt_Field) _Column() string { return "denied_at" }
+
+type OauthClient_SecretKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func OauthClient_SecretKeyId(v string) OauthClient_SecretKeyId_Field {
+	return OauthClient_SecretKeyId_Field{_set: true, _value: &v}
+}
+
+func OauthClient_SecretKeyId_Raw(v *string) OauthClient_SecretKeyId_Field {
+	if v == nil {
+		return OauthClient_SecretKeyId_Null()
+	}
+	return OauthClient_SecretKeyId(*v)
+}
+
+func OauthClient_SecretKeyId_Null() OauthClient_SecretKeyId_Field {
+	return OauthClient_SecretKeyId_Field{_set: true, _null: true}
+}
+
+func (f OauthClient_SecretKeyId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthClient_SecretKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_SecretKeyId_Field) _Column() string { return "secret_key_id" }
+
+type OauthClient_PreviousSecretKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func OauthClient_PreviousSecretKeyId(v string) OauthClient_PreviousSecretKeyId_Field {
+	return OauthClient_PreviousSecretKeyId_Field{_set: true, _value: &v}
+}
+
+func OauthClient_PreviousSecretKeyId_Raw(v *string) OauthClient_PreviousSecretKeyId_Field {
+	if v == nil {
+		return OauthClient_PreviousSecretKeyId_Null()
+	}
+	return OauthClient_PreviousSecretKeyId(*v)
+}
+
+func OauthClient_PreviousSecretKeyId_Null() OauthClient_PreviousSecretKeyId_Field {
+	return OauthClient_PreviousSecretKeyId_Field{_set: true, _null: true}
+}
+
+func (f OauthClient_PreviousSecretKeyId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f OauthClient_PreviousSecretKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_PreviousSecretKeyId_Field) _Column() string { return "previous_secret_key_id" }
+
+type OauthClient_ClaimTemplate_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_ClaimTemplate(v []byte) OauthClient_ClaimTemplate_Field {
+	return OauthClient_ClaimTemplate_Field{_set: true, _value: v}
+}
+
+func OauthClient_ClaimTemplate_Raw(v []byte) OauthClient_ClaimTemplate_Field {
+	if v == nil {
+		return OauthClient_ClaimTemplate_Null()
+	}
+	return OauthClient_ClaimTemplate(v)
+}
+
+func OauthClient_ClaimTemplate_Null() OauthClient_ClaimTemplate_Field {
+	return OauthClient_ClaimTemplate_Field{_set: true, _null: true}
+}
+
+func (f OauthClient_ClaimTemplate_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthClient_ClaimTemplate_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_ClaimTemplate_Field) _Column() string { return "claim_template" }
+
+type OauthCode struct {
+	ClientId        []byte
+	UserId          []byte
+	Scope           string
+	RedirectUrl     string
+	Challenge       string
+	ChallengeMethod string
+	Code            string
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	ClaimedAt       *time.Time
+	Resources       *string
+}
+
+func (OauthCode) _Table() string { return "oauth_codes" }
+
+type OauthCode_Create_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+	Resources OauthCode_Resources_Field
+}
+
+type OauthCode_Update_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_ClientId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
+	return OauthCode_ClientId_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_ClientId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+
+type OauthCode_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
+	return OauthCode_UserId_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_UserId_Field) _Column() string { return "user_id" }
+
+type OauthCode_Scope_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthCode_Scope(v string) OauthCode_Scope_Field {
+	return OauthCode_Scope_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_Scope_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_Scope_Field) _Column() string { return "scope" }
+
+type OauthCode_RedirectUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
+	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_RedirectUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
+
+type OauthCode_Challenge_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
+	return OauthCode_Challenge_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_Challenge_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+
+type OauthCode_ChallengeMethod_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
+	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_ChallengeMethod_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
+
+type OauthCode_Code_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthCode_Code(v string) OauthCode_Code_Field {
+	return OauthCode_Code_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_Code_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_Code_Field) _Column() string { return "code" }
+
+type OauthCode_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
+	return OauthCode_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
+
+type OauthCode_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
+	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type OauthCode_ClaimedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+}
+
+func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+	if v == nil {
+		return OauthCode_ClaimedAt_Null()
+	}
+	return OauthCode_ClaimedAt(*v)
+}
+
+func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+}
+
+func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthCode_ClaimedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Scope_Field) _Column() string { return "scope" }
+func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
 
-type OauthCode_RedirectUrl_Field struct {
+type OauthCode_Resources_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *string
 }
 
-func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
-	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+func OauthCode_Resources(v string) OauthCode_Resources_Field {
+	return OauthCode_Resources_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_RedirectUrl_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func OauthCode_Resources_Raw(v *string) OauthCode_Resources_Field {
+	if v == nil {
+		return OauthCode_Resources_Null()
 	}
-	return f._value
+	return OauthCode_Resources(*v)
 }
 
-func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
-
-type OauthCode_Challenge_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func OauthCode_Resources_Null() OauthCode_Resources_Field {
+	return OauthCode_Resources_Field{_set: true, _null: true}
 }
 
-func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
-	return OauthCode_Challenge_Field{_set: true, _value: v}
-}
+func (f OauthCode_Resources_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_Challenge_Field) value() interface{} {
+func (f OauthCode_Resources_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+func (OauthCode_Resources_Field) _Column() string { return "resources" }
 
-type OauthCode_ChallengeMethod_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type OauthConsent struct {
+	UserId    []byte
+	ClientId  []byte
+	Scope     string
+	GrantedAt time.Time
 }
 
-func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
-	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
-}
+func (OauthConsent) _Table() string { return "oauth_consents" }
 
-func (f OauthCode_ChallengeMethod_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type OauthConsent_Update_Fields struct {
+	GrantedAt OauthConsent_GrantedAt_Field
 }
 
-func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
-
-type OauthCode_Code_Field struct {
+type OauthConsent_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthCode_Code(v string) OauthCode_Code_Field {
-	return OauthCode_Code_Field{_set: true, _value: v}
+func OauthConsent_UserId(v []byte) OauthConsent_UserId_Field {
+	return OauthConsent_UserId_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Code_Field) value() interface{} {
+func (f OauthConsent_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Code_Field) _Column() string { return "code" }
+func (OauthConsent_UserId_Field) _Column() string { return "user_id" }
 
-type OauthCode_CreatedAt_Field struct {
+type OauthConsent_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
-	return OauthCode_CreatedAt_Field{_set: true, _value: v}
+func OauthConsent_ClientId(v []byte) OauthConsent_ClientId_Field {
+	return OauthConsent_ClientId_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_CreatedAt_Field) value() interface{} {
+func (f OauthConsent_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (OauthConsent_ClientId_Field) _Column() string { return "client_id" }
 
-type OauthCode_ExpiresAt_Field struct {
+type OauthConsent_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
-	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
+func OauthConsent_Scope(v string) OauthConsent_Scope_Field {
+	return OauthConsent_Scope_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ExpiresAt_Field) value() interface{} {
+func (f OauthConsent_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (OauthConsent_Scope_Field) _Column() string { return "scope" }
 
-type OauthCode_ClaimedAt_Field struct {
+type OauthConsent_GrantedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
-}
-
-func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
-	if v == nil {
-		return OauthCode_ClaimedAt_Null()
-	}
-	return OauthCode_ClaimedAt(*v)
+	_value time.Time
 }
 
-func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+func OauthConsent_GrantedAt(v time.Time) OauthConsent_GrantedAt_Field {
+	return OauthConsent_GrantedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f OauthCode_ClaimedAt_Field) value() interface{} {
+func (f OauthConsent_GrantedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+func (OauthConsent_GrantedAt_Field) _Column() string { return "granted_at" }
 
 type OauthToken struct {
 	ClientId  []byte
@@ -5282,10 +6147,15 @@ type OauthToken struct {
 	Token     []byte
 	CreatedAt time.Time
 	ExpiresAt time.Time
+	Resources *string
 }
 
 func (OauthToken) _Table() string { return "oauth_tokens" }
 
+type OauthToken_Create_Fields struct {
+	Resources OauthToken_Resources_Field
+}
+
 type OauthToken_Update_Fields struct {
 	ExpiresAt OauthToken_ExpiresAt_Field
 }
@@ -5423,6 +6293,38 @@ func (f OauthToken_ExpiresAt_Field) value() interface{} {
 
 func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
+type OauthToken_Resources_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func OauthToken_Resources(v string) OauthToken_Resources_Field {
+	return OauthToken_Resources_Field{_set: true, _value: &v}
+}
+
+func OauthToken_Resources_Raw(v *string) OauthToken_Resources_Field {
+	if v == nil {
+		return OauthToken_Resources_Null()
+	}
+	return OauthToken_Resources(*v)
+}
+
+func OauthToken_Resources_Null() OauthToken_Resources_Field {
+	return OauthToken_Resources_Field{_set: true, _null: true}
+}
+
+func (f OauthToken_Resources_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthToken_Resources_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Resources_Field) _Column() string { return "resources" }
+
 type Offer struct {
 	Id                        int
 	Name                      string
@@ -9419,6 +10321,7 @@ type User struct {
 	FailedLoginCount         *int
 	LoginLockoutExpiration   *time.Time
 	SignupCaptcha            *float64
+	EmailUnverified          bool
 }
 
 func (User) _Table() string { return "users" }
@@ -9448,6 +10351,7 @@ type User_Create_Fields struct {
 	FailedLoginCount         User_FailedLoginCount_Field
 	LoginLockoutExpiration   User_LoginLockoutExpiration_Field
 	SignupCaptcha            User_SignupCaptcha_Field
+	EmailUnverified          User_EmailUnverified_Field
 }
 
 type User_Update_Fields struct {
@@ -9477,6 +10381,7 @@ type User_Update_Fields struct {
 	VerificationReminders    User_VerificationReminders_Field
 	FailedLoginCount         User_FailedLoginCount_Field
 	LoginLockoutExpiration   User_LoginLockoutExpiration_Field
+	EmailUnverified          User_EmailUnverified_Field
 }
 
 type User_Id_Field struct {
@@ -10267,6 +11172,25 @@ func (f User_SignupCaptcha_Field) value() interface{} {
 
 func (User_SignupCaptcha_Field) _Column() string { return "signup_captcha" }
 
+type User_EmailUnverified_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func User_EmailUnverified(v bool) User_EmailUnverified_Field {
+	return User_EmailUnverified_Field{_set: true, _value: v}
+}
+
+func (f User_EmailUnverified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_EmailUnverified_Field) _Column() string { return "email_unverified" }
+
 type ValueAttribution struct {
 	ProjectId   []byte
 	BucketName  []byte
@@ -12238,7 +13162,7 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified")}}
 
 	var __values []interface{}
 	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __partner_id_val, __user_agent_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __signup_promo_code_val, __last_verification_reminder_val, __failed_login_count_val, __login_lockout_expiration_val, __signup_captcha_val)
@@ -12300,6 +13224,12 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
 	}
 
+	if optional.EmailUnverified._set {
+		__values = append(__values, optional.EmailUnverified.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("email_unverified"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
 	if len(__optional_columns.SQLs) == 0 {
 		if __columns.SQL == nil {
 			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
@@ -12312,7 +13242,7 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -13213,7 +14143,8 @@ func (obj *pgxImpl) CreateNoReturn_OauthClient(ctx context.Context,
 	oauth_client_redirect_url OauthClient_RedirectUrl_Field,
 	oauth_client_user_id OauthClient_UserId_Field,
 	oauth_client_app_name OauthClient_AppName_Field,
-	oauth_client_app_logo_url OauthClient_AppLogoUrl_Field) (
+	oauth_client_app_logo_url OauthClient_AppLogoUrl_Field,
+	optional OauthClient_Create_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	__id_val := oauth_client_id.value()
@@ -13222,11 +14153,17 @@ func (obj *pgxImpl) CreateNoReturn_OauthClient(ctx context.Context,
 	__user_id_val := oauth_client_user_id.value()
 	__app_name_val := oauth_client_app_name.value()
 	__app_logo_url_val := oauth_client_app_logo_url.value()
+	__previous_secret_val := optional.PreviousSecret.value()
+	__previous_secret_expires_at_val := optional.PreviousSecretExpiresAt.value()
+	__denied_at_val := optional.DeniedAt.value()
+	__secret_key_id_val := optional.SecretKeyId.value()
+	__previous_secret_key_id_val := optional.PreviousSecretKeyId.value()
+	__claim_template_val := optional.ClaimTemplate.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_clients ( id, encrypted_secret, redirect_url, user_id, app_name, app_logo_url ) VALUES ( ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_clients ( id, encrypted_secret, redirect_url, user_id, app_name, app_logo_url, previous_secret, previous_secret_expires_at, denied_at, secret_key_id, previous_secret_key_id, claim_template ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __encrypted_secret_val, __redirect_url_val, __user_id_val, __app_name_val, __app_logo_url_val)
+	__values = append(__values, __id_val, __encrypted_secret_val, __redirect_url_val, __user_id_val, __app_name_val, __app_logo_url_val, __previous_secret_val, __previous_secret_expires_at_val, __denied_at_val, __secret_key_id_val, __previous_secret_key_id_val, __claim_template_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -13262,11 +14199,12 @@ func (obj *pgxImpl) CreateNoReturn_OauthCode(ctx context.Context,
 	__created_at_val := oauth_code_created_at.value()
 	__expires_at_val := oauth_code_expires_at.value()
 	__claimed_at_val := optional.ClaimedAt.value()
+	__resources_val := optional.Resources.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_codes ( client_id, user_id, scope, redirect_url, challenge, challenge_method, code, created_at, expires_at, claimed_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_codes ( client_id, user_id, scope, redirect_url, challenge, challenge_method, code, created_at, expires_at, claimed_at, resources ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __redirect_url_val, __challenge_val, __challenge_method_val, __code_val, __created_at_val, __expires_at_val, __claimed_at_val)
+	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __redirect_url_val, __challenge_val, __challenge_method_val, __code_val, __created_at_val, __expires_at_val, __claimed_at_val, __resources_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -13286,7 +14224,8 @@ func (obj *pgxImpl) CreateNoReturn_OauthToken(ctx context.Context,
 	oauth_token_kind OauthToken_Kind_Field,
 	oauth_token_token OauthToken_Token_Field,
 	oauth_token_created_at OauthToken_CreatedAt_Field,
-	oauth_token_expires_at OauthToken_ExpiresAt_Field) (
+	oauth_token_expires_at OauthToken_ExpiresAt_Field,
+	optional OauthToken_Create_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	__client_id_val := oauth_token_client_id.value()
@@ -13296,11 +14235,12 @@ func (obj *pgxImpl) CreateNoReturn_OauthToken(ctx context.Context,
 	__token_val := oauth_token_token.value()
 	__created_at_val := oauth_token_created_at.value()
 	__expires_at_val := oauth_token_expires_at.value()
+	__resources_val := optional.Resources.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_tokens ( client_id, user_id, scope, kind, token, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_tokens ( client_id, user_id, scope, kind, token, created_at, expires_at, resources ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __kind_val, __token_val, __created_at_val, __expires_at_val)
+	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __kind_val, __token_val, __created_at_val, __expires_at_val, __resources_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -13388,7 +14328,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -13397,7 +14337,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -13453,9 +14393,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -13482,7 +14422,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -13577,7 +14517,7 @@ func (obj *pgxImpl) All_User_By_NormalizedEmail(ctx context.Context,
 	rows []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha FROM users WHERE users.normalized_email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified FROM users WHERE users.normalized_email = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -13595,7 +14535,7 @@ func (obj *pgxImpl) All_User_By_NormalizedEmail(ctx context.Context,
 
 			for __rows.Next() {
 				user := &User{}
-				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 				if err != nil {
 					return nil, err
 				}
@@ -13622,7 +14562,7 @@ func (obj *pgxImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(ctx contex
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -13646,7 +14586,7 @@ func (obj *pgxImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(ctx contex
 			}
 
 			user = &User{}
-			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 			if err != nil {
 				return nil, err
 			}
@@ -13680,7 +14620,7 @@ func (obj *pgxImpl) Get_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -13689,7 +14629,7 @@ func (obj *pgxImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 	if err != nil {
 		return (*User)(nil), obj.makeErr(err)
 	}
@@ -16606,7 +17546,7 @@ func (obj *pgxImpl) Get_OauthClient_By_Id(ctx context.Context,
 	oauth_client *OauthClient, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_clients.id, oauth_clients.encrypted_secret, oauth_clients.redirect_url, oauth_clients.user_id, oauth_clients.app_name, oauth_clients.app_logo_url FROM oauth_clients WHERE oauth_clients.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_clients.id, oauth_clients.encrypted_secret, oauth_clients.redirect_url, oauth_clients.user_id, oauth_clients.app_name, oauth_clients.app_logo_url, oauth_clients.previous_secret, oauth_clients.previous_secret_expires_at, oauth_clients.denied_at, oauth_clients.secret_key_id, oauth_clients.previous_secret_key_id, oauth_clients.claim_template FROM oauth_clients WHERE oauth_clients.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, oauth_client_id.value())
@@ -16615,7 +17555,7 @@ func (obj *pgxImpl) Get_OauthClient_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	oauth_client = &OauthClient{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_client.Id, &oauth_client.EncryptedSecret, &oauth_client.RedirectUrl, &oauth_client.UserId, &oauth_client.AppName, &oauth_client.AppLogoUrl)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_client.Id, &oauth_client.EncryptedSecret, &oauth_client.RedirectUrl, &oauth_client.UserId, &oauth_client.AppName, &oauth_client.AppLogoUrl, &oauth_client.PreviousSecret, &oauth_client.PreviousSecretExpiresAt, &oauth_client.DeniedAt, &oauth_client.SecretKeyId, &oauth_client.PreviousSecretKeyId, &oauth_client.ClaimTemplate)
 	if err != nil {
 		return (*OauthClient)(nil), obj.makeErr(err)
 	}
@@ -16628,7 +17568,7 @@ func (obj *pgxImpl) Get_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx context.Cont
 	oauth_code *OauthCode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_codes.client_id, oauth_codes.user_id, oauth_codes.scope, oauth_codes.redirect_url, oauth_codes.challenge, oauth_codes.challenge_method, oauth_codes.code, oauth_codes.created_at, oauth_codes.expires_at, oauth_codes.claimed_at FROM oauth_codes WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")
+	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_codes.client_id, oauth_codes.user_id, oauth_codes.scope, oauth_codes.redirect_url, oauth_codes.challenge, oauth_codes.challenge_method, oauth_codes.code, oauth_codes.created_at, oauth_codes.expires_at, oauth_codes.claimed_at, oauth_codes.resources FROM oauth_codes WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")
 
 	var __values []interface{}
 	__values = append(__values, oauth_code_code.value())
@@ -16637,7 +17577,7 @@ func (obj *pgxImpl) Get_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	oauth_code = &OauthCode{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_code.ClientId, &oauth_code.UserId, &oauth_code.Scope, &oauth_code.RedirectUrl, &oauth_code.Challenge, &oauth_code.ChallengeMethod, &oauth_code.Code, &oauth_code.CreatedAt, &oauth_code.ExpiresAt, &oauth_code.ClaimedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_code.ClientId, &oauth_code.UserId, &oauth_code.Scope, &oauth_code.RedirectUrl, &oauth_code.Challenge, &oauth_code.ChallengeMethod, &oauth_code.Code, &oauth_code.CreatedAt, &oauth_code.ExpiresAt, &oauth_code.ClaimedAt, &oauth_code.Resources)
 	if err != nil {
 		return (*OauthCode)(nil), obj.makeErr(err)
 	}
@@ -16651,7 +17591,7 @@ func (obj *pgxImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Context,
 	oauth_token *OauthToken, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_tokens.client_id, oauth_tokens.user_id, oauth_tokens.scope, oauth_tokens.kind, oauth_tokens.token, oauth_tokens.created_at, oauth_tokens.expires_at FROM oauth_tokens WHERE oauth_tokens.kind = ? AND oauth_tokens.token = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_tokens.client_id, oauth_tokens.user_id, oauth_tokens.scope, oauth_tokens.kind, oauth_tokens.token, oauth_tokens.created_at, oauth_tokens.expires_at, oauth_tokens.resources FROM oauth_tokens WHERE oauth_tokens.kind = ? AND oauth_tokens.token = ?")
 
 	var __values []interface{}
 	__values = append(__values, oauth_token_kind.value(), oauth_token_token.value())
@@ -16660,7 +17600,7 @@ func (obj *pgxImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	oauth_token = &OauthToken{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_token.ClientId, &oauth_token.UserId, &oauth_token.Scope, &oauth_token.Kind, &oauth_token.Token, &oauth_token.CreatedAt, &oauth_token.ExpiresAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_token.ClientId, &oauth_token.UserId, &oauth_token.Scope, &oauth_token.Kind, &oauth_token.Token, &oauth_token.CreatedAt, &oauth_token.ExpiresAt, &oauth_token.Resources)
 	if err != nil {
 		return (*OauthToken)(nil), obj.makeErr(err)
 	}
@@ -16712,7 +17652,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -16738,6 +17678,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.GeoRefreshedAt._set {
+		__values = append(__values, update.GeoRefreshedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("geo_refreshed_at = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -16882,7 +17827,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -16925,6 +17870,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.GeoRefreshedAt._set {
+		__values = append(__values, update.GeoRefreshedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("geo_refreshed_at = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -17108,6 +18058,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.GeoRefreshedAt._set {
+		__values = append(__values, update.GeoRefreshedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("geo_refreshed_at = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -17583,7 +18538,7 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -17719,6 +18674,11 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("login_lockout_expiration = ?"))
 	}
 
+	if update.EmailUnverified._set {
+		__values = append(__values, update.EmailUnverified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email_unverified = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -17732,7 +18692,7 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -18489,6 +19449,36 @@ func (obj *pgxImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_logo_url = ?"))
 	}
 
+	if update.PreviousSecret._set {
+		__values = append(__values, update.PreviousSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("previous_secret = ?"))
+	}
+
+	if update.PreviousSecretExpiresAt._set {
+		__values = append(__values, update.PreviousSecretExpiresAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("previous_secret_expires_at = ?"))
+	}
+
+	if update.DeniedAt._set {
+		__values = append(__values, update.DeniedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("denied_at = ?"))
+	}
+
+	if update.SecretKeyId._set {
+		__values = append(__values, update.SecretKeyId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("secret_key_id = ?"))
+	}
+
+	if update.PreviousSecretKeyId._set {
+		__values = append(__values, update.PreviousSecretKeyId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("previous_secret_key_id = ?"))
+	}
+
+	if update.ClaimTemplate._set {
+		__values = append(__values, update.ClaimTemplate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("claim_template = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -19366,6 +20356,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_consents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19386,6 +20386,36 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_wallet_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_retain_filters;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_repair_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19516,6 +20546,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_failures;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19729,7 +20769,7 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified")}}
 
 	var __values []interface{}
 	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __partner_id_val, __user_agent_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __signup_promo_code_val, __last_verification_reminder_val, __failed_login_count_val, __login_lockout_expiration_val, __signup_captcha_val)
@@ -19791,6 +20831,12 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
 	}
 
+	if optional.EmailUnverified._set {
+		__values = append(__values, optional.EmailUnverified.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("email_unverified"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
 	if len(__optional_columns.SQLs) == 0 {
 		if __columns.SQL == nil {
 			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
@@ -19803,7 +20849,7 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -20704,7 +21750,8 @@ func (obj *pgxcockroachImpl) CreateNoReturn_OauthClient(ctx context.Context,
 	oauth_client_redirect_url OauthClient_RedirectUrl_Field,
 	oauth_client_user_id OauthClient_UserId_Field,
 	oauth_client_app_name OauthClient_AppName_Field,
-	oauth_client_app_logo_url OauthClient_AppLogoUrl_Field) (
+	oauth_client_app_logo_url OauthClient_AppLogoUrl_Field,
+	optional OauthClient_Create_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	__id_val := oauth_client_id.value()
@@ -20713,11 +21760,17 @@ func (obj *pgxcockroachImpl) CreateNoReturn_OauthClient(ctx context.Context,
 	__user_id_val := oauth_client_user_id.value()
 	__app_name_val := oauth_client_app_name.value()
 	__app_logo_url_val := oauth_client_app_logo_url.value()
+	__previous_secret_val := optional.PreviousSecret.value()
+	__previous_secret_expires_at_val := optional.PreviousSecretExpiresAt.value()
+	__denied_at_val := optional.DeniedAt.value()
+	__secret_key_id_val := optional.SecretKeyId.value()
+	__previous_secret_key_id_val := optional.PreviousSecretKeyId.value()
+	__claim_template_val := optional.ClaimTemplate.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_clients ( id, encrypted_secret, redirect_url, user_id, app_name, app_logo_url ) VALUES ( ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_clients ( id, encrypted_secret, redirect_url, user_id, app_name, app_logo_url, previous_secret, previous_secret_expires_at, denied_at, secret_key_id, previous_secret_key_id, claim_template ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __encrypted_secret_val, __redirect_url_val, __user_id_val, __app_name_val, __app_logo_url_val)
+	__values = append(__values, __id_val, __encrypted_secret_val, __redirect_url_val, __user_id_val, __app_name_val, __app_logo_url_val, __previous_secret_val, __previous_secret_expires_at_val, __denied_at_val, __secret_key_id_val, __previous_secret_key_id_val, __claim_template_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -20753,11 +21806,12 @@ func (obj *pgxcockroachImpl) CreateNoReturn_OauthCode(ctx context.Context,
 	__created_at_val := oauth_code_created_at.value()
 	__expires_at_val := oauth_code_expires_at.value()
 	__claimed_at_val := optional.ClaimedAt.value()
+	__resources_val := optional.Resources.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_codes ( client_id, user_id, scope, redirect_url, challenge, challenge_method, code, created_at, expires_at, claimed_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_codes ( client_id, user_id, scope, redirect_url, challenge, challenge_method, code, created_at, expires_at, claimed_at, resources ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __redirect_url_val, __challenge_val, __challenge_method_val, __code_val, __created_at_val, __expires_at_val, __claimed_at_val)
+	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __redirect_url_val, __challenge_val, __challenge_method_val, __code_val, __created_at_val, __expires_at_val, __claimed_at_val, __resources_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -20777,7 +21831,8 @@ func (obj *pgxcockroachImpl) CreateNoReturn_OauthToken(ctx context.Context,
 	oauth_token_kind OauthToken_Kind_Field,
 	oauth_token_token OauthToken_Token_Field,
 	oauth_token_created_at OauthToken_CreatedAt_Field,
-	oauth_token_expires_at OauthToken_ExpiresAt_Field) (
+	oauth_token_expires_at OauthToken_ExpiresAt_Field,
+	optional OauthToken_Create_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	__client_id_val := oauth_token_client_id.value()
@@ -20787,11 +21842,12 @@ func (obj *pgxcockroachImpl) CreateNoReturn_OauthToken(ctx context.Context,
 	__token_val := oauth_token_token.value()
 	__created_at_val := oauth_token_created_at.value()
 	__expires_at_val := oauth_token_expires_at.value()
+	__resources_val := optional.Resources.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_tokens ( client_id, user_id, scope, kind, token, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO oauth_tokens ( client_id, user_id, scope, kind, token, created_at, expires_at, resources ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __kind_val, __token_val, __created_at_val, __expires_at_val)
+	__values = append(__values, __client_id_val, __user_id_val, __scope_val, __kind_val, __token_val, __created_at_val, __expires_at_val, __resources_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -20879,7 +21935,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -20888,7 +21944,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -20944,9 +22000,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -20973,7 +22029,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -21068,7 +22124,7 @@ func (obj *pgxcockroachImpl) All_User_By_NormalizedEmail(ctx context.Context,
 	rows []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha FROM users WHERE users.normalized_email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified FROM users WHERE users.normalized_email = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -21086,7 +22142,7 @@ func (obj *pgxcockroachImpl) All_User_By_NormalizedEmail(ctx context.Context,
 
 			for __rows.Next() {
 				user := &User{}
-				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 				if err != nil {
 					return nil, err
 				}
@@ -21113,7 +22169,7 @@ func (obj *pgxcockroachImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(c
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -21137,7 +22193,7 @@ func (obj *pgxcockroachImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(c
 			}
 
 			user = &User{}
-			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 			if err != nil {
 				return nil, err
 			}
@@ -21171,7 +22227,7 @@ func (obj *pgxcockroachImpl) Get_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -21180,7 +22236,7 @@ func (obj *pgxcockroachImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 	if err != nil {
 		return (*User)(nil), obj.makeErr(err)
 	}
@@ -24097,7 +25153,7 @@ func (obj *pgxcockroachImpl) Get_OauthClient_By_Id(ctx context.Context,
 	oauth_client *OauthClient, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_clients.id, oauth_clients.encrypted_secret, oauth_clients.redirect_url, oauth_clients.user_id, oauth_clients.app_name, oauth_clients.app_logo_url FROM oauth_clients WHERE oauth_clients.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_clients.id, oauth_clients.encrypted_secret, oauth_clients.redirect_url, oauth_clients.user_id, oauth_clients.app_name, oauth_clients.app_logo_url, oauth_clients.previous_secret, oauth_clients.previous_secret_expires_at, oauth_clients.denied_at, oauth_clients.secret_key_id, oauth_clients.previous_secret_key_id, oauth_clients.claim_template FROM oauth_clients WHERE oauth_clients.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, oauth_client_id.value())
@@ -24106,7 +25162,7 @@ func (obj *pgxcockroachImpl) Get_OauthClient_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	oauth_client = &OauthClient{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_client.Id, &oauth_client.EncryptedSecret, &oauth_client.RedirectUrl, &oauth_client.UserId, &oauth_client.AppName, &oauth_client.AppLogoUrl)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_client.Id, &oauth_client.EncryptedSecret, &oauth_client.RedirectUrl, &oauth_client.UserId, &oauth_client.AppName, &oauth_client.AppLogoUrl, &oauth_client.PreviousSecret, &oauth_client.PreviousSecretExpiresAt, &oauth_client.DeniedAt, &oauth_client.SecretKeyId, &oauth_client.PreviousSecretKeyId, &oauth_client.ClaimTemplate)
 	if err != nil {
 		return (*OauthClient)(nil), obj.makeErr(err)
 	}
@@ -24119,7 +25175,7 @@ func (obj *pgxcockroachImpl) Get_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx con
 	oauth_code *OauthCode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_codes.client_id, oauth_codes.user_id, oauth_codes.scope, oauth_codes.redirect_url, oauth_codes.challenge, oauth_codes.challenge_method, oauth_codes.code, oauth_codes.created_at, oauth_codes.expires_at, oauth_codes.claimed_at FROM oauth_codes WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")
+	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_codes.client_id, oauth_codes.user_id, oauth_codes.scope, oauth_codes.redirect_url, oauth_codes.challenge, oauth_codes.challenge_method, oauth_codes.code, oauth_codes.created_at, oauth_codes.expires_at, oauth_codes.claimed_at, oauth_codes.resources FROM oauth_codes WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")
 
 	var __values []interface{}
 	__values = append(__values, oauth_code_code.value())
@@ -24128,7 +25184,7 @@ func (obj *pgxcockroachImpl) Get_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	oauth_code = &OauthCode{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_code.ClientId, &oauth_code.UserId, &oauth_code.Scope, &oauth_code.RedirectUrl, &oauth_code.Challenge, &oauth_code.ChallengeMethod, &oauth_code.Code, &oauth_code.CreatedAt, &oauth_code.ExpiresAt, &oauth_code.ClaimedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_code.ClientId, &oauth_code.UserId, &oauth_code.Scope, &oauth_code.RedirectUrl, &oauth_code.Challenge, &oauth_code.ChallengeMethod, &oauth_code.Code, &oauth_code.CreatedAt, &oauth_code.ExpiresAt, &oauth_code.ClaimedAt, &oauth_code.Resources)
 	if err != nil {
 		return (*OauthCode)(nil), obj.makeErr(err)
 	}
@@ -24142,7 +25198,7 @@ func (obj *pgxcockroachImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Contex
 	oauth_token *OauthToken, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_tokens.client_id, oauth_tokens.user_id, oauth_tokens.scope, oauth_tokens.kind, oauth_tokens.token, oauth_tokens.created_at, oauth_tokens.expires_at FROM oauth_tokens WHERE oauth_tokens.kind = ? AND oauth_tokens.token = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT oauth_tokens.client_id, oauth_tokens.user_id, oauth_tokens.scope, oauth_tokens.kind, oauth_tokens.token, oauth_tokens.created_at, oauth_tokens.expires_at, oauth_tokens.resources FROM oauth_tokens WHERE oauth_tokens.kind = ? AND oauth_tokens.token = ?")
 
 	var __values []interface{}
 	__values = append(__values, oauth_token_kind.value(), oauth_token_token.value())
@@ -24151,7 +25207,7 @@ func (obj *pgxcockroachImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Contex
 	obj.logStmt(__stmt, __values...)
 
 	oauth_token = &OauthToken{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_token.ClientId, &oauth_token.UserId, &oauth_token.Scope, &oauth_token.Kind, &oauth_token.Token, &oauth_token.CreatedAt, &oauth_token.ExpiresAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&oauth_token.ClientId, &oauth_token.UserId, &oauth_token.Scope, &oauth_token.Kind, &oauth_token.Token, &oauth_token.CreatedAt, &oauth_token.ExpiresAt, &oauth_token.Resources)
 	if err != nil {
 		return (*OauthToken)(nil), obj.makeErr(err)
 	}
@@ -24203,7 +25259,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -24229,6 +25285,11 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.GeoRefreshedAt._set {
+		__values = append(__values, update.GeoRefreshedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("geo_refreshed_at = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -24373,7 +25434,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -24416,6 +25477,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.GeoRefreshedAt._set {
+		__values = append(__values, update.GeoRefreshedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("geo_refreshed_at = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -24599,6 +25665,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.GeoRefreshedAt._set {
+		__values = append(__values, update.GeoRefreshedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("geo_refreshed_at = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -25074,7 +26145,7 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.last_verification_reminder, users.verification_reminders, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.email_unverified")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -25210,6 +26281,11 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("login_lockout_expiration = ?"))
 	}
 
+	if update.EmailUnverified._set {
+		__values = append(__values, update.EmailUnverified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email_unverified = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -25223,7 +26299,7 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.LastVerificationReminder, &user.VerificationReminders, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.EmailUnverified)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -25980,6 +27056,36 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Contex
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_logo_url = ?"))
 	}
 
+	if update.PreviousSecret._set {
+		__values = append(__values, update.PreviousSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("previous_secret = ?"))
+	}
+
+	if update.PreviousSecretExpiresAt._set {
+		__values = append(__values, update.PreviousSecretExpiresAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("previous_secret_expires_at = ?"))
+	}
+
+	if update.DeniedAt._set {
+		__values = append(__values, update.DeniedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("denied_at = ?"))
+	}
+
+	if update.SecretKeyId._set {
+		__values = append(__values, update.SecretKeyId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("secret_key_id = ?"))
+	}
+
+	if update.PreviousSecretKeyId._set {
+		__values = append(__values, update.PreviousSecretKeyId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("previous_secret_key_id = ?"))
+	}
+
+	if update.ClaimTemplate._set {
+		__values = append(__values, update.ClaimTemplate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("claim_template = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -26857,6 +27963,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_consents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26877,6 +27993,36 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_wallet_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_retain_filters;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_repair_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27007,6 +28153,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_failures;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27358,13 +28514,14 @@ func (rx *Rx) CreateNoReturn_OauthClient(ctx context.Context,
 	oauth_client_redirect_url OauthClient_RedirectUrl_Field,
 	oauth_client_user_id OauthClient_UserId_Field,
 	oauth_client_app_name OauthClient_AppName_Field,
-	oauth_client_app_logo_url OauthClient_AppLogoUrl_Field) (
+	oauth_client_app_logo_url OauthClient_AppLogoUrl_Field,
+	optional OauthClient_Create_Fields) (
 	err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.CreateNoReturn_OauthClient(ctx, oauth_client_id, oauth_client_encrypted_secret, oauth_client_redirect_url, oauth_client_user_id, oauth_client_app_name, oauth_client_app_logo_url)
+	return tx.CreateNoReturn_OauthClient(ctx, oauth_client_id, oauth_client_encrypted_secret, oauth_client_redirect_url, oauth_client_user_id, oauth_client_app_name, oauth_client_app_logo_url, optional)
 
 }
 
@@ -27395,13 +28552,14 @@ func (rx *Rx) CreateNoReturn_OauthToken(ctx context.Context,
 	oauth_token_kind OauthToken_Kind_Field,
 	oauth_token_token OauthToken_Token_Field,
 	oauth_token_created_at OauthToken_CreatedAt_Field,
-	oauth_token_expires_at OauthToken_ExpiresAt_Field) (
+	oauth_token_expires_at OauthToken_ExpiresAt_Field,
+	optional OauthToken_Create_Fields) (
 	err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.CreateNoReturn_OauthToken(ctx, oauth_token_client_id, oauth_token_user_id, oauth_token_scope, oauth_token_kind, oauth_token_token, oauth_token_created_at, oauth_token_expires_at)
+	return tx.CreateNoReturn_OauthToken(ctx, oauth_token_client_id, oauth_token_user_id, oauth_token_scope, oauth_token_kind, oauth_token_token, oauth_token_created_at, oauth_token_expires_at, optional)
 
 }
 
@@ -29121,7 +30279,8 @@ type Methods interface {
 		oauth_client_redirect_url OauthClient_RedirectUrl_Field,
 		oauth_client_user_id OauthClient_UserId_Field,
 		oauth_client_app_name OauthClient_AppName_Field,
-		oauth_client_app_logo_url OauthClient_AppLogoUrl_Field) (
+		oauth_client_app_logo_url OauthClient_AppLogoUrl_Field,
+		optional OauthClient_Create_Fields) (
 		err error)
 
 	CreateNoReturn_OauthCode(ctx context.Context,
@@ -29144,7 +30303,8 @@ type Methods interface {
 		oauth_token_kind OauthToken_Kind_Field,
 		oauth_token_token OauthToken_Token_Field,
 		oauth_token_created_at OauthToken_CreatedAt_Field,
-		oauth_token_expires_at OauthToken_ExpiresAt_Field) (
+		oauth_token_expires_at OauthToken_ExpiresAt_Field,
+		optional OauthToken_Create_Fields) (
 		err error)
 
 	CreateNoReturn_PeerIdentity(ctx context.Context,
@@ -17,6 +17,13 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_failures (
+	node_id bytea NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	failed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, stream_id, position )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -146,6 +153,7 @@ CREATE TABLE nodes (
 	last_net text NOT NULL,
 	last_ip_port text,
 	country_code text,
+	geo_refreshed_at timestamp with time zone,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -183,6 +191,29 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_repair_rollups (
+	node_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	pieces_removed bigint NOT NULL,
+	pieces_added bigint NOT NULL,
+	downloads_succeeded bigint NOT NULL DEFAULT 0,
+	downloads_failed bigint NOT NULL DEFAULT 0,
+	PRIMARY KEY ( node_id, interval_start )
+);
+CREATE TABLE node_retain_filters (
+	node_id bytea NOT NULL,
+	sent_at timestamp with time zone NOT NULL,
+	creation_date timestamp with time zone NOT NULL,
+	piece_count bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_wallet_changes (
+	node_id bytea NOT NULL,
+	old_wallet text NOT NULL,
+	new_wallet text NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, changed_at )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -190,6 +221,12 @@ CREATE TABLE oauth_clients (
 	user_id bytea NOT NULL,
 	app_name text NOT NULL,
 	app_logo_url text NOT NULL,
+	previous_secret bytea,
+	previous_secret_expires_at timestamp with time zone,
+	denied_at timestamp with time zone,
+	secret_key_id text,
+	previous_secret_key_id text,
+	claim_template jsonb,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE oauth_codes (
@@ -203,8 +240,16 @@ CREATE TABLE oauth_codes (
 	created_at timestamp with time zone NOT NULL,
 	expires_at timestamp with time zone NOT NULL,
 	claimed_at timestamp with time zone,
+	resources text,
 	PRIMARY KEY ( code )
 );
+CREATE TABLE oauth_consents (
+	user_id bytea NOT NULL,
+	client_id bytea NOT NULL,
+	scope text NOT NULL,
+	granted_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, client_id, scope )
+);
 CREATE TABLE oauth_tokens (
 	client_id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -213,6 +258,7 @@ CREATE TABLE oauth_tokens (
 	token bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	expires_at timestamp with time zone NOT NULL,
+	resources text,
 	PRIMARY KEY ( token )
 );
 CREATE TABLE offers (
@@ -478,6 +524,7 @@ CREATE TABLE users (
 	failed_login_count integer,
 	login_lockout_expiration timestamp with time zone,
 	signup_captcha double precision,
+	email_unverified boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -568,6 +615,7 @@ CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_wallet_changes_changed_at_index ON node_wallet_changes ( changed_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
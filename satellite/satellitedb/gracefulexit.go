@@ -486,6 +486,19 @@ func (db *gracefulexitDB) GetIncompleteFailed(ctx context.Context, nodeID storj.
 	return transferQueueItemRows, nil
 }
 
+// CountIncomplete returns the number of graceful exit transfer queue entries of the node that haven't finished.
+func (db *gracefulexitDB) CountIncomplete(ctx context.Context, nodeID storj.NodeID) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT COUNT(*)
+		FROM graceful_exit_segment_transfer_queue
+		WHERE node_id = ?
+		AND finished_at is NULL
+	`), nodeID.Bytes()).Scan(&count)
+	return count, Error.Wrap(err)
+}
+
 // IncrementOrderLimitSendCount increments the number of times a node has been sent an order limit for transferring.
 func (db *gracefulexitDB) IncrementOrderLimitSendCount(ctx context.Context, nodeID storj.NodeID, streamID uuid.UUID, position metabase.SegmentPosition, pieceNum int32) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2097,6 +2097,145 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE projects ADD COLUMN user_specified_bandwidth_limit bigint;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add previous_secret and previous_secret_expires_at columns to oauth_clients",
+				Version:     213,
+				Action: migrate.SQL{
+					`ALTER TABLE oauth_clients ADD COLUMN previous_secret bytea;`,
+					`ALTER TABLE oauth_clients ADD COLUMN previous_secret_expires_at timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_wallet_changes table",
+				Version:     214,
+				Action: migrate.SQL{
+					`CREATE TABLE node_wallet_changes (
+						node_id bytea NOT NULL,
+						old_wallet text NOT NULL,
+						new_wallet text NOT NULL,
+						changed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, changed_at )
+					);`,
+					`CREATE INDEX node_wallet_changes_changed_at_index ON node_wallet_changes ( changed_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add resources column to oauth_codes and oauth_tokens",
+				Version:     215,
+				Action: migrate.SQL{
+					`ALTER TABLE oauth_codes ADD COLUMN resources text;`,
+					`ALTER TABLE oauth_tokens ADD COLUMN resources text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add oauth_consents table",
+				Version:     216,
+				Action: migrate.SQL{
+					`CREATE TABLE oauth_consents (
+						user_id bytea NOT NULL,
+						client_id bytea NOT NULL,
+						scope text NOT NULL,
+						granted_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id, client_id, scope )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add geo_refreshed_at to nodes",
+				Version:     217,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN geo_refreshed_at timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add denied_at column to oauth_clients",
+				Version:     218,
+				Action: migrate.SQL{
+					`ALTER TABLE oauth_clients ADD COLUMN denied_at timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add audit_failures table",
+				Version:     219,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_failures (
+						node_id bytea NOT NULL,
+						stream_id bytea NOT NULL,
+						position bigint NOT NULL,
+						failed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, stream_id, position )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_repair_rollups table",
+				Version:     220,
+				Action: migrate.SQL{
+					`CREATE TABLE node_repair_rollups (
+						node_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						pieces_removed bigint NOT NULL,
+						pieces_added bigint NOT NULL,
+						PRIMARY KEY ( node_id, interval_start )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_retain_filters table",
+				Version:     221,
+				Action: migrate.SQL{
+					`CREATE TABLE node_retain_filters (
+						node_id bytea NOT NULL,
+						sent_at timestamp with time zone NOT NULL,
+						creation_date timestamp with time zone NOT NULL,
+						piece_count bigint NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add download counts to node_repair_rollups",
+				Version:     222,
+				Action: migrate.SQL{
+					`ALTER TABLE node_repair_rollups ADD COLUMN downloads_succeeded bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE node_repair_rollups ADD COLUMN downloads_failed bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add email_unverified to users",
+				Version:     223,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN email_unverified boolean NOT NULL DEFAULT false;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add secret_key_id and previous_secret_key_id to oauth_clients",
+				Version:     224,
+				Action: migrate.SQL{
+					`ALTER TABLE oauth_clients ADD COLUMN secret_key_id text;`,
+					`ALTER TABLE oauth_clients ADD COLUMN previous_secret_key_id text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add claim_template to oauth_clients",
+				Version:     225,
+				Action: migrate.SQL{
+					`ALTER TABLE oauth_clients ADD COLUMN claim_template jsonb;`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
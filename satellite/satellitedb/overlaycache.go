@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 	"time"
@@ -1077,6 +1078,7 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 		ExitStatus:             exitStatus,
 		CreatedAt:              info.CreatedAt,
 		LastNet:                info.LastNet,
+		GeoRefreshedAt:         info.GeoRefreshedAt,
 	}
 	if info.LastIpPort != nil {
 		node.LastIPPort = *info.LastIpPort
@@ -1230,9 +1232,23 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		return Error.Wrap(err)
 	}
 
-	// First try the fast path.
+	// latency_90 jumps up to slower pings and decays slowly towards faster ones, so that it approximates the latency of
+	// the slowest pings of the node. Pings that weren't measured leave it unchanged.
+	latency := int64((node.Latency + time.Millisecond - 1) / time.Millisecond)
+
+	// First try the fast path. A changed wallet can indicate the operator's account was taken over, so the wallet it
+	// replaces is recorded in the same statement, which reads it from before the update. New nodes take the slow path,
+	// and have no wallet to replace.
 	var res sql.Result
 	res, err = cache.db.ExecContext(ctx, `
+		WITH previous AS (
+			SELECT wallet FROM nodes WHERE id = $1
+		), wallet_change AS (
+			INSERT INTO node_wallet_changes (node_id, old_wallet, new_wallet, changed_at)
+			SELECT $1::bytea, previous.wallet, $6::text, $15::timestamptz
+			FROM previous
+			WHERE previous.wallet <> $6::text
+		)
 		UPDATE nodes
 		SET
 			address=$2,
@@ -1252,7 +1268,15 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 			END,
 			last_ip_port=$16,
 			wallet_features=$17,
-			country_code=$18
+			country_code=$18,
+			latency_90 = CASE WHEN $19::int8 <= 0 THEN nodes.latency_90
+				WHEN $19::int8 >= nodes.latency_90 THEN $19::int8
+				ELSE nodes.latency_90 + ($19::int8 - nodes.latency_90) / 10
+			END,
+			geo_refreshed_at = CASE WHEN $20::bool IS TRUE
+				THEN $15::timestamptz
+				ELSE nodes.geo_refreshed_at
+			END
 		WHERE id = $1
 	`, // args $1 - $4
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(),
@@ -1270,6 +1294,10 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		walletFeatures,
 		// args $18,
 		node.CountryCode.String(),
+		// args $19,
+		latency,
+		// args $20,
+		node.GeoRefresh,
 	)
 
 	if err == nil {
@@ -1289,7 +1317,9 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				major, minor, patch, hash, timestamp, release,
 				last_ip_port,
 				wallet_features,
-				country_code
+				country_code,
+				latency_90,
+				geo_refreshed_at
 			)
 			VALUES (
 				$1, $2, $3, $4, $5,
@@ -1303,7 +1333,11 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$10, $11, $12, $13, $14, $15,
 				$17,
 				$18,
-				$19
+				$19,
+				$20,
+				CASE WHEN $21::bool IS TRUE THEN $16::timestamptz
+					ELSE NULL
+				END
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1325,7 +1359,15 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				END,
 				last_ip_port=$17,
 				wallet_features=$18,
-				country_code=$19;
+				country_code=$19,
+				latency_90 = CASE WHEN $20::int8 <= 0 THEN nodes.latency_90
+					WHEN $20::int8 >= nodes.latency_90 THEN $20::int8
+					ELSE nodes.latency_90 + ($20::int8 - nodes.latency_90) / 10
+				END,
+				geo_refreshed_at = CASE WHEN $21::bool IS TRUE
+					THEN $16::timestamptz
+					ELSE nodes.geo_refreshed_at
+				END;
 			`,
 		// args $1 - $5
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(), int(pb.NodeType_STORAGE),
@@ -1343,6 +1385,10 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		walletFeatures,
 		// args $19,
 		node.CountryCode.String(),
+		// args $20,
+		latency,
+		// args $21,
+		node.GeoRefresh,
 	)
 	if err != nil {
 		return Error.Wrap(err)
@@ -1351,6 +1397,511 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 	return nil
 }
 
+// GetWalletChanges returns the wallet changes made since the given time, most recent first.
+func (cache *overlaycache) GetWalletChanges(ctx context.Context, since time.Time, offset, limit int) (changes []overlay.WalletChange, more bool, err error) {
+	for {
+		changes, more, err = cache.getWalletChanges(ctx, since, offset, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return changes, more, err
+		}
+		break
+	}
+
+	return changes, more, err
+}
+
+func (cache *overlaycache) getWalletChanges(ctx context.Context, since time.Time, offset, limit int) (changes []overlay.WalletChange, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT node_id, old_wallet, new_wallet, changed_at
+		FROM node_wallet_changes
+		WHERE changed_at >= $1
+		ORDER BY changed_at DESC, node_id
+		LIMIT $2 OFFSET $3
+	`), since, limit+1, offset)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var change overlay.WalletChange
+		err = rows.Scan(&change.NodeID, &change.OldWallet, &change.NewWallet, &change.ChangedAt)
+		if err != nil {
+			return nil, false, err
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(changes), limit)
+	return changes[:end], more, nil
+}
+
+// nodeDossierColumns are the columns of a node that scanNodeDossier scans, in the order dbx selects them.
+const nodeDossierColumns = `nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code,
+	nodes.geo_refreshed_at, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features,
+	nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp,
+	nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at,
+	nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason,
+	nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at,
+	nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success`
+
+// scanNodeDossier scans the nodeDossierColumns of a row, followed by dest.
+func scanNodeDossier(ctx context.Context, rows tagsql.Rows, dest ...interface{}) (*overlay.NodeDossier, error) {
+	node := &dbx.Node{}
+	err := rows.Scan(append([]interface{}{&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode,
+		&node.GeoRefreshedAt, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures,
+		&node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp,
+		&node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt,
+		&node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason,
+		&node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt,
+		&node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess}, dest...)...)
+	if err != nil {
+		return nil, err
+	}
+	return convertDBNode(ctx, node)
+}
+
+// nodeLastIP is the ip of the last ip and port of a node, or NULL when it isn't an ip and port.
+const nodeLastIP = `CASE WHEN nodes.last_ip_port ~ '^([0-9.]+|\[[0-9A-Fa-f:.]+\]):[0-9]+$'
+		THEN btrim(substring(nodes.last_ip_port FROM '^(.*):[0-9]+$'), '[]')::INET
+	END`
+
+// latestStoredBytes selects the average bytes every node stored at rest during its latest accounting rollup. Rollups
+// cover a day when the end of their interval isn't known.
+const latestStoredBytes = `
+	SELECT DISTINCT ON (node_id) node_id,
+		trunc(at_rest_total / CASE
+			WHEN interval_end_time > start_time THEN EXTRACT(EPOCH FROM (interval_end_time - start_time))::FLOAT8 / 3600
+			ELSE 24
+		END)::INT8 AS stored_bytes
+	FROM accounting_rollups
+	ORDER BY node_id, start_time DESC
+`
+
+// pageEnd returns how many of the items queried with limit+1 fit the page, and whether there are more.
+func pageEnd(length, limit int) (end int, more bool) {
+	if length > limit {
+		return limit, true
+	}
+	return length, false
+}
+
+// GetNodesByIP returns the nodes after startAfter whose last known ip is within the subnet, ordered by id.
+func (cache *overlaycache) GetNodesByIP(ctx context.Context, subnet *net.IPNet, startAfter storj.NodeID, limit int) (nodes []*overlay.NodeDossier, more bool, err error) {
+	for {
+		nodes, more, err = cache.getNodesByIP(ctx, subnet, startAfter, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, more, err
+		}
+		break
+	}
+
+	return nodes, more, err
+}
+
+func (cache *overlaycache) getNodesByIP(ctx context.Context, subnet *net.IPNet, startAfter storj.NodeID, limit int) (nodes []*overlay.NodeDossier, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT `+nodeDossierColumns+`
+		FROM nodes
+		WHERE nodes.id > $1 AND `+nodeLastIP+` <<= $2::INET
+		ORDER BY nodes.id
+		LIMIT $3
+	`), startAfter.Bytes(), subnet.String(), limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		node, err := scanNodeDossier(ctx, rows)
+		if err != nil {
+			return nil, false, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(nodes), limit)
+	return nodes[:end], more, nil
+}
+
+// GetIPsWithManyNodes returns the ips after startAfter that more than minNodes nodes last resolved to, ordered by ip,
+// along with those nodes ordered by id. A nil startAfter starts from the first ip.
+func (cache *overlaycache) GetIPsWithManyNodes(ctx context.Context, minNodes int, startAfter net.IP, limit int) (ips []overlay.IPNodes, more bool, err error) {
+	for {
+		ips, more, err = cache.getIPsWithManyNodes(ctx, minNodes, startAfter, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return ips, more, err
+		}
+		break
+	}
+
+	return ips, more, err
+}
+
+func (cache *overlaycache) getIPsWithManyNodes(ctx context.Context, minNodes int, startAfter net.IP, limit int) (ips []overlay.IPNodes, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var after interface{}
+	if startAfter != nil {
+		after = startAfter.String()
+	}
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		WITH node_ips AS (
+			SELECT nodes.id, `+nodeLastIP+` AS ip
+			FROM nodes
+		), many AS (
+			SELECT ip
+			FROM node_ips
+			WHERE ip IS NOT NULL AND ($2::INET IS NULL OR ip > $2::INET)
+			GROUP BY ip
+			HAVING count(*) > $1
+			ORDER BY ip
+			LIMIT $3
+		)
+		SELECT `+nodeDossierColumns+`, host(many.ip)
+		FROM many
+		JOIN node_ips ON node_ips.ip = many.ip
+		JOIN nodes ON nodes.id = node_ips.id
+		ORDER BY many.ip, nodes.id
+	`), minNodes, after, limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var ip string
+		node, err := scanNodeDossier(ctx, rows, &ip)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(ips) == 0 || ips[len(ips)-1].IP != ip {
+			ips = append(ips, overlay.IPNodes{IP: ip})
+		}
+		ips[len(ips)-1].Nodes = append(ips[len(ips)-1].Nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(ips), limit)
+	return ips[:end], more, nil
+}
+
+// GetNodesByStoredBytes returns the nodes with an accounting rollup by the bytes they stored at rest during their latest
+// one, most first. Nodes that haven't been contacted within the online window are left out when onlineOnly is set.
+func (cache *overlaycache) GetNodesByStoredBytes(ctx context.Context, onlineWindow time.Duration, onlineOnly bool, offset, limit int) (nodes []overlay.NodeStorage, more bool, err error) {
+	for {
+		nodes, more, err = cache.getNodesByStoredBytes(ctx, onlineWindow, onlineOnly, offset, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, more, err
+		}
+		break
+	}
+
+	return nodes, more, err
+}
+
+func (cache *overlaycache) getNodesByStoredBytes(ctx context.Context, onlineWindow time.Duration, onlineOnly bool, offset, limit int) (nodes []overlay.NodeStorage, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		WITH stored AS (`+latestStoredBytes+`)
+		SELECT nodes.id, stored.stored_bytes, nodes.piece_count, nodes.last_contact_success > $1
+		FROM stored
+		JOIN nodes ON nodes.id = stored.node_id
+		WHERE NOT $2::BOOL OR nodes.last_contact_success > $1
+		ORDER BY stored.stored_bytes DESC, nodes.id
+		LIMIT $3 OFFSET $4
+	`), time.Now().Add(-onlineWindow), onlineOnly, limit+1, offset)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var node overlay.NodeStorage
+		err = rows.Scan(&node.NodeID, &node.StoredBytes, &node.PieceCount, &node.Online)
+		if err != nil {
+			return nil, false, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(nodes), limit)
+	return nodes[:end], more, nil
+}
+
+// GetSpaceDiscrepancies returns the nodes with at least minFreeBytes free whose free space exceeds maxFreeRatio times the
+// bytes they stored at rest during their latest accounting rollup, by how much it exceeds it, most first.
+func (cache *overlaycache) GetSpaceDiscrepancies(ctx context.Context, maxFreeRatio float64, minFreeBytes int64, offset, limit int) (nodes []overlay.SpaceDiscrepancy, more bool, err error) {
+	for {
+		nodes, more, err = cache.getSpaceDiscrepancies(ctx, maxFreeRatio, minFreeBytes, offset, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, more, err
+		}
+		break
+	}
+
+	return nodes, more, err
+}
+
+func (cache *overlaycache) getSpaceDiscrepancies(ctx context.Context, maxFreeRatio float64, minFreeBytes int64, offset, limit int) (nodes []overlay.SpaceDiscrepancy, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// nodes that haven't reported their free space yet report it as negative
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		WITH stored AS (`+latestStoredBytes+`)
+		SELECT node_id, stored_bytes, free_disk, excess_bytes
+		FROM (
+			SELECT nodes.id AS node_id, stored.stored_bytes, nodes.free_disk,
+				nodes.free_disk - trunc($1::FLOAT8 * stored.stored_bytes::FLOAT8)::INT8 AS excess_bytes
+			FROM stored
+			JOIN nodes ON nodes.id = stored.node_id
+			WHERE nodes.free_disk >= 0 AND nodes.free_disk >= $2
+		) AS discrepancies
+		WHERE excess_bytes > 0
+		ORDER BY excess_bytes DESC, node_id
+		LIMIT $3 OFFSET $4
+	`), maxFreeRatio, minFreeBytes, limit+1, offset)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var node overlay.SpaceDiscrepancy
+		err = rows.Scan(&node.NodeID, &node.StoredBytes, &node.FreeBytes, &node.ExcessBytes)
+		if err != nil {
+			return nil, false, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(nodes), limit)
+	return nodes[:end], more, nil
+}
+
+// CountNodesByLatency counts the online nodes that weren't disqualified and didn't exit by how many of the ascending
+// bounds their latency reaches, along with the nodes without a measured latency.
+func (cache *overlaycache) CountNodesByLatency(ctx context.Context, onlineWindow time.Duration, bounds []int64) (counts []int64, unmeasured int64, err error) {
+	for {
+		counts, unmeasured, err = cache.countNodesByLatency(ctx, onlineWindow, bounds)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return counts, unmeasured, err
+		}
+		break
+	}
+
+	return counts, unmeasured, err
+}
+
+func (cache *overlaycache) countNodesByLatency(ctx context.Context, onlineWindow time.Duration, bounds []int64) (counts []int64, unmeasured int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT CASE WHEN latency_90 > 0 THEN width_bucket(latency_90, $2::INT8[]) ELSE -1 END, count(*)
+		FROM nodes
+		WHERE last_contact_success > $1 AND disqualified IS NULL AND exit_finished_at IS NULL
+		GROUP BY 1
+	`), time.Now().Add(-onlineWindow), pgutil.Int8Array(bounds))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts = make([]int64, len(bounds)+1)
+	for rows.Next() {
+		var tier, count int64
+		err = rows.Scan(&tier, &count)
+		if err != nil {
+			return nil, 0, err
+		}
+		if tier < 0 {
+			unmeasured = count
+			continue
+		}
+		counts[tier] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, Error.Wrap(err)
+	}
+
+	return counts, unmeasured, nil
+}
+
+// GetNodesByLatency returns the online nodes that weren't disqualified and didn't exit with a measured latency from lower
+// up to upper, ordered by latency. A zero upper has no bound.
+func (cache *overlaycache) GetNodesByLatency(ctx context.Context, onlineWindow time.Duration, lower, upper int64, offset, limit int) (nodes []overlay.NodeLatency, more bool, err error) {
+	for {
+		nodes, more, err = cache.getNodesByLatency(ctx, onlineWindow, lower, upper, offset, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, more, err
+		}
+		break
+	}
+
+	return nodes, more, err
+}
+
+func (cache *overlaycache) getNodesByLatency(ctx context.Context, onlineWindow time.Duration, lower, upper int64, offset, limit int) (nodes []overlay.NodeLatency, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT id, latency_90
+		FROM nodes
+		WHERE last_contact_success > $1 AND disqualified IS NULL AND exit_finished_at IS NULL
+			AND latency_90 > 0 AND latency_90 >= $2 AND ($3 = 0 OR latency_90 < $3)
+		ORDER BY latency_90, id
+		LIMIT $4 OFFSET $5
+	`), time.Now().Add(-onlineWindow), lower, upper, limit+1, offset)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var node overlay.NodeLatency
+		err = rows.Scan(&node.NodeID, &node.LatencyMs)
+		if err != nil {
+			return nil, false, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(nodes), limit)
+	return nodes[:end], more, nil
+}
+
+// CountNodesContactedSince counts the nodes that didn't exit and those of them contacted since the given time.
+func (cache *overlaycache) CountNodesContactedSince(ctx context.Context, since time.Time) (stats overlay.NodeContactStats, err error) {
+	for {
+		stats, err = cache.countNodesContactedSince(ctx, since)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return stats, err
+		}
+		break
+	}
+
+	return stats, err
+}
+
+func (cache *overlaycache) countNodesContactedSince(ctx context.Context, since time.Time) (stats overlay.NodeContactStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var latest *time.Time
+	err = cache.db.QueryRow(ctx, cache.db.Rebind(`
+		SELECT count(*), count(CASE WHEN last_contact_success >= $1 THEN 1 END), max(last_contact_success)
+		FROM nodes
+		WHERE exit_finished_at IS NULL
+	`), since).Scan(&stats.Nodes, &stats.Contacted, &latest)
+	if err != nil {
+		return overlay.NodeContactStats{}, err
+	}
+	if latest != nil {
+		stats.LatestContact = *latest
+	}
+
+	return stats, nil
+}
+
+// GetNodesContactedSince returns the nodes that didn't exit and were contacted since the given time after the cursor,
+// most recent first.
+func (cache *overlaycache) GetNodesContactedSince(ctx context.Context, since time.Time, cursor overlay.NodeContactCursor, limit int) (nodes []*overlay.NodeDossier, more bool, err error) {
+	for {
+		nodes, more, err = cache.getNodesContactedSince(ctx, since, cursor, limit)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, more, err
+		}
+		break
+	}
+
+	return nodes, more, err
+}
+
+func (cache *overlaycache) getNodesContactedSince(ctx context.Context, since time.Time, cursor overlay.NodeContactCursor, limit int) (nodes []*overlay.NodeDossier, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastContact interface{}
+	if !cursor.LastContact.IsZero() {
+		lastContact = cursor.LastContact
+	}
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT `+nodeDossierColumns+`
+		FROM nodes
+		WHERE exit_finished_at IS NULL AND last_contact_success >= $1
+			AND ($2::TIMESTAMPTZ IS NULL OR (last_contact_success, id) < ($2::TIMESTAMPTZ, $3))
+		ORDER BY last_contact_success DESC, id DESC
+		LIMIT $4
+	`), since, lastContact, cursor.NodeID.Bytes(), limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		node, err := scanNodeDossier(ctx, rows)
+		if err != nil {
+			return nil, false, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	end, more := pageEnd(len(nodes), limit)
+	return nodes[:end], more, nil
+}
+
 var (
 	// ErrVetting is the error class for the following test methods.
 	ErrVetting = errs.Class("vetting")
@@ -0,0 +1,158 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/repair"
+)
+
+type repairNodeStats struct {
+	db *satelliteDB
+}
+
+// Record counts the pieces a repair removed from and added to nodes, in the hour it was repaired at.
+func (stats *repairNodeStats) Record(ctx context.Context, repairedAt time.Time, removed, added storj.NodeIDList) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// a statement can't upsert the same row twice
+	type counts struct{ removed, added int64 }
+	byNode := make(map[storj.NodeID]*counts)
+	var nodeIDs []storj.NodeID
+	count := func(nodeID storj.NodeID) *counts {
+		c, ok := byNode[nodeID]
+		if !ok {
+			c = &counts{}
+			byNode[nodeID] = c
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+		return c
+	}
+	for _, nodeID := range removed {
+		count(nodeID).removed++
+	}
+	for _, nodeID := range added {
+		count(nodeID).added++
+	}
+
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	removedCounts := make([]int64, 0, len(nodeIDs))
+	addedCounts := make([]int64, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		removedCounts = append(removedCounts, byNode[nodeID].removed)
+		addedCounts = append(addedCounts, byNode[nodeID].added)
+	}
+
+	_, err = stats.db.ExecContext(ctx, `
+		INSERT INTO node_repair_rollups (node_id, interval_start, pieces_removed, pieces_added)
+		SELECT unnest($1::BYTEA[]), $2, unnest($3::INT8[]), unnest($4::INT8[])
+		ON CONFLICT (node_id, interval_start) DO UPDATE SET
+			pieces_removed = node_repair_rollups.pieces_removed + EXCLUDED.pieces_removed,
+			pieces_added = node_repair_rollups.pieces_added + EXCLUDED.pieces_added
+	`, pgutil.NodeIDArray(nodeIDs), repairedAt.UTC().Truncate(time.Hour), pgutil.Int8Array(removedCounts), pgutil.Int8Array(addedCounts))
+	return repair.NodeStatsError.Wrap(err)
+}
+
+// RecordDownloads counts the pieces repair downloaded from nodes and failed to download from them, in the hour they
+// were downloaded at.
+func (stats *repairNodeStats) RecordDownloads(ctx context.Context, downloadedAt time.Time, succeeded, failed storj.NodeIDList) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// a statement can't upsert the same row twice
+	type counts struct{ succeeded, failed int64 }
+	byNode := make(map[storj.NodeID]*counts)
+	var nodeIDs []storj.NodeID
+	count := func(nodeID storj.NodeID) *counts {
+		c, ok := byNode[nodeID]
+		if !ok {
+			c = &counts{}
+			byNode[nodeID] = c
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+		return c
+	}
+	for _, nodeID := range succeeded {
+		count(nodeID).succeeded++
+	}
+	for _, nodeID := range failed {
+		count(nodeID).failed++
+	}
+
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	succeededCounts := make([]int64, 0, len(nodeIDs))
+	failedCounts := make([]int64, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		succeededCounts = append(succeededCounts, byNode[nodeID].succeeded)
+		failedCounts = append(failedCounts, byNode[nodeID].failed)
+	}
+
+	_, err = stats.db.ExecContext(ctx, `
+		INSERT INTO node_repair_rollups (node_id, interval_start, pieces_removed, pieces_added, downloads_succeeded, downloads_failed)
+		SELECT unnest($1::BYTEA[]), $2, 0, 0, unnest($3::INT8[]), unnest($4::INT8[])
+		ON CONFLICT (node_id, interval_start) DO UPDATE SET
+			downloads_succeeded = node_repair_rollups.downloads_succeeded + EXCLUDED.downloads_succeeded,
+			downloads_failed = node_repair_rollups.downloads_failed + EXCLUDED.downloads_failed
+	`, pgutil.NodeIDArray(nodeIDs), downloadedAt.UTC().Truncate(time.Hour), pgutil.Int8Array(succeededCounts), pgutil.Int8Array(failedCounts))
+	return repair.NodeStatsError.Wrap(err)
+}
+
+// Get sums the pieces repair removed from, added to and downloaded from the node in the hours from the one since is
+// in, up to before.
+func (stats *repairNodeStats) Get(ctx context.Context, nodeID storj.NodeID, since, before time.Time) (_ repair.NodeRepairs, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	repairs := repair.NodeRepairs{NodeID: nodeID}
+	err = stats.db.QueryRowContext(ctx, `
+		SELECT coalesce(sum(pieces_removed), 0), coalesce(sum(pieces_added), 0),
+			coalesce(sum(downloads_succeeded), 0), coalesce(sum(downloads_failed), 0)
+		FROM node_repair_rollups
+		WHERE node_id = $1 AND interval_start >= $2 AND interval_start < $3
+	`, nodeID, since.UTC().Truncate(time.Hour), before.UTC()).Scan(&repairs.Removed, &repairs.Added, &repairs.DownloadsSucceeded, &repairs.DownloadsFailed)
+	if err != nil {
+		return repair.NodeRepairs{}, repair.NodeStatsError.Wrap(err)
+	}
+	return repairs, nil
+}
+
+// ListDownloads sums the pieces of every node repair downloaded from or failed to download from in the hours from the
+// one since is in, up to before.
+func (stats *repairNodeStats) ListDownloads(ctx context.Context, since, before time.Time) (_ []repair.NodeRepairs, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := stats.db.QueryContext(ctx, `
+		SELECT node_id, sum(pieces_removed), sum(pieces_added), sum(downloads_succeeded), sum(downloads_failed)
+		FROM node_repair_rollups
+		WHERE interval_start >= $1 AND interval_start < $2
+		GROUP BY node_id
+		HAVING sum(downloads_succeeded) + sum(downloads_failed) > 0
+		ORDER BY node_id
+	`, since.UTC().Truncate(time.Hour), before.UTC())
+	if err != nil {
+		return nil, repair.NodeStatsError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes []repair.NodeRepairs
+	for rows.Next() {
+		var repairs repair.NodeRepairs
+		err = rows.Scan(&repairs.NodeID, &repairs.Removed, &repairs.Added, &repairs.DownloadsSucceeded, &repairs.DownloadsFailed)
+		if err != nil {
+			return nil, repair.NodeStatsError.Wrap(err)
+		}
+		nodes = append(nodes, repairs)
+	}
+	return nodes, repair.NodeStatsError.Wrap(rows.Err())
+}
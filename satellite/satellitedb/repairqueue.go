@@ -7,6 +7,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -229,6 +231,22 @@ func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, er
 	return &segment, err
 }
 
+func (r *repairQueue) Get(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (_ *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment := queue.InjuredSegment{}
+	err = r.db.QueryRowContext(ctx, r.db.Rebind(`
+		SELECT stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+		FROM repair_queue
+		WHERE stream_id = ? AND position = ?
+	`), streamID, position.Encode()).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
+		&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &segment, nil
+}
+
 func (r *repairQueue) Delete(ctx context.Context, seg *queue.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	_, err = r.db.ExecContext(ctx, r.db.Rebind(`DELETE FROM repair_queue WHERE stream_id = ? AND position = ?`), seg.StreamID, seg.Position.Encode())
@@ -278,6 +296,118 @@ func (r *repairQueue) Count(ctx context.Context) (count int, err error) {
 	return count, Error.Wrap(err)
 }
 
+// Stats returns the size of the queue, its oldest segment and the distribution of segment health.
+func (r *repairQueue) Stats(ctx context.Context, buckets int) (stats queue.Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var oldest *time.Time
+	var minHealth, maxHealth *float64
+	err = r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*), MIN(inserted_at), MIN(segment_health), MAX(segment_health) FROM repair_queue
+	`).Scan(&stats.Count, &oldest, &minHealth, &maxHealth)
+	if err != nil {
+		return queue.Stats{}, Error.Wrap(err)
+	}
+	if stats.Count == 0 {
+		return stats, nil
+	}
+
+	stats.OldestInsertedAt = oldest
+	stats.MinHealth, stats.MaxHealth = *minHealth, *maxHealth
+
+	// all segments share a single bucket when their health doesn't differ
+	if buckets <= 1 || stats.MaxHealth == stats.MinHealth {
+		stats.HealthBuckets = []queue.HealthBucket{{Lower: stats.MinHealth, Upper: stats.MaxHealth, Count: stats.Count}}
+		return stats, nil
+	}
+
+	width := (stats.MaxHealth - stats.MinHealth) / float64(buckets)
+	stats.HealthBuckets = make([]queue.HealthBucket, buckets)
+	for i := range stats.HealthBuckets {
+		stats.HealthBuckets[i].Lower = stats.MinHealth + float64(i)*width
+		stats.HealthBuckets[i].Upper = stats.MinHealth + float64(i+1)*width
+	}
+	stats.HealthBuckets[buckets-1].Upper = stats.MaxHealth
+
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`
+		SELECT LEAST(FLOOR((segment_health - ?) / ?), ?)::INT8 AS bucket, COUNT(*)
+		FROM repair_queue
+		GROUP BY bucket
+	`), stats.MinHealth, width, buckets-1)
+	if err != nil {
+		return queue.Stats{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var bucket, count int64
+		if err := rows.Scan(&bucket, &count); err != nil {
+			return queue.Stats{}, Error.Wrap(err)
+		}
+		if bucket >= 0 && bucket < int64(buckets) {
+			stats.HealthBuckets[bucket].Count += count
+		}
+	}
+
+	return stats, Error.Wrap(rows.Err())
+}
+
+// AgeHistogram returns the size of the queue, its oldest segment and how long before now the segments were inserted.
+func (r *repairQueue) AgeHistogram(ctx context.Context, now time.Time, bounds []time.Duration) (histogram queue.AgeHistogram, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*), MIN(inserted_at) FROM repair_queue
+	`).Scan(&histogram.Count, &histogram.OldestInsertedAt)
+	if err != nil {
+		return queue.AgeHistogram{}, Error.Wrap(err)
+	}
+
+	histogram.Ranges = make([]queue.AgeRange, len(bounds)+1)
+	var lower time.Duration
+	for i, bound := range bounds {
+		histogram.Ranges[i] = queue.AgeRange{Lower: lower, Upper: bound}
+		lower = bound
+	}
+	histogram.Ranges[len(bounds)] = queue.AgeRange{Lower: lower}
+
+	if histogram.Count == 0 {
+		return histogram, nil
+	}
+
+	// a segment falls into the first range whose upper bound is after the time it was inserted at
+	var bucket strings.Builder
+	args := make([]interface{}, 0, len(bounds)+1)
+	bucket.WriteString("CASE")
+	for i, bound := range bounds {
+		fmt.Fprintf(&bucket, " WHEN inserted_at > ? THEN %d", i)
+		args = append(args, now.Add(-bound))
+	}
+	fmt.Fprintf(&bucket, " ELSE %d END", len(bounds))
+
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`
+		SELECT `+bucket.String()+` AS bucket, COUNT(*)
+		FROM repair_queue
+		GROUP BY bucket
+	`), args...)
+	if err != nil {
+		return queue.AgeHistogram{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var bucket, count int64
+		if err := rows.Scan(&bucket, &count); err != nil {
+			return queue.AgeHistogram{}, Error.Wrap(err)
+		}
+		if bucket >= 0 && bucket < int64(len(histogram.Ranges)) {
+			histogram.Ranges[bucket].Count += count
+		}
+	}
+
+	return histogram, Error.Wrap(rows.Err())
+}
+
 // TestingSetAttemptedTime sets attempted time for a segment.
 func (r *repairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID,
 	position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error) {
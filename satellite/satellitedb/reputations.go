@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -261,6 +262,101 @@ func (reputations *reputations) SuspendNodeUnknownAudit(ctx context.Context, nod
 	return Error.Wrap(err)
 }
 
+// UpdateAuditHistory replaces a node's audit history and sets its online score to the score of the history.
+func (reputations *reputations) UpdateAuditHistory(ctx context.Context, nodeID storj.NodeID, history *pb.AuditHistory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	historyBytes, err := pb.Marshal(history)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	updated, err := reputations.db.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), dbx.Reputation_Update_Fields{
+		AuditHistory: dbx.Reputation_AuditHistory(historyBytes),
+		OnlineScore:  dbx.Reputation_OnlineScore(history.Score),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == nil {
+		return reputation.ErrNodeNotFound.New("no reputation entry for node")
+	}
+
+	return nil
+}
+
+// GetNodesBelowOnlineScore returns the online scoring data of nodes that aren't disqualified and whose online score is
+// below the threshold.
+func (reputations *reputations) GetNodesBelowOnlineScore(ctx context.Context, threshold float64) (_ []reputation.NodeOnlineScore, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reputations.db.QueryContext(ctx, reputations.db.Rebind(`
+		SELECT id, online_score, audit_history, offline_suspended, under_review
+		FROM reputations
+		WHERE disqualified IS NULL
+			AND online_score < ?
+	`), threshold)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var scores []reputation.NodeOnlineScore
+	for rows.Next() {
+		var score reputation.NodeOnlineScore
+		var history []byte
+		err = rows.Scan(&score.NodeID, &score.OnlineScore, &history, &score.OfflineSuspended, &score.UnderReview)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		score.AuditHistory = &pb.AuditHistory{}
+		if err := pb.Unmarshal(history, score.AuditHistory); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		scores = append(scores, score)
+	}
+
+	return scores, Error.Wrap(rows.Err())
+}
+
+// GetAuditScores returns the audit scores of the nodes that have a reputation entry.
+func (reputations *reputations) GetAuditScores(ctx context.Context, nodeIDs []storj.NodeID) (_ map[storj.NodeID]float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scores := make(map[storj.NodeID]float64, len(nodeIDs))
+	if len(nodeIDs) == 0 {
+		return scores, nil
+	}
+
+	rows, err := reputations.db.QueryContext(ctx, reputations.db.Rebind(`
+		SELECT id, audit_reputation_alpha, audit_reputation_beta
+		FROM reputations
+		WHERE id = ANY(?::BYTEA[])
+	`), pgutil.NodeIDArray(nodeIDs))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var nodeID storj.NodeID
+		var alpha, beta float64
+		if err := rows.Scan(&nodeID, &alpha, &beta); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		var score float64
+		if sum := alpha + beta; sum > 0 {
+			score = alpha / sum
+		}
+		scores[nodeID] = score
+	}
+
+	return scores, Error.Wrap(rows.Err())
+}
+
 // UnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
 func (reputations *reputations) UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -300,6 +396,52 @@ func (reputations *reputations) UnsuspendNodeUnknownAudit(ctx context.Context, n
 	return Error.Wrap(err)
 }
 
+// ReinstateNode lifts the suspensions of a node that isn't disqualified. The unknown audit reputation is reset to that
+// of a new node, and the audit history to an empty one with a perfect online score.
+func (reputations *reputations) ReinstateNode(ctx context.Context, nodeID storj.NodeID, reinstatement reputation.Reinstatement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+		_, err = tx.Tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+		if err != nil {
+			return err
+		}
+
+		dbNode, err := tx.Get_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()))
+		if errors.Is(err, sql.ErrNoRows) {
+			return reputation.ErrNodeNotFound.New("no reputation entry for node")
+		}
+		if err != nil {
+			return err
+		}
+		if dbNode.Disqualified != nil {
+			return reputation.ErrNodeDisqualified.New("%s", nodeID)
+		}
+
+		updateFields := dbx.Reputation_Update_Fields{}
+		if reinstatement.UnknownAudit {
+			updateFields.UnknownAuditSuspended = dbx.Reputation_UnknownAuditSuspended_Null()
+			updateFields.UnknownAuditReputationAlpha = dbx.Reputation_UnknownAuditReputationAlpha(1)
+			updateFields.UnknownAuditReputationBeta = dbx.Reputation_UnknownAuditReputationBeta(0)
+		}
+		if reinstatement.Offline {
+			historyBytes, err := pb.Marshal(&pb.AuditHistory{Score: 1})
+			if err != nil {
+				return err
+			}
+
+			updateFields.OfflineSuspended = dbx.Reputation_OfflineSuspended_Null()
+			updateFields.UnderReview = dbx.Reputation_UnderReview_Null()
+			updateFields.AuditHistory = dbx.Reputation_AuditHistory(historyBytes)
+			updateFields.OnlineScore = dbx.Reputation_OnlineScore(1)
+		}
+
+		_, err = tx.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), updateFields)
+		return err
+	})
+	return Error.Wrap(err)
+}
+
 func (reputations *reputations) populateCreateFields(update updateNodeStats) dbx.Reputation_Create_Fields {
 	createFields := dbx.Reputation_Create_Fields{}
 
@@ -0,0 +1,51 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/gc/sender"
+)
+
+type sentFilters struct {
+	db *satelliteDB
+}
+
+// Record replaces the filter remembered for the node.
+func (filters *sentFilters) Record(ctx context.Context, filter sender.SentFilter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = filters.db.ExecContext(ctx, `
+		INSERT INTO node_retain_filters (node_id, sent_at, creation_date, piece_count)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (node_id) DO UPDATE SET
+			sent_at = EXCLUDED.sent_at,
+			creation_date = EXCLUDED.creation_date,
+			piece_count = EXCLUDED.piece_count
+	`, filter.NodeID, filter.SentAt.UTC(), filter.CreationDate.UTC(), filter.PieceCount)
+	return sender.SentFiltersError.Wrap(err)
+}
+
+// Get returns the latest filter sent to the node.
+func (filters *sentFilters) Get(ctx context.Context, nodeID storj.NodeID) (_ sender.SentFilter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	filter := sender.SentFilter{NodeID: nodeID}
+	err = filters.db.QueryRowContext(ctx, `
+		SELECT sent_at, creation_date, piece_count
+		FROM node_retain_filters
+		WHERE node_id = $1
+	`, nodeID).Scan(&filter.SentAt, &filter.CreationDate, &filter.PieceCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sender.SentFilter{}, sender.ErrFilterNotSent.New("%s", nodeID)
+	}
+	if err != nil {
+		return sender.SentFilter{}, sender.SentFiltersError.Wrap(err)
+	}
+	return filter, nil
+}
@@ -531,6 +531,37 @@ func (db *StoragenodeAccounting) QueryStorageNodeUsage(ctx context.Context, node
 	return nodeStorageUsages, rows.Err()
 }
 
+// QueryStorageNodeUsageSince returns the rollups of data at rest of every node started since the given time, ordered by
+// node and start time.
+func (db *StoragenodeAccounting) QueryStorageNodeUsageSince(ctx context.Context, since time.Time) (_ []accounting.StorageNodeUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// TODO: remove COALESCE when interval_end_time is non-nullable, see QueryStorageNodeUsage
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT node_id, at_rest_total, start_time,
+			COALESCE(interval_end_time, start_time) AS interval_end_time
+		FROM accounting_rollups
+		WHERE start_time >= ?
+		ORDER BY node_id, start_time
+	`), since.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var usages []accounting.StorageNodeUsage
+	for rows.Next() {
+		var usage accounting.StorageNodeUsage
+		err = rows.Scan(&usage.NodeID, &usage.StorageUsed, &usage.Timestamp, &usage.IntervalEndTime)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, Error.Wrap(rows.Err())
+}
+
 // DeleteTalliesBefore deletes all raw tallies prior to some time.
 func (db *StoragenodeAccounting) DeleteTalliesBefore(ctx context.Context, latestRollup time.Time, batchSize int) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -352,6 +352,10 @@ func toUpdateUser(request console.UpdateUserRequest) (*dbx.User_Update_Fields, e
 		}
 	}
 
+	if request.EmailUnverified != nil {
+		update.EmailUnverified = dbx.User_EmailUnverified(*request.EmailUnverified)
+	}
+
 	return &update, nil
 }
 
@@ -392,6 +396,7 @@ func userFromDBX(ctx context.Context, user *dbx.User) (_ *console.User, err erro
 		MFAEnabled:            user.MfaEnabled,
 		VerificationReminders: user.VerificationReminders,
 		SignupCaptcha:         user.SignupCaptcha,
+		EmailUnverified:       user.EmailUnverified,
 	}
 
 	if user.PartnerId != nil {